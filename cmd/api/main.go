@@ -7,27 +7,53 @@ import (
 
 	"github.com/gin-contrib/graceful"
 	"github.com/gin-gonic/gin"
+	"github.com/redis/go-redis/v9"
 	"github.com/rs/zerolog/log"
 	"github.com/snilli/ormprovider"
 	"go.uber.org/fx"
 
 	"gin-swagger-api/config"
 	_ "gin-swagger-api/docs"
+	"gin-swagger-api/internal/domain"
 	"gin-swagger-api/internal/handler"
+	"gin-swagger-api/internal/handler/couponhdl"
+	"gin-swagger-api/internal/handler/httperr"
 	"gin-swagger-api/internal/handler/orderhdl"
 	"gin-swagger-api/internal/handler/producthdl"
+	"gin-swagger-api/internal/handler/ratehdl"
+	"gin-swagger-api/internal/handler/reporthdl"
 	"gin-swagger-api/internal/handler/userhdl"
+	"gin-swagger-api/internal/middleware"
+	portcouponrepo "gin-swagger-api/internal/port/repository/couponrepo"
+	portidempotencyrepo "gin-swagger-api/internal/port/repository/idempotencyrepo"
+	portordereventrepo "gin-swagger-api/internal/port/repository/ordereventrepo"
 	portorderrepo "gin-swagger-api/internal/port/repository/orderrepo"
 	portproductrepo "gin-swagger-api/internal/port/repository/productrepo"
+	portraterepo "gin-swagger-api/internal/port/repository/raterepo"
+	portreportrepo "gin-swagger-api/internal/port/repository/reportrepo"
+	porttxmanager "gin-swagger-api/internal/port/repository/txmanager"
 	portuserrepo "gin-swagger-api/internal/port/repository/userrepo"
+	portcouponsvc "gin-swagger-api/internal/port/service/couponsvc"
 	portordersvc "gin-swagger-api/internal/port/service/ordersvc"
 	portproductsvc "gin-swagger-api/internal/port/service/productsvc"
+	portratesvc "gin-swagger-api/internal/port/service/ratesvc"
+	portreportsvc "gin-swagger-api/internal/port/service/reportsvc"
 	portusersvc "gin-swagger-api/internal/port/service/usersvc"
+	"gin-swagger-api/internal/repository/couponrepo"
+	"gin-swagger-api/internal/repository/idempotencyredis"
+	"gin-swagger-api/internal/repository/idempotencyrepo"
+	"gin-swagger-api/internal/repository/ordereventrepo"
 	"gin-swagger-api/internal/repository/orderrepo"
 	"gin-swagger-api/internal/repository/productrepo"
+	"gin-swagger-api/internal/repository/raterepo"
+	"gin-swagger-api/internal/repository/reportrepo"
+	"gin-swagger-api/internal/repository/txmanager"
 	"gin-swagger-api/internal/repository/userrepo"
+	"gin-swagger-api/internal/service/couponsvc"
 	"gin-swagger-api/internal/service/ordersvc"
 	"gin-swagger-api/internal/service/productsvc"
+	"gin-swagger-api/internal/service/ratesvc"
+	"gin-swagger-api/internal/service/reportsvc"
 	"gin-swagger-api/internal/service/usersvc"
 )
 
@@ -68,21 +94,45 @@ func main() {
 				orderrepo.New,
 				fx.As(new(portorderrepo.Repository)),
 			),
+			fx.Annotate(
+				ordereventrepo.New,
+				fx.As(new(portordereventrepo.Repository)),
+			),
+			fx.Annotate(
+				reportrepo.New,
+				fx.As(new(portreportrepo.Repository)),
+			),
+			fx.Annotate(
+				couponrepo.New,
+				fx.As(new(portcouponrepo.Repository)),
+			),
+			fx.Annotate(
+				txmanager.New,
+				fx.As(new(porttxmanager.Manager)),
+			),
+			provideIdempotencyRepository,
+			provideRateRepository,
 		),
 
 		// Provide services
 		fx.Provide(
+			provideUserService,
+			provideProductService,
 			fx.Annotate(
-				usersvc.New,
-				fx.As(new(portusersvc.Service)),
+				ordersvc.New,
+				fx.As(new(portordersvc.Service)),
 			),
 			fx.Annotate(
-				productsvc.New,
-				fx.As(new(portproductsvc.Service)),
+				reportsvc.New,
+				fx.As(new(portreportsvc.Service)),
 			),
 			fx.Annotate(
-				ordersvc.New,
-				fx.As(new(portordersvc.Service)),
+				ratesvc.New,
+				fx.As(new(portratesvc.Service)),
+			),
+			fx.Annotate(
+				couponsvc.New,
+				fx.As(new(portcouponsvc.Service)),
 			),
 		),
 
@@ -92,6 +142,9 @@ func main() {
 			userhdl.NewHandler,
 			producthdl.NewHandler,
 			orderhdl.NewHandler,
+			reporthdl.NewHandler,
+			ratehdl.NewHandler,
+			couponhdl.NewHandler,
 		),
 
 		// Provide Gin engine
@@ -155,12 +208,80 @@ func provideDatabase(lc fx.Lifecycle, cfg *config.Config) (*ormprovider.Client,
 	return db, nil
 }
 
+// provideIdempotencyRepository creates the idempotency key store selected
+// by IDEMPOTENCY_STORE
+func provideIdempotencyRepository(lc fx.Lifecycle, cfg *config.Config, db *ormprovider.Client) portidempotencyrepo.Repository {
+	if cfg.IdempotencyStore != "redis" {
+		return idempotencyrepo.New(db)
+	}
+
+	client := redis.NewClient(&redis.Options{
+		Addr:     cfg.RedisAddr(),
+		Password: cfg.RedisPassword,
+		DB:       cfg.RedisDB,
+	})
+
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			if err := client.Ping(ctx).Err(); err != nil {
+				return fmt.Errorf("failed to connect to redis: %w", err)
+			}
+			log.Info().Str("address", cfg.RedisAddr()).Msg("Connected to redis successfully")
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			log.Info().Msg("Closing redis connection")
+			return client.Close()
+		},
+	})
+
+	return idempotencyredis.New(client)
+}
+
+// provideRateRepository creates the exchange rate repository, seeding an
+// empty rate table from EXCHANGE_RATES_FILE on startup
+func provideRateRepository(lc fx.Lifecycle, cfg *config.Config, db *ormprovider.Client) portraterepo.Repository {
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			if err := raterepo.Seed(ctx, db, cfg.ExchangeRatesFile); err != nil {
+				return fmt.Errorf("failed to seed exchange rates: %w", err)
+			}
+			return nil
+		},
+	})
+
+	return raterepo.New(db)
+}
+
+// provideUserService creates the user service with USER_DELETE_POLICY
+func provideUserService(userRepo portuserrepo.Repository, orderRepo portorderrepo.Repository, productRepo portproductrepo.Repository, txManager porttxmanager.Manager, cfg *config.Config) portusersvc.Service {
+	return usersvc.New(userRepo, orderRepo, productRepo, txManager, domain.DeletePolicy(cfg.UserDeletePolicy))
+}
+
+// provideProductService creates the product service with
+// PRODUCT_DELETE_POLICY
+func provideProductService(productRepo portproductrepo.Repository, orderRepo portorderrepo.Repository, txManager porttxmanager.Manager, cfg *config.Config) portproductsvc.Service {
+	return productsvc.New(productRepo, orderRepo, txManager, domain.DeletePolicy(cfg.ProductDeletePolicy))
+}
+
 // provideGinEngine creates and configures Gin engine
-func provideGinEngine() *gin.Engine {
+func provideGinEngine(cfg *config.Config, idempotencyRepo portidempotencyrepo.Repository) (*gin.Engine, error) {
+	if err := httperr.SetupValidator(); err != nil {
+		return nil, fmt.Errorf("failed to set up request validation: %w", err)
+	}
+
 	r := gin.New()
+	r.Use(middleware.RequestID())
 	r.Use(gin.Logger())
 	r.Use(gin.Recovery())
-	return r
+	r.Use(middleware.Admin(cfg.AdminAPIKeys))
+	r.Use(middleware.Idempotency(idempotencyRepo, middleware.IdempotencyConfig{
+		TTL:         time.Duration(cfg.IdempotencyTTL) * time.Hour,
+		Lease:       time.Duration(cfg.IdempotencyLease) * time.Second,
+		MaxBodySize: cfg.MaxUploadSize,
+	}))
+	r.Use(middleware.Preconditions(cfg.RequireIfMatch))
+	return r, nil
 }
 
 // runServer sets up routes and starts the server
@@ -172,6 +293,9 @@ func runServer(
 	userHandler *userhdl.Handler,
 	productHandler *producthdl.Handler,
 	orderHandler *orderhdl.Handler,
+	reportHandler *reporthdl.Handler,
+	rateHandler *ratehdl.Handler,
+	couponHandler *couponhdl.Handler,
 ) {
 	// Register routes
 	systemHandler.RegisterRoutes(r)
@@ -181,6 +305,9 @@ func runServer(
 		userHandler.RegisterRoutes(v1)
 		productHandler.RegisterRoutes(v1)
 		orderHandler.RegisterRoutes(v1)
+		reportHandler.RegisterRoutes(v1)
+		rateHandler.RegisterRoutes(v1)
+		couponHandler.RegisterRoutes(v1)
 	}
 
 	log.Info().
@@ -3,12 +3,16 @@ package config
 import (
 	"fmt"
 	"os"
+	"slices"
+	"strings"
 	"sync"
 
 	"github.com/joho/godotenv"
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
 	"go-simpler.org/env"
+
+	"gin-swagger-api/internal/domain"
 )
 
 type Config struct {
@@ -26,6 +30,8 @@ type Config struct {
 	JWTSecret     string `env:"JWT_SECRET" default:"your-secret-key-change-this"`
 	JWTExpiration int    `env:"JWT_EXPIRATION" default:"24"`
 
+	AdminAPIKeys []string `env:"ADMIN_API_KEYS"`
+
 	APIVersion    string `env:"API_VERSION" default:"v1"`
 	APITimeout    int    `env:"API_TIMEOUT" default:"30"`
 	RateLimitRPS  int    `env:"RATE_LIMIT_RPS" default:"100"`
@@ -36,10 +42,21 @@ type Config struct {
 	RedisPassword string `env:"REDIS_PASSWORD"`
 	RedisDB       int    `env:"REDIS_DB" default:"0"`
 
+	IdempotencyStore string `env:"IDEMPOTENCY_STORE" default:"db"`
+	IdempotencyTTL   int    `env:"IDEMPOTENCY_TTL" default:"24"`
+	IdempotencyLease int    `env:"IDEMPOTENCY_LEASE" default:"60"`
+
 	EnableSwagger bool `env:"ENABLE_SWAGGER" default:"true"`
 	EnableCORS    bool `env:"ENABLE_CORS" default:"true"`
 	EnableMetrics bool `env:"ENABLE_METRICS" default:"false"`
 
+	RequireIfMatch bool `env:"REQUIRE_IF_MATCH" default:"false"`
+
+	UserDeletePolicy    string `env:"USER_DELETE_POLICY" default:"restrict"`
+	ProductDeletePolicy string `env:"PRODUCT_DELETE_POLICY" default:"restrict"`
+
+	ExchangeRatesFile string `env:"EXCHANGE_RATES_FILE" default:"config/exchange_rates.json"`
+
 	LogLevel  string `env:"LOG_LEVEL" default:"info"`
 	LogFormat string `env:"LOG_FORMAT" default:"json"`
 }
@@ -105,9 +122,42 @@ func (c *Config) Validate() error {
 		return fmt.Errorf("LOG_FORMAT must be one of: json, text")
 	}
 
+	if c.IdempotencyStore != "db" && c.IdempotencyStore != "redis" {
+		return fmt.Errorf("IDEMPOTENCY_STORE must be one of: db, redis")
+	}
+
+	if c.IdempotencyTTL <= 0 {
+		return fmt.Errorf("IDEMPOTENCY_TTL must be a positive number of hours")
+	}
+
+	if c.IdempotencyLease <= 0 {
+		return fmt.Errorf("IDEMPOTENCY_LEASE must be a positive number of seconds")
+	}
+
+	if err := checkDeletePolicy("USER_DELETE_POLICY", c.UserDeletePolicy); err != nil {
+		return err
+	}
+
+	if err := checkDeletePolicy("PRODUCT_DELETE_POLICY", c.ProductDeletePolicy); err != nil {
+		return err
+	}
+
 	return nil
 }
 
+// checkDeletePolicy reports a policy that is not one of
+// domain.DeletePolicies as an error of the variable env
+func checkDeletePolicy(env, policy string) error {
+	if slices.Contains(domain.DeletePolicies, domain.DeletePolicy(policy)) {
+		return nil
+	}
+	names := make([]string, len(domain.DeletePolicies))
+	for i, p := range domain.DeletePolicies {
+		names[i] = string(p)
+	}
+	return fmt.Errorf("%s must be one of: %s", env, strings.Join(names, ", "))
+}
+
 func (c *Config) DatabaseDSN() string {
 	return fmt.Sprintf(
 		"host=%s port=%s user=%s password=%s dbname=%s sslmode=%s",
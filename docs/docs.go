@@ -24,62 +24,66 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/health": {
+        "/coupons": {
             "get": {
-                "description": "Check if the service is running",
+                "description": "Get the coupons in ID order, paginated by offset, with how often each has been redeemed.\nRequires an admin API key.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "health"
+                    "coupons"
                 ],
-                "summary": "Health check",
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
-                        }
+                "summary": "Get coupons",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Page size (default 20, max 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of items to skip",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Admin API key",
+                        "name": "X-API-Key",
+                        "in": "header",
+                        "required": true
                     }
-                }
-            }
-        },
-        "/orders": {
-            "get": {
-                "description": "Get a list of all orders",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "orders"
                 ],
-                "summary": "List all orders",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/orderhdl.OrderResponse"
-                            }
+                            "$ref": "#/definitions/pagination.Response-couponhdl_CouponResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/orderhdl.ErrorResponse"
+                            "$ref": "#/definitions/httperr.Problem"
                         }
                     }
                 }
             },
             "post": {
-                "description": "Create a new order with the provided information",
+                "description": "Create a discount code customers can send as coupon_code when they place an order.\nCodes are matched regardless of case and stored upper-cased; a code that is taken is rejected with 409.\nA percentage coupon needs a percent from 1 to 100 and a fixed coupon a positive amount; invalid terms are rejected with 422 naming the field.\nRequires an admin API key.",
                 "consumes": [
                     "application/json"
                 ],
@@ -87,87 +91,140 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "orders"
+                    "coupons"
                 ],
-                "summary": "Create a new order",
+                "summary": "Create a coupon",
                 "parameters": [
                     {
-                        "description": "Order information",
-                        "name": "order",
+                        "description": "Coupon terms",
+                        "name": "coupon",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/orderhdl.CreateOrderRequest"
+                            "$ref": "#/definitions/couponhdl.CouponRequest"
                         }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Admin API key",
+                        "name": "X-API-Key",
+                        "in": "header",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/orderhdl.OrderResponse"
+                            "$ref": "#/definitions/couponhdl.CouponResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Entity tag of the current coupon version"
+                            }
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/orderhdl.ErrorResponse"
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/orderhdl.ErrorResponse"
+                            "$ref": "#/definitions/httperr.Problem"
                         }
                     }
                 }
             }
         },
-        "/orders/{id}": {
+        "/coupons/{id}": {
             "get": {
-                "description": "Get an order by its ID",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Get a coupon by ID. Requires an admin API key.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "orders"
+                    "coupons"
                 ],
-                "summary": "Get order by ID",
+                "summary": "Get a coupon",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Order ID",
+                        "description": "Coupon ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Admin API key",
+                        "name": "X-API-Key",
+                        "in": "header",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/orderhdl.OrderResponse"
+                            "$ref": "#/definitions/couponhdl.CouponResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Entity tag of the current coupon version"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
                         }
                     },
                     "404": {
                         "description": "Not Found",
                         "schema": {
-                            "$ref": "#/definitions/orderhdl.ErrorResponse"
+                            "$ref": "#/definitions/httperr.Problem"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/orderhdl.ErrorResponse"
+                            "$ref": "#/definitions/httperr.Problem"
                         }
                     }
                 }
             },
             "put": {
-                "description": "Update an order's information by ID",
+                "description": "Replace the terms of a coupon by ID. Orders already placed keep the discount they got, and redemptions so far count towards the new limits.\nRequires an admin API key.",
                 "consumes": [
                     "application/json"
                 ],
@@ -175,188 +232,226 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "orders"
+                    "coupons"
                 ],
-                "summary": "Update an order",
+                "summary": "Update a coupon",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Order ID",
+                        "description": "Coupon ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Order information",
-                        "name": "order",
+                        "description": "Coupon terms",
+                        "name": "coupon",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/orderhdl.UpdateOrderRequest"
+                            "$ref": "#/definitions/couponhdl.CouponRequest"
                         }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Admin API key",
+                        "name": "X-API-Key",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag the coupon must still have; required when the server enforces preconditions",
+                        "name": "If-Match",
+                        "in": "header"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/orderhdl.OrderResponse"
+                            "$ref": "#/definitions/couponhdl.CouponResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Entity tag of the current coupon version"
+                            }
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/orderhdl.ErrorResponse"
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
                         }
                     },
                     "404": {
                         "description": "Not Found",
                         "schema": {
-                            "$ref": "#/definitions/orderhdl.ErrorResponse"
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "428": {
+                        "description": "Precondition Required",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/orderhdl.ErrorResponse"
+                            "$ref": "#/definitions/httperr.Problem"
                         }
                     }
                 }
             },
             "delete": {
-                "description": "Delete an order by ID",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
+                "description": "Delete a coupon by ID. A coupon that has been redeemed is kept for the orders it was applied to and is rejected with 409; set its ends_at to stop it being used instead.\nRequires an admin API key.",
                 "tags": [
-                    "orders"
+                    "coupons"
                 ],
-                "summary": "Delete an order",
+                "summary": "Delete a coupon",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Order ID",
+                        "description": "Coupon ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Admin API key",
+                        "name": "X-API-Key",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag the coupon must still have; required when the server enforces preconditions",
+                        "name": "If-Match",
+                        "in": "header"
                     }
                 ],
                 "responses": {
                     "204": {
                         "description": "No Content"
                     },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
-                            "$ref": "#/definitions/orderhdl.ErrorResponse"
+                            "$ref": "#/definitions/httperr.Problem"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "409": {
+                        "description": "Conflict",
                         "schema": {
-                            "$ref": "#/definitions/orderhdl.ErrorResponse"
+                            "$ref": "#/definitions/httperr.Problem"
                         }
-                    }
-                }
-            }
-        },
-        "/products": {
-            "get": {
-                "description": "Get a list of all products",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "products"
-                ],
-                "summary": "List all products",
-                "responses": {
-                    "200": {
-                        "description": "OK",
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/producthdl.ProductResponse"
-                            }
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "428": {
+                        "description": "Precondition Required",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/producthdl.ErrorResponse"
+                            "$ref": "#/definitions/httperr.Problem"
                         }
                     }
                 }
-            },
-            "post": {
-                "description": "Create a new product with the provided information",
-                "consumes": [
-                    "application/json"
-                ],
+            }
+        },
+        "/exchange-rates": {
+            "get": {
+                "description": "Get the rates that convert product prices from the base currency to the other currencies orders can be placed in, ordered by currency.\nThe base currency is not listed; its rate is always 1.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "products"
-                ],
-                "summary": "Create a new product",
-                "parameters": [
-                    {
-                        "description": "Product information",
-                        "name": "product",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/producthdl.CreateProductRequest"
-                        }
-                    }
+                    "exchange-rates"
                 ],
+                "summary": "Get all exchange rates",
                 "responses": {
-                    "201": {
-                        "description": "Created",
-                        "schema": {
-                            "$ref": "#/definitions/producthdl.ProductResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/producthdl.ErrorResponse"
+                            "$ref": "#/definitions/ratehdl.ExchangeRatesResponse"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/producthdl.ErrorResponse"
+                            "$ref": "#/definitions/httperr.Problem"
                         }
                     }
                 }
             }
         },
-        "/products/{id}": {
+        "/exchange-rates/{currency}": {
             "get": {
-                "description": "Get a product by its ID",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Get the rate of a currency; the base currency always has rate 1.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "products"
+                    "exchange-rates"
                 ],
-                "summary": "Get product by ID",
+                "summary": "Get an exchange rate",
                 "parameters": [
                     {
+                        "enum": [
+                            "THB",
+                            "USD",
+                            "EUR"
+                        ],
                         "type": "string",
-                        "description": "Product ID",
-                        "name": "id",
+                        "description": "ISO 4217 currency code",
+                        "name": "currency",
                         "in": "path",
                         "required": true
                     }
@@ -365,25 +460,31 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/producthdl.ProductResponse"
+                            "$ref": "#/definitions/ratehdl.ExchangeRateResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
                         }
                     },
                     "404": {
                         "description": "Not Found",
                         "schema": {
-                            "$ref": "#/definitions/producthdl.ErrorResponse"
+                            "$ref": "#/definitions/httperr.Problem"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/producthdl.ErrorResponse"
+                            "$ref": "#/definitions/httperr.Problem"
                         }
                     }
                 }
             },
             "put": {
-                "description": "Update a product's information by ID",
+                "description": "Add or replace the rate of a currency, a positive decimal with at most 10 places giving the units of the currency per unit of the base currency.\nOrders already placed keep the rate they were placed at. The rate of the base currency cannot be changed.\nRequires an admin API key.",
                 "consumes": [
                     "application/json"
                 ],
@@ -391,124 +492,225 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "products"
+                    "exchange-rates"
                 ],
-                "summary": "Update a product",
+                "summary": "Set an exchange rate",
                 "parameters": [
                     {
+                        "enum": [
+                            "USD",
+                            "EUR"
+                        ],
                         "type": "string",
-                        "description": "Product ID",
-                        "name": "id",
+                        "description": "ISO 4217 currency code",
+                        "name": "currency",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Product information",
-                        "name": "product",
+                        "description": "Exchange rate",
+                        "name": "rate",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/producthdl.UpdateProductRequest"
+                            "$ref": "#/definitions/ratehdl.SetExchangeRateRequest"
                         }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Admin API key",
+                        "name": "X-API-Key",
+                        "in": "header",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/producthdl.ProductResponse"
+                            "$ref": "#/definitions/ratehdl.ExchangeRateResponse"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/producthdl.ErrorResponse"
+                            "$ref": "#/definitions/httperr.Problem"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
-                            "$ref": "#/definitions/producthdl.ErrorResponse"
+                            "$ref": "#/definitions/httperr.Problem"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/producthdl.ErrorResponse"
+                            "$ref": "#/definitions/httperr.Problem"
                         }
                     }
                 }
             },
             "delete": {
-                "description": "Delete a product by ID",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
+                "description": "Remove the rate of a currency, so that no new orders can be placed in it. Orders already placed keep their rate.\nThe rate of the base currency cannot be deleted. Requires an admin API key.",
                 "tags": [
-                    "products"
+                    "exchange-rates"
                 ],
-                "summary": "Delete a product",
+                "summary": "Delete an exchange rate",
                 "parameters": [
                     {
+                        "enum": [
+                            "USD",
+                            "EUR"
+                        ],
                         "type": "string",
-                        "description": "Product ID",
-                        "name": "id",
+                        "description": "ISO 4217 currency code",
+                        "name": "currency",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Admin API key",
+                        "name": "X-API-Key",
+                        "in": "header",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "204": {
                         "description": "No Content"
                     },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
-                            "$ref": "#/definitions/producthdl.ErrorResponse"
+                            "$ref": "#/definitions/httperr.Problem"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/producthdl.ErrorResponse"
+                            "$ref": "#/definitions/httperr.Problem"
                         }
                     }
                 }
             }
         },
-        "/users": {
+        "/health": {
             "get": {
-                "description": "Get all users from the system",
+                "description": "Check if the service is running",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "system"
                 ],
-                "summary": "Get all users",
+                "summary": "Health check",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/userhdl.UserResponse"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
                             }
                         }
+                    }
+                }
+            }
+        },
+        "/orders": {
+            "get": {
+                "description": "Get a list of all orders, paginated by offset or cursor.\nFilter with filter[field]=value or filter[field][op]=value where op is one of eq, ne, in (comma separated values), gt and lt (numeric fields) or like (text fields, case-insensitive substring).\nFilterable and sortable fields: id, user_id, total_price (in THB, compared with the total of each order converted at its rate), currency, status, created_at (a date or RFC 3339 time).\nExample: ?filter[status]=pending\u0026filter[total_price][gt]=100\u0026sort=-total_price\nEmbed related resources with expand=user,product; each relation is loaded with one query for the whole page.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "orders"
+                ],
+                "summary": "List all orders",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Page size (default 20, max 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of items to skip",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Opaque cursor from meta.next_cursor or meta.prev_cursor; overrides offset and cannot be combined with sort",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated sort fields, prefixed with - for descending order",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated relations to embed: user, product",
+                        "name": "expand",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Also return soft-deleted orders; admins only",
+                        "name": "include_deleted",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/pagination.Response-orderhdl_OrderResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/userhdl.ErrorResponse"
+                            "$ref": "#/definitions/httperr.Problem"
                         }
                     }
                 }
             },
             "post": {
-                "description": "Create a new user in the system",
+                "description": "Create a new order with the provided information.\nSend the lines of the order in items, or a single product with product_id and quantity.\nItems are priced from the current product prices and the total is their sum; clients cannot set them.\nPrices are converted from the base currency (THB) to currency at the current exchange rate, which the order records; a currency that is not supported or has no rate is rejected with 422.\nThe quantities are taken out of product stock; 409 when not enough is in stock.\nA coupon_code takes its discount off the total; a code that does not exist, is outside its validity window or needs a larger order is rejected with 422, and one whose usage limit is reached with 409.\nNew orders are pending; any other status is rejected with 400.\nA user or product that does not exist, a product out of stock or a product listed twice is rejected with 422 naming the field.",
                 "consumes": [
                     "application/json"
                 ],
@@ -516,84 +718,264 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "orders"
                 ],
-                "summary": "Create a new user",
+                "summary": "Create a new order",
                 "parameters": [
                     {
-                        "description": "User to create",
-                        "name": "user",
+                        "description": "Order information",
+                        "name": "order",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/userhdl.CreateUserRequest"
+                            "$ref": "#/definitions/orderhdl.CreateOrderRequest"
                         }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Key that makes retries return the first response instead of repeating the request",
+                        "name": "Idempotency-Key",
+                        "in": "header"
                     }
                 ],
                 "responses": {
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/userhdl.UserResponse"
+                            "$ref": "#/definitions/orderhdl.OrderResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Entity tag of the current order version"
+                            }
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/userhdl.ErrorResponse"
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/userhdl.ErrorResponse"
+                            "$ref": "#/definitions/httperr.Problem"
                         }
                     }
                 }
             }
         },
-        "/users/{id}": {
+        "/orders/batch": {
+            "post": {
+                "description": "Apply up to 100 order creates, updates and deletes in one request: creates first, then updates, then deletes. Each item is priced and moves stock like the single-order endpoints.\nIn all_or_nothing mode (the default) the batch runs in one transaction and stops at the first failing item; nothing is saved and the other items report 424.\nIn best_effort mode each item is saved on its own.\nEach result carries the status code the single-order endpoint would have returned. The response is 200 when every item succeeded and 207 otherwise.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "orders"
+                ],
+                "summary": "Create, update and delete orders in a batch",
+                "parameters": [
+                    {
+                        "description": "Orders to create, update and delete",
+                        "name": "batch",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/orderhdl.BatchOrdersRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Key that makes retries return the first response instead of repeating the request",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/batch.Response-orderhdl_OrderResponse"
+                        }
+                    },
+                    "207": {
+                        "description": "Multi-Status",
+                        "schema": {
+                            "$ref": "#/definitions/batch.Response-orderhdl_OrderResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/orders/export": {
             "get": {
-                "description": "Get a single user by ID",
+                "description": "Stream every order matching the filters as CSV or NDJSON, chosen by the Accept header (CSV by default). CSV has one row per order item.\nOrders are read from the database in batches, so exports of any size use little memory. They are ordered by id and cannot be sorted.\nFilter like the order list, with filter[field]=value or filter[field][op]=value on id, user_id, total_price (in THB), currency, status and created_at (a date or RFC 3339 time).\nExample: ?filter[status]=paid\u0026filter[created_at][gt]=2026-01-01\u0026filter[created_at][lt]=2026-02-01",
+                "produces": [
+                    "text/csv",
+                    "application/x-ndjson"
+                ],
+                "tags": [
+                    "orders"
+                ],
+                "summary": "Export orders",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Also return soft-deleted orders; admins only",
+                        "name": "include_deleted",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "CSV with a header row, or one JSON order per line",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "406": {
+                        "description": "Not Acceptable",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/orders/{id}": {
+            "get": {
+                "description": "Get an order by its ID.\nEmbed related resources with expand=user,product: the user who placed the order and the product of each item.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "orders"
                 ],
-                "summary": "Get user by ID",
+                "summary": "Get order by ID",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "User ID",
+                        "description": "Order ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated relations to embed: user, product",
+                        "name": "expand",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Also return soft-deleted orders; admins only",
+                        "name": "include_deleted",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/userhdl.UserResponse"
+                            "$ref": "#/definitions/orderhdl.OrderResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Entity tag of the current order version"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
                         }
                     },
                     "404": {
                         "description": "Not Found",
                         "schema": {
-                            "$ref": "#/definitions/userhdl.ErrorResponse"
+                            "$ref": "#/definitions/httperr.Problem"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/userhdl.ErrorResponse"
+                            "$ref": "#/definitions/httperr.Problem"
                         }
                     }
                 }
             },
             "put": {
-                "description": "Update an existing user by ID",
+                "description": "Update an order's information by ID.\nItems replace the items of the order. For a single product send quantity and product_id instead; product_id can be left out when the order has a single item.\nProducts already on the order keep the unit price they were placed at; added products are priced at their current price.\nStock follows quantity changes and is returned when the status becomes cancelled; 409 when not enough is in stock.\nItems can only change while the order is pending or paid; 409 otherwise.\nThe status must follow the order lifecycle (pending → paid → shipped → delivered, cancel while pending or paid); 409 otherwise.",
                 "consumes": [
                     "application/json"
                 ],
@@ -601,24 +983,30 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "orders"
                 ],
-                "summary": "Update a user",
+                "summary": "Update an order",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "User ID",
+                        "description": "Order ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "User data to update",
-                        "name": "user",
+                        "type": "string",
+                        "description": "ETag the order must still have; required when the server enforces preconditions",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Order information",
+                        "name": "order",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/userhdl.UpdateUserRequest"
+                            "$ref": "#/definitions/orderhdl.UpdateOrderRequest"
                         }
                     }
                 ],
@@ -626,160 +1014,3491 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/userhdl.UserResponse"
+                            "$ref": "#/definitions/orderhdl.OrderResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Entity tag of the current order version"
+                            }
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/userhdl.ErrorResponse"
+                            "$ref": "#/definitions/httperr.Problem"
                         }
                     },
                     "404": {
                         "description": "Not Found",
                         "schema": {
-                            "$ref": "#/definitions/userhdl.ErrorResponse"
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "428": {
+                        "description": "Precondition Required",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/userhdl.ErrorResponse"
+                            "$ref": "#/definitions/httperr.Problem"
                         }
                     }
                 }
             },
             "delete": {
-                "description": "Delete a user by ID",
+                "description": "Soft-delete an order by ID. A pending or paid order returns its quantity to product stock; shipped and delivered goods do not come back.\nAn admin can still list it with include_deleted=true and restore it until it is purged.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "users"
+                    "orders"
                 ],
-                "summary": "Delete a user",
+                "summary": "Delete an order",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "User ID",
+                        "description": "Order ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag the order must still have; required when the server enforces preconditions",
+                        "name": "If-Match",
+                        "in": "header"
                     }
                 ],
                 "responses": {
                     "204": {
                         "description": "No Content"
                     },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
-                            "$ref": "#/definitions/userhdl.ErrorResponse"
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "428": {
+                        "description": "Precondition Required",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/userhdl.ErrorResponse"
+                            "$ref": "#/definitions/httperr.Problem"
                         }
                     }
                 }
-            }
-        }
-    },
-    "definitions": {
-        "orderhdl.CreateOrderRequest": {
-            "type": "object",
-            "required": [
-                "product_id",
-                "quantity",
-                "total_price",
-                "user_id"
+            },
+            "patch": {
+                "description": "Update only the supplied fields of an order.\nSend a JSON Merge Patch object (application/merge-patch+json or application/json) or a JSON Patch array (application/json-patch+json).\nFields cannot be removed or set to null.\nPatched items replace the items of the order; quantity is only present for single-item orders and changes that item.\nProducts already on the order keep the unit price they were placed at; added products are priced at their current price.\nStock follows quantity changes and is returned when the status becomes cancelled; 409 when not enough is in stock.\nItems can only change while the order is pending or paid; 409 otherwise.\nThe status must follow the order lifecycle (pending → paid → shipped → delivered, cancel while pending or paid); 409 otherwise.",
+                "consumes": [
+                    "application/json",
+                    "application/merge-patch+json",
+                    "application/json-patch+json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "orders"
+                ],
+                "summary": "Partially update an order",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Order ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag the order must still have; required when the server enforces preconditions",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Fields to change",
+                        "name": "patch",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/orderhdl.PatchOrderRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/orderhdl.OrderResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Entity tag of the current order version"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "415": {
+                        "description": "Unsupported Media Type",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "428": {
+                        "description": "Precondition Required",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/orders/{id}/cancel": {
+            "post": {
+                "description": "Cancel a pending or paid order and return its quantity to product stock; 409 once the order has shipped.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "orders"
+                ],
+                "summary": "Cancel an order",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Order ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag the order must still have",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Reason recorded in the order history",
+                        "name": "transition",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/orderhdl.TransitionOrderRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Key that makes retries return the first response instead of repeating the request",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/orderhdl.OrderResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Entity tag of the current order version"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "428": {
+                        "description": "Precondition Required",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/orders/{id}/deliver": {
+            "post": {
+                "description": "Move a shipped order to delivered; 409 when the order is not shipped.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "orders"
+                ],
+                "summary": "Deliver an order",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Order ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag the order must still have",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Reason recorded in the order history",
+                        "name": "transition",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/orderhdl.TransitionOrderRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Key that makes retries return the first response instead of repeating the request",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/orderhdl.OrderResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Entity tag of the current order version"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "428": {
+                        "description": "Precondition Required",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/orders/{id}/history": {
+            "get": {
+                "description": "List the status changes of an order, oldest first, with who made them and why",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "orders"
+                ],
+                "summary": "Get the status history of an order",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Order ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Also return the history of a soft-deleted order; admins only",
+                        "name": "include_deleted",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/orderhdl.OrderEventResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/orders/{id}/pay": {
+            "post": {
+                "description": "Move a pending order to paid; 409 when the order is not pending.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "orders"
+                ],
+                "summary": "Pay an order",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Order ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag the order must still have",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Reason recorded in the order history",
+                        "name": "transition",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/orderhdl.TransitionOrderRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Key that makes retries return the first response instead of repeating the request",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/orderhdl.OrderResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Entity tag of the current order version"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "428": {
+                        "description": "Precondition Required",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/orders/{id}/purge": {
+            "post": {
+                "description": "Remove a soft-deleted order for good; 409 when the order is not deleted.\nThe stock the order held was returned when it was deleted.\nRequires an admin API key.",
+                "tags": [
+                    "orders"
+                ],
+                "summary": "Purge a deleted order",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Order ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Admin API key",
+                        "name": "X-API-Key",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Key that makes retries return the first response instead of repeating the request",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/orders/{id}/restore": {
+            "post": {
+                "description": "Bring back a soft-deleted order; restoring an order that is not deleted returns it unchanged.\nRequires an admin API key.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "orders"
+                ],
+                "summary": "Restore a deleted order",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Order ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Admin API key",
+                        "name": "X-API-Key",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Key that makes retries return the first response instead of repeating the request",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/orderhdl.OrderResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Entity tag of the current order version"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/orders/{id}/ship": {
+            "post": {
+                "description": "Move a paid order to shipped; 409 when the order is not paid.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "orders"
+                ],
+                "summary": "Ship an order",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Order ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag the order must still have",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Reason recorded in the order history",
+                        "name": "transition",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/orderhdl.TransitionOrderRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Key that makes retries return the first response instead of repeating the request",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/orderhdl.OrderResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Entity tag of the current order version"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "428": {
+                        "description": "Precondition Required",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/products": {
+            "get": {
+                "description": "Get a list of all products, paginated by offset or cursor.\nFilter with filter[field]=value or filter[field][op]=value where op is one of eq, ne, in (comma separated values), gt and lt (numeric fields) or like (text fields, case-insensitive substring).\nFilterable and sortable fields: id, name, description, price, stock.\nExample: ?filter[price][gt]=10\u0026filter[price][lt]=50\u0026filter[stock][gt]=0\u0026sort=name",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "products"
+                ],
+                "summary": "List all products",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Page size (default 20, max 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of items to skip",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Opaque cursor from meta.next_cursor or meta.prev_cursor; overrides offset and cannot be combined with sort",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated sort fields, prefixed with - for descending order",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Also return soft-deleted products; admins only",
+                        "name": "include_deleted",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/pagination.Response-producthdl_ProductResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create a new product with the provided information",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "products"
+                ],
+                "summary": "Create a new product",
+                "parameters": [
+                    {
+                        "description": "Product information",
+                        "name": "product",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/producthdl.CreateProductRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Key that makes retries return the first response instead of repeating the request",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/producthdl.ProductResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Entity tag of the current product version"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/products/batch": {
+            "post": {
+                "description": "Apply up to 100 product creates, updates and deletes in one request: creates first, then updates, then deletes.\nIn all_or_nothing mode (the default) the batch runs in one transaction and stops at the first failing item; nothing is saved and the other items report 424.\nIn best_effort mode each item is saved on its own.\nEach result carries the status code the single-product endpoint would have returned. The response is 200 when every item succeeded and 207 otherwise.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "products"
+                ],
+                "summary": "Create, update and delete products in a batch",
+                "parameters": [
+                    {
+                        "description": "Products to create, update and delete",
+                        "name": "batch",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/producthdl.BatchProductsRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Key that makes retries return the first response instead of repeating the request",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/batch.Response-producthdl_ProductResponse"
+                        }
+                    },
+                    "207": {
+                        "description": "Multi-Status",
+                        "schema": {
+                            "$ref": "#/definitions/batch.Response-producthdl_ProductResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/products/export": {
+            "get": {
+                "description": "Stream every product matching the filters as CSV or NDJSON, chosen by the Accept header (CSV by default).\nProducts are read from the database in batches, so exports of any size use little memory. They are ordered by id and cannot be sorted.\nFilter like the product list, with filter[field]=value or filter[field][op]=value on id, name, description, price and stock.\nExample: ?filter[stock][gt]=0",
+                "produces": [
+                    "text/csv",
+                    "application/x-ndjson"
+                ],
+                "tags": [
+                    "products"
+                ],
+                "summary": "Export products",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Also return soft-deleted products; admins only",
+                        "name": "include_deleted",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "CSV with a header row, or one JSON product per line",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "406": {
+                        "description": "Not Acceptable",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/products/import": {
+            "post": {
+                "description": "Create and update products from an uploaded CSV or JSON Lines file, sent as the multipart field \"file\" and recognised by its .csv or .jsonl extension.\nCSV needs a header row naming any of the columns id, name, description, price and stock; JSON Lines has one object per line with the same keys.\nRows without an id create a product and are validated like a product creation. Rows with an id update only the fields they have a value for; blank or missing fields, such as the stock, are left as they are.\nRows that fail are reported and skipped; the other rows are saved together in one transaction. With dry_run=true nothing is saved.",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "products"
+                ],
+                "summary": "Import products",
+                "parameters": [
+                    {
+                        "type": "file",
+                        "description": "CSV or JSON Lines file of products",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Report what the import would do without saving it",
+                        "name": "dry_run",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Key that makes retries return the first response instead of repeating the request",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/producthdl.ImportReportResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "413": {
+                        "description": "Request Entity Too Large",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "415": {
+                        "description": "Unsupported Media Type",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/products/{id}": {
+            "get": {
+                "description": "Get a product by its ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "products"
+                ],
+                "summary": "Get product by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Product ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Also return soft-deleted products; admins only",
+                        "name": "include_deleted",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/producthdl.ProductResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Entity tag of the current product version"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Update a product's information by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "products"
+                ],
+                "summary": "Update a product",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Product ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag the product must still have; required when the server enforces preconditions",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Product information",
+                        "name": "product",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/producthdl.UpdateProductRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/producthdl.ProductResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Entity tag of the current product version"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "428": {
+                        "description": "Precondition Required",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Soft-delete a product by ID: it disappears from reads and can no longer be ordered, but an admin can still list it with include_deleted=true and restore it until it is purged.\nOrders with an item for the product are kept as they are.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "products"
+                ],
+                "summary": "Delete a product",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Product ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag the product must still have; required when the server enforces preconditions",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "428": {
+                        "description": "Precondition Required",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "description": "Update only the supplied fields of a product.\nSend a JSON Merge Patch object (application/merge-patch+json or application/json) or a JSON Patch array (application/json-patch+json).\nFields cannot be removed or set to null.",
+                "consumes": [
+                    "application/json",
+                    "application/merge-patch+json",
+                    "application/json-patch+json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "products"
+                ],
+                "summary": "Partially update a product",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Product ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag the product must still have; required when the server enforces preconditions",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Fields to change",
+                        "name": "patch",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/producthdl.PatchProductRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/producthdl.ProductResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Entity tag of the current product version"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "415": {
+                        "description": "Unsupported Media Type",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "428": {
+                        "description": "Precondition Required",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/products/{id}/orders": {
+            "get": {
+                "description": "Get the orders with an item for a product, paginated by offset.\nQuantity, unit_price and line_total describe the order's line for the product.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "products"
+                ],
+                "summary": "Get the orders for a product",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Product ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size (default 20, max 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of items to skip",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Also return soft-deleted orders, and the orders of a soft-deleted product; admins only",
+                        "name": "include_deleted",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/pagination.Response-producthdl_ProductOrderResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/products/{id}/purge": {
+            "post": {
+                "description": "Remove a soft-deleted product for good; 409 when the product is not deleted.\nWhat happens to the orders with an item for the product depends on PRODUCT_DELETE_POLICY, applied in the same transaction as the purge:\nrestrict (default) refuses with 409 and counts the blocking orders in blocking_orders,\ncascade deletes those orders and returns the stock of the ones that have not shipped,\nnullify keeps them, with their prices and totals, without the reference to the product.\nRequires an admin API key.",
+                "tags": [
+                    "products"
+                ],
+                "summary": "Purge a deleted product",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Product ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Admin API key",
+                        "name": "X-API-Key",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Key that makes retries return the first response instead of repeating the request",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/products/{id}/restore": {
+            "post": {
+                "description": "Bring back a soft-deleted product; restoring a product that is not deleted returns it unchanged.\nRequires an admin API key.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "products"
+                ],
+                "summary": "Restore a deleted product",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Product ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Admin API key",
+                        "name": "X-API-Key",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Key that makes retries return the first response instead of repeating the request",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/producthdl.ProductResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Entity tag of the current product version"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/reports/sales": {
+            "get": {
+                "description": "Get the revenue, order count and units sold of the orders placed in a date range, leaving cancelled and deleted orders out.\nGroup the sales by product, user, status, day, week or month with group_by; rows are ordered by period, otherwise by revenue. Periods are keyed by their first day in UTC and weeks start on Monday.\nAn order with items for several products counts once for each product and once in the total.\nThe range defaults to the 30 days up to now. Bounds are dates (2006-01-02) or RFC 3339 times; a date to includes the whole day.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reports"
+                ],
+                "summary": "Get a sales report",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Start of the range, inclusive",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "End of the range; a date is inclusive, a time exclusive",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "product",
+                            "user",
+                            "status",
+                            "day",
+                            "week",
+                            "month"
+                        ],
+                        "type": "string",
+                        "description": "Dimension to group by",
+                        "name": "group_by",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/reporthdl.SalesReportResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/users": {
+            "get": {
+                "description": "Get all users from the system, paginated by offset or cursor.\nFilter with filter[field]=value or filter[field][op]=value where op is one of eq, ne, in (comma separated values), gt and lt (numeric fields) or like (text fields, case-insensitive substring).\nFilterable and sortable fields: id, name, email.\nExample: ?filter[email][like]=example.com\u0026sort=name",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Get all users",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Page size (default 20, max 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of items to skip",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Opaque cursor from meta.next_cursor or meta.prev_cursor; overrides offset and cannot be combined with sort",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated sort fields, prefixed with - for descending order",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Also return soft-deleted users; admins only",
+                        "name": "include_deleted",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/pagination.Response-userhdl_UserResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create a new user in the system",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Create a new user",
+                "parameters": [
+                    {
+                        "description": "User to create",
+                        "name": "user",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/userhdl.CreateUserRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Key that makes retries return the first response instead of repeating the request",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/userhdl.UserResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Entity tag of the current user version"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/batch": {
+            "post": {
+                "description": "Apply up to 100 user creates, updates and deletes in one request: creates first, then updates, then deletes.\nIn all_or_nothing mode (the default) the batch runs in one transaction and stops at the first failing item; nothing is saved and the other items report 424.\nIn best_effort mode each item is saved on its own.\nEach result carries the status code the single-user endpoint would have returned. The response is 200 when every item succeeded and 207 otherwise.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Create, update and delete users in a batch",
+                "parameters": [
+                    {
+                        "description": "Users to create, update and delete",
+                        "name": "batch",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/userhdl.BatchUsersRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Key that makes retries return the first response instead of repeating the request",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/batch.Response-userhdl_UserResponse"
+                        }
+                    },
+                    "207": {
+                        "description": "Multi-Status",
+                        "schema": {
+                            "$ref": "#/definitions/batch.Response-userhdl_UserResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/export": {
+            "get": {
+                "description": "Stream every user matching the filters as CSV or NDJSON, chosen by the Accept header (CSV by default).\nUsers are read from the database in batches, so exports of any size use little memory. They are ordered by id and cannot be sorted.\nFilter like the user list, with filter[field]=value or filter[field][op]=value on id, name and email.\nExample: ?filter[email][like]=example.com",
+                "produces": [
+                    "text/csv",
+                    "application/x-ndjson"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Export users",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Also return soft-deleted users; admins only",
+                        "name": "include_deleted",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "CSV with a header row, or one JSON user per line",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "406": {
+                        "description": "Not Acceptable",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{id}": {
+            "get": {
+                "description": "Get a single user by ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Get user by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Also return soft-deleted users; admins only",
+                        "name": "include_deleted",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/userhdl.UserResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Entity tag of the current user version"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Update an existing user by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Update a user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag the user must still have; required when the server enforces preconditions",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "description": "User data to update",
+                        "name": "user",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/userhdl.UpdateUserRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/userhdl.UserResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Entity tag of the current user version"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "428": {
+                        "description": "Precondition Required",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Soft-delete a user by ID: it disappears from reads unless an admin asks for include_deleted=true, and can be restored until it is purged.\nThe orders the user placed are kept as they are.",
+                "tags": [
+                    "users"
+                ],
+                "summary": "Delete a user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag the user must still have; required when the server enforces preconditions",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "428": {
+                        "description": "Precondition Required",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{id}/orders": {
+            "get": {
+                "description": "Get the orders placed by a user, paginated by offset.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Get the orders of a user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size (default 20, max 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of items to skip",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Also return soft-deleted orders, and the orders of a soft-deleted user; admins only",
+                        "name": "include_deleted",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/pagination.Response-userhdl_UserOrderResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{id}/purge": {
+            "post": {
+                "description": "Remove a soft-deleted user for good; 409 when the user is not deleted.\nWhat happens to the orders the user placed depends on USER_DELETE_POLICY, applied in the same transaction as the purge:\nrestrict (default) refuses with 409 and counts the blocking orders in blocking_orders,\ncascade deletes those orders and returns the stock of the ones that have not shipped,\nnullify keeps them, with their prices and totals, without the reference to the user.\nRequires an admin API key.",
+                "tags": [
+                    "users"
+                ],
+                "summary": "Purge a deleted user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Admin API key",
+                        "name": "X-API-Key",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Key that makes retries return the first response instead of repeating the request",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{id}/restore": {
+            "post": {
+                "description": "Bring back a soft-deleted user; restoring a user that is not deleted returns it unchanged.\nRequires an admin API key.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Restore a deleted user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Admin API key",
+                        "name": "X-API-Key",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Key that makes retries return the first response instead of repeating the request",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/userhdl.UserResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Entity tag of the current user version"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httperr.Problem"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "batch.DeleteItem": {
+            "type": "object",
+            "required": [
+                "id"
+            ],
+            "properties": {
+                "id": {
+                    "type": "string",
+                    "example": "1"
+                },
+                "version": {
+                    "type": "string",
+                    "example": "5d41402abc4b2a76"
+                }
+            }
+        },
+        "batch.Response-orderhdl_OrderResponse": {
+            "type": "object",
+            "properties": {
+                "create": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/batch.Result-orderhdl_OrderResponse"
+                    }
+                },
+                "delete": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/batch.Result-orderhdl_OrderResponse"
+                    }
+                },
+                "failed": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "mode": {
+                    "type": "string",
+                    "enum": [
+                        "all_or_nothing",
+                        "best_effort"
+                    ],
+                    "example": "all_or_nothing"
+                },
+                "succeeded": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "update": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/batch.Result-orderhdl_OrderResponse"
+                    }
+                }
+            }
+        },
+        "batch.Response-producthdl_ProductResponse": {
+            "type": "object",
+            "properties": {
+                "create": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/batch.Result-producthdl_ProductResponse"
+                    }
+                },
+                "delete": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/batch.Result-producthdl_ProductResponse"
+                    }
+                },
+                "failed": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "mode": {
+                    "type": "string",
+                    "enum": [
+                        "all_or_nothing",
+                        "best_effort"
+                    ],
+                    "example": "all_or_nothing"
+                },
+                "succeeded": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "update": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/batch.Result-producthdl_ProductResponse"
+                    }
+                }
+            }
+        },
+        "batch.Response-userhdl_UserResponse": {
+            "type": "object",
+            "properties": {
+                "create": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/batch.Result-userhdl_UserResponse"
+                    }
+                },
+                "delete": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/batch.Result-userhdl_UserResponse"
+                    }
+                },
+                "failed": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "mode": {
+                    "type": "string",
+                    "enum": [
+                        "all_or_nothing",
+                        "best_effort"
+                    ],
+                    "example": "all_or_nothing"
+                },
+                "succeeded": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "update": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/batch.Result-userhdl_UserResponse"
+                    }
+                }
+            }
+        },
+        "batch.Result-orderhdl_OrderResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/orderhdl.OrderResponse"
+                },
+                "error": {
+                    "$ref": "#/definitions/httperr.Problem"
+                },
+                "status": {
+                    "type": "integer",
+                    "example": 201
+                },
+                "version": {
+                    "type": "string",
+                    "example": "5d41402abc4b2a76"
+                }
+            }
+        },
+        "batch.Result-producthdl_ProductResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/producthdl.ProductResponse"
+                },
+                "error": {
+                    "$ref": "#/definitions/httperr.Problem"
+                },
+                "status": {
+                    "type": "integer",
+                    "example": 201
+                },
+                "version": {
+                    "type": "string",
+                    "example": "5d41402abc4b2a76"
+                }
+            }
+        },
+        "batch.Result-userhdl_UserResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/userhdl.UserResponse"
+                },
+                "error": {
+                    "$ref": "#/definitions/httperr.Problem"
+                },
+                "status": {
+                    "type": "integer",
+                    "example": 201
+                },
+                "version": {
+                    "type": "string",
+                    "example": "5d41402abc4b2a76"
+                }
+            }
+        },
+        "couponhdl.CouponRequest": {
+            "type": "object",
+            "required": [
+                "code",
+                "type"
+            ],
+            "properties": {
+                "amount": {
+                    "type": "string",
+                    "example": "100.00"
+                },
+                "code": {
+                    "type": "string",
+                    "example": "SAVE10"
+                },
+                "ends_at": {
+                    "type": "string",
+                    "example": "2026-12-01T00:00:00Z"
+                },
+                "min_order_value": {
+                    "type": "string",
+                    "example": "500.00"
+                },
+                "per_user_limit": {
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 1
+                },
+                "percent": {
+                    "type": "integer",
+                    "example": 10
+                },
+                "starts_at": {
+                    "type": "string",
+                    "example": "2026-11-01T00:00:00Z"
+                },
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "percentage",
+                        "fixed"
+                    ],
+                    "example": "percentage"
+                },
+                "usage_limit": {
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 1000
+                }
+            }
+        },
+        "couponhdl.CouponResponse": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "string",
+                    "example": "100.00"
+                },
+                "code": {
+                    "type": "string",
+                    "example": "SAVE10"
+                },
+                "currency": {
+                    "type": "string",
+                    "example": "THB"
+                },
+                "ends_at": {
+                    "type": "string",
+                    "example": "2026-12-01T00:00:00Z"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "1"
+                },
+                "min_order_value": {
+                    "type": "string",
+                    "example": "500.00"
+                },
+                "per_user_limit": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "percent": {
+                    "type": "integer",
+                    "example": 10
+                },
+                "redemptions": {
+                    "type": "integer",
+                    "example": 42
+                },
+                "starts_at": {
+                    "type": "string",
+                    "example": "2026-11-01T00:00:00Z"
+                },
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "percentage",
+                        "fixed"
+                    ],
+                    "example": "percentage"
+                },
+                "usage_limit": {
+                    "type": "integer",
+                    "example": 1000
+                }
+            }
+        },
+        "httperr.FieldError": {
+            "type": "object",
+            "properties": {
+                "field": {
+                    "type": "string",
+                    "example": "email"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "must be a valid email address"
+                }
+            }
+        },
+        "httperr.Problem": {
+            "type": "object",
+            "properties": {
+                "detail": {
+                    "type": "string",
+                    "example": "user not found"
+                },
+                "errors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/httperr.FieldError"
+                    }
+                },
+                "instance": {
+                    "type": "string",
+                    "example": "/api/v1/users/42"
+                },
+                "request_id": {
+                    "type": "string",
+                    "example": "5SWLNQ6DPYBTNSBE2WW5UROJ3M"
+                },
+                "status": {
+                    "type": "integer",
+                    "example": 404
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Not Found"
+                },
+                "type": {
+                    "type": "string",
+                    "example": "/problems/not-found"
+                }
+            }
+        },
+        "orderhdl.BatchOrdersRequest": {
+            "type": "object",
+            "properties": {
+                "create": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/orderhdl.CreateOrderRequest"
+                    }
+                },
+                "delete": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/batch.DeleteItem"
+                    }
+                },
+                "mode": {
+                    "type": "string",
+                    "enum": [
+                        "all_or_nothing",
+                        "best_effort"
+                    ],
+                    "example": "all_or_nothing"
+                },
+                "update": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/orderhdl.BatchUpdateOrderRequest"
+                    }
+                }
+            }
+        },
+        "orderhdl.BatchUpdateOrderRequest": {
+            "type": "object",
+            "required": [
+                "id"
+            ],
+            "properties": {
+                "id": {
+                    "type": "string",
+                    "example": "1"
+                },
+                "items": {
+                    "type": "array",
+                    "maxItems": 100,
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/orderhdl.OrderItemRequest"
+                    }
+                },
+                "product_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "quantity": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "pending",
+                        "paid",
+                        "shipped",
+                        "delivered",
+                        "cancelled"
+                    ],
+                    "example": "paid"
+                },
+                "version": {
+                    "type": "string",
+                    "example": "5d41402abc4b2a76"
+                }
+            }
+        },
+        "orderhdl.CreateOrderRequest": {
+            "type": "object",
+            "required": [
+                "user_id"
+            ],
+            "properties": {
+                "coupon_code": {
+                    "type": "string",
+                    "example": "SAVE10"
+                },
+                "currency": {
+                    "type": "string",
+                    "enum": [
+                        "THB",
+                        "USD",
+                        "EUR"
+                    ],
+                    "example": "USD"
+                },
+                "items": {
+                    "type": "array",
+                    "maxItems": 100,
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/orderhdl.OrderItemRequest"
+                    }
+                },
+                "product_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "quantity": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "pending"
+                    ],
+                    "example": "pending"
+                },
+                "user_id": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "orderhdl.OrderEventResponse": {
+            "type": "object",
+            "properties": {
+                "actor": {
+                    "type": "string",
+                    "example": "api-key:1a2b3c4d"
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2024-01-02T15:04:05Z"
+                },
+                "from_status": {
+                    "type": "string",
+                    "enum": [
+                        "pending",
+                        "paid",
+                        "shipped",
+                        "delivered",
+                        "cancelled"
+                    ],
+                    "example": "paid"
+                },
+                "reason": {
+                    "type": "string",
+                    "example": "customer changed their mind"
+                },
+                "to_status": {
+                    "type": "string",
+                    "enum": [
+                        "pending",
+                        "paid",
+                        "shipped",
+                        "delivered",
+                        "cancelled"
+                    ],
+                    "example": "cancelled"
+                }
+            }
+        },
+        "orderhdl.OrderItemRequest": {
+            "type": "object",
+            "required": [
+                "product_id",
+                "quantity"
             ],
             "properties": {
                 "product_id": {
                     "type": "integer",
                     "example": 1
                 },
-                "quantity": {
-                    "type": "integer",
-                    "example": 2
+                "quantity": {
+                    "type": "integer",
+                    "example": 2
+                }
+            }
+        },
+        "orderhdl.OrderItemResponse": {
+            "type": "object",
+            "properties": {
+                "line_total": {
+                    "type": "string",
+                    "example": "50000.00"
+                },
+                "product": {
+                    "$ref": "#/definitions/producthdl.ProductResponse"
+                },
+                "product_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "quantity": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "unit_price": {
+                    "type": "string",
+                    "example": "25000.00"
+                }
+            }
+        },
+        "orderhdl.OrderResponse": {
+            "type": "object",
+            "properties": {
+                "coupon_code": {
+                    "type": "string",
+                    "example": "SAVE10"
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2024-01-02T15:04:05Z"
+                },
+                "currency": {
+                    "type": "string",
+                    "example": "THB"
+                },
+                "deleted_at": {
+                    "type": "string",
+                    "example": "2026-01-15T10:00:00Z"
+                },
+                "discount": {
+                    "type": "string",
+                    "example": "5000.00"
+                },
+                "exchange_rate": {
+                    "type": "string",
+                    "example": "1"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "1"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/orderhdl.OrderItemResponse"
+                    }
+                },
+                "product_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "quantity": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "pending",
+                        "paid",
+                        "shipped",
+                        "delivered",
+                        "cancelled"
+                    ],
+                    "example": "pending"
+                },
+                "total_price": {
+                    "type": "string",
+                    "example": "50000.00"
+                },
+                "unit_price": {
+                    "type": "string",
+                    "example": "25000.00"
+                },
+                "user": {
+                    "$ref": "#/definitions/userhdl.UserResponse"
+                },
+                "user_id": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "orderhdl.PatchOrderRequest": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "maxItems": 100,
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/orderhdl.OrderItemRequest"
+                    }
+                },
+                "quantity": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "pending",
+                        "paid",
+                        "shipped",
+                        "delivered",
+                        "cancelled"
+                    ],
+                    "example": "paid"
+                }
+            }
+        },
+        "orderhdl.TransitionOrderRequest": {
+            "type": "object",
+            "properties": {
+                "reason": {
+                    "type": "string",
+                    "maxLength": 500,
+                    "example": "customer changed their mind"
+                }
+            }
+        },
+        "orderhdl.UpdateOrderRequest": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "maxItems": 100,
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/orderhdl.OrderItemRequest"
+                    }
+                },
+                "product_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "quantity": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "pending",
+                        "paid",
+                        "shipped",
+                        "delivered",
+                        "cancelled"
+                    ],
+                    "example": "paid"
+                }
+            }
+        },
+        "pagination.Links": {
+            "type": "object",
+            "properties": {
+                "next": {
+                    "type": "string",
+                    "example": "/api/v1/users?limit=20\u0026offset=20"
+                },
+                "prev": {
+                    "type": "string"
+                },
+                "self": {
+                    "type": "string",
+                    "example": "/api/v1/users?limit=20\u0026offset=0"
+                }
+            }
+        },
+        "pagination.Meta": {
+            "type": "object",
+            "properties": {
+                "limit": {
+                    "type": "integer",
+                    "example": 20
+                },
+                "next_cursor": {
+                    "type": "string",
+                    "example": "YToyMA"
+                },
+                "offset": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "prev_cursor": {
+                    "type": "string",
+                    "example": "YjoyMQ"
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 42
+                }
+            }
+        },
+        "pagination.Response-couponhdl_CouponResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/couponhdl.CouponResponse"
+                    }
+                },
+                "links": {
+                    "$ref": "#/definitions/pagination.Links"
+                },
+                "meta": {
+                    "$ref": "#/definitions/pagination.Meta"
+                }
+            }
+        },
+        "pagination.Response-orderhdl_OrderResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/orderhdl.OrderResponse"
+                    }
+                },
+                "links": {
+                    "$ref": "#/definitions/pagination.Links"
+                },
+                "meta": {
+                    "$ref": "#/definitions/pagination.Meta"
+                }
+            }
+        },
+        "pagination.Response-producthdl_ProductOrderResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/producthdl.ProductOrderResponse"
+                    }
+                },
+                "links": {
+                    "$ref": "#/definitions/pagination.Links"
+                },
+                "meta": {
+                    "$ref": "#/definitions/pagination.Meta"
+                }
+            }
+        },
+        "pagination.Response-producthdl_ProductResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/producthdl.ProductResponse"
+                    }
+                },
+                "links": {
+                    "$ref": "#/definitions/pagination.Links"
+                },
+                "meta": {
+                    "$ref": "#/definitions/pagination.Meta"
+                }
+            }
+        },
+        "pagination.Response-userhdl_UserOrderResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/userhdl.UserOrderResponse"
+                    }
+                },
+                "links": {
+                    "$ref": "#/definitions/pagination.Links"
                 },
-                "status": {
-                    "type": "string",
-                    "example": "pending"
+                "meta": {
+                    "$ref": "#/definitions/pagination.Meta"
+                }
+            }
+        },
+        "pagination.Response-userhdl_UserResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/userhdl.UserResponse"
+                    }
                 },
-                "total_price": {
-                    "type": "number",
-                    "example": 50000
+                "links": {
+                    "$ref": "#/definitions/pagination.Links"
                 },
-                "user_id": {
-                    "type": "integer",
-                    "example": 1
+                "meta": {
+                    "$ref": "#/definitions/pagination.Meta"
                 }
             }
         },
-        "orderhdl.ErrorResponse": {
+        "producthdl.BatchProductsRequest": {
             "type": "object",
             "properties": {
-                "error": {
+                "create": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/producthdl.CreateProductRequest"
+                    }
+                },
+                "delete": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/batch.DeleteItem"
+                    }
+                },
+                "mode": {
                     "type": "string",
-                    "example": "error message"
+                    "enum": [
+                        "all_or_nothing",
+                        "best_effort"
+                    ],
+                    "example": "all_or_nothing"
+                },
+                "update": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/producthdl.BatchUpdateProductRequest"
+                    }
                 }
             }
         },
-        "orderhdl.OrderResponse": {
+        "producthdl.BatchUpdateProductRequest": {
             "type": "object",
+            "required": [
+                "id"
+            ],
             "properties": {
+                "description": {
+                    "type": "string",
+                    "example": "Gaming laptop"
+                },
                 "id": {
                     "type": "string",
                     "example": "1"
                 },
-                "product_id": {
-                    "type": "integer",
-                    "example": 1
+                "name": {
+                    "type": "string",
+                    "example": "Laptop"
                 },
-                "quantity": {
+                "price": {
+                    "type": "string",
+                    "example": "25000.50"
+                },
+                "stock": {
                     "type": "integer",
-                    "example": 2
+                    "example": 10
                 },
-                "status": {
+                "version": {
                     "type": "string",
-                    "example": "pending"
+                    "example": "5d41402abc4b2a76"
+                }
+            }
+        },
+        "producthdl.CreateProductRequest": {
+            "type": "object",
+            "required": [
+                "name",
+                "price"
+            ],
+            "properties": {
+                "description": {
+                    "type": "string",
+                    "example": "Gaming laptop"
                 },
-                "total_price": {
-                    "type": "number",
-                    "example": 50000
+                "name": {
+                    "type": "string",
+                    "example": "Laptop"
                 },
-                "user_id": {
+                "price": {
+                    "type": "string",
+                    "example": "25000.50"
+                },
+                "stock": {
                     "type": "integer",
-                    "example": 1
+                    "minimum": 0,
+                    "example": 10
                 }
             }
         },
-        "orderhdl.UpdateOrderRequest": {
+        "producthdl.ImportReportResponse": {
             "type": "object",
             "properties": {
-                "product_id": {
+                "created": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "dry_run": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "failed": {
                     "type": "integer",
                     "example": 1
                 },
-                "quantity": {
+                "rows": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/producthdl.ImportRowResponse"
+                    }
+                },
+                "updated": {
                     "type": "integer",
-                    "example": 2
+                    "example": 1
+                }
+            }
+        },
+        "producthdl.ImportRowResponse": {
+            "type": "object",
+            "properties": {
+                "errors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/httperr.FieldError"
+                    }
                 },
-                "status": {
+                "id": {
                     "type": "string",
-                    "example": "completed"
+                    "example": "12"
                 },
-                "total_price": {
-                    "type": "number",
-                    "example": 50000
-                },
-                "user_id": {
+                "row": {
                     "type": "integer",
-                    "example": 1
+                    "example": 2
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "created",
+                        "updated",
+                        "failed"
+                    ],
+                    "example": "created"
                 }
             }
         },
-        "producthdl.CreateProductRequest": {
+        "producthdl.PatchProductRequest": {
             "type": "object",
             "required": [
                 "name",
@@ -795,8 +4514,8 @@ const docTemplate = `{
                     "example": "Laptop"
                 },
                 "price": {
-                    "type": "number",
-                    "example": 25000.5
+                    "type": "string",
+                    "example": "25000.50"
                 },
                 "stock": {
                     "type": "integer",
@@ -805,18 +4524,61 @@ const docTemplate = `{
                 }
             }
         },
-        "producthdl.ErrorResponse": {
+        "producthdl.ProductOrderResponse": {
             "type": "object",
             "properties": {
-                "error": {
+                "currency": {
+                    "type": "string",
+                    "example": "THB"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "1"
+                },
+                "line_total": {
+                    "type": "string",
+                    "example": "50001.00"
+                },
+                "quantity": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "pending",
+                        "paid",
+                        "shipped",
+                        "delivered",
+                        "cancelled"
+                    ],
+                    "example": "pending"
+                },
+                "total_price": {
                     "type": "string",
-                    "example": "error message"
+                    "example": "50001.00"
+                },
+                "unit_price": {
+                    "type": "string",
+                    "example": "25000.50"
+                },
+                "user_id": {
+                    "type": "integer",
+                    "example": 1
                 }
             }
         },
         "producthdl.ProductResponse": {
             "type": "object",
             "properties": {
+                "currency": {
+                    "type": "string",
+                    "example": "THB"
+                },
+                "deleted_at": {
+                    "type": "string",
+                    "example": "2026-01-15T10:00:00Z"
+                },
                 "description": {
                     "type": "string",
                     "example": "Gaming laptop"
@@ -830,8 +4592,8 @@ const docTemplate = `{
                     "example": "Laptop"
                 },
                 "price": {
-                    "type": "number",
-                    "example": 25000.5
+                    "type": "string",
+                    "example": "25000.50"
                 },
                 "stock": {
                     "type": "integer",
@@ -851,8 +4613,8 @@ const docTemplate = `{
                     "example": "Laptop"
                 },
                 "price": {
-                    "type": "number",
-                    "example": 25000.5
+                    "type": "string",
+                    "example": "25000.50"
                 },
                 "stock": {
                     "type": "integer",
@@ -860,29 +4622,178 @@ const docTemplate = `{
                 }
             }
         },
-        "userhdl.CreateUserRequest": {
+        "ratehdl.ExchangeRateResponse": {
+            "type": "object",
+            "properties": {
+                "currency": {
+                    "type": "string",
+                    "example": "USD"
+                },
+                "rate": {
+                    "type": "string",
+                    "example": "0.0275"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2026-01-02T00:00:00Z"
+                }
+            }
+        },
+        "ratehdl.ExchangeRatesResponse": {
+            "type": "object",
+            "properties": {
+                "base": {
+                    "type": "string",
+                    "example": "THB"
+                },
+                "rates": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ratehdl.ExchangeRateResponse"
+                    }
+                }
+            }
+        },
+        "ratehdl.SetExchangeRateRequest": {
             "type": "object",
             "required": [
-                "email",
-                "name"
+                "rate"
+            ],
+            "properties": {
+                "rate": {
+                    "type": "string",
+                    "example": "0.0275"
+                }
+            }
+        },
+        "reporthdl.SalesReportResponse": {
+            "type": "object",
+            "properties": {
+                "from": {
+                    "type": "string",
+                    "example": "2026-01-01T00:00:00Z"
+                },
+                "group_by": {
+                    "type": "string",
+                    "enum": [
+                        "product",
+                        "user",
+                        "status",
+                        "day",
+                        "week",
+                        "month"
+                    ],
+                    "example": "product"
+                },
+                "rows": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/reporthdl.SalesRowResponse"
+                    }
+                },
+                "to": {
+                    "type": "string",
+                    "example": "2026-02-01T00:00:00Z"
+                },
+                "total": {
+                    "$ref": "#/definitions/reporthdl.SalesRowResponse"
+                }
+            }
+        },
+        "reporthdl.SalesRowResponse": {
+            "type": "object",
+            "properties": {
+                "currency": {
+                    "type": "string",
+                    "example": "THB"
+                },
+                "key": {
+                    "type": "string",
+                    "example": "1"
+                },
+                "orders": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "revenue": {
+                    "type": "string",
+                    "example": "2975.00"
+                },
+                "units": {
+                    "type": "integer",
+                    "example": 6
+                }
+            }
+        },
+        "userhdl.BatchUpdateUserRequest": {
+            "type": "object",
+            "required": [
+                "id"
             ],
             "properties": {
                 "email": {
                     "type": "string",
                     "example": "john@example.com"
                 },
+                "id": {
+                    "type": "string",
+                    "example": "1"
+                },
                 "name": {
                     "type": "string",
                     "example": "John Doe"
+                },
+                "version": {
+                    "type": "string",
+                    "example": "5d41402abc4b2a76"
                 }
             }
         },
-        "userhdl.ErrorResponse": {
+        "userhdl.BatchUsersRequest": {
             "type": "object",
             "properties": {
-                "error": {
+                "create": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/userhdl.CreateUserRequest"
+                    }
+                },
+                "delete": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/batch.DeleteItem"
+                    }
+                },
+                "mode": {
+                    "type": "string",
+                    "enum": [
+                        "all_or_nothing",
+                        "best_effort"
+                    ],
+                    "example": "all_or_nothing"
+                },
+                "update": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/userhdl.BatchUpdateUserRequest"
+                    }
+                }
+            }
+        },
+        "userhdl.CreateUserRequest": {
+            "type": "object",
+            "required": [
+                "email",
+                "name"
+            ],
+            "properties": {
+                "email": {
+                    "type": "string",
+                    "example": "john@example.com"
+                },
+                "name": {
                     "type": "string",
-                    "example": "error message"
+                    "example": "John Doe"
                 }
             }
         },
@@ -899,9 +4810,68 @@ const docTemplate = `{
                 }
             }
         },
+        "userhdl.UserOrderItemResponse": {
+            "type": "object",
+            "properties": {
+                "line_total": {
+                    "type": "string",
+                    "example": "50000.00"
+                },
+                "product_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "quantity": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "unit_price": {
+                    "type": "string",
+                    "example": "25000.00"
+                }
+            }
+        },
+        "userhdl.UserOrderResponse": {
+            "type": "object",
+            "properties": {
+                "currency": {
+                    "type": "string",
+                    "example": "THB"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "1"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/userhdl.UserOrderItemResponse"
+                    }
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "pending",
+                        "paid",
+                        "shipped",
+                        "delivered",
+                        "cancelled"
+                    ],
+                    "example": "pending"
+                },
+                "total_price": {
+                    "type": "string",
+                    "example": "50000.00"
+                }
+            }
+        },
         "userhdl.UserResponse": {
             "type": "object",
             "properties": {
+                "deleted_at": {
+                    "type": "string",
+                    "example": "2026-01-15T10:00:00Z"
+                },
                 "email": {
                     "type": "string",
                     "example": "john@example.com"
@@ -925,8 +4895,8 @@ var SwaggerInfo = &swag.Spec{
 	Host:             "localhost:8081",
 	BasePath:         "/api/v1",
 	Schemes:          []string{"http", "https"},
-	Title:            "Meek API",
-	Description:      "API documentation for Meek service with Ent ORM",
+	Title:            "Gin Swagger API",
+	Description:      "API documentation for Gin Swagger API service with Ent ORM",
 	InfoInstanceName: "swagger",
 	SwaggerTemplate:  docTemplate,
 	LeftDelim:        "{{",
@@ -0,0 +1,18 @@
+package domain
+
+import "context"
+
+// actorKey is the context key of the actor making a request
+type actorKey struct{}
+
+// WithActor returns a context carrying the actor making a request
+func WithActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, actorKey{}, actor)
+}
+
+// ActorFromContext returns the actor carried by ctx, or an empty string
+// when the request is not authenticated
+func ActorFromContext(ctx context.Context) string {
+	actor, _ := ctx.Value(actorKey{}).(string)
+	return actor
+}
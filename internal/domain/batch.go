@@ -0,0 +1,67 @@
+package domain
+
+// MaxBatchSize is the most items a batch may hold across its creates,
+// updates and deletes
+const MaxBatchSize = 100
+
+// Batch modes. An all-or-nothing batch applies every item or none of
+// them; a best-effort batch applies each item on its own.
+const (
+	BatchAllOrNothing = "all_or_nothing"
+	BatchBestEffort   = "best_effort"
+)
+
+// BatchModes lists every batch mode
+var BatchModes = []string{BatchAllOrNothing, BatchBestEffort}
+
+// Batch holds the writes of a batch on one resource. Creates are applied
+// first, then updates, then deletes, each in the given order.
+type Batch[T any] struct {
+	Mode   string
+	Create []T
+	Update []BatchUpdate[T]
+	Delete []BatchRef
+}
+
+// Size returns the number of items in the batch
+func (b Batch[T]) Size() int {
+	return len(b.Create) + len(b.Update) + len(b.Delete)
+}
+
+// BatchUpdate replaces the resource Value identifies. A non-empty Version
+// must match the current version of the resource.
+type BatchUpdate[T any] struct {
+	Version string
+	Value   T
+}
+
+// BatchRef identifies a resource to delete. A non-empty Version must
+// match the current version of the resource.
+type BatchRef struct {
+	ID      string
+	Version string
+}
+
+// BatchResult is the outcome of one item of a batch. Value is the written
+// resource, nil for deletes and failed items.
+type BatchResult[T any] struct {
+	Value *T
+	Err   error
+}
+
+// BatchResults holds the outcome of every item of a batch, in the order
+// of the batch
+type BatchResults[T any] struct {
+	Create []BatchResult[T]
+	Update []BatchResult[T]
+	Delete []BatchResult[T]
+}
+
+// NewBatchResults returns results sized for the items of b
+func NewBatchResults[T any](b Batch[T]) *BatchResults[T] {
+	return &BatchResults[T]{
+		Create: make([]BatchResult[T], len(b.Create)),
+		Update: make([]BatchResult[T], len(b.Update)),
+		Delete: make([]BatchResult[T], len(b.Delete)),
+	}
+}
@@ -0,0 +1,150 @@
+package domain
+
+import (
+	"fmt"
+	"math/big"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// Coupon types
+const (
+	// CouponPercentage takes Percent percent off the items total
+	CouponPercentage = "percentage"
+	// CouponFixed takes Amount off the items total
+	CouponFixed = "fixed"
+)
+
+// couponCodePattern is the form of a normalized coupon code
+var couponCodePattern = regexp.MustCompile(`^[A-Z0-9_-]{3,32}$`)
+
+// Coupon is a discount code applied to orders when they are placed.
+// Amount and MinOrderValue are in BaseCurrency and converted at the
+// order's exchange rate. A zero UsageLimit or PerUserLimit is unlimited,
+// and the coupon is valid from StartsAt, inclusive, until EndsAt,
+// exclusive, when they are set. Redemptions counts the orders it was
+// applied to.
+type Coupon struct {
+	ID            string
+	Code          string
+	Type          string
+	Percent       int
+	Amount        Money
+	MinOrderValue Money
+	UsageLimit    int
+	PerUserLimit  int
+	Redemptions   int
+	StartsAt      *time.Time
+	EndsAt        *time.Time
+}
+
+// CouponRedemption records the use of a coupon on an order
+type CouponRedemption struct {
+	CouponID int
+	UserID   int
+	OrderID  int
+	Discount Money
+}
+
+// NormalizeCouponCode returns code in the form coupons are stored and
+// looked up in, so that codes are matched regardless of case
+func NormalizeCouponCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+// Version identifies the current state of the coupon. Redemptions do not
+// affect it.
+func (c Coupon) Version() string {
+	return version(c.ID, c.Code, c.Type, c.Percent, c.Amount, c.MinOrderValue, c.UsageLimit, c.PerUserLimit, c.StartsAt, c.EndsAt)
+}
+
+// Validate checks the fields of a coupon to create or update. Every
+// failed check is reported in the returned ValidationError, which is nil
+// when the coupon is valid.
+func (c Coupon) Validate() ValidationError {
+	var fields ValidationError
+	if !couponCodePattern.MatchString(c.Code) {
+		fields = append(fields, FieldError{Field: "code", Message: "must be 3 to 32 letters, digits, _ or -"})
+	}
+	switch c.Type {
+	case CouponPercentage:
+		if c.Percent < 1 || c.Percent > 100 {
+			fields = append(fields, FieldError{Field: "percent", Message: "must be between 1 and 100"})
+		}
+		if !c.Amount.IsZero() {
+			fields = append(fields, FieldError{Field: "amount", Message: "must not be set on a percentage coupon"})
+		}
+	case CouponFixed:
+		if !c.Amount.IsPositive() || c.Amount.Currency != BaseCurrency {
+			fields = append(fields, FieldError{Field: "amount", Message: fmt.Sprintf("must be a positive amount of %s", BaseCurrency)})
+		}
+		if c.Percent != 0 {
+			fields = append(fields, FieldError{Field: "percent", Message: "must not be set on a fixed coupon"})
+		}
+	default:
+		fields = append(fields, FieldError{Field: "type", Message: fmt.Sprintf("must be %s or %s", CouponPercentage, CouponFixed)})
+	}
+	if c.MinOrderValue.IsNegative() || (!c.MinOrderValue.IsZero() && c.MinOrderValue.Currency != BaseCurrency) {
+		fields = append(fields, FieldError{Field: "min_order_value", Message: fmt.Sprintf("must be an amount of %s that is not negative", BaseCurrency)})
+	}
+	if c.UsageLimit < 0 {
+		fields = append(fields, FieldError{Field: "usage_limit", Message: "must not be negative"})
+	}
+	if c.PerUserLimit < 0 {
+		fields = append(fields, FieldError{Field: "per_user_limit", Message: "must not be negative"})
+	}
+	if c.StartsAt != nil && c.EndsAt != nil && !c.EndsAt.After(*c.StartsAt) {
+		fields = append(fields, FieldError{Field: "ends_at", Message: "must be after starts_at"})
+	}
+	return fields
+}
+
+// Discount returns the amount the coupon takes off the items total of an
+// order placed at rate and at time now. Percentages round half up, and a
+// fixed amount never exceeds the total. A coupon that is not valid at now
+// or whose minimum the total does not reach fails with a ValidationError
+// on coupon_code. Usage limits are checked when the coupon is redeemed.
+func (c Coupon) Discount(total Money, rate ExchangeRate, now time.Time) (Money, error) {
+	switch {
+	case c.StartsAt != nil && now.Before(*c.StartsAt):
+		return Money{}, c.unusable("is not valid yet")
+	case c.EndsAt != nil && !now.Before(*c.EndsAt):
+		return Money{}, c.unusable("has expired")
+	}
+
+	if c.MinOrderValue.IsPositive() {
+		minimum, err := rate.Convert(c.MinOrderValue)
+		if err != nil {
+			return Money{}, err
+		}
+		below, err := total.Cmp(minimum)
+		if err != nil {
+			return Money{}, err
+		}
+		if below < 0 {
+			return Money{}, c.unusable(fmt.Sprintf("needs an order of at least %s %s", minimum, minimum.Currency))
+		}
+	}
+
+	switch c.Type {
+	case CouponPercentage:
+		return total.Scale(big.NewRat(int64(c.Percent), 100), RoundHalfUp), nil
+	case CouponFixed:
+		amount, err := rate.Convert(c.Amount)
+		if err != nil {
+			return Money{}, err
+		}
+		if over, err := amount.Cmp(total); err != nil || over <= 0 {
+			return amount, err
+		}
+		return total, nil
+	default:
+		return Money{}, fmt.Errorf("%w: coupon %s has unknown type %q", ErrInvalidArgument, c.Code, c.Type)
+	}
+}
+
+// unusable reports that the coupon cannot be applied to an order
+func (c Coupon) unusable(reason string) ValidationError {
+	return ValidationError{{Field: "coupon_code", Message: fmt.Sprintf("coupon %s %s", c.Code, reason)}}
+}
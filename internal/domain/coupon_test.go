@@ -0,0 +1,133 @@
+package domain_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"gin-swagger-api/internal/domain"
+	"gin-swagger-api/internal/testutil"
+)
+
+var _ = Describe("Coupon", func() {
+	now := time.Date(2026, 3, 15, 12, 0, 0, 0, time.UTC)
+	usdRate := domain.ExchangeRate{Currency: "USD", Rate: "0.0275"}
+
+	// usd returns an amount of US dollars
+	usd := func(amount string) domain.Money {
+		return domain.MustParseMoney(amount, "USD")
+	}
+
+	Describe("NormalizeCouponCode", func() {
+		It("should trim and upper-case the code", func() {
+			Expect(domain.NormalizeCouponCode(" save10 ")).To(Equal("SAVE10"))
+		})
+	})
+
+	Describe("Validate", func() {
+		It("should accept a valid percentage coupon", func() {
+			coupon := domain.Coupon{Code: "SAVE10", Type: domain.CouponPercentage, Percent: 10}
+
+			Expect(coupon.Validate()).To(BeEmpty())
+		})
+
+		It("should accept a valid fixed coupon with limits and a window", func() {
+			ends := now.AddDate(0, 1, 0)
+			coupon := domain.Coupon{
+				Code:          "WELCOME-100",
+				Type:          domain.CouponFixed,
+				Amount:        testutil.THB("100.00"),
+				MinOrderValue: testutil.THB("500.00"),
+				UsageLimit:    1000,
+				PerUserLimit:  1,
+				StartsAt:      &now,
+				EndsAt:        &ends,
+			}
+
+			Expect(coupon.Validate()).To(BeEmpty())
+		})
+
+		DescribeTable("should name the invalid field",
+			func(coupon domain.Coupon, field string) {
+				Expect(coupon.Validate()).To(ContainElement(HaveField("Field", field)))
+			},
+			Entry("malformed code", domain.Coupon{Code: "save 10", Type: domain.CouponPercentage, Percent: 10}, "code"),
+			Entry("short code", domain.Coupon{Code: "AB", Type: domain.CouponPercentage, Percent: 10}, "code"),
+			Entry("unknown type", domain.Coupon{Code: "SAVE10", Type: "bogo"}, "type"),
+			Entry("percent above 100", domain.Coupon{Code: "SAVE10", Type: domain.CouponPercentage, Percent: 101}, "percent"),
+			Entry("percent with amount", domain.Coupon{Code: "SAVE10", Type: domain.CouponPercentage, Percent: 10, Amount: testutil.THB("1.00")}, "amount"),
+			Entry("fixed without amount", domain.Coupon{Code: "SAVE10", Type: domain.CouponFixed}, "amount"),
+			Entry("fixed in another currency", domain.Coupon{Code: "SAVE10", Type: domain.CouponFixed, Amount: usd("1.00")}, "amount"),
+			Entry("fixed with percent", domain.Coupon{Code: "SAVE10", Type: domain.CouponFixed, Amount: testutil.THB("1.00"), Percent: 5}, "percent"),
+			Entry("negative minimum", domain.Coupon{Code: "SAVE10", Type: domain.CouponPercentage, Percent: 10, MinOrderValue: testutil.THB("-1.00")}, "min_order_value"),
+			Entry("negative usage limit", domain.Coupon{Code: "SAVE10", Type: domain.CouponPercentage, Percent: 10, UsageLimit: -1}, "usage_limit"),
+			Entry("negative per-user limit", domain.Coupon{Code: "SAVE10", Type: domain.CouponPercentage, Percent: 10, PerUserLimit: -1}, "per_user_limit"),
+			Entry("window ending before it starts", domain.Coupon{Code: "SAVE10", Type: domain.CouponPercentage, Percent: 10, StartsAt: &now, EndsAt: &now}, "ends_at"),
+		)
+	})
+
+	Describe("Discount", func() {
+		DescribeTable("should compute the discount",
+			func(coupon domain.Coupon, total domain.Money, rate domain.ExchangeRate, expected domain.Money) {
+				Expect(coupon.Discount(total, rate, now)).To(Equal(expected))
+			},
+			Entry("percentage rounded half up",
+				domain.Coupon{Code: "SAVE15", Type: domain.CouponPercentage, Percent: 15}, testutil.THB("99.90"), domain.BaseRate(), testutil.THB("14.99")),
+			Entry("whole total",
+				domain.Coupon{Code: "FREE", Type: domain.CouponPercentage, Percent: 100}, testutil.THB("99.90"), domain.BaseRate(), testutil.THB("99.90")),
+			Entry("fixed amount",
+				domain.Coupon{Code: "LESS100", Type: domain.CouponFixed, Amount: testutil.THB("100.00")}, testutil.THB("250.00"), domain.BaseRate(), testutil.THB("100.00")),
+			Entry("fixed amount capped at the total",
+				domain.Coupon{Code: "LESS100", Type: domain.CouponFixed, Amount: testutil.THB("100.00")}, testutil.THB("60.00"), domain.BaseRate(), testutil.THB("60.00")),
+			Entry("fixed amount converted to the order currency",
+				domain.Coupon{Code: "LESS100", Type: domain.CouponFixed, Amount: testutil.THB("100.00")}, usd("20.00"), usdRate, usd("2.75")),
+			Entry("minimum reached in the order currency",
+				domain.Coupon{Code: "SAVE10", Type: domain.CouponPercentage, Percent: 10, MinOrderValue: testutil.THB("1000.00")}, usd("27.50"), usdRate, usd("2.75")),
+		)
+
+		DescribeTable("should reject a coupon that does not apply on coupon_code",
+			func(coupon domain.Coupon, total domain.Money, message string) {
+				_, err := coupon.Discount(total, domain.BaseRate(), now)
+
+				Expect(err).To(MatchError(domain.ErrValidation))
+				Expect(err).To(Equal(domain.ValidationError{{Field: "coupon_code", Message: message}}))
+			},
+			Entry("not started",
+				domain.Coupon{Code: "SPRING", Type: domain.CouponPercentage, Percent: 10, StartsAt: ptr(now.Add(time.Hour))}, testutil.THB("100.00"), "coupon SPRING is not valid yet"),
+			Entry("expired",
+				domain.Coupon{Code: "WINTER", Type: domain.CouponPercentage, Percent: 10, EndsAt: ptr(now)}, testutil.THB("100.00"), "coupon WINTER has expired"),
+			Entry("below the minimum",
+				domain.Coupon{Code: "BIG", Type: domain.CouponFixed, Amount: testutil.THB("50.00"), MinOrderValue: testutil.THB("500.00")}, testutil.THB("499.99"), "coupon BIG needs an order of at least 500.00 THB"),
+		)
+
+		It("should apply from the start of its window", func() {
+			coupon := domain.Coupon{Code: "SPRING", Type: domain.CouponPercentage, Percent: 10, StartsAt: &now}
+
+			Expect(coupon.Discount(testutil.THB("100.00"), domain.BaseRate(), now)).To(Equal(testutil.THB("10.00")))
+		})
+	})
+
+	Describe("Version", func() {
+		coupon := domain.Coupon{ID: "1", Code: "SAVE10", Type: domain.CouponPercentage, Percent: 10}
+
+		It("should change when the terms change", func() {
+			changed := coupon
+			changed.Percent = 15
+
+			Expect(changed.Version()).ToNot(Equal(coupon.Version()))
+		})
+
+		It("should not depend on redemptions", func() {
+			redeemed := coupon
+			redeemed.Redemptions = 3
+
+			Expect(redeemed.Version()).To(Equal(coupon.Version()))
+		})
+	})
+})
+
+// ptr returns a pointer to t
+func ptr(t time.Time) *time.Time {
+	return &t
+}
@@ -0,0 +1,20 @@
+package domain
+
+// DeletePolicy says what deleting a user or product does to the orders
+// that reference it
+type DeletePolicy string
+
+const (
+	// DeleteRestrict refuses the deletion while any order references the
+	// entity
+	DeleteRestrict DeletePolicy = "restrict"
+	// DeleteCascade deletes the referencing orders too, returning the stock
+	// they hold
+	DeleteCascade DeletePolicy = "cascade"
+	// DeleteNullify keeps the referencing orders, with their prices and
+	// totals, but drops their reference to the deleted entity
+	DeleteNullify DeletePolicy = "nullify"
+)
+
+// DeletePolicies lists the valid delete policies
+var DeletePolicies = []DeletePolicy{DeleteRestrict, DeleteCascade, DeleteNullify}
@@ -0,0 +1,66 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Domain errors returned by repositories and services.
+// Wrap them with context and match them with errors.Is.
+var (
+	ErrNotFound           = errors.New("not found")
+	ErrConflict           = errors.New("conflicts with existing data")
+	ErrValidation         = errors.New("failed validation")
+	ErrInvalidID          = errors.New("invalid id")
+	ErrInvalidArgument    = errors.New("invalid argument")
+	ErrPreconditionFailed = errors.New("precondition failed")
+	ErrInsufficientStock  = errors.New("insufficient stock")
+	ErrInvalidTransition  = errors.New("invalid status transition")
+	ErrOrderClosed        = errors.New("order can no longer change")
+	ErrBatchAborted       = errors.New("not applied because another item of the batch failed")
+	ErrInUse              = errors.New("still referenced by orders")
+	ErrNotDeleted         = errors.New("not deleted")
+	ErrCouponUsedUp       = errors.New("usage limit reached")
+)
+
+// FieldError describes an input field that failed validation
+type FieldError struct {
+	Field   string
+	Message string
+}
+
+// ValidationError reports input fields that failed validation. It matches
+// ErrValidation.
+type ValidationError []FieldError
+
+// Error lists the failed fields
+func (e ValidationError) Error() string {
+	messages := make([]string, len(e))
+	for i, fe := range e {
+		messages[i] = fe.Field + " " + fe.Message
+	}
+	return ErrValidation.Error() + ": " + strings.Join(messages, "; ")
+}
+
+// Unwrap makes the error match ErrValidation
+func (e ValidationError) Unwrap() error {
+	return ErrValidation
+}
+
+// InUseError reports an entity that cannot be deleted because orders still
+// reference it. It matches ErrInUse.
+type InUseError struct {
+	Entity string
+	Orders int
+}
+
+// Error names the entity and counts the orders blocking its deletion
+func (e *InUseError) Error() string {
+	return fmt.Sprintf("%s %s: %d blocking orders", e.Entity, ErrInUse, e.Orders)
+}
+
+// Unwrap makes the error match ErrInUse
+func (e *InUseError) Unwrap() error {
+	return ErrInUse
+}
@@ -0,0 +1,50 @@
+package domain_test
+
+import (
+	"errors"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"gin-swagger-api/internal/domain"
+)
+
+var _ = Describe("ValidationError", func() {
+	err := domain.ValidationError{
+		{Field: "user_id", Message: "does not exist"},
+		{Field: "product_id", Message: "is out of stock"},
+	}
+
+	It("should list every failed field", func() {
+		Expect(err.Error()).To(Equal("failed validation: user_id does not exist; product_id is out of stock"))
+	})
+
+	It("should match ErrValidation when wrapped", func() {
+		wrapped := fmt.Errorf("order: %w", err)
+
+		Expect(wrapped).To(MatchError(domain.ErrValidation))
+
+		var fields domain.ValidationError
+		Expect(errors.As(wrapped, &fields)).To(BeTrue())
+		Expect(fields).To(HaveLen(2))
+	})
+})
+
+var _ = Describe("InUseError", func() {
+	err := &domain.InUseError{Entity: "user", Orders: 3}
+
+	It("should count the blocking orders", func() {
+		Expect(err.Error()).To(Equal("user still referenced by orders: 3 blocking orders"))
+	})
+
+	It("should match ErrInUse when wrapped", func() {
+		wrapped := fmt.Errorf("delete: %w", err)
+
+		Expect(wrapped).To(MatchError(domain.ErrInUse))
+
+		var inUse *domain.InUseError
+		Expect(errors.As(wrapped, &inUse)).To(BeTrue())
+		Expect(inUse.Orders).To(Equal(3))
+	})
+})
@@ -0,0 +1,120 @@
+package domain
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+)
+
+// maxRateDigits is the number of decimal places a rate may have
+const maxRateDigits = 10
+
+// Rate is an exchange rate: the units of a currency that one unit of
+// BaseCurrency buys. It is kept as a canonical decimal string, without
+// leading or trailing zeros, so that it is stored and compared exactly.
+type Rate string
+
+// RateOne converts BaseCurrency to itself
+const RateOne Rate = "1"
+
+// ExchangeRate is the rate at which prices in BaseCurrency are converted
+// to Currency
+type ExchangeRate struct {
+	Currency  string
+	Rate      Rate
+	UpdatedAt time.Time
+}
+
+// BaseRate returns the exchange rate of BaseCurrency to itself
+func BaseRate() ExchangeRate {
+	return ExchangeRate{Currency: BaseCurrency, Rate: RateOne}
+}
+
+// ParseRate parses a positive decimal rate such as "0.0275" with at most
+// ten decimal places
+func ParseRate(rate string) (Rate, error) {
+	invalid := fmt.Errorf("%w: %q is not a positive rate with at most %d decimal places", ErrInvalidArgument, rate, maxRateDigits)
+
+	whole, fraction, hasPoint := strings.Cut(rate, ".")
+	if !isDigits(whole) || (hasPoint && !isDigits(fraction)) || len(fraction) > maxRateDigits {
+		return "", invalid
+	}
+
+	whole = strings.TrimLeft(whole, "0")
+	fraction = strings.TrimRight(fraction, "0")
+	if whole == "" && fraction == "" {
+		return "", invalid
+	}
+	if whole == "" {
+		whole = "0"
+	}
+	if fraction == "" {
+		return Rate(whole), nil
+	}
+	return Rate(whole + "." + fraction), nil
+}
+
+// Convert converts an amount of BaseCurrency to the rate's currency,
+// rounding half up to its minor unit
+func (r ExchangeRate) Convert(m Money) (Money, error) {
+	if m.Currency != BaseCurrency {
+		return Money{}, fmt.Errorf("%w: cannot convert %s, rates are from %s", ErrInvalidArgument, m.Currency, BaseCurrency)
+	}
+	factor, err := r.factor()
+	if err != nil {
+		return Money{}, err
+	}
+
+	converted := m.Scale(factor, RoundHalfUp)
+	converted.Currency = r.Currency
+	return converted, nil
+}
+
+// ToBase converts an amount of the rate's currency back to BaseCurrency,
+// rounding half up to its minor unit
+func (r ExchangeRate) ToBase(m Money) (Money, error) {
+	if m.Currency != r.Currency {
+		return Money{}, fmt.Errorf("%w: cannot convert %s at a rate to %s", ErrInvalidArgument, m.Currency, r.Currency)
+	}
+	factor, err := r.factor()
+	if err != nil {
+		return Money{}, err
+	}
+
+	converted := m.Scale(factor.Inv(factor), RoundHalfUp)
+	converted.Currency = BaseCurrency
+	return converted, nil
+}
+
+// factor returns the number of minor units of the rate's currency that one
+// minor unit of BaseCurrency buys
+func (r ExchangeRate) factor() (*big.Rat, error) {
+	to, ok := currencyDigits[r.Currency]
+	if !ok {
+		return nil, fmt.Errorf("%w: unsupported currency %q", ErrInvalidArgument, r.Currency)
+	}
+
+	factor, ok := new(big.Rat).SetString(string(r.Rate))
+	if !ok || factor.Sign() <= 0 {
+		return nil, fmt.Errorf("%w: %q is not a rate", ErrInvalidArgument, r.Rate)
+	}
+
+	// Move between the minor units of the two currencies as well
+	from := currencyDigits[BaseCurrency]
+	shift := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(abs(to-from))), nil)
+	if to > from {
+		factor.Mul(factor, new(big.Rat).SetInt(shift))
+	} else {
+		factor.Quo(factor, new(big.Rat).SetInt(shift))
+	}
+	return factor, nil
+}
+
+// abs returns the absolute value of n
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
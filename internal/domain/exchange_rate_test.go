@@ -0,0 +1,94 @@
+package domain_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"gin-swagger-api/internal/domain"
+	"gin-swagger-api/internal/testutil"
+)
+
+var _ = Describe("ExchangeRate", func() {
+	Describe("ParseRate", func() {
+		DescribeTable("should keep rates as canonical decimals",
+			func(rate string, expected domain.Rate) {
+				Expect(domain.ParseRate(rate)).To(Equal(expected))
+			},
+			Entry("fraction", "0.0275", domain.Rate("0.0275")),
+			Entry("trailing zeros", "0.02750", domain.Rate("0.0275")),
+			Entry("leading zeros", "001.5", domain.Rate("1.5")),
+			Entry("whole", "36.00", domain.Rate("36")),
+			Entry("ten decimal places", "0.0000000001", domain.Rate("0.0000000001")),
+		)
+
+		DescribeTable("should reject rates that are not positive decimals",
+			func(rate string) {
+				_, err := domain.ParseRate(rate)
+
+				Expect(err).To(MatchError(domain.ErrInvalidArgument))
+			},
+			Entry("empty", ""),
+			Entry("zero", "0.000"),
+			Entry("negative", "-0.5"),
+			Entry("exponent", "2e-2"),
+			Entry("too many decimal places", "0.00000000001"),
+			Entry("missing whole part", ".5"),
+		)
+	})
+
+	Describe("Convert", func() {
+		DescribeTable("should convert base amounts, rounding half up",
+			func(rate domain.Rate, amount, expected string) {
+				converted, err := domain.ExchangeRate{Currency: "USD", Rate: rate}.Convert(testutil.THB(amount))
+
+				Expect(err).ToNot(HaveOccurred())
+				Expect(converted).To(Equal(domain.MustParseMoney(expected, "USD")))
+			},
+			Entry("exact", domain.Rate("0.03"), "100.00", "3.00"),
+			Entry("rounded down", domain.Rate("0.0275"), "25000.50", "687.51"),
+			Entry("rounded up on a half", domain.Rate("0.5"), "0.05", "0.03"),
+			Entry("whole rate", domain.Rate("2"), "1.25", "2.50"),
+		)
+
+		It("should leave base amounts unchanged at the base rate", func() {
+			Expect(domain.BaseRate().Convert(testutil.THB("499.99"))).To(Equal(testutil.THB("499.99")))
+		})
+
+		It("should only convert from the base currency", func() {
+			usd := domain.MustParseMoney("1.00", "USD")
+
+			_, err := domain.ExchangeRate{Currency: "EUR", Rate: "0.9"}.Convert(usd)
+
+			Expect(err).To(MatchError(domain.ErrInvalidArgument))
+		})
+
+		It("should reject unsupported currencies and malformed rates", func() {
+			_, err := domain.ExchangeRate{Currency: "XYZ", Rate: "1"}.Convert(testutil.THB("1.00"))
+			Expect(err).To(MatchError(domain.ErrInvalidArgument))
+
+			_, err = domain.ExchangeRate{Currency: "USD"}.Convert(testutil.THB("1.00"))
+			Expect(err).To(MatchError(domain.ErrInvalidArgument))
+		})
+	})
+
+	Describe("ToBase", func() {
+		DescribeTable("should convert amounts back to the base currency, rounding half up",
+			func(rate domain.Rate, amount, expected string) {
+				converted, err := domain.ExchangeRate{Currency: "USD", Rate: rate}.ToBase(domain.MustParseMoney(amount, "USD"))
+
+				Expect(err).ToNot(HaveOccurred())
+				Expect(converted).To(Equal(testutil.THB(expected)))
+			},
+			Entry("exact", domain.Rate("0.03"), "3.00", "100.00"),
+			Entry("rounded down", domain.Rate("0.0275"), "0.69", "25.09"),
+			Entry("rounded up on a half", domain.Rate("2"), "0.01", "0.01"),
+			Entry("whole rate", domain.Rate("2"), "2.50", "1.25"),
+		)
+
+		It("should only convert from the rate's currency", func() {
+			_, err := domain.ExchangeRate{Currency: "EUR", Rate: "0.9"}.ToBase(testutil.THB("1.00"))
+
+			Expect(err).To(MatchError(domain.ErrInvalidArgument))
+		})
+	})
+})
@@ -0,0 +1,27 @@
+package domain
+
+import "time"
+
+// IdempotencyRecord remembers a request sent with an idempotency key.
+// Once the request completes it also holds the response, so a retry of the
+// request gets the same response instead of repeating the write.
+type IdempotencyRecord struct {
+	Key string
+	// Fingerprint identifies the request the key was first used for
+	Fingerprint string
+	Status      int
+	Header      map[string]string
+	Body        []byte
+	ExpiresAt   time.Time
+}
+
+// Completed reports whether the response was recorded. The request of a
+// record without one is still in flight.
+func (r IdempotencyRecord) Completed() bool {
+	return r.Status != 0
+}
+
+// Expired reports whether the key is free to reuse at now
+func (r IdempotencyRecord) Expired(now time.Time) bool {
+	return !now.Before(r.ExpiresAt)
+}
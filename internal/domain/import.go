@@ -0,0 +1,26 @@
+package domain
+
+// ImportBatchSize is how many new rows an import creates per statement
+const ImportBatchSize = 100
+
+// Outcomes of an import row
+const (
+	ImportCreated = "created"
+	ImportUpdated = "updated"
+	ImportFailed  = "failed"
+)
+
+// ImportResult reports what an import did with one row of the upload.
+// Row is the row's number within the upload. ID is the created or updated
+// resource; it is empty for failed rows and for rows created by a dry run.
+type ImportResult struct {
+	Row    int
+	Status string
+	ID     string
+	Errors []FieldError
+}
+
+// Failed reports whether the row was rejected
+func (r ImportResult) Failed() bool {
+	return r.Status == ImportFailed
+}
@@ -0,0 +1,277 @@
+package domain
+
+import (
+	"fmt"
+	"math/big"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// BaseCurrency is the currency product prices are kept in
+const BaseCurrency = "THB"
+
+// currencyDigits holds the supported ISO 4217 currencies and the number of
+// decimal places of each, which sets the size of its minor unit
+var currencyDigits = map[string]int{
+	"THB": 2,
+	"USD": 2,
+	"EUR": 2,
+}
+
+// Money is an exact amount of a currency, counted in the currency's minor
+// unit (satang for THB, cents for USD and EUR). The zero value is zero of
+// no currency.
+type Money struct {
+	Amount   int64
+	Currency string
+}
+
+// Rounding says how an amount that falls between two minor units is
+// rounded
+type Rounding int
+
+const (
+	// RoundHalfUp rounds to the nearest minor unit, halves away from zero.
+	// Prices, conversions and discounts are rounded this way.
+	RoundHalfUp Rounding = iota
+	// RoundHalfEven rounds to the nearest minor unit, halves to the even
+	// one
+	RoundHalfEven
+	// RoundDown truncates toward zero
+	RoundDown
+)
+
+// SupportedCurrency reports whether money can be held in currency
+func SupportedCurrency(currency string) bool {
+	_, ok := currencyDigits[currency]
+	return ok
+}
+
+// ParseMoney parses a decimal amount of currency such as "25000.50" or
+// "-3". It is exact: amounts with more decimal places than the currency
+// has are rejected rather than rounded.
+func ParseMoney(amount, currency string) (Money, error) {
+	digits, ok := currencyDigits[currency]
+	if !ok {
+		return Money{}, fmt.Errorf("%w: unsupported currency %q", ErrInvalidArgument, currency)
+	}
+	invalid := fmt.Errorf("%w: %q is not an amount of %s with at most %d decimal places", ErrInvalidArgument, amount, currency, digits)
+
+	unsigned, negative := strings.CutPrefix(amount, "-")
+	whole, fraction, hasPoint := strings.Cut(unsigned, ".")
+	if !isDigits(whole) || (hasPoint && !isDigits(fraction)) || len(fraction) > digits {
+		return Money{}, invalid
+	}
+
+	minor, err := strconv.ParseInt(whole+fraction+strings.Repeat("0", digits-len(fraction)), 10, 64)
+	if err != nil {
+		return Money{}, invalid
+	}
+	if negative {
+		minor = -minor
+	}
+	return Money{Amount: minor, Currency: currency}, nil
+}
+
+// MustParseMoney is like ParseMoney but panics if the amount cannot be
+// parsed. It is meant for constants and tests.
+func MustParseMoney(amount, currency string) Money {
+	m, err := ParseMoney(amount, currency)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// isDigits reports whether s is a non-empty run of ASCII digits
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// String formats the amount as a decimal with the currency's number of
+// decimal places, without the currency code
+func (m Money) String() string {
+	digits := m.digits()
+	amount := m.Amount
+	sign := ""
+	if amount < 0 {
+		sign, amount = "-", -amount
+	}
+
+	s := strconv.FormatInt(amount, 10)
+	if digits == 0 {
+		return sign + s
+	}
+	if len(s) <= digits {
+		s = strings.Repeat("0", digits-len(s)+1) + s
+	}
+	return sign + s[:len(s)-digits] + "." + s[len(s)-digits:]
+}
+
+// digits returns the decimal places of the currency, two for the zero
+// value
+func (m Money) digits() int {
+	if digits, ok := currencyDigits[m.Currency]; ok {
+		return digits
+	}
+	return 2
+}
+
+// IsZero reports whether the amount is zero
+func (m Money) IsZero() bool {
+	return m.Amount == 0
+}
+
+// IsPositive reports whether the amount is greater than zero
+func (m Money) IsPositive() bool {
+	return m.Amount > 0
+}
+
+// IsNegative reports whether the amount is less than zero
+func (m Money) IsNegative() bool {
+	return m.Amount < 0
+}
+
+// Add returns m+o. Amounts of different currencies cannot be added.
+func (m Money) Add(o Money) (Money, error) {
+	if err := m.sameCurrency(o); err != nil {
+		return Money{}, err
+	}
+	return Money{Amount: m.Amount + o.Amount, Currency: m.Currency}, nil
+}
+
+// Sub returns m-o. Amounts of different currencies cannot be subtracted.
+func (m Money) Sub(o Money) (Money, error) {
+	if err := m.sameCurrency(o); err != nil {
+		return Money{}, err
+	}
+	return Money{Amount: m.Amount - o.Amount, Currency: m.Currency}, nil
+}
+
+// Cmp compares m and o, returning -1, 0 or +1 like cmp.Compare. Amounts
+// of different currencies cannot be compared.
+func (m Money) Cmp(o Money) (int, error) {
+	if err := m.sameCurrency(o); err != nil {
+		return 0, err
+	}
+	switch {
+	case m.Amount < o.Amount:
+		return -1, nil
+	case m.Amount > o.Amount:
+		return 1, nil
+	default:
+		return 0, nil
+	}
+}
+
+// Mul returns the amount n times over, exactly
+func (m Money) Mul(n int) Money {
+	return Money{Amount: m.Amount * int64(n), Currency: m.Currency}
+}
+
+// Scale multiplies the amount by factor, such as a rate or a percentage,
+// and rounds the result to a minor unit
+func (m Money) Scale(factor *big.Rat, rounding Rounding) Money {
+	product := new(big.Rat).Mul(new(big.Rat).SetInt64(m.Amount), factor)
+	return Money{Amount: round(product, rounding), Currency: m.Currency}
+}
+
+// Allocate splits the amount into parts in proportion to weights, which
+// must not be negative. Each part is rounded down and the minor units left
+// over go to the parts with the largest remainders, earlier parts first,
+// so the parts add up to the amount exactly. Without any weight the whole
+// amount goes to the last part.
+func (m Money) Allocate(weights []int64) []Money {
+	parts := make([]Money, len(weights))
+	if len(weights) == 0 {
+		return parts
+	}
+
+	total := new(big.Int)
+	for _, w := range weights {
+		total.Add(total, big.NewInt(w))
+	}
+	if total.Sign() == 0 {
+		for i := range parts {
+			parts[i] = Money{Currency: m.Currency}
+		}
+		parts[len(parts)-1].Amount = m.Amount
+		return parts
+	}
+
+	remainders := make([]*big.Int, len(weights))
+	left := m.Amount
+	for i, w := range weights {
+		product := new(big.Int).Mul(big.NewInt(m.Amount), big.NewInt(w))
+		quotient, remainder := new(big.Int).DivMod(product, total, new(big.Int))
+		parts[i] = Money{Amount: quotient.Int64(), Currency: m.Currency}
+		remainders[i] = remainder
+		left -= parts[i].Amount
+	}
+
+	order := make([]int, len(weights))
+	for i := range order {
+		order[i] = i
+	}
+	slices.SortStableFunc(order, func(a, b int) int {
+		return remainders[b].Cmp(remainders[a])
+	})
+	for _, i := range order[:left] {
+		parts[i].Amount++
+	}
+	return parts
+}
+
+// sameCurrency reports amounts of different currencies as
+// ErrInvalidArgument
+func (m Money) sameCurrency(o Money) error {
+	if m.Currency != o.Currency {
+		return fmt.Errorf("%w: cannot combine amounts of %s and %s", ErrInvalidArgument, m.Currency, o.Currency)
+	}
+	return nil
+}
+
+// round rounds r to an integer
+func round(r *big.Rat, rounding Rounding) int64 {
+	quotient, remainder := new(big.Int).QuoRem(r.Num(), r.Denom(), new(big.Int))
+	if remainder.Sign() == 0 || rounding == RoundDown {
+		return quotient.Int64()
+	}
+
+	// Compare twice the remainder with the denominator to tell which
+	// neighbour is nearer
+	half := new(big.Int).Abs(remainder)
+	half.Lsh(half, 1)
+	away := false
+	switch half.Cmp(r.Denom()) {
+	case 1:
+		away = true
+	case 0:
+		away = rounding == RoundHalfUp || quotient.Bit(0) == 1
+	}
+	if away {
+		quotient.Add(quotient, big.NewInt(int64(r.Sign())))
+	}
+	return quotient.Int64()
+}
+
+// Sum adds amounts of currency. It is zero of currency without amounts.
+func Sum(currency string, amounts ...Money) (Money, error) {
+	total := Money{Currency: currency}
+	for _, amount := range amounts {
+		var err error
+		if total, err = total.Add(amount); err != nil {
+			return Money{}, err
+		}
+	}
+	return total, nil
+}
@@ -0,0 +1,156 @@
+package domain_test
+
+import (
+	"math/big"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"gin-swagger-api/internal/domain"
+	"gin-swagger-api/internal/testutil"
+)
+
+var _ = Describe("Money", func() {
+	Describe("ParseMoney", func() {
+		DescribeTable("should parse decimal amounts exactly",
+			func(amount string, expected int64) {
+				m, err := domain.ParseMoney(amount, "THB")
+
+				Expect(err).ToNot(HaveOccurred())
+				Expect(m).To(Equal(domain.Money{Amount: expected, Currency: "THB"}))
+			},
+			Entry("two decimal places", "25000.50", int64(2500050)),
+			Entry("one decimal place", "25000.5", int64(2500050)),
+			Entry("whole amount", "3", int64(300)),
+			Entry("zero", "0", int64(0)),
+			Entry("negative amount", "-0.05", int64(-5)),
+		)
+
+		DescribeTable("should reject malformed amounts",
+			func(amount string) {
+				_, err := domain.ParseMoney(amount, "THB")
+
+				Expect(err).To(MatchError(domain.ErrInvalidArgument))
+			},
+			Entry("empty", ""),
+			Entry("too many decimal places", "0.125"),
+			Entry("exponent", "1e3"),
+			Entry("missing whole part", ".5"),
+			Entry("missing fraction", "5."),
+			Entry("plus sign", "+5"),
+			Entry("thousands separator", "1,000"),
+			Entry("out of range", "92233720368547758.08"),
+		)
+
+		It("should reject unsupported currencies", func() {
+			_, err := domain.ParseMoney("1.00", "XYZ")
+
+			Expect(err).To(MatchError(ContainSubstring(`unsupported currency "XYZ"`)))
+		})
+	})
+
+	Describe("String", func() {
+		DescribeTable("should format the currency's decimal places",
+			func(m domain.Money, expected string) {
+				Expect(m.String()).To(Equal(expected))
+			},
+			Entry("whole and fraction", testutil.THB("25000.50"), "25000.50"),
+			Entry("under one", domain.Money{Amount: 5, Currency: "THB"}, "0.05"),
+			Entry("negative", domain.Money{Amount: -150, Currency: "USD"}, "-1.50"),
+			Entry("zero value", domain.Money{}, "0.00"),
+		)
+	})
+
+	Describe("arithmetic", func() {
+		It("should multiply without drift", func() {
+			Expect(testutil.THB("499.99").Mul(3)).To(Equal(testutil.THB("1499.97")))
+			Expect(testutil.THB("0.10").Mul(3)).To(Equal(testutil.THB("0.30")))
+		})
+
+		It("should add and subtract amounts of one currency", func() {
+			sum, err := testutil.THB("0.10").Add(testutil.THB("0.20"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(sum).To(Equal(testutil.THB("0.30")))
+
+			difference, err := testutil.THB("10.00").Sub(testutil.THB("12.50"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(difference).To(Equal(testutil.THB("-2.50")))
+		})
+
+		It("should compare amounts of one currency", func() {
+			Expect(testutil.THB("1.00").Cmp(testutil.THB("2.00"))).To(Equal(-1))
+			Expect(testutil.THB("2.00").Cmp(testutil.THB("2.00"))).To(Equal(0))
+			Expect(testutil.THB("2.01").Cmp(testutil.THB("2.00"))).To(Equal(1))
+		})
+
+		It("should refuse to combine currencies", func() {
+			usd := domain.MustParseMoney("1.00", "USD")
+
+			_, err := testutil.THB("1.00").Add(usd)
+			Expect(err).To(MatchError(domain.ErrInvalidArgument))
+			_, err = testutil.THB("1.00").Sub(usd)
+			Expect(err).To(MatchError(domain.ErrInvalidArgument))
+			_, err = testutil.THB("1.00").Cmp(usd)
+			Expect(err).To(MatchError(domain.ErrInvalidArgument))
+		})
+
+		It("should sum amounts, zero without any", func() {
+			Expect(domain.Sum("THB", testutil.THB("0.10"), testutil.THB("0.20"), testutil.THB("0.30"))).To(Equal(testutil.THB("0.60")))
+			Expect(domain.Sum("THB")).To(Equal(testutil.THB("0")))
+
+			_, err := domain.Sum("USD", testutil.THB("1.00"))
+			Expect(err).To(MatchError(domain.ErrInvalidArgument))
+		})
+
+		It("should report the sign", func() {
+			Expect(testutil.THB("0").IsZero()).To(BeTrue())
+			Expect(testutil.THB("0.01").IsPositive()).To(BeTrue())
+			Expect(testutil.THB("-0.01").IsNegative()).To(BeTrue())
+			Expect(testutil.THB("0").IsPositive()).To(BeFalse())
+		})
+	})
+
+	Describe("Scale", func() {
+		rat := func(s string) *big.Rat {
+			r, ok := new(big.Rat).SetString(s)
+			Expect(ok).To(BeTrue())
+			return r
+		}
+
+		DescribeTable("should round to a minor unit",
+			func(amount, factor string, rounding domain.Rounding, expected string) {
+				Expect(testutil.THB(amount).Scale(rat(factor), rounding)).To(Equal(testutil.THB(expected)))
+			},
+			Entry("exact", "100.00", "0.15", domain.RoundHalfUp, "15.00"),
+			Entry("half up rounds halves away from zero", "0.05", "0.5", domain.RoundHalfUp, "0.03"),
+			Entry("half up on negatives", "-0.05", "0.5", domain.RoundHalfUp, "-0.03"),
+			Entry("half up below half", "10.00", "1/3", domain.RoundHalfUp, "3.33"),
+			Entry("half up above half", "20.00", "1/3", domain.RoundHalfUp, "6.67"),
+			Entry("half even rounds down to even", "0.05", "0.5", domain.RoundHalfEven, "0.02"),
+			Entry("half even rounds up to even", "0.07", "0.5", domain.RoundHalfEven, "0.04"),
+			Entry("down truncates", "20.00", "1/3", domain.RoundDown, "6.66"),
+			Entry("down truncates negatives toward zero", "-20.00", "1/3", domain.RoundDown, "-6.66"),
+		)
+	})
+
+	Describe("Allocate", func() {
+		DescribeTable("should split the amount exactly in proportion to the weights",
+			func(amount string, weights []int64, expected []string) {
+				parts := make([]domain.Money, len(expected))
+				for i, part := range expected {
+					parts[i] = testutil.THB(part)
+				}
+				Expect(testutil.THB(amount).Allocate(weights)).To(Equal(parts))
+			},
+			Entry("exact", "922.50", []int64{100000, 2500}, []string{"900.00", "22.50"}),
+			Entry("leftovers to the largest remainders", "10.00", []int64{1, 1, 1}, []string{"3.34", "3.33", "3.33"}),
+			Entry("leftovers by remainder, not position", "1.00", []int64{1, 2}, []string{"0.33", "0.67"}),
+			Entry("zero weights", "5.00", []int64{0, 3}, []string{"0", "5.00"}),
+			Entry("without any weight", "5.00", []int64{0, 0}, []string{"0", "5.00"}),
+		)
+
+		It("should return no parts without weights", func() {
+			Expect(testutil.THB("5.00").Allocate(nil)).To(BeEmpty())
+		})
+	})
+})
@@ -1,13 +1,202 @@
 package domain
 
-// Order represents an order in the system
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+)
+
+// Order represents an order in the system. Its prices are in Currency,
+// converted from the product prices at ExchangeRate when it was placed.
+// Discount is the amount the coupon CouponCode took off the items total
+// when the order was placed; TotalPrice is net of it. BaseTotal is
+// TotalPrice converted back to BaseCurrency at ExchangeRate. DeletedAt is
+// set while the order is soft-deleted. Revision counts the writes to the
+// order and its items; the repository makes writes conditional on it.
 type Order struct {
-	ID         string
-	UserID     int
-	ProductID  int
-	Quantity   int
-	TotalPrice float64
-	Status     string
-	User       *User
-	Product    *Product
+	ID           string
+	UserID       int
+	Items        []OrderItem
+	TotalPrice   Money
+	BaseTotal    Money
+	Currency     string
+	ExchangeRate Rate
+	CouponCode   string
+	Discount     Money
+	Status       string
+	CreatedAt    time.Time
+	DeletedAt    *time.Time
+	Revision     int
+	User         *User
+}
+
+// OrderItem is a line of an order: a quantity of one product and the
+// price it was placed at. BaseTotal is the line's share of the BaseTotal
+// of the order.
+type OrderItem struct {
+	ProductID int
+	Quantity  int
+	UnitPrice Money
+	LineTotal Money
+	BaseTotal Money
+	Product   *Product
+}
+
+// NewOrderItem prices quantity units of a product at unitPrice
+func NewOrderItem(productID, quantity int, unitPrice Money) OrderItem {
+	return OrderItem{
+		ProductID: productID,
+		Quantity:  quantity,
+		UnitPrice: unitPrice,
+		LineTotal: unitPrice.Mul(quantity),
+	}
+}
+
+// Version identifies the current state of the order, which changes with
+// every write to it. Loaded relations and the immutable currency, rate,
+// discount and creation time do not affect it.
+func (o Order) Version() string {
+	fields := []any{o.ID, o.UserID, o.TotalPrice, o.Status, o.Revision}
+	for _, item := range o.Items {
+		fields = append(fields, item.ProductID, item.Quantity, item.UnitPrice, item.LineTotal)
+	}
+	return version(fields...)
+}
+
+// Pricing returns the exchange rate the order was placed at. Orders
+// without a currency are in the base currency.
+func (o Order) Pricing() ExchangeRate {
+	if o.Currency == "" {
+		return BaseRate()
+	}
+	return ExchangeRate{Currency: o.Currency, Rate: o.ExchangeRate}
+}
+
+// BaseTotals converts the total price of the order to BaseCurrency at the
+// rate it was placed at and splits the result across its items in
+// proportion to their line totals, so that the item shares add up to the
+// order amount and discounts are spread over the lines
+func (o Order) BaseTotals() (Money, []Money, error) {
+	total, err := o.Pricing().ToBase(o.TotalPrice)
+	if err != nil {
+		return Money{}, nil, err
+	}
+
+	weights := make([]int64, len(o.Items))
+	for i, item := range o.Items {
+		weights[i] = item.LineTotal.Amount
+	}
+	return total, total.Allocate(weights), nil
+}
+
+// Item returns the line of the order for a product
+func (o Order) Item(productID int) (OrderItem, bool) {
+	i := slices.IndexFunc(o.Items, func(item OrderItem) bool { return item.ProductID == productID })
+	if i < 0 {
+		return OrderItem{}, false
+	}
+	return o.Items[i], true
+}
+
+// Reserved returns the units of stock the order holds by product ID.
+// Cancelled and soft-deleted orders hold none, and neither do lines whose
+// product was deleted.
+func (o Order) Reserved() map[int]int {
+	reserved := make(map[int]int, len(o.Items))
+	if o.Status == OrderStatusCancelled || o.DeletedAt != nil {
+		return reserved
+	}
+	for _, item := range o.Items {
+		if item.ProductID == 0 {
+			continue
+		}
+		reserved[item.ProductID] += item.Quantity
+	}
+	return reserved
+}
+
+// Releasable returns the units of stock that go back to stock when the
+// order is deleted, by product ID. Only pending and paid orders still
+// hold theirs; the goods of shipped and delivered orders have left the
+// warehouse.
+func (o Order) Releasable() map[int]int {
+	if o.Status != OrderStatusPending && o.Status != OrderStatusPaid {
+		return make(map[int]int)
+	}
+	return o.Reserved()
+}
+
+// CheckItemsChange checks that lines can replace the items of the order.
+// Only pending and paid orders can change their items; lines with other
+// products or quantities fail with ErrOrderClosed once the order has
+// shipped, been delivered or been cancelled.
+func (o Order) CheckItemsChange(lines []OrderItem) error {
+	if o.Status == OrderStatusPending || o.Status == OrderStatusPaid {
+		return nil
+	}
+	if maps.Equal(quantities(o.Items), quantities(lines)) {
+		return nil
+	}
+	return fmt.Errorf("%w: the items of a %s order cannot change", ErrOrderClosed, o.Status)
+}
+
+// quantities sums the quantities of items by product ID
+func quantities(items []OrderItem) map[int]int {
+	quantities := make(map[int]int, len(items))
+	for _, item := range items {
+		quantities[item.ProductID] += item.Quantity
+	}
+	return quantities
+}
+
+// TotalReleasable sums the units of stock that deleting orders returns to
+// stock, by product ID
+func TotalReleasable(orders []Order) map[int]int {
+	total := make(map[int]int)
+	for _, o := range orders {
+		for productID, quantity := range o.Releasable() {
+			total[productID] += quantity
+		}
+	}
+	return total
+}
+
+// ItemsTotal sums the line totals of items, which must all be in currency
+func ItemsTotal(currency string, items []OrderItem) (Money, error) {
+	lineTotals := make([]Money, len(items))
+	for i, item := range items {
+		lineTotals[i] = item.LineTotal
+	}
+	return Sum(currency, lineTotals...)
+}
+
+// Total returns the total of items in the order's currency less the
+// order's discount, which is kept when the items change but never takes
+// the total below zero
+func (o Order) Total(items []OrderItem) (Money, error) {
+	total, err := ItemsTotal(o.Pricing().Currency, items)
+	if err != nil || o.Discount.IsZero() {
+		return total, err
+	}
+	if over, err := o.Discount.Cmp(total); err != nil || over >= 0 {
+		return Money{Amount: 0, Currency: total.Currency}, err
+	}
+	return total.Sub(o.Discount)
+}
+
+// OrderPatch holds the order fields to change; nil fields are kept.
+// Item prices and TotalPrice are priced by the order service, never taken
+// from clients.
+type OrderPatch struct {
+	Items      []OrderItem
+	TotalPrice *Money
+	Status     *string
+}
+
+// OrderExpand selects the relations loaded with orders: the user who
+// placed them and the product of each item
+type OrderExpand struct {
+	User    bool
+	Product bool
 }
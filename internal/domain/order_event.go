@@ -0,0 +1,15 @@
+package domain
+
+import "time"
+
+// OrderEvent records a change of order status. FromStatus is empty for
+// the event of a newly placed order.
+type OrderEvent struct {
+	ID         string
+	OrderID    int
+	FromStatus string
+	ToStatus   string
+	Actor      string
+	Reason     string
+	CreatedAt  time.Time
+}
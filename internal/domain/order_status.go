@@ -0,0 +1,50 @@
+package domain
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Order statuses. Orders start pending and move pending → paid → shipped
+// → delivered; pending and paid orders can be cancelled.
+const (
+	OrderStatusPending   = "pending"
+	OrderStatusPaid      = "paid"
+	OrderStatusShipped   = "shipped"
+	OrderStatusDelivered = "delivered"
+	OrderStatusCancelled = "cancelled"
+)
+
+// OrderStatuses lists every order status in lifecycle order
+var OrderStatuses = []string{
+	OrderStatusPending,
+	OrderStatusPaid,
+	OrderStatusShipped,
+	OrderStatusDelivered,
+	OrderStatusCancelled,
+}
+
+// orderTransitions maps each status to the statuses an order can move to
+var orderTransitions = map[string][]string{
+	OrderStatusPending: {OrderStatusPaid, OrderStatusCancelled},
+	OrderStatusPaid:    {OrderStatusShipped, OrderStatusCancelled},
+	OrderStatusShipped: {OrderStatusDelivered},
+}
+
+// CheckOrderTransition checks that an order can move from one status to
+// another. Keeping the same status is allowed. An unknown target status
+// fails with ErrValidation and a move the lifecycle does not allow with
+// ErrInvalidTransition.
+func CheckOrderTransition(from, to string) error {
+	if from == to {
+		return nil
+	}
+	if !slices.Contains(OrderStatuses, to) {
+		return fmt.Errorf("%w: status must be one of: %s", ErrValidation, strings.Join(OrderStatuses, ", "))
+	}
+	if !slices.Contains(orderTransitions[from], to) {
+		return fmt.Errorf("%w: order cannot go from %s to %s", ErrInvalidTransition, from, to)
+	}
+	return nil
+}
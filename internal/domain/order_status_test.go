@@ -0,0 +1,43 @@
+package domain_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"gin-swagger-api/internal/domain"
+)
+
+var _ = Describe("Order status", func() {
+	Describe("CheckOrderTransition", func() {
+		DescribeTable("should allow the lifecycle transitions",
+			func(from, to string) {
+				Expect(domain.CheckOrderTransition(from, to)).To(Succeed())
+			},
+			Entry("pay", domain.OrderStatusPending, domain.OrderStatusPaid),
+			Entry("ship", domain.OrderStatusPaid, domain.OrderStatusShipped),
+			Entry("deliver", domain.OrderStatusShipped, domain.OrderStatusDelivered),
+			Entry("cancel pending", domain.OrderStatusPending, domain.OrderStatusCancelled),
+			Entry("cancel paid", domain.OrderStatusPaid, domain.OrderStatusCancelled),
+			Entry("keep the status", domain.OrderStatusShipped, domain.OrderStatusShipped),
+		)
+
+		DescribeTable("should reject transitions outside the lifecycle",
+			func(from, to string) {
+				Expect(domain.CheckOrderTransition(from, to)).To(MatchError(domain.ErrInvalidTransition))
+			},
+			Entry("ship unpaid", domain.OrderStatusPending, domain.OrderStatusShipped),
+			Entry("skip shipping", domain.OrderStatusPaid, domain.OrderStatusDelivered),
+			Entry("cancel shipped", domain.OrderStatusShipped, domain.OrderStatusCancelled),
+			Entry("reopen cancelled", domain.OrderStatusCancelled, domain.OrderStatusPending),
+			Entry("leave delivered", domain.OrderStatusDelivered, domain.OrderStatusPaid),
+			Entry("go back", domain.OrderStatusPaid, domain.OrderStatusPending),
+		)
+
+		It("should reject an unknown status as invalid", func() {
+			err := domain.CheckOrderTransition(domain.OrderStatusPending, "completed")
+
+			Expect(err).To(MatchError(domain.ErrValidation))
+			Expect(err.Error()).To(ContainSubstring("pending, paid, shipped, delivered, cancelled"))
+		})
+	})
+})
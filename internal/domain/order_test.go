@@ -0,0 +1,245 @@
+package domain_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"gin-swagger-api/internal/domain"
+	"gin-swagger-api/internal/testutil"
+)
+
+var _ = Describe("Order", func() {
+	Describe("NewOrderItem", func() {
+		It("should price the line at the unit price", func() {
+			Expect(domain.NewOrderItem(7, 3, testutil.THB("499.99"))).To(Equal(domain.OrderItem{
+				ProductID: 7,
+				Quantity:  3,
+				UnitPrice: testutil.THB("499.99"),
+				LineTotal: testutil.THB("1499.97"),
+			}))
+		})
+	})
+
+	Describe("ItemsTotal", func() {
+		It("should sum the line totals exactly", func() {
+			items := []domain.OrderItem{
+				domain.NewOrderItem(1, 3, testutil.THB("0.10")),
+				domain.NewOrderItem(2, 1, testutil.THB("0.20")),
+			}
+
+			Expect(domain.ItemsTotal(domain.BaseCurrency, items)).To(Equal(testutil.THB("0.50")))
+		})
+
+		It("should be zero without items", func() {
+			Expect(domain.ItemsTotal(domain.BaseCurrency, nil)).To(Equal(testutil.THB("0")))
+		})
+
+		It("should refuse lines in another currency", func() {
+			items := []domain.OrderItem{domain.NewOrderItem(1, 1, domain.MustParseMoney("1.00", "USD"))}
+
+			_, err := domain.ItemsTotal(domain.BaseCurrency, items)
+
+			Expect(err).To(MatchError(domain.ErrInvalidArgument))
+		})
+	})
+
+	Describe("Total", func() {
+		items := []domain.OrderItem{domain.NewOrderItem(1, 2, testutil.THB("150.00"))}
+
+		It("should be the items total without a discount", func() {
+			Expect(domain.Order{}.Total(items)).To(Equal(testutil.THB("300.00")))
+		})
+
+		It("should take the discount off the items total", func() {
+			order := domain.Order{CouponCode: "SAVE50", Discount: testutil.THB("50.00")}
+
+			Expect(order.Total(items)).To(Equal(testutil.THB("250.00")))
+		})
+
+		It("should not go below zero when the discount exceeds the items", func() {
+			order := domain.Order{CouponCode: "SAVE500", Discount: testutil.THB("500.00")}
+
+			Expect(order.Total(items)).To(Equal(testutil.THB("0")))
+		})
+
+		It("should total in the order's currency", func() {
+			order := domain.Order{Currency: "USD", ExchangeRate: "0.0275", Discount: domain.MustParseMoney("1.00", "USD")}
+			items := []domain.OrderItem{domain.NewOrderItem(1, 1, domain.MustParseMoney("4.13", "USD"))}
+
+			Expect(order.Total(items)).To(Equal(domain.MustParseMoney("3.13", "USD")))
+		})
+	})
+
+	Describe("Item", func() {
+		order := domain.Order{Items: []domain.OrderItem{
+			domain.NewOrderItem(1, 2, testutil.THB("10")),
+			domain.NewOrderItem(2, 1, testutil.THB("5")),
+		}}
+
+		It("should find the line of a product", func() {
+			item, ok := order.Item(2)
+
+			Expect(ok).To(BeTrue())
+			Expect(item.Quantity).To(Equal(1))
+		})
+
+		It("should report a product that is not on the order", func() {
+			_, ok := order.Item(3)
+
+			Expect(ok).To(BeFalse())
+		})
+	})
+
+	Describe("Reserved", func() {
+		items := []domain.OrderItem{
+			domain.NewOrderItem(1, 3, testutil.THB("10")),
+			domain.NewOrderItem(2, 1, testutil.THB("5")),
+		}
+
+		It("should hold the ordered quantity of each product", func() {
+			order := domain.Order{Items: items, Status: domain.OrderStatusPending}
+
+			Expect(order.Reserved()).To(Equal(map[int]int{1: 3, 2: 1}))
+		})
+
+		It("should release the stock of a cancelled order", func() {
+			order := domain.Order{Items: items, Status: domain.OrderStatusCancelled}
+
+			Expect(order.Reserved()).To(BeEmpty())
+		})
+
+		It("should release the stock of a soft-deleted order", func() {
+			deletedAt := time.Now()
+			order := domain.Order{Items: items, Status: domain.OrderStatusPending, DeletedAt: &deletedAt}
+
+			Expect(order.Reserved()).To(BeEmpty())
+		})
+
+		It("should hold no stock for the lines of deleted products", func() {
+			order := domain.Order{Items: append(items, domain.NewOrderItem(0, 4, testutil.THB("2"))), Status: domain.OrderStatusPaid}
+
+			Expect(order.Reserved()).To(Equal(map[int]int{1: 3, 2: 1}))
+		})
+	})
+
+	Describe("Releasable", func() {
+		items := []domain.OrderItem{domain.NewOrderItem(1, 3, testutil.THB("10"))}
+
+		DescribeTable("should release stock only for orders that have not shipped",
+			func(status string, expected map[int]int) {
+				order := domain.Order{Items: items, Status: status}
+
+				Expect(order.Releasable()).To(Equal(expected))
+			},
+			Entry("pending", domain.OrderStatusPending, map[int]int{1: 3}),
+			Entry("paid", domain.OrderStatusPaid, map[int]int{1: 3}),
+			Entry("shipped", domain.OrderStatusShipped, map[int]int{}),
+			Entry("delivered", domain.OrderStatusDelivered, map[int]int{}),
+			Entry("cancelled", domain.OrderStatusCancelled, map[int]int{}),
+		)
+	})
+
+	Describe("BaseTotals", func() {
+		It("should convert the total back and share it over the items", func() {
+			order := domain.Order{
+				Currency:     "USD",
+				ExchangeRate: "0.0275",
+				Items: []domain.OrderItem{
+					domain.NewOrderItem(1, 1, domain.MustParseMoney("2.75", "USD")),
+					domain.NewOrderItem(2, 1, domain.MustParseMoney("0.55", "USD")),
+				},
+				TotalPrice: domain.MustParseMoney("3.00", "USD"),
+			}
+
+			total, items, err := order.BaseTotals()
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(total).To(Equal(testutil.THB("109.09")))
+			Expect(items).To(Equal([]domain.Money{testutil.THB("90.91"), testutil.THB("18.18")}))
+		})
+
+		It("should refuse a total in another currency than the order", func() {
+			order := domain.Order{Currency: "USD", ExchangeRate: "0.0275", TotalPrice: testutil.THB("3.00")}
+
+			_, _, err := order.BaseTotals()
+
+			Expect(err).To(MatchError(domain.ErrInvalidArgument))
+		})
+	})
+
+	Describe("CheckItemsChange", func() {
+		items := []domain.OrderItem{domain.NewOrderItem(1, 2, testutil.THB("10")), domain.NewOrderItem(2, 1, testutil.THB("5"))}
+		reordered := []domain.OrderItem{{ProductID: 2, Quantity: 1}, {ProductID: 1, Quantity: 2}}
+		changed := []domain.OrderItem{{ProductID: 1, Quantity: 3}, {ProductID: 2, Quantity: 1}}
+
+		DescribeTable("should allow new items only while the order is pending or paid",
+			func(status string, allowed bool) {
+				err := domain.Order{Items: items, Status: status}.CheckItemsChange(changed)
+
+				if allowed {
+					Expect(err).ToNot(HaveOccurred())
+				} else {
+					Expect(err).To(MatchError(domain.ErrOrderClosed))
+				}
+			},
+			Entry("pending", domain.OrderStatusPending, true),
+			Entry("paid", domain.OrderStatusPaid, true),
+			Entry("shipped", domain.OrderStatusShipped, false),
+			Entry("delivered", domain.OrderStatusDelivered, false),
+			Entry("cancelled", domain.OrderStatusCancelled, false),
+		)
+
+		It("should allow the same items in any order", func() {
+			order := domain.Order{Items: items, Status: domain.OrderStatusShipped}
+
+			Expect(order.CheckItemsChange(reordered)).To(Succeed())
+		})
+	})
+
+	Describe("TotalReleasable", func() {
+		It("should sum the stock returned by deleting every order", func() {
+			orders := []domain.Order{
+				{Items: []domain.OrderItem{domain.NewOrderItem(1, 3, testutil.THB("10")), domain.NewOrderItem(2, 1, testutil.THB("5"))}, Status: domain.OrderStatusPending},
+				{Items: []domain.OrderItem{domain.NewOrderItem(1, 2, testutil.THB("10"))}, Status: domain.OrderStatusPaid},
+				{Items: []domain.OrderItem{domain.NewOrderItem(2, 4, testutil.THB("5"))}, Status: domain.OrderStatusCancelled},
+				{Items: []domain.OrderItem{domain.NewOrderItem(1, 6, testutil.THB("10"))}, Status: domain.OrderStatusDelivered},
+			}
+
+			Expect(domain.TotalReleasable(orders)).To(Equal(map[int]int{1: 5, 2: 1}))
+		})
+	})
+
+	Describe("Version", func() {
+		order := domain.Order{
+			ID:         "1",
+			UserID:     1,
+			Items:      []domain.OrderItem{domain.NewOrderItem(1, 2, testutil.THB("10"))},
+			TotalPrice: testutil.THB("20"),
+			Status:     domain.OrderStatusPending,
+		}
+
+		It("should change when an item changes", func() {
+			changed := order
+			changed.Items = []domain.OrderItem{domain.NewOrderItem(1, 3, testutil.THB("10"))}
+
+			Expect(changed.Version()).ToNot(Equal(order.Version()))
+		})
+
+		It("should change with every write, even one that changes nothing else", func() {
+			rewritten := order
+			rewritten.Revision++
+
+			Expect(rewritten.Version()).ToNot(Equal(order.Version()))
+		})
+
+		It("should not depend on loaded item products", func() {
+			loaded := order
+			loaded.Items = []domain.OrderItem{order.Items[0]}
+			loaded.Items[0].Product = &domain.Product{ID: "1"}
+
+			Expect(loaded.Version()).To(Equal(order.Version()))
+		})
+	})
+})
@@ -0,0 +1,85 @@
+package domain
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	// DefaultPageSize is used when a list request does not set a limit
+	DefaultPageSize = 20
+	// MaxPageSize is the largest page a list request may return
+	MaxPageSize = 100
+	// ExportBatchSize is how many rows an export reads at a time
+	ExportBatchSize = 500
+)
+
+// PageRequest selects a page of a list ordered by ID.
+// When Cursor is set, keyset pagination is used and Offset is ignored.
+type PageRequest struct {
+	Limit  int
+	Offset int
+	Cursor string
+}
+
+// Normalize applies the default and maximum page size
+func (p PageRequest) Normalize() PageRequest {
+	if p.Limit <= 0 {
+		p.Limit = DefaultPageSize
+	}
+	if p.Limit > MaxPageSize {
+		p.Limit = MaxPageSize
+	}
+	if p.Offset < 0 {
+		p.Offset = 0
+	}
+	return p
+}
+
+// Page represents one page of a list
+type Page[T any] struct {
+	Items      []T
+	Total      int
+	Limit      int
+	Offset     int
+	NextCursor string
+	PrevCursor string
+}
+
+// Cursor is the decoded form of an opaque keyset pagination cursor.
+// It points at the rows after ID, or before ID when Before is set.
+type Cursor struct {
+	ID     int
+	Before bool
+}
+
+// Encode returns the opaque string form of the cursor
+func (c Cursor) Encode() string {
+	direction := "a"
+	if c.Before {
+		direction = "b"
+	}
+	return base64.RawURLEncoding.EncodeToString(fmt.Appendf(nil, "%s:%d", direction, c.ID))
+}
+
+// DecodeCursor parses an opaque cursor produced by Cursor.Encode
+func DecodeCursor(s string) (Cursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return Cursor{}, fmt.Errorf("%w: malformed cursor", ErrInvalidArgument)
+	}
+
+	direction, id, ok := strings.Cut(string(raw), ":")
+	if !ok || (direction != "a" && direction != "b") {
+		return Cursor{}, fmt.Errorf("%w: malformed cursor", ErrInvalidArgument)
+	}
+
+	intID, err := strconv.Atoi(id)
+	if err != nil {
+		return Cursor{}, fmt.Errorf("%w: malformed cursor", ErrInvalidArgument)
+	}
+
+	return Cursor{ID: intID, Before: direction == "b"}, nil
+}
@@ -0,0 +1,51 @@
+package domain_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"gin-swagger-api/internal/domain"
+)
+
+var _ = Describe("Page", func() {
+	Describe("PageRequest.Normalize", func() {
+		DescribeTable("should clamp the page window",
+			func(in, expected domain.PageRequest) {
+				Expect(in.Normalize()).To(Equal(expected))
+			},
+			Entry("default limit", domain.PageRequest{}, domain.PageRequest{Limit: domain.DefaultPageSize}),
+			Entry("negative limit", domain.PageRequest{Limit: -5}, domain.PageRequest{Limit: domain.DefaultPageSize}),
+			Entry("limit above maximum", domain.PageRequest{Limit: 1000}, domain.PageRequest{Limit: domain.MaxPageSize}),
+			Entry("negative offset", domain.PageRequest{Limit: 10, Offset: -1}, domain.PageRequest{Limit: 10}),
+			Entry("valid window", domain.PageRequest{Limit: 10, Offset: 30, Cursor: "abc"}, domain.PageRequest{Limit: 10, Offset: 30, Cursor: "abc"}),
+		)
+	})
+
+	Describe("Cursor", func() {
+		It("should round-trip an after cursor", func() {
+			cursor, err := domain.DecodeCursor(domain.Cursor{ID: 42}.Encode())
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(cursor).To(Equal(domain.Cursor{ID: 42}))
+		})
+
+		It("should round-trip a before cursor", func() {
+			cursor, err := domain.DecodeCursor(domain.Cursor{ID: 7, Before: true}.Encode())
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(cursor).To(Equal(domain.Cursor{ID: 7, Before: true}))
+		})
+
+		DescribeTable("should reject malformed cursors",
+			func(raw string) {
+				_, err := domain.DecodeCursor(raw)
+
+				Expect(err).To(MatchError(domain.ErrInvalidArgument))
+			},
+			Entry("not base64", "!!!"),
+			Entry("missing direction", "NDI"),
+			Entry("unknown direction", "eDo0Mg"),
+			Entry("non-numeric id", "YTp4"),
+		)
+	})
+})
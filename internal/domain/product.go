@@ -1,10 +1,36 @@
 package domain
 
-// Product represents a product in the system
+import "time"
+
+// Product represents a product in the system. Price is in BaseCurrency.
+// DeletedAt is set while the product is soft-deleted.
 type Product struct {
 	ID          string
 	Name        string
 	Description string
-	Price       float64
+	Price       Money
 	Stock       int
+	DeletedAt   *time.Time
+}
+
+// Version identifies the current state of the product
+func (p Product) Version() string {
+	return version(p.ID, p.Name, p.Description, p.Price, p.Stock)
+}
+
+// ProductPatch holds the product fields to change; nil fields are kept
+type ProductPatch struct {
+	Name        *string
+	Description *string
+	Price       *Money
+	Stock       *int
+}
+
+// ProductImportRow is a product to create, or to update when ID is set,
+// read from row Row of an upload. An update only changes the fields of
+// Patch the row has a value for.
+type ProductImportRow struct {
+	Row int
+	Product
+	Patch ProductPatch
 }
@@ -0,0 +1,150 @@
+package domain
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"time"
+)
+
+// Operator is a filter comparison operator
+type Operator string
+
+const (
+	OpEq Operator = "eq"
+	OpNe Operator = "ne"
+	OpGt Operator = "gt"
+	OpLt Operator = "lt"
+	OpIn Operator = "in"
+	// OpLike matches a case-insensitive substring
+	OpLike Operator = "like"
+)
+
+// Filter restricts a list to items whose field matches the values.
+// Only OpIn takes more than one value.
+type Filter struct {
+	Field  string
+	Op     Operator
+	Values []any
+}
+
+// Sort orders a list by a field
+type Sort struct {
+	Field string
+	Desc  bool
+}
+
+// ListQuery holds the filters and sort order of a list request
+type ListQuery struct {
+	Filters []Filter
+	Sort    []Sort
+}
+
+// FieldKind is the value type of a filterable field
+type FieldKind int
+
+const (
+	StringField FieldKind = iota
+	IntField
+	// MoneyField values are decimal amounts, which are compared in minor
+	// units
+	MoneyField
+	// TimeField values are RFC 3339 times or dates, which stand for
+	// midnight UTC
+	TimeField
+)
+
+// operators returns the operators a field of this kind supports
+func (k FieldKind) operators() []Operator {
+	if k == StringField {
+		return []Operator{OpEq, OpNe, OpIn, OpLike}
+	}
+	return []Operator{OpEq, OpNe, OpGt, OpLt, OpIn}
+}
+
+// parse converts a raw filter value to the Go type of the field
+func (k FieldKind) parse(raw string) (any, error) {
+	switch k {
+	case IntField:
+		return strconv.Atoi(raw)
+	case MoneyField:
+		m, err := ParseMoney(raw, BaseCurrency)
+		return m.Amount, err
+	case TimeField:
+		if t, err := time.Parse(time.DateOnly, raw); err == nil {
+			return t, nil
+		}
+		return time.Parse(time.RFC3339, raw)
+	default:
+		return raw, nil
+	}
+}
+
+// FieldSet whitelists the fields a list can be filtered and sorted by
+type FieldSet map[string]FieldKind
+
+// UserFields are the filterable and sortable user fields
+var UserFields = FieldSet{
+	"id":    IntField,
+	"name":  StringField,
+	"email": StringField,
+}
+
+// ProductFields are the filterable and sortable product fields
+var ProductFields = FieldSet{
+	"id":          IntField,
+	"name":        StringField,
+	"description": StringField,
+	"price":       MoneyField,
+	"stock":       IntField,
+}
+
+// OrderFields are the filterable and sortable order fields. total_price
+// is given in BaseCurrency and compared with the total of each order
+// converted at its rate, so that orders in every currency compare.
+var OrderFields = FieldSet{
+	"id":          IntField,
+	"user_id":     IntField,
+	"total_price": MoneyField,
+	"currency":    StringField,
+	"status":      StringField,
+	"created_at":  TimeField,
+}
+
+// Resolve checks a query against the field set and converts filter values
+// to the type of their field. Unknown fields, unsupported operators and
+// malformed values are reported as ErrInvalidArgument.
+func (s FieldSet) Resolve(q ListQuery) (ListQuery, error) {
+	resolved := ListQuery{Sort: q.Sort}
+
+	for _, f := range q.Filters {
+		kind, ok := s[f.Field]
+		if !ok {
+			return ListQuery{}, fmt.Errorf("%w: unknown filter field %q", ErrInvalidArgument, f.Field)
+		}
+		if !slices.Contains(kind.operators(), f.Op) {
+			return ListQuery{}, fmt.Errorf("%w: operator %q is not supported on field %q", ErrInvalidArgument, f.Op, f.Field)
+		}
+		if len(f.Values) == 0 || (f.Op != OpIn && len(f.Values) > 1) {
+			return ListQuery{}, fmt.Errorf("%w: wrong number of values for field %q", ErrInvalidArgument, f.Field)
+		}
+
+		values := make([]any, len(f.Values))
+		for i, v := range f.Values {
+			value, err := kind.parse(fmt.Sprint(v))
+			if err != nil {
+				return ListQuery{}, fmt.Errorf("%w: invalid value %q for field %q", ErrInvalidArgument, fmt.Sprint(v), f.Field)
+			}
+			values[i] = value
+		}
+		resolved.Filters = append(resolved.Filters, Filter{Field: f.Field, Op: f.Op, Values: values})
+	}
+
+	for _, o := range q.Sort {
+		if _, ok := s[o.Field]; !ok {
+			return ListQuery{}, fmt.Errorf("%w: unknown sort field %q", ErrInvalidArgument, o.Field)
+		}
+	}
+
+	return resolved, nil
+}
@@ -0,0 +1,94 @@
+package domain_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"gin-swagger-api/internal/domain"
+)
+
+var _ = Describe("Query", func() {
+	Describe("FieldSet.Resolve", func() {
+		It("should convert filter values to the field type, amounts to minor units", func() {
+			list, err := domain.OrderFields.Resolve(domain.ListQuery{
+				Filters: []domain.Filter{
+					{Field: "user_id", Op: domain.OpEq, Values: []any{"5"}},
+					{Field: "total_price", Op: domain.OpGt, Values: []any{"99.5"}},
+					{Field: "status", Op: domain.OpIn, Values: []any{"pending", "paid"}},
+				},
+				Sort: []domain.Sort{{Field: "total_price", Desc: true}},
+			})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(list).To(Equal(domain.ListQuery{
+				Filters: []domain.Filter{
+					{Field: "user_id", Op: domain.OpEq, Values: []any{5}},
+					{Field: "total_price", Op: domain.OpGt, Values: []any{int64(9950)}},
+					{Field: "status", Op: domain.OpIn, Values: []any{"pending", "paid"}},
+				},
+				Sort: []domain.Sort{{Field: "total_price", Desc: true}},
+			}))
+		})
+
+		It("should parse times and dates of time fields", func() {
+			list, err := domain.OrderFields.Resolve(domain.ListQuery{
+				Filters: []domain.Filter{
+					{Field: "created_at", Op: domain.OpGt, Values: []any{"2026-01-01"}},
+					{Field: "created_at", Op: domain.OpLt, Values: []any{"2026-01-31T18:00:00+07:00"}},
+				},
+			})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(list.Filters[0].Values).To(Equal([]any{time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC)}))
+			Expect(list.Filters[1].Values[0]).To(BeTemporally("==", time.Date(2026, time.January, 31, 11, 0, 0, 0, time.UTC)))
+		})
+
+		It("should reject a malformed time", func() {
+			_, err := domain.OrderFields.Resolve(domain.ListQuery{
+				Filters: []domain.Filter{{Field: "created_at", Op: domain.OpGt, Values: []any{"yesterday"}}},
+			})
+
+			Expect(err).To(MatchError(domain.ErrInvalidArgument))
+			Expect(err.Error()).To(ContainSubstring(`invalid value "yesterday" for field "created_at"`))
+		})
+
+		It("should accept an empty query", func() {
+			list, err := domain.UserFields.Resolve(domain.ListQuery{})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(list).To(Equal(domain.ListQuery{}))
+		})
+
+		DescribeTable("should reject invalid queries",
+			func(list domain.ListQuery, message string) {
+				_, err := domain.ProductFields.Resolve(list)
+
+				Expect(err).To(MatchError(domain.ErrInvalidArgument))
+				Expect(err.Error()).To(ContainSubstring(message))
+			},
+			Entry("unknown filter field",
+				domain.ListQuery{Filters: []domain.Filter{{Field: "secret", Op: domain.OpEq, Values: []any{"x"}}}},
+				`unknown filter field "secret"`),
+			Entry("like on a numeric field",
+				domain.ListQuery{Filters: []domain.Filter{{Field: "price", Op: domain.OpLike, Values: []any{"1"}}}},
+				`operator "like" is not supported on field "price"`),
+			Entry("gt on a text field",
+				domain.ListQuery{Filters: []domain.Filter{{Field: "name", Op: domain.OpGt, Values: []any{"a"}}}},
+				`operator "gt" is not supported on field "name"`),
+			Entry("malformed number",
+				domain.ListQuery{Filters: []domain.Filter{{Field: "stock", Op: domain.OpGt, Values: []any{"many"}}}},
+				`invalid value "many" for field "stock"`),
+			Entry("amount with too many decimal places",
+				domain.ListQuery{Filters: []domain.Filter{{Field: "price", Op: domain.OpGt, Values: []any{"9.999"}}}},
+				`invalid value "9.999" for field "price"`),
+			Entry("several values without in",
+				domain.ListQuery{Filters: []domain.Filter{{Field: "name", Op: domain.OpEq, Values: []any{"a", "b"}}}},
+				`wrong number of values for field "name"`),
+			Entry("unknown sort field",
+				domain.ListQuery{Sort: []domain.Sort{{Field: "secret"}}},
+				`unknown sort field "secret"`),
+		)
+	})
+})
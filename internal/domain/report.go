@@ -0,0 +1,46 @@
+package domain
+
+import "time"
+
+// Dimensions a sales report can be grouped by
+const (
+	SalesByProduct = "product"
+	SalesByUser    = "user"
+	SalesByStatus  = "status"
+	SalesByDay     = "day"
+	SalesByWeek    = "week"
+	SalesByMonth   = "month"
+)
+
+// SalesDimensions lists the dimensions a sales report can be grouped by
+var SalesDimensions = []string{SalesByProduct, SalesByUser, SalesByStatus, SalesByDay, SalesByWeek, SalesByMonth}
+
+// SalesQuery selects the orders placed from From up to, not including, To
+// and the dimension to group their sales by. An empty GroupBy reports the
+// total only.
+type SalesQuery struct {
+	From    time.Time
+	To      time.Time
+	GroupBy string
+}
+
+// SalesRow holds the sales of one group. Key is the product or user ID,
+// the status, or the first day of the period (2006-01-02, or 2006-01 for
+// months) the group stands for.
+type SalesRow struct {
+	Key     string
+	Revenue Money
+	Orders  int
+	Units   int
+}
+
+// SalesReport holds the sales of each group and the total over all of
+// them. An order with items for several products counts once in the
+// total and once for each of its products.
+type SalesReport struct {
+	From    time.Time
+	To      time.Time
+	GroupBy string
+	Rows    []SalesRow
+	Total   SalesRow
+}
@@ -0,0 +1,20 @@
+package domain
+
+import "context"
+
+// includeDeletedKey is the context key asking reads for soft-deleted
+// entities too
+type includeDeletedKey struct{}
+
+// WithDeleted returns a context whose reads also return soft-deleted
+// users, products and orders
+func WithDeleted(ctx context.Context) context.Context {
+	return context.WithValue(ctx, includeDeletedKey{}, true)
+}
+
+// IncludesDeleted reports whether reads made with ctx return soft-deleted
+// entities too
+func IncludesDeleted(ctx context.Context) bool {
+	include, _ := ctx.Value(includeDeletedKey{}).(bool)
+	return include
+}
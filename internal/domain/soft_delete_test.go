@@ -0,0 +1,20 @@
+package domain_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"gin-swagger-api/internal/domain"
+)
+
+var _ = Describe("WithDeleted", func() {
+	It("should leave soft-deleted entities out of reads by default", func() {
+		Expect(domain.IncludesDeleted(context.Background())).To(BeFalse())
+	})
+
+	It("should ask reads for soft-deleted entities too", func() {
+		Expect(domain.IncludesDeleted(domain.WithDeleted(context.Background()))).To(BeTrue())
+	})
+})
@@ -1,8 +1,17 @@
 package domain
 
-// User represents a user in the system
+import "time"
+
+// User represents a user in the system. DeletedAt is set while the user
+// is soft-deleted.
 type User struct {
-	ID    string
-	Name  string
-	Email string
+	ID        string
+	Name      string
+	Email     string
+	DeletedAt *time.Time
+}
+
+// Version identifies the current state of the user
+func (u User) Version() string {
+	return version(u.ID, u.Name, u.Email)
 }
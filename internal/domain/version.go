@@ -0,0 +1,17 @@
+package domain
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+)
+
+// version hashes the field values of an entity into an opaque string that
+// changes whenever any of the values change
+func version(fields ...any) string {
+	h := sha256.New()
+	for _, f := range fields {
+		fmt.Fprintf(h, "%v\x00", f)
+	}
+	return hex.EncodeToString(h.Sum(nil)[:8])
+}
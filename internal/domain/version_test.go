@@ -0,0 +1,52 @@
+package domain_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"gin-swagger-api/internal/domain"
+	"gin-swagger-api/internal/testutil"
+)
+
+var _ = Describe("Version", func() {
+	product := domain.Product{ID: "1", Name: "Laptop", Description: "Gaming", Price: testutil.THB("999.99"), Stock: 10}
+
+	It("should be stable for equal values", func() {
+		copied := product
+
+		Expect(product.Version()).To(Equal(copied.Version()))
+		Expect(product.Version()).To(MatchRegexp(`^[0-9a-f]{16}$`))
+	})
+
+	DescribeTable("should change when a product field changes",
+		func(change func(p *domain.Product)) {
+			changed := product
+			change(&changed)
+
+			Expect(changed.Version()).ToNot(Equal(product.Version()))
+		},
+		Entry("id", func(p *domain.Product) { p.ID = "2" }),
+		Entry("name", func(p *domain.Product) { p.Name = "Desktop" }),
+		Entry("description", func(p *domain.Product) { p.Description = "" }),
+		Entry("price", func(p *domain.Product) { p.Price = testutil.THB("1000") }),
+		Entry("stock", func(p *domain.Product) { p.Stock = 9 }),
+	)
+
+	It("should not confuse values across field boundaries", func() {
+		a := domain.User{ID: "1", Name: "ab", Email: "c"}
+		b := domain.User{ID: "1", Name: "a", Email: "bc"}
+
+		Expect(a.Version()).ToNot(Equal(b.Version()))
+	})
+
+	It("should ignore loaded order relations", func() {
+		order := domain.Order{ID: "1", UserID: 1, Items: []domain.OrderItem{domain.NewOrderItem(2, 3, testutil.THB("10"))}, TotalPrice: testutil.THB("30"), Status: "pending"}
+		loaded := order
+		loaded.User = &domain.User{ID: "1"}
+
+		Expect(loaded.Version()).To(Equal(order.Version()))
+
+		loaded.Status = "paid"
+		Expect(loaded.Version()).ToNot(Equal(order.Version()))
+	})
+})
@@ -0,0 +1,116 @@
+package batch
+
+import (
+	"fmt"
+	"net/http"
+
+	"gin-swagger-api/internal/domain"
+	"gin-swagger-api/internal/handler/httperr"
+)
+
+// Request represents the body of a batch request on one resource.
+// Creates are applied first, then updates, then deletes. The mode defaults
+// to all_or_nothing.
+type Request[C, U any] struct {
+	Mode   string       `json:"mode" binding:"omitempty,oneof=all_or_nothing best_effort" enums:"all_or_nothing,best_effort" example:"all_or_nothing"`
+	Create []C          `json:"create" binding:"omitempty,dive"`
+	Update []U          `json:"update" binding:"omitempty,dive"`
+	Delete []DeleteItem `json:"delete" binding:"omitempty,dive"`
+}
+
+// DeleteItem represents a resource to delete in a batch. A version, the
+// ETag of the resource without quotes, must match the current version.
+type DeleteItem struct {
+	ID      string `json:"id" binding:"required" example:"1"`
+	Version string `json:"version" example:"5d41402abc4b2a76"`
+}
+
+// Response represents the outcome of a batch. Each list holds one result
+// per item of the matching request list, in the same order.
+type Response[T any] struct {
+	Mode      string      `json:"mode" enums:"all_or_nothing,best_effort" example:"all_or_nothing"`
+	Succeeded int         `json:"succeeded" example:"3"`
+	Failed    int         `json:"failed" example:"0"`
+	Create    []Result[T] `json:"create"`
+	Update    []Result[T] `json:"update"`
+	Delete    []Result[T] `json:"delete"`
+}
+
+// Result represents the outcome of one item, with the status code the
+// single-item endpoint would have returned. Data and version describe the
+// written resource; error describes a failure.
+type Result[T any] struct {
+	Status  int              `json:"status" example:"201"`
+	Version string           `json:"version,omitempty" example:"5d41402abc4b2a76"`
+	Data    *T               `json:"data,omitempty"`
+	Error   *httperr.Problem `json:"error,omitempty"`
+}
+
+// ToBatch converts a request to a domain.Batch, converting its creates and
+// updates with create and update. It fails with domain.ErrInvalidArgument
+// when the batch is empty or holds more than domain.MaxBatchSize items.
+func ToBatch[C, U, T any](req Request[C, U], create func(C) T, update func(U) domain.BatchUpdate[T]) (domain.Batch[T], error) {
+	b := domain.Batch[T]{
+		Mode:   req.Mode,
+		Create: make([]T, len(req.Create)),
+		Update: make([]domain.BatchUpdate[T], len(req.Update)),
+		Delete: make([]domain.BatchRef, len(req.Delete)),
+	}
+	if b.Mode == "" {
+		b.Mode = domain.BatchAllOrNothing
+	}
+	for i, item := range req.Create {
+		b.Create[i] = create(item)
+	}
+	for i, item := range req.Update {
+		b.Update[i] = update(item)
+	}
+	for i, item := range req.Delete {
+		b.Delete[i] = domain.BatchRef{ID: item.ID, Version: item.Version}
+	}
+
+	if size := b.Size(); size == 0 || size > domain.MaxBatchSize {
+		return domain.Batch[T]{}, fmt.Errorf("%w: a batch holds 1 to %d items, got %d", domain.ErrInvalidArgument, domain.MaxBatchSize, size)
+	}
+	return b, nil
+}
+
+// NewResponse reports the results of a batch, converting written
+// resources with convert
+func NewResponse[T interface{ Version() string }, R any](mode string, results *domain.BatchResults[T], convert func(T) R) Response[R] {
+	resp := Response[R]{Mode: mode}
+	add := func(results []domain.BatchResult[T], status int) []Result[R] {
+		out := make([]Result[R], len(results))
+		for i, result := range results {
+			if result.Err != nil {
+				problem := httperr.From(result.Err)
+				out[i] = Result[R]{Status: problem.Status, Error: &problem}
+				resp.Failed++
+				continue
+			}
+
+			out[i].Status = status
+			if result.Value != nil {
+				data := convert(*result.Value)
+				out[i].Data = &data
+				out[i].Version = (*result.Value).Version()
+			}
+			resp.Succeeded++
+		}
+		return out
+	}
+
+	resp.Create = add(results.Create, http.StatusCreated)
+	resp.Update = add(results.Update, http.StatusOK)
+	resp.Delete = add(results.Delete, http.StatusNoContent)
+	return resp
+}
+
+// Status returns 200 when every item succeeded and 207 Multi-Status when
+// any failed, so the results need to be read item by item
+func (r Response[T]) Status() int {
+	if r.Failed > 0 {
+		return http.StatusMultiStatus
+	}
+	return http.StatusOK
+}
@@ -0,0 +1,88 @@
+package batch_test
+
+import (
+	"fmt"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"gin-swagger-api/internal/domain"
+	"gin-swagger-api/internal/handler/batch"
+)
+
+var _ = Describe("Batch", func() {
+	create := func(name string) domain.User { return domain.User{Name: name} }
+	update := func(id string) domain.BatchUpdate[domain.User] {
+		return domain.BatchUpdate[domain.User]{Version: "v1", Value: domain.User{ID: id}}
+	}
+
+	Describe("ToBatch", func() {
+		It("should convert every item and default to all-or-nothing", func() {
+			b, err := batch.ToBatch(batch.Request[string, string]{
+				Create: []string{"John"},
+				Update: []string{"2"},
+				Delete: []batch.DeleteItem{{ID: "3", Version: "v2"}},
+			}, create, update)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(b).To(Equal(domain.Batch[domain.User]{
+				Mode:   domain.BatchAllOrNothing,
+				Create: []domain.User{{Name: "John"}},
+				Update: []domain.BatchUpdate[domain.User]{{Version: "v1", Value: domain.User{ID: "2"}}},
+				Delete: []domain.BatchRef{{ID: "3", Version: "v2"}},
+			}))
+		})
+
+		It("should keep the requested mode", func() {
+			b, err := batch.ToBatch(batch.Request[string, string]{Mode: domain.BatchBestEffort, Create: []string{"John"}}, create, update)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(b.Mode).To(Equal(domain.BatchBestEffort))
+		})
+
+		DescribeTable("should reject batches of the wrong size",
+			func(size int) {
+				names := make([]string, size)
+
+				_, err := batch.ToBatch(batch.Request[string, string]{Create: names}, create, update)
+
+				Expect(err).To(MatchError(domain.ErrInvalidArgument))
+			},
+			Entry("empty", 0),
+			Entry("too large", domain.MaxBatchSize+1),
+		)
+	})
+
+	Describe("NewResponse", func() {
+		It("should report each item with the status of its single-item endpoint", func() {
+			user := domain.User{ID: "1", Name: "John"}
+			resp := batch.NewResponse(domain.BatchBestEffort, &domain.BatchResults[domain.User]{
+				Create: []domain.BatchResult[domain.User]{{Value: &user}, {Err: fmt.Errorf("user %w", domain.ErrConflict)}},
+				Update: []domain.BatchResult[domain.User]{{Value: &user}},
+				Delete: []domain.BatchResult[domain.User]{{}, {Err: domain.ErrBatchAborted}},
+			}, func(u domain.User) string { return u.Name })
+
+			name := "John"
+			Expect(resp.Mode).To(Equal(domain.BatchBestEffort))
+			Expect(resp.Succeeded).To(Equal(3))
+			Expect(resp.Failed).To(Equal(2))
+			Expect(resp.Create[0]).To(Equal(batch.Result[string]{Status: http.StatusCreated, Version: user.Version(), Data: &name}))
+			Expect(resp.Create[1].Status).To(Equal(http.StatusConflict))
+			Expect(resp.Create[1].Error.Detail).To(Equal("user conflicts with existing data"))
+			Expect(resp.Update[0].Status).To(Equal(http.StatusOK))
+			Expect(resp.Delete[0]).To(Equal(batch.Result[string]{Status: http.StatusNoContent}))
+			Expect(resp.Delete[1].Status).To(Equal(http.StatusFailedDependency))
+			Expect(resp.Status()).To(Equal(http.StatusMultiStatus))
+		})
+
+		It("should be OK when every item succeeded", func() {
+			resp := batch.NewResponse(domain.BatchAllOrNothing, &domain.BatchResults[domain.User]{
+				Delete: []domain.BatchResult[domain.User]{{}},
+			}, func(u domain.User) string { return u.Name })
+
+			Expect(resp.Status()).To(Equal(http.StatusOK))
+			Expect(resp.Create).To(BeEmpty())
+		})
+	})
+})
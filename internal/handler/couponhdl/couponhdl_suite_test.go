@@ -0,0 +1,19 @@
+package couponhdl_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"gin-swagger-api/internal/handler/httperr"
+)
+
+func TestCouponHdl(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "CouponHdl Suite")
+}
+
+var _ = BeforeSuite(func() {
+	Expect(httperr.SetupValidator()).To(Succeed())
+})
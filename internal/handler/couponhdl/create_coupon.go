@@ -0,0 +1,46 @@
+package couponhdl
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"gin-swagger-api/internal/handler/etag"
+	"gin-swagger-api/internal/handler/httperr"
+)
+
+// CreateCoupon godoc
+// @Summary Create a coupon
+// @Description Create a discount code customers can send as coupon_code when they place an order.
+// @Description Codes are matched regardless of case and stored upper-cased; a code that is taken is rejected with 409.
+// @Description A percentage coupon needs a percent from 1 to 100 and a fixed coupon a positive amount; invalid terms are rejected with 422 naming the field.
+// @Description Requires an admin API key.
+// @Tags coupons
+// @Accept json
+// @Produce json
+// @Param coupon body CouponRequest true "Coupon terms"
+// @Param X-API-Key header string true "Admin API key"
+// @Success 201 {object} CouponResponse
+// @Header 201 {string} ETag "Entity tag of the current coupon version"
+// @Failure 400 {object} httperr.Problem
+// @Failure 403 {object} httperr.Problem
+// @Failure 409 {object} httperr.Problem
+// @Failure 422 {object} httperr.Problem
+// @Failure 500 {object} httperr.Problem
+// @Router /coupons [post]
+func (h *Handler) CreateCoupon(c *gin.Context) {
+	var req CouponRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		httperr.BadRequest(c, err)
+		return
+	}
+
+	coupon, err := h.couponService.CreateCoupon(c.Request.Context(), req.toCoupon())
+	if err != nil {
+		httperr.Respond(c, err)
+		return
+	}
+
+	etag.Set(c, coupon.Version())
+	c.JSON(http.StatusCreated, toCouponResponse(*coupon))
+}
@@ -0,0 +1,121 @@
+package couponhdl_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"gin-swagger-api/internal/domain"
+	"gin-swagger-api/internal/handler/couponhdl"
+	"gin-swagger-api/internal/handler/etag"
+	"gin-swagger-api/internal/handler/httperr"
+	"gin-swagger-api/internal/testutil"
+	mockcouponsvc "gin-swagger-api/mock/service/couponsvc"
+)
+
+var _ = Describe("Handler CreateCoupon", func() {
+	var (
+		mockService *mockcouponsvc.MockService
+		handler     *couponhdl.Handler
+		ctx         context.Context
+	)
+
+	BeforeEach(func() {
+		gin.SetMode(gin.TestMode)
+		mockService = mockcouponsvc.NewMockService(GinkgoT())
+		handler = couponhdl.NewHandler(mockService)
+		ctx = context.Background()
+	})
+
+	// createCoupon calls the handler with body
+	createCoupon := func(body string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodPost, "/api/v1/coupons", strings.NewReader(body))
+		c.Request.Header.Set("Content-Type", "application/json")
+		c.Request = c.Request.WithContext(ctx)
+
+		handler.CreateCoupon(c)
+		return w
+	}
+
+	Describe("CreateCoupon", func() {
+		It("should create a fixed coupon with its amounts in the base currency", func() {
+			endsAt := time.Date(2026, 12, 1, 0, 0, 0, 0, time.UTC)
+			coupon := &domain.Coupon{
+				ID:            "1",
+				Code:          "WELCOME",
+				Type:          domain.CouponFixed,
+				Amount:        testutil.THB("100.00"),
+				MinOrderValue: testutil.THB("500.00"),
+				PerUserLimit:  1,
+				EndsAt:        &endsAt,
+			}
+			mockService.EXPECT().CreateCoupon(ctx, domain.Coupon{
+				Code:          "welcome",
+				Type:          domain.CouponFixed,
+				Amount:        testutil.THB("100.00"),
+				MinOrderValue: testutil.THB("500.00"),
+				PerUserLimit:  1,
+				EndsAt:        &endsAt,
+			}).Return(coupon, nil)
+
+			w := createCoupon(`{"code": "welcome", "type": "fixed", "amount": "100", "min_order_value": 500, "per_user_limit": 1, "ends_at": "2026-12-01T00:00:00Z"}`)
+
+			Expect(w.Code).To(Equal(http.StatusCreated))
+			Expect(w.Header().Get(etag.Header)).To(Equal(`"` + coupon.Version() + `"`))
+
+			var response couponhdl.CouponResponse
+			Expect(json.Unmarshal(w.Body.Bytes(), &response)).To(Succeed())
+			Expect(response.Code).To(Equal("WELCOME"))
+			Expect(response.Amount).To(Equal("100.00"))
+			Expect(response.MinOrderValue).To(Equal("500.00"))
+			Expect(response.Currency).To(Equal("THB"))
+			Expect(response.Percent).To(BeZero())
+		})
+
+		DescribeTable("should reject a malformed body naming the field",
+			func(body string, expected httperr.FieldError) {
+				w := createCoupon(body)
+
+				Expect(w.Code).To(Equal(http.StatusBadRequest))
+
+				var response httperr.Problem
+				Expect(json.Unmarshal(w.Body.Bytes(), &response)).To(Succeed())
+				Expect(response.Errors).To(ContainElement(expected))
+			},
+			Entry("missing code",
+				`{"type": "percentage", "percent": 10}`,
+				httperr.FieldError{Field: "code", Message: "is required"}),
+			Entry("negative usage limit",
+				`{"code": "SAVE10", "type": "percentage", "percent": 10, "usage_limit": -1}`,
+				httperr.FieldError{Field: "usage_limit", Message: "must be greater than or equal to 0"}),
+		)
+
+		It("should return 422 when the service rejects the terms", func() {
+			mockService.EXPECT().CreateCoupon(ctx, domain.Coupon{Code: "SAVE", Type: domain.CouponPercentage, Percent: 120, Amount: testutil.THB("0"), MinOrderValue: testutil.THB("0")}).
+				Return(nil, domain.ValidationError{{Field: "percent", Message: "must be between 1 and 100"}})
+
+			w := createCoupon(`{"code": "SAVE", "type": "percentage", "percent": 120}`)
+
+			Expect(w.Code).To(Equal(http.StatusUnprocessableEntity))
+		})
+
+		It("should return 409 when the code is taken", func() {
+			mockService.EXPECT().CreateCoupon(ctx, domain.Coupon{Code: "SAVE10", Type: domain.CouponPercentage, Percent: 10, Amount: testutil.THB("0"), MinOrderValue: testutil.THB("0")}).
+				Return(nil, fmt.Errorf("coupon %w", domain.ErrConflict))
+
+			w := createCoupon(`{"code": "SAVE10", "type": "percentage", "percent": 10}`)
+
+			Expect(w.Code).To(Equal(http.StatusConflict))
+		})
+	})
+})
@@ -0,0 +1,42 @@
+package couponhdl
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"gin-swagger-api/internal/handler/etag"
+	"gin-swagger-api/internal/handler/httperr"
+)
+
+// DeleteCoupon godoc
+// @Summary Delete a coupon
+// @Description Delete a coupon by ID. A coupon that has been redeemed is kept for the orders it was applied to and is rejected with 409; set its ends_at to stop it being used instead.
+// @Description Requires an admin API key.
+// @Tags coupons
+// @Param id path string true "Coupon ID"
+// @Param X-API-Key header string true "Admin API key"
+// @Param If-Match header string false "ETag the coupon must still have; required when the server enforces preconditions"
+// @Success 204
+// @Failure 400 {object} httperr.Problem
+// @Failure 403 {object} httperr.Problem
+// @Failure 404 {object} httperr.Problem
+// @Failure 409 {object} httperr.Problem
+// @Failure 412 {object} httperr.Problem
+// @Failure 428 {object} httperr.Problem
+// @Failure 500 {object} httperr.Problem
+// @Router /coupons/{id} [delete]
+func (h *Handler) DeleteCoupon(c *gin.Context) {
+	version, err := etag.IfMatch(c)
+	if err != nil {
+		httperr.Respond(c, err)
+		return
+	}
+
+	if err := h.couponService.DeleteCoupon(c.Request.Context(), c.Param("id"), version); err != nil {
+		httperr.Respond(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,74 @@
+package couponhdl_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/gin-gonic/gin"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"gin-swagger-api/internal/domain"
+	"gin-swagger-api/internal/handler/couponhdl"
+	"gin-swagger-api/internal/handler/etag"
+	mockcouponsvc "gin-swagger-api/mock/service/couponsvc"
+)
+
+var _ = Describe("Handler DeleteCoupon", func() {
+	var (
+		mockService *mockcouponsvc.MockService
+		handler     *couponhdl.Handler
+		ctx         context.Context
+	)
+
+	BeforeEach(func() {
+		gin.SetMode(gin.TestMode)
+		mockService = mockcouponsvc.NewMockService(GinkgoT())
+		handler = couponhdl.NewHandler(mockService)
+		ctx = context.Background()
+	})
+
+	// deleteCoupon calls the handler for id with an If-Match header when
+	// ifMatch is set
+	deleteCoupon := func(id, ifMatch string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodDelete, "/api/v1/coupons/"+id, nil)
+		if ifMatch != "" {
+			c.Request.Header.Set(etag.IfMatchHeader, ifMatch)
+		}
+		c.Request = c.Request.WithContext(ctx)
+		c.Params = gin.Params{{Key: "id", Value: id}}
+
+		handler.DeleteCoupon(c)
+		c.Writer.WriteHeaderNow() // c.Status only writes the status with a body
+		return w
+	}
+
+	Describe("DeleteCoupon", func() {
+		It("should delete the coupon", func() {
+			mockService.EXPECT().DeleteCoupon(ctx, "1", "").Return(nil)
+
+			w := deleteCoupon("1", "")
+
+			Expect(w.Code).To(Equal(http.StatusNoContent))
+		})
+
+		It("should pass the expected version to the service", func() {
+			mockService.EXPECT().DeleteCoupon(ctx, "1", "abc123").Return(nil)
+
+			w := deleteCoupon("1", `"abc123"`)
+
+			Expect(w.Code).To(Equal(http.StatusNoContent))
+		})
+
+		It("should return 409 when the coupon has been redeemed", func() {
+			mockService.EXPECT().DeleteCoupon(ctx, "1", "").Return(&domain.InUseError{Entity: "coupon", Orders: 3})
+
+			w := deleteCoupon("1", "")
+
+			Expect(w.Code).To(Equal(http.StatusConflict))
+		})
+	})
+})
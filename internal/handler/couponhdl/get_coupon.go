@@ -0,0 +1,35 @@
+package couponhdl
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"gin-swagger-api/internal/handler/etag"
+	"gin-swagger-api/internal/handler/httperr"
+)
+
+// GetCoupon godoc
+// @Summary Get a coupon
+// @Description Get a coupon by ID. Requires an admin API key.
+// @Tags coupons
+// @Produce json
+// @Param id path string true "Coupon ID"
+// @Param X-API-Key header string true "Admin API key"
+// @Success 200 {object} CouponResponse
+// @Header 200 {string} ETag "Entity tag of the current coupon version"
+// @Failure 400 {object} httperr.Problem
+// @Failure 403 {object} httperr.Problem
+// @Failure 404 {object} httperr.Problem
+// @Failure 500 {object} httperr.Problem
+// @Router /coupons/{id} [get]
+func (h *Handler) GetCoupon(c *gin.Context) {
+	coupon, err := h.couponService.GetCoupon(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		httperr.Respond(c, err)
+		return
+	}
+
+	etag.Set(c, coupon.Version())
+	c.JSON(http.StatusOK, toCouponResponse(*coupon))
+}
@@ -0,0 +1,77 @@
+package couponhdl_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/gin-gonic/gin"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"gin-swagger-api/internal/domain"
+	"gin-swagger-api/internal/handler/couponhdl"
+	"gin-swagger-api/internal/handler/etag"
+	mockcouponsvc "gin-swagger-api/mock/service/couponsvc"
+)
+
+var _ = Describe("Handler GetCoupon", func() {
+	var (
+		mockService *mockcouponsvc.MockService
+		handler     *couponhdl.Handler
+		ctx         context.Context
+	)
+
+	BeforeEach(func() {
+		gin.SetMode(gin.TestMode)
+		mockService = mockcouponsvc.NewMockService(GinkgoT())
+		handler = couponhdl.NewHandler(mockService)
+		ctx = context.Background()
+	})
+
+	// getCoupon calls the handler for id
+	getCoupon := func(id string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodGet, "/api/v1/coupons/"+id, nil)
+		c.Request = c.Request.WithContext(ctx)
+		c.Params = gin.Params{{Key: "id", Value: id}}
+
+		handler.GetCoupon(c)
+		return w
+	}
+
+	Describe("GetCoupon", func() {
+		It("should return the coupon with its redemptions and ETag", func() {
+			coupon := &domain.Coupon{ID: "1", Code: "SAVE10", Type: domain.CouponPercentage, Percent: 10, UsageLimit: 100, Redemptions: 42}
+			mockService.EXPECT().GetCoupon(ctx, "1").Return(coupon, nil)
+
+			w := getCoupon("1")
+
+			Expect(w.Code).To(Equal(http.StatusOK))
+			Expect(w.Header().Get(etag.Header)).To(Equal(`"` + coupon.Version() + `"`))
+
+			var response couponhdl.CouponResponse
+			Expect(json.Unmarshal(w.Body.Bytes(), &response)).To(Succeed())
+			Expect(response).To(Equal(couponhdl.CouponResponse{
+				ID:          "1",
+				Code:        "SAVE10",
+				Type:        "percentage",
+				Percent:     10,
+				Currency:    "THB",
+				UsageLimit:  100,
+				Redemptions: 42,
+			}))
+		})
+
+		It("should return 404 when the coupon does not exist", func() {
+			mockService.EXPECT().GetCoupon(ctx, "999").Return(nil, fmt.Errorf("coupon %w", domain.ErrNotFound))
+
+			w := getCoupon("999")
+
+			Expect(w.Code).To(Equal(http.StatusNotFound))
+		})
+	})
+})
@@ -0,0 +1,40 @@
+package couponhdl
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"gin-swagger-api/internal/handler/httperr"
+	"gin-swagger-api/internal/handler/pagination"
+)
+
+// GetCoupons godoc
+// @Summary Get coupons
+// @Description Get the coupons in ID order, paginated by offset, with how often each has been redeemed.
+// @Description Requires an admin API key.
+// @Tags coupons
+// @Produce json
+// @Param limit query int false "Page size (default 20, max 100)"
+// @Param offset query int false "Number of items to skip"
+// @Param X-API-Key header string true "Admin API key"
+// @Success 200 {object} pagination.Response[couponhdl.CouponResponse]
+// @Failure 400 {object} httperr.Problem
+// @Failure 403 {object} httperr.Problem
+// @Failure 500 {object} httperr.Problem
+// @Router /coupons [get]
+func (h *Handler) GetCoupons(c *gin.Context) {
+	var query pagination.OffsetQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		httperr.BadRequest(c, err)
+		return
+	}
+
+	page, err := h.couponService.GetCoupons(c.Request.Context(), query.PageRequest())
+	if err != nil {
+		httperr.Respond(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, pagination.NewResponse(c, page, toCouponResponse))
+}
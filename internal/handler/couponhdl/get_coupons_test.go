@@ -0,0 +1,84 @@
+package couponhdl_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/gin-gonic/gin"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"gin-swagger-api/internal/domain"
+	"gin-swagger-api/internal/handler/couponhdl"
+	"gin-swagger-api/internal/handler/pagination"
+	"gin-swagger-api/internal/testutil"
+	mockcouponsvc "gin-swagger-api/mock/service/couponsvc"
+)
+
+var _ = Describe("Handler GetCoupons", func() {
+	var (
+		mockService *mockcouponsvc.MockService
+		handler     *couponhdl.Handler
+		ctx         context.Context
+	)
+
+	BeforeEach(func() {
+		gin.SetMode(gin.TestMode)
+		mockService = mockcouponsvc.NewMockService(GinkgoT())
+		handler = couponhdl.NewHandler(mockService)
+		ctx = context.Background()
+	})
+
+	// getCoupons calls the handler with query
+	getCoupons := func(query string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodGet, "/api/v1/coupons"+query, nil)
+		c.Request = c.Request.WithContext(ctx)
+
+		handler.GetCoupons(c)
+		return w
+	}
+
+	Describe("GetCoupons", func() {
+		It("should return a page of coupons", func() {
+			mockService.EXPECT().GetCoupons(ctx, domain.PageRequest{Limit: 2, Offset: 2}).
+				Return(&domain.Page[domain.Coupon]{
+					Items: []domain.Coupon{
+						{ID: "3", Code: "SAVE10", Type: domain.CouponPercentage, Percent: 10},
+						{ID: "4", Code: "WELCOME", Type: domain.CouponFixed, Amount: testutil.THB("100.00")},
+					},
+					Total:  5,
+					Limit:  2,
+					Offset: 2,
+				}, nil)
+
+			w := getCoupons("?limit=2&offset=2")
+
+			Expect(w.Code).To(Equal(http.StatusOK))
+
+			var response pagination.Response[couponhdl.CouponResponse]
+			Expect(json.Unmarshal(w.Body.Bytes(), &response)).To(Succeed())
+			Expect(response.Data).To(HaveLen(2))
+			Expect(response.Data[1].Amount).To(Equal("100.00"))
+			Expect(response.Meta.Total).To(Equal(5))
+		})
+
+		It("should return bad request for a malformed limit", func() {
+			w := getCoupons("?limit=-1")
+
+			Expect(w.Code).To(Equal(http.StatusBadRequest))
+		})
+
+		It("should return 500 when the service fails", func() {
+			mockService.EXPECT().GetCoupons(ctx, domain.PageRequest{}).Return(nil, errors.New("database error"))
+
+			w := getCoupons("")
+
+			Expect(w.Code).To(Equal(http.StatusInternalServerError))
+		})
+	})
+})
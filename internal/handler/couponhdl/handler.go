@@ -0,0 +1,35 @@
+package couponhdl
+
+import (
+	"gin-swagger-api/internal/middleware"
+	"gin-swagger-api/internal/port/service/couponsvc"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Handler handles coupon HTTP requests
+type Handler struct {
+	couponService couponsvc.Service
+}
+
+// NewHandler creates a new coupon handler
+func NewHandler(couponService couponsvc.Service) *Handler {
+	return &Handler{
+		couponService: couponService,
+	}
+}
+
+// RegisterRoutes registers all coupon routes. Coupons are managed by
+// marketing, so every route requires an admin API key; customers only
+// send a code when they place an order.
+func (h *Handler) RegisterRoutes(rg *gin.RouterGroup) {
+	coupons := rg.Group("/coupons")
+	coupons.Use(middleware.Logger(), middleware.RequireAdmin()) // Apply logger and admin check to all coupon routes
+	{
+		coupons.POST("", h.CreateCoupon)
+		coupons.GET("", h.GetCoupons)
+		coupons.GET("/:id", h.GetCoupon)
+		coupons.PUT("/:id", middleware.RequireIfMatch(), h.UpdateCoupon)
+		coupons.DELETE("/:id", middleware.RequireIfMatch(), h.DeleteCoupon)
+	}
+}
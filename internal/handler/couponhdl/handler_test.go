@@ -0,0 +1,72 @@
+package couponhdl_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/gin-gonic/gin"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"gin-swagger-api/internal/domain"
+	"gin-swagger-api/internal/handler/couponhdl"
+	"gin-swagger-api/internal/middleware"
+	mockcouponsvc "gin-swagger-api/mock/service/couponsvc"
+)
+
+var _ = Describe("CouponHandler RegisterRoutes", func() {
+	var (
+		mockService *mockcouponsvc.MockService
+		handler     *couponhdl.Handler
+		router      *gin.Engine
+	)
+
+	BeforeEach(func() {
+		gin.SetMode(gin.TestMode)
+		mockService = mockcouponsvc.NewMockService(GinkgoT())
+		handler = couponhdl.NewHandler(mockService)
+		router = gin.New()
+		router.Use(middleware.Admin([]string{"secret"}))
+		handler.RegisterRoutes(router.Group("/api/v1"))
+	})
+
+	Describe("RegisterRoutes", func() {
+		It("should register the coupon routes", func() {
+			routes := make([]string, 0)
+			for _, route := range router.Routes() {
+				routes = append(routes, route.Method+" "+route.Path)
+			}
+
+			Expect(routes).To(ConsistOf(
+				"POST /api/v1/coupons",
+				"GET /api/v1/coupons",
+				"GET /api/v1/coupons/:id",
+				"PUT /api/v1/coupons/:id",
+				"DELETE /api/v1/coupons/:id",
+			))
+		})
+
+		It("should require an admin API key to list coupons", func() {
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/coupons", nil)
+			router.ServeHTTP(w, req)
+
+			Expect(w.Code).To(Equal(http.StatusForbidden))
+		})
+
+		It("should list coupons with an admin API key", func() {
+			mockService.EXPECT().
+				GetCoupons(mock.Anything, mock.Anything).
+				Return(&domain.Page[domain.Coupon]{Items: []domain.Coupon{}}, nil).
+				Once()
+
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/coupons", nil)
+			req.Header.Set("X-API-Key", "secret")
+			router.ServeHTTP(w, req)
+
+			Expect(w.Code).To(Equal(http.StatusOK))
+		})
+	})
+})
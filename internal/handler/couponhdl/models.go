@@ -0,0 +1,92 @@
+package couponhdl
+
+import (
+	"time"
+
+	"gin-swagger-api/internal/domain"
+	"gin-swagger-api/internal/handler/money"
+)
+
+// CouponResponse represents the API response for a coupon. Amounts are
+// decimal strings of the base currency given in currency; amount is only
+// set on fixed coupons, percent on percentage coupons and min_order_value
+// when the coupon has a minimum. A zero limit is unlimited. Redemptions
+// counts the orders the coupon was applied to.
+type CouponResponse struct {
+	ID            string     `json:"id" example:"1"`
+	Code          string     `json:"code" example:"SAVE10"`
+	Type          string     `json:"type" enums:"percentage,fixed" example:"percentage"`
+	Percent       int        `json:"percent,omitempty" example:"10"`
+	Amount        string     `json:"amount,omitempty" example:"100.00"`
+	MinOrderValue string     `json:"min_order_value,omitempty" example:"500.00"`
+	Currency      string     `json:"currency" example:"THB"`
+	UsageLimit    int        `json:"usage_limit" example:"1000"`
+	PerUserLimit  int        `json:"per_user_limit" example:"1"`
+	Redemptions   int        `json:"redemptions" example:"42"`
+	StartsAt      *time.Time `json:"starts_at,omitempty" example:"2026-11-01T00:00:00Z"`
+	EndsAt        *time.Time `json:"ends_at,omitempty" example:"2026-12-01T00:00:00Z"`
+}
+
+// CouponRequest represents the request body for creating or replacing a
+// coupon. A percentage coupon takes percent percent off the items total; a
+// fixed coupon takes amount off it. Amounts are in the base currency, sent
+// as a decimal string or number with at most two decimal places, and are
+// converted at the exchange rate of the order. A zero or missing limit is
+// unlimited, and the coupon is valid from starts_at until ends_at when they
+// are set.
+type CouponRequest struct {
+	Code          string       `json:"code" binding:"required" example:"SAVE10"`
+	Type          string       `json:"type" binding:"required" enums:"percentage,fixed" example:"percentage"`
+	Percent       int          `json:"percent" example:"10"`
+	Amount        money.Amount `json:"amount" binding:"omitempty,amount" example:"100.00"`
+	MinOrderValue money.Amount `json:"min_order_value" binding:"omitempty,amount" example:"500.00"`
+	UsageLimit    int          `json:"usage_limit" binding:"gte=0" example:"1000"`
+	PerUserLimit  int          `json:"per_user_limit" binding:"gte=0" example:"1"`
+	StartsAt      *time.Time   `json:"starts_at" example:"2026-11-01T00:00:00Z"`
+	EndsAt        *time.Time   `json:"ends_at" example:"2026-12-01T00:00:00Z"`
+}
+
+// toCoupon converts a request checked by the amount rule to domain.Coupon
+func (req CouponRequest) toCoupon() domain.Coupon {
+	return domain.Coupon{
+		Code:          req.Code,
+		Type:          req.Type,
+		Percent:       req.Percent,
+		Amount:        toAmount(req.Amount),
+		MinOrderValue: toAmount(req.MinOrderValue),
+		UsageLimit:    req.UsageLimit,
+		PerUserLimit:  req.PerUserLimit,
+		StartsAt:      req.StartsAt,
+		EndsAt:        req.EndsAt,
+	}
+}
+
+// toAmount converts an amount checked by the amount rule to the base
+// currency. An empty amount is zero.
+func toAmount(amount money.Amount) domain.Money {
+	m, _ := amount.Money(domain.BaseCurrency)
+	return m
+}
+
+// toCouponResponse converts a domain coupon to its response
+func toCouponResponse(coupon domain.Coupon) CouponResponse {
+	resp := CouponResponse{
+		ID:           coupon.ID,
+		Code:         coupon.Code,
+		Type:         coupon.Type,
+		Percent:      coupon.Percent,
+		Currency:     domain.BaseCurrency,
+		UsageLimit:   coupon.UsageLimit,
+		PerUserLimit: coupon.PerUserLimit,
+		Redemptions:  coupon.Redemptions,
+		StartsAt:     coupon.StartsAt,
+		EndsAt:       coupon.EndsAt,
+	}
+	if !coupon.Amount.IsZero() {
+		resp.Amount = coupon.Amount.String()
+	}
+	if !coupon.MinOrderValue.IsZero() {
+		resp.MinOrderValue = coupon.MinOrderValue.String()
+	}
+	return resp
+}
@@ -0,0 +1,55 @@
+package couponhdl
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"gin-swagger-api/internal/handler/etag"
+	"gin-swagger-api/internal/handler/httperr"
+)
+
+// UpdateCoupon godoc
+// @Summary Update a coupon
+// @Description Replace the terms of a coupon by ID. Orders already placed keep the discount they got, and redemptions so far count towards the new limits.
+// @Description Requires an admin API key.
+// @Tags coupons
+// @Accept json
+// @Produce json
+// @Param id path string true "Coupon ID"
+// @Param coupon body CouponRequest true "Coupon terms"
+// @Param X-API-Key header string true "Admin API key"
+// @Param If-Match header string false "ETag the coupon must still have; required when the server enforces preconditions"
+// @Success 200 {object} CouponResponse
+// @Header 200 {string} ETag "Entity tag of the current coupon version"
+// @Failure 400 {object} httperr.Problem
+// @Failure 403 {object} httperr.Problem
+// @Failure 404 {object} httperr.Problem
+// @Failure 409 {object} httperr.Problem
+// @Failure 412 {object} httperr.Problem
+// @Failure 422 {object} httperr.Problem
+// @Failure 428 {object} httperr.Problem
+// @Failure 500 {object} httperr.Problem
+// @Router /coupons/{id} [put]
+func (h *Handler) UpdateCoupon(c *gin.Context) {
+	version, err := etag.IfMatch(c)
+	if err != nil {
+		httperr.Respond(c, err)
+		return
+	}
+
+	var req CouponRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		httperr.BadRequest(c, err)
+		return
+	}
+
+	coupon, err := h.couponService.UpdateCoupon(c.Request.Context(), c.Param("id"), version, req.toCoupon())
+	if err != nil {
+		httperr.Respond(c, err)
+		return
+	}
+
+	etag.Set(c, coupon.Version())
+	c.JSON(http.StatusOK, toCouponResponse(*coupon))
+}
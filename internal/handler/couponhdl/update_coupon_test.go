@@ -0,0 +1,88 @@
+package couponhdl_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"gin-swagger-api/internal/domain"
+	"gin-swagger-api/internal/handler/couponhdl"
+	"gin-swagger-api/internal/handler/etag"
+	"gin-swagger-api/internal/testutil"
+	mockcouponsvc "gin-swagger-api/mock/service/couponsvc"
+)
+
+var _ = Describe("Handler UpdateCoupon", func() {
+	var (
+		mockService *mockcouponsvc.MockService
+		handler     *couponhdl.Handler
+		ctx         context.Context
+	)
+
+	save15 := domain.Coupon{Code: "SAVE10", Type: domain.CouponPercentage, Percent: 15, Amount: testutil.THB("0"), MinOrderValue: testutil.THB("0"), UsageLimit: 100}
+
+	BeforeEach(func() {
+		gin.SetMode(gin.TestMode)
+		mockService = mockcouponsvc.NewMockService(GinkgoT())
+		handler = couponhdl.NewHandler(mockService)
+		ctx = context.Background()
+	})
+
+	// updateCoupon calls the handler for id with body and an If-Match header
+	// when ifMatch is set
+	updateCoupon := func(id, ifMatch, body string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodPut, "/api/v1/coupons/"+id, strings.NewReader(body))
+		c.Request.Header.Set("Content-Type", "application/json")
+		if ifMatch != "" {
+			c.Request.Header.Set(etag.IfMatchHeader, ifMatch)
+		}
+		c.Request = c.Request.WithContext(ctx)
+		c.Params = gin.Params{{Key: "id", Value: id}}
+
+		handler.UpdateCoupon(c)
+		return w
+	}
+
+	body := `{"code": "SAVE10", "type": "percentage", "percent": 15, "usage_limit": 100}`
+
+	Describe("UpdateCoupon", func() {
+		It("should pass the expected version and return the new ETag", func() {
+			coupon := &domain.Coupon{ID: "1", Code: "SAVE10", Type: domain.CouponPercentage, Percent: 15, UsageLimit: 100, Redemptions: 3}
+			mockService.EXPECT().UpdateCoupon(ctx, "1", "abc123", save15).Return(coupon, nil)
+
+			w := updateCoupon("1", `"abc123"`, body)
+
+			Expect(w.Code).To(Equal(http.StatusOK))
+			Expect(w.Header().Get(etag.Header)).To(Equal(`"` + coupon.Version() + `"`))
+
+			var response couponhdl.CouponResponse
+			Expect(json.Unmarshal(w.Body.Bytes(), &response)).To(Succeed())
+			Expect(response.Percent).To(Equal(15))
+			Expect(response.Redemptions).To(Equal(3))
+		})
+
+		It("should return 412 when the version is stale", func() {
+			mockService.EXPECT().UpdateCoupon(ctx, "1", "stale", save15).
+				Return(nil, fmt.Errorf("coupon %w", domain.ErrPreconditionFailed))
+
+			w := updateCoupon("1", `"stale"`, body)
+
+			Expect(w.Code).To(Equal(http.StatusPreconditionFailed))
+		})
+
+		It("should return bad request for a malformed body", func() {
+			w := updateCoupon("1", "", `{"type": "percentage"}`)
+
+			Expect(w.Code).To(Equal(http.StatusBadRequest))
+		})
+	})
+})
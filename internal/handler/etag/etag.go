@@ -0,0 +1,53 @@
+package etag
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"gin-swagger-api/internal/domain"
+)
+
+const (
+	// Header is the response header carrying the entity tag of a resource
+	Header = "ETag"
+	// IfMatchHeader is the request header carrying the entity tag a write
+	// expects the resource to still have
+	IfMatchHeader = "If-Match"
+)
+
+// Set writes version as a strong entity tag in the ETag header
+func Set(c *gin.Context, version string) {
+	c.Header(Header, `"`+version+`"`)
+}
+
+// IfMatch returns the version required by the If-Match header. It is
+// empty when the header is absent or "*", which any current version
+// matches. Weak tags never match under the strong comparison If-Match
+// uses, and only a single tag is supported.
+func IfMatch(c *gin.Context) (string, error) {
+	value := strings.TrimSpace(c.GetHeader(IfMatchHeader))
+	switch {
+	case value == "" || value == "*":
+		return "", nil
+	case strings.HasPrefix(value, "W/"):
+		return "", fmt.Errorf("%w: weak entity tags cannot be used with %s", domain.ErrPreconditionFailed, IfMatchHeader)
+	case len(value) < 2 || value[0] != '"' || value[len(value)-1] != '"' || strings.Contains(value[1:len(value)-1], `"`):
+		return "", fmt.Errorf("%w: %s must be a single quoted entity tag", domain.ErrInvalidArgument, IfMatchHeader)
+	}
+	return value[1 : len(value)-1], nil
+}
+
+// Check verifies that the If-Match header matches the current version of
+// a resource and returns the required version for the guarded write
+func Check(c *gin.Context, current string) (string, error) {
+	version, err := IfMatch(c)
+	if err != nil {
+		return "", err
+	}
+	if version != "" && version != current {
+		return "", fmt.Errorf("%w: %s does not match the current version", domain.ErrPreconditionFailed, IfMatchHeader)
+	}
+	return version, nil
+}
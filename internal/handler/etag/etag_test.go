@@ -0,0 +1,106 @@
+package etag_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/gin-gonic/gin"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"gin-swagger-api/internal/domain"
+	"gin-swagger-api/internal/handler/etag"
+)
+
+var _ = Describe("ETag", func() {
+	var newContext func(ifMatch string) (*gin.Context, *httptest.ResponseRecorder)
+
+	BeforeEach(func() {
+		gin.SetMode(gin.TestMode)
+		newContext = func(ifMatch string) (*gin.Context, *httptest.ResponseRecorder) {
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest(http.MethodPut, "/api/v1/products/1", nil)
+			if ifMatch != "" {
+				c.Request.Header.Set(etag.IfMatchHeader, ifMatch)
+			}
+			return c, w
+		}
+	})
+
+	Describe("Set", func() {
+		It("should write a strong entity tag", func() {
+			c, w := newContext("")
+
+			etag.Set(c, "abc123")
+
+			Expect(w.Header().Get(etag.Header)).To(Equal(`"abc123"`))
+		})
+	})
+
+	Describe("IfMatch", func() {
+		DescribeTable("should return the required version",
+			func(header, expected string) {
+				c, _ := newContext(header)
+
+				version, err := etag.IfMatch(c)
+
+				Expect(err).ToNot(HaveOccurred())
+				Expect(version).To(Equal(expected))
+			},
+			Entry("absent header", "", ""),
+			Entry("any version", "*", ""),
+			Entry("quoted tag", `"abc123"`, "abc123"),
+			Entry("surrounding whitespace", ` "abc123" `, "abc123"),
+		)
+
+		It("should reject weak tags as never matching", func() {
+			c, _ := newContext(`W/"abc123"`)
+
+			_, err := etag.IfMatch(c)
+
+			Expect(err).To(MatchError(domain.ErrPreconditionFailed))
+		})
+
+		DescribeTable("should reject malformed headers",
+			func(header string) {
+				c, _ := newContext(header)
+
+				_, err := etag.IfMatch(c)
+
+				Expect(err).To(MatchError(domain.ErrInvalidArgument))
+			},
+			Entry("unquoted tag", "abc123"),
+			Entry("lone quote", `"`),
+			Entry("tag list", `"abc", "def"`),
+		)
+	})
+
+	Describe("Check", func() {
+		It("should return the version when it matches the current version", func() {
+			c, _ := newContext(`"abc123"`)
+
+			version, err := etag.Check(c, "abc123")
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(version).To(Equal("abc123"))
+		})
+
+		It("should allow writes without If-Match", func() {
+			c, _ := newContext("")
+
+			version, err := etag.Check(c, "abc123")
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(version).To(BeEmpty())
+		})
+
+		It("should fail when the version differs", func() {
+			c, _ := newContext(`"def456"`)
+
+			_, err := etag.Check(c, "abc123")
+
+			Expect(err).To(MatchError(domain.ErrPreconditionFailed))
+		})
+	})
+})
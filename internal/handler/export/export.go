@@ -0,0 +1,125 @@
+package export
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog/log"
+
+	"gin-swagger-api/internal/handler/httperr"
+)
+
+// Media types an export can be streamed as. CSV is used when the Accept
+// header does not prefer either.
+const (
+	CSV    = "text/csv"
+	NDJSON = "application/x-ndjson"
+)
+
+// Table describes how items of a resource are exported
+type Table[T any] struct {
+	// Name is the file name offered to the client, without extension
+	Name string
+	// Columns is the CSV header row
+	Columns []string
+	// Records returns the CSV records of an item, one or more per item
+	Records func(T) [][]string
+	// Value returns the JSON value written as one NDJSON line per item
+	Value func(T) any
+}
+
+// Stream writes the items run passes to emit in the format negotiated from
+// the Accept header, flushing after every batch. Errors before the first
+// batch are responded to as problems. Once the body has started the status
+// is already sent, so a later error only ends the response early.
+func Stream[T any](c *gin.Context, table Table[T], run func(emit func([]T) error) error) {
+	format := c.NegotiateFormat(CSV, NDJSON)
+	if format == "" {
+		httperr.Abort(c, http.StatusNotAcceptable, fmt.Sprintf("exports are available as %s and %s", CSV, NDJSON))
+		return
+	}
+
+	w := &writer[T]{c: c, table: table, format: format}
+	err := run(w.write)
+	if err == nil && !w.started {
+		// An empty export still has a header row
+		err = w.write(nil)
+	}
+	if err == nil {
+		return
+	}
+	if !w.started {
+		httperr.Respond(c, err)
+		return
+	}
+
+	log.Error().Err(err).Str("export", table.Name).Msg("Export ended early")
+	c.Abort()
+}
+
+// Time formats a timestamp for CSV as RFC 3339 in UTC
+func Time(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
+
+// writer starts the response on its first batch
+type writer[T any] struct {
+	c       *gin.Context
+	table   Table[T]
+	format  string
+	started bool
+	csv     *csv.Writer
+	json    *json.Encoder
+}
+
+func (w *writer[T]) start() error {
+	w.started = true
+
+	ext := "csv"
+	if w.format == NDJSON {
+		ext = "ndjson"
+	}
+	w.c.Header("Content-Type", w.format)
+	w.c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s.%s"`, w.table.Name, ext))
+	w.c.Status(http.StatusOK)
+
+	if w.format == NDJSON {
+		w.json = json.NewEncoder(w.c.Writer)
+		return nil
+	}
+	w.csv = csv.NewWriter(w.c.Writer)
+	return w.csv.Write(w.table.Columns)
+}
+
+func (w *writer[T]) write(items []T) error {
+	if !w.started {
+		if err := w.start(); err != nil {
+			return err
+		}
+	}
+
+	for _, item := range items {
+		if w.json != nil {
+			if err := w.json.Encode(w.table.Value(item)); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := w.csv.WriteAll(w.table.Records(item)); err != nil {
+			return err
+		}
+	}
+	if w.csv != nil {
+		w.csv.Flush()
+		if err := w.csv.Error(); err != nil {
+			return err
+		}
+	}
+
+	w.c.Writer.Flush()
+	return nil
+}
@@ -0,0 +1,145 @@
+package export_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"gin-swagger-api/internal/domain"
+	"gin-swagger-api/internal/handler/export"
+)
+
+type item struct {
+	ID    int      `json:"id"`
+	Lines []string `json:"lines"`
+}
+
+var table = export.Table[item]{
+	Name:    "items",
+	Columns: []string{"id", "line"},
+	Records: func(it item) [][]string {
+		records := make([][]string, 0, len(it.Lines))
+		for _, line := range it.Lines {
+			records = append(records, []string{fmt.Sprint(it.ID), line})
+		}
+		return records
+	},
+	Value: func(it item) any { return it },
+}
+
+var _ = Describe("Export", func() {
+	var newContext func(accept string) (*gin.Context, *httptest.ResponseRecorder)
+
+	BeforeEach(func() {
+		gin.SetMode(gin.TestMode)
+		newContext = func(accept string) (*gin.Context, *httptest.ResponseRecorder) {
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest(http.MethodGet, "/api/v1/items/export", nil)
+			if accept != "" {
+				c.Request.Header.Set("Accept", accept)
+			}
+			return c, w
+		}
+	})
+
+	batches := func(emit func([]item) error) error {
+		if err := emit([]item{{ID: 1, Lines: []string{"a", "b"}}}); err != nil {
+			return err
+		}
+		return emit([]item{{ID: 2, Lines: []string{"c, d"}}})
+	}
+
+	Describe("Stream", func() {
+		DescribeTable("should stream CSV by default",
+			func(accept string) {
+				c, w := newContext(accept)
+
+				export.Stream(c, table, batches)
+
+				Expect(w.Code).To(Equal(http.StatusOK))
+				Expect(w.Header().Get("Content-Type")).To(Equal(export.CSV))
+				Expect(w.Header().Get("Content-Disposition")).To(Equal(`attachment; filename="items.csv"`))
+				Expect(w.Body.String()).To(Equal("id,line\n1,a\n1,b\n2,\"c, d\"\n"))
+				Expect(w.Flushed).To(BeTrue())
+			},
+			Entry("no Accept header", ""),
+			Entry("any type", "*/*"),
+			Entry("CSV", "text/csv"),
+		)
+
+		It("should stream NDJSON when accepted", func() {
+			c, w := newContext("application/x-ndjson")
+
+			export.Stream(c, table, batches)
+
+			Expect(w.Code).To(Equal(http.StatusOK))
+			Expect(w.Header().Get("Content-Type")).To(Equal(export.NDJSON))
+			Expect(w.Header().Get("Content-Disposition")).To(Equal(`attachment; filename="items.ndjson"`))
+			Expect(w.Body.String()).To(Equal(
+				`{"id":1,"lines":["a","b"]}` + "\n" + `{"id":2,"lines":["c, d"]}` + "\n"))
+		})
+
+		It("should write the header row of an empty export", func() {
+			c, w := newContext("")
+
+			export.Stream(c, table, func(func([]item) error) error { return nil })
+
+			Expect(w.Code).To(Equal(http.StatusOK))
+			Expect(w.Body.String()).To(Equal("id,line\n"))
+		})
+
+		It("should reject other media types", func() {
+			c, w := newContext("application/json")
+			called := false
+
+			export.Stream(c, table, func(func([]item) error) error {
+				called = true
+				return nil
+			})
+
+			Expect(called).To(BeFalse())
+			Expect(w.Code).To(Equal(http.StatusNotAcceptable))
+		})
+
+		It("should respond with a problem for errors before the first batch", func() {
+			c, w := newContext("")
+
+			export.Stream(c, table, func(func([]item) error) error {
+				return fmt.Errorf("%w: bad filter", domain.ErrInvalidArgument)
+			})
+
+			Expect(w.Code).To(Equal(http.StatusBadRequest))
+			Expect(w.Body.String()).To(ContainSubstring("bad filter"))
+		})
+
+		It("should end the response early for errors after the first batch", func() {
+			c, w := newContext("")
+
+			export.Stream(c, table, func(emit func([]item) error) error {
+				if err := emit([]item{{ID: 1, Lines: []string{"a"}}}); err != nil {
+					return err
+				}
+				return errors.New("connection lost")
+			})
+
+			Expect(w.Code).To(Equal(http.StatusOK))
+			Expect(w.Body.String()).To(Equal("id,line\n1,a\n"))
+			Expect(c.IsAborted()).To(BeTrue())
+		})
+	})
+
+	Describe("Time", func() {
+		It("should format RFC 3339 in UTC", func() {
+			t := time.Date(2026, 3, 1, 12, 0, 0, 0, time.FixedZone("CET", 3600))
+
+			Expect(export.Time(t)).To(Equal("2026-03-01T11:00:00Z"))
+		})
+	})
+})
@@ -0,0 +1,245 @@
+package httperr
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog/log"
+
+	"gin-swagger-api/internal/domain"
+)
+
+// ContentType is the media type of RFC 7807 error responses
+const ContentType = "application/problem+json"
+
+// requestIDHeader is the response header set by middleware.RequestID
+const requestIDHeader = "X-Request-ID"
+
+// ErrUnsupportedMediaType reports a request body in a format the endpoint
+// does not accept
+var ErrUnsupportedMediaType = errors.New("unsupported media type")
+
+// ErrPreconditionRequired reports a write sent without the precondition
+// header the server requires
+var ErrPreconditionRequired = errors.New("precondition required")
+
+// ErrIdempotencyKeyReused reports an idempotency key sent again with a
+// different request than the one it was first used for
+var ErrIdempotencyKeyReused = errors.New("idempotency key reused with a different request")
+
+// Problem represents an RFC 7807 problem details error response.
+// It is served with the application/problem+json content type.
+// Extensions holds the extension members of a problem type, such as
+// blocking_orders for /problems/in-use, which are served alongside the
+// standard members.
+type Problem struct {
+	Type       string         `json:"type" example:"/problems/not-found"`
+	Title      string         `json:"title" example:"Not Found"`
+	Status     int            `json:"status" example:"404"`
+	Detail     string         `json:"detail,omitempty" example:"user not found"`
+	Instance   string         `json:"instance,omitempty" example:"/api/v1/users/42"`
+	RequestID  string         `json:"request_id,omitempty" example:"5SWLNQ6DPYBTNSBE2WW5UROJ3M"`
+	Errors     []FieldError   `json:"errors,omitempty"`
+	Extensions map[string]any `json:"-"`
+}
+
+// problemMembers has the standard members of a Problem, without its
+// JSON methods
+type problemMembers Problem
+
+// MarshalJSON writes the extension members after the standard ones. An
+// extension cannot replace a standard member.
+func (p Problem) MarshalJSON() ([]byte, error) {
+	data, err := json.Marshal(problemMembers(p))
+	if err != nil {
+		return nil, err
+	}
+
+	extensions := make(map[string]any, len(p.Extensions))
+	for name, value := range p.Extensions {
+		if !isStandardMember(name) {
+			extensions[name] = value
+		}
+	}
+	if len(extensions) == 0 {
+		return data, nil
+	}
+
+	extra, err := json.Marshal(extensions)
+	if err != nil {
+		return nil, err
+	}
+	return append(append(data[:len(data)-1], ','), extra[1:]...), nil
+}
+
+// UnmarshalJSON reads the members that are not standard into Extensions
+func (p *Problem) UnmarshalJSON(data []byte) error {
+	var members problemMembers
+	if err := json.Unmarshal(data, &members); err != nil {
+		return err
+	}
+
+	var all map[string]any
+	if err := json.Unmarshal(data, &all); err != nil {
+		return err
+	}
+	for name, value := range all {
+		if isStandardMember(name) {
+			continue
+		}
+		if members.Extensions == nil {
+			members.Extensions = make(map[string]any)
+		}
+		members.Extensions[name] = value
+	}
+
+	*p = Problem(members)
+	return nil
+}
+
+// isStandardMember reports whether name is a member Problem has a field
+// for
+func isStandardMember(name string) bool {
+	switch name {
+	case "type", "title", "status", "detail", "instance", "request_id", "errors":
+		return true
+	default:
+		return false
+	}
+}
+
+// FieldError describes a request field that failed validation
+type FieldError struct {
+	Field   string `json:"field" example:"email"`
+	Message string `json:"message" example:"must be a valid email address"`
+}
+
+// problemType describes how a domain error is reported
+type problemType struct {
+	err    error
+	uri    string
+	status int
+}
+
+// problemTypes maps domain errors to problem types and HTTP status codes
+var problemTypes = []problemType{
+	{domain.ErrInvalidID, "/problems/invalid-id", http.StatusBadRequest},
+	{domain.ErrInvalidArgument, "/problems/invalid-argument", http.StatusBadRequest},
+	{domain.ErrNotFound, "/problems/not-found", http.StatusNotFound},
+	{domain.ErrConflict, "/problems/conflict", http.StatusConflict},
+	{domain.ErrInsufficientStock, "/problems/insufficient-stock", http.StatusConflict},
+	{domain.ErrInvalidTransition, "/problems/invalid-transition", http.StatusConflict},
+	{domain.ErrOrderClosed, "/problems/order-closed", http.StatusConflict},
+	{domain.ErrPreconditionFailed, "/problems/precondition-failed", http.StatusPreconditionFailed},
+	{domain.ErrValidation, "/problems/validation", http.StatusUnprocessableEntity},
+	{domain.ErrBatchAborted, "/problems/batch-aborted", http.StatusFailedDependency},
+	{domain.ErrInUse, "/problems/in-use", http.StatusConflict},
+	{domain.ErrNotDeleted, "/problems/not-deleted", http.StatusConflict},
+	{domain.ErrCouponUsedUp, "/problems/coupon-used-up", http.StatusConflict},
+	{ErrUnsupportedMediaType, "/problems/unsupported-media-type", http.StatusUnsupportedMediaType},
+	{ErrPreconditionRequired, "/problems/precondition-required", http.StatusPreconditionRequired},
+	{ErrIdempotencyKeyReused, "/problems/idempotency-key-reused", http.StatusUnprocessableEntity},
+}
+
+// Resolve maps an error to an HTTP status code and a message that is safe
+// to return to clients. Unknown errors are logged and reported as 500.
+func Resolve(err error) (int, string) {
+	for _, t := range problemTypes {
+		if errors.Is(err, t.err) {
+			return t.status, err.Error()
+		}
+	}
+
+	log.Error().Err(err).Msg("Unhandled error")
+	return http.StatusInternalServerError, http.StatusText(http.StatusInternalServerError)
+}
+
+// Respond aborts the request with the problem matching a service error.
+// Fields of a domain.ValidationError are listed per field.
+func Respond(c *gin.Context, err error) {
+	write(c, From(err))
+}
+
+// From returns the problem matching a service error, without the members
+// that describe the request. Batch responses report each item with it.
+func From(err error) Problem {
+	status, detail := Resolve(err)
+	problem := Problem{
+		Type:   typeOf(err),
+		Title:  http.StatusText(status),
+		Status: status,
+		Detail: detail,
+	}
+
+	var validationErr domain.ValidationError
+	if errors.As(err, &validationErr) {
+		problem.Errors = make([]FieldError, len(validationErr))
+		for i, fe := range validationErr {
+			problem.Errors[i] = FieldError{
+				Field:   fe.Field,
+				Message: fe.Message,
+			}
+		}
+	}
+
+	var inUseErr *domain.InUseError
+	if errors.As(err, &inUseErr) {
+		problem.Extensions = map[string]any{"blocking_orders": inUseErr.Orders}
+	}
+
+	return problem
+}
+
+// BadRequest aborts the request with a 400 problem for a request that
+// could not be bound. Validator failures are listed per field.
+func BadRequest(c *gin.Context, err error) {
+	problem := Problem{
+		Type:   "/problems/invalid-request",
+		Status: http.StatusBadRequest,
+		Detail: "request is invalid",
+	}
+
+	if fields := FieldErrors(err); fields != nil {
+		problem.Detail = "request failed validation"
+		problem.Errors = make([]FieldError, len(fields))
+		for i, fe := range fields {
+			problem.Errors[i] = FieldError{
+				Field:   fe.Field,
+				Message: fe.Message,
+			}
+		}
+	}
+
+	write(c, problem)
+}
+
+// Abort aborts the request with a generic problem for the given status
+func Abort(c *gin.Context, status int, detail string) {
+	write(c, Problem{
+		Type:   "about:blank",
+		Status: status,
+		Detail: detail,
+	})
+}
+
+// typeOf returns the problem type URI for an error
+func typeOf(err error) string {
+	for _, t := range problemTypes {
+		if errors.Is(err, t.err) {
+			return t.uri
+		}
+	}
+	return "about:blank"
+}
+
+// write fills in the request-scoped members and writes the problem
+func write(c *gin.Context, problem Problem) {
+	problem.Title = http.StatusText(problem.Status)
+	problem.Instance = c.Request.URL.Path
+	problem.RequestID = c.Writer.Header().Get(requestIDHeader)
+
+	c.Header("Content-Type", ContentType)
+	c.AbortWithStatusJSON(problem.Status, problem)
+}
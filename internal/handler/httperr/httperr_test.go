@@ -0,0 +1,270 @@
+package httperr_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"gin-swagger-api/internal/domain"
+	"gin-swagger-api/internal/handler/httperr"
+)
+
+var _ = Describe("Resolve", func() {
+	DescribeTable("should map domain errors to status codes",
+		func(err error, expectedStatus int) {
+			status, message := httperr.Resolve(err)
+
+			Expect(status).To(Equal(expectedStatus))
+			Expect(message).To(Equal(err.Error()))
+		},
+		Entry("invalid id", fmt.Errorf("%w: %q", domain.ErrInvalidID, "abc"), http.StatusBadRequest),
+		Entry("invalid argument", fmt.Errorf("%w: malformed cursor", domain.ErrInvalidArgument), http.StatusBadRequest),
+		Entry("not found", fmt.Errorf("user %w", domain.ErrNotFound), http.StatusNotFound),
+		Entry("conflict", fmt.Errorf("user %w", domain.ErrConflict), http.StatusConflict),
+		Entry("insufficient stock", fmt.Errorf("product 1 has %w", domain.ErrInsufficientStock), http.StatusConflict),
+		Entry("invalid transition", fmt.Errorf("%w: order cannot go from pending to shipped", domain.ErrInvalidTransition), http.StatusConflict),
+		Entry("precondition failed", fmt.Errorf("product %w", domain.ErrPreconditionFailed), http.StatusPreconditionFailed),
+		Entry("validation", fmt.Errorf("order %w", domain.ErrValidation), http.StatusUnprocessableEntity),
+		Entry("in use", &domain.InUseError{Entity: "user", Orders: 2}, http.StatusConflict),
+		Entry("not deleted", fmt.Errorf("user 1 is %w", domain.ErrNotDeleted), http.StatusConflict),
+		Entry("coupon used up", fmt.Errorf("coupon SAVE10 %w", domain.ErrCouponUsedUp), http.StatusConflict),
+		Entry("unsupported media type", fmt.Errorf("%w: text/plain", httperr.ErrUnsupportedMediaType), http.StatusUnsupportedMediaType),
+		Entry("precondition required", fmt.Errorf("%w: send If-Match", httperr.ErrPreconditionRequired), http.StatusPreconditionRequired),
+	)
+
+	It("should hide the message of unknown errors", func() {
+		status, message := httperr.Resolve(errors.New("pq: connection refused"))
+
+		Expect(status).To(Equal(http.StatusInternalServerError))
+		Expect(message).To(Equal("Internal Server Error"))
+	})
+})
+
+var _ = Describe("Problem responses", func() {
+	var (
+		w *httptest.ResponseRecorder
+		c *gin.Context
+	)
+
+	BeforeEach(func() {
+		gin.SetMode(gin.TestMode)
+		w = httptest.NewRecorder()
+		c, _ = gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodGet, "/api/v1/users/42", nil)
+		c.Header("X-Request-ID", "req-123")
+	})
+
+	Describe("Respond", func() {
+		It("should write problem details for a domain error", func() {
+			httperr.Respond(c, fmt.Errorf("user %w", domain.ErrNotFound))
+
+			Expect(w.Code).To(Equal(http.StatusNotFound))
+			Expect(w.Header().Get("Content-Type")).To(HavePrefix(httperr.ContentType))
+			Expect(c.IsAborted()).To(BeTrue())
+
+			var problem httperr.Problem
+			err := json.Unmarshal(w.Body.Bytes(), &problem)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(problem).To(Equal(httperr.Problem{
+				Type:      "/problems/not-found",
+				Title:     "Not Found",
+				Status:    http.StatusNotFound,
+				Detail:    "user not found",
+				Instance:  "/api/v1/users/42",
+				RequestID: "req-123",
+			}))
+		})
+
+		It("should list the fields of a validation error", func() {
+			httperr.Respond(c, domain.ValidationError{
+				{Field: "user_id", Message: "user 7 does not exist"},
+				{Field: "product_id", Message: "product 9 is out of stock"},
+			})
+
+			Expect(w.Code).To(Equal(http.StatusUnprocessableEntity))
+
+			var problem httperr.Problem
+			err := json.Unmarshal(w.Body.Bytes(), &problem)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(problem.Type).To(Equal("/problems/validation"))
+			Expect(problem.Errors).To(Equal([]httperr.FieldError{
+				{Field: "user_id", Message: "user 7 does not exist"},
+				{Field: "product_id", Message: "product 9 is out of stock"},
+			}))
+		})
+
+		It("should count the orders blocking a deletion", func() {
+			httperr.Respond(c, &domain.InUseError{Entity: "user", Orders: 3})
+
+			Expect(w.Code).To(Equal(http.StatusConflict))
+
+			var problem httperr.Problem
+			err := json.Unmarshal(w.Body.Bytes(), &problem)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(problem.Type).To(Equal("/problems/in-use"))
+			Expect(problem.Extensions).To(HaveKeyWithValue("blocking_orders", BeNumerically("==", 3)))
+			Expect(w.Body.String()).To(ContainSubstring(`"blocking_orders":3`))
+		})
+
+		It("should use about:blank for unknown errors", func() {
+			httperr.Respond(c, errors.New("database error"))
+
+			var problem httperr.Problem
+			err := json.Unmarshal(w.Body.Bytes(), &problem)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(problem.Type).To(Equal("about:blank"))
+			Expect(problem.Status).To(Equal(http.StatusInternalServerError))
+			Expect(problem.Detail).To(Equal("Internal Server Error"))
+		})
+	})
+
+	Describe("FieldErrors", func() {
+		It("should list validator failures as domain field errors", func() {
+			type row struct {
+				Name  string  `json:"name" binding:"required"`
+				Price float64 `json:"price" binding:"gt=0"`
+			}
+
+			fields := httperr.FieldErrors(binding.Validator.ValidateStruct(row{Price: -1}))
+
+			Expect(fields).To(ConsistOf(
+				domain.FieldError{Field: "name", Message: "is required"},
+				domain.FieldError{Field: "price", Message: "must be greater than 0"},
+			))
+		})
+
+		DescribeTable("should check amounts with the amount rule",
+			func(amount string, valid bool) {
+				type row struct {
+					Price string `json:"price" binding:"amount"`
+				}
+
+				fields := httperr.FieldErrors(binding.Validator.ValidateStruct(row{Price: amount}))
+
+				if valid {
+					Expect(fields).To(BeNil())
+				} else {
+					Expect(fields).To(ConsistOf(domain.FieldError{Field: "price", Message: "must be a positive amount with at most 2 decimal places"}))
+				}
+			},
+			Entry("decimal amount", "25000.50", true),
+			Entry("whole amount", "3", true),
+			Entry("zero", "0", false),
+			Entry("negative", "-1.00", false),
+			Entry("too many decimal places", "0.125", false),
+			Entry("not a number", "free", false),
+		)
+
+		It("should return nil for other errors", func() {
+			Expect(httperr.FieldErrors(errors.New("boom"))).To(BeNil())
+			Expect(httperr.FieldErrors(nil)).To(BeNil())
+		})
+	})
+
+	Describe("BadRequest", func() {
+		type request struct {
+			Email string `json:"email" binding:"required,email"`
+			Price int    `json:"price" binding:"gt=0"`
+		}
+
+		It("should list validator failures by JSON field name", func() {
+			c.Request = httptest.NewRequest(http.MethodPost, "/api/v1/users", strings.NewReader(`{"price": 0}`))
+			c.Request.Header.Set("Content-Type", "application/json")
+
+			var req request
+			httperr.BadRequest(c, c.ShouldBindJSON(&req))
+
+			Expect(w.Code).To(Equal(http.StatusBadRequest))
+
+			var problem httperr.Problem
+			err := json.Unmarshal(w.Body.Bytes(), &problem)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(problem.Type).To(Equal("/problems/invalid-request"))
+			Expect(problem.Errors).To(ConsistOf(
+				httperr.FieldError{Field: "email", Message: "is required"},
+				httperr.FieldError{Field: "price", Message: "must be greater than 0"},
+			))
+		})
+
+		It("should name the list element of a nested field", func() {
+			type item struct {
+				ProductID int `json:"product_id" binding:"required"`
+				Quantity  int `json:"quantity" binding:"gt=0"`
+			}
+			type order struct {
+				Items     []item `json:"items" binding:"required_without=ProductID,dive"`
+				ProductID int    `json:"product_id" binding:"excluded_with=Items"`
+			}
+			c.Request = httptest.NewRequest(http.MethodPost, "/api/v1/orders", strings.NewReader(`{"items": [{"product_id": 1, "quantity": 0}], "product_id": 1}`))
+			c.Request.Header.Set("Content-Type", "application/json")
+
+			var req order
+			httperr.BadRequest(c, c.ShouldBindJSON(&req))
+
+			var problem httperr.Problem
+			err := json.Unmarshal(w.Body.Bytes(), &problem)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(problem.Errors).To(ConsistOf(
+				httperr.FieldError{Field: "items[0].quantity", Message: "must be greater than 0"},
+				httperr.FieldError{Field: "product_id", Message: "must not be set with items"},
+			))
+		})
+
+		It("should not list fields for malformed JSON", func() {
+			c.Request = httptest.NewRequest(http.MethodPost, "/api/v1/users", strings.NewReader("invalid json"))
+			c.Request.Header.Set("Content-Type", "application/json")
+
+			var req request
+			httperr.BadRequest(c, c.ShouldBindJSON(&req))
+
+			var problem httperr.Problem
+			err := json.Unmarshal(w.Body.Bytes(), &problem)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(problem.Status).To(Equal(http.StatusBadRequest))
+			Expect(problem.Errors).To(BeEmpty())
+		})
+	})
+
+	Describe("Abort", func() {
+		It("should write a generic problem for the given status", func() {
+			httperr.Abort(c, http.StatusUnauthorized, "missing API key")
+
+			var problem httperr.Problem
+			err := json.Unmarshal(w.Body.Bytes(), &problem)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(problem.Type).To(Equal("about:blank"))
+			Expect(problem.Title).To(Equal("Unauthorized"))
+			Expect(problem.Detail).To(Equal("missing API key"))
+		})
+	})
+
+	Describe("Problem JSON", func() {
+		It("should write extension members alongside the standard ones", func() {
+			data, err := json.Marshal(httperr.Problem{
+				Type:       "/problems/in-use",
+				Status:     http.StatusConflict,
+				Extensions: map[string]any{"blocking_orders": 2, "status": 500},
+			})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(data).To(MatchJSON(`{"type":"/problems/in-use","title":"","status":409,"blocking_orders":2}`))
+		})
+
+		It("should read members that are not standard into extensions", func() {
+			var problem httperr.Problem
+			err := json.Unmarshal([]byte(`{"type":"/problems/in-use","status":409,"blocking_orders":2}`), &problem)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(problem.Status).To(Equal(http.StatusConflict))
+			Expect(problem.Extensions).To(Equal(map[string]any{"blocking_orders": float64(2)}))
+		})
+	})
+})
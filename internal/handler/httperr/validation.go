@@ -0,0 +1,141 @@
+package httperr
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"unicode"
+
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
+
+	"gin-swagger-api/internal/domain"
+)
+
+// SetupValidator configures the validator gin binds requests with. Field
+// errors name the JSON or query parameter of a field instead of its Go
+// struct field name, and binding tags can use the amount rule. Call it
+// before serving requests.
+func SetupValidator() error {
+	v, ok := binding.Validator.Engine().(*validator.Validate)
+	if !ok {
+		return errors.New("gin binding does not use go-playground/validator")
+	}
+
+	v.RegisterTagNameFunc(fieldName)
+	return v.RegisterValidation("amount", isAmount)
+}
+
+// isAmount validates the amount rule: a positive decimal amount of the
+// base currency, such as 25000.50
+func isAmount(fl validator.FieldLevel) bool {
+	m, err := domain.ParseMoney(fl.Field().String(), domain.BaseCurrency)
+	return err == nil && m.IsPositive()
+}
+
+// FieldErrors lists the failed fields of a validator error, such as one
+// from binding.Validator. It returns nil for any other error.
+func FieldErrors(err error) []domain.FieldError {
+	var validationErrors validator.ValidationErrors
+	if !errors.As(err, &validationErrors) {
+		return nil
+	}
+
+	fields := make([]domain.FieldError, len(validationErrors))
+	for i, fe := range validationErrors {
+		fields[i] = domain.FieldError{
+			Field:   fieldPath(fe),
+			Message: fieldMessage(fe),
+		}
+	}
+	return fields
+}
+
+// fieldName returns the JSON or query parameter name of a struct field
+func fieldName(field reflect.StructField) string {
+	tag := field.Tag.Get("json")
+	if tag == "" {
+		tag = field.Tag.Get("form")
+	}
+
+	name, _, _ := strings.Cut(tag, ",")
+	switch name {
+	case "-":
+		return ""
+	case "":
+		return field.Name
+	default:
+		return name
+	}
+}
+
+// fieldMessage returns a human readable message for a validator failure
+func fieldMessage(fe validator.FieldError) string {
+	switch fe.Tag() {
+	case "required":
+		return "is required"
+	case "email":
+		return "must be a valid email address"
+	case "gt":
+		return fmt.Sprintf("must be greater than %s", fe.Param())
+	case "gte":
+		return fmt.Sprintf("must be greater than or equal to %s", fe.Param())
+	case "lt":
+		return fmt.Sprintf("must be less than %s", fe.Param())
+	case "lte":
+		return fmt.Sprintf("must be less than or equal to %s", fe.Param())
+	case "min":
+		return fmt.Sprintf("must be at least %s", fe.Param())
+	case "max":
+		return fmt.Sprintf("must be at most %s", fe.Param())
+	case "amount":
+		return "must be a positive amount with at most 2 decimal places"
+	case "oneof":
+		return fmt.Sprintf("must be one of: %s", fe.Param())
+	case "required_with":
+		return fmt.Sprintf("is required with %s", snakeCase(fe.Param()))
+	case "required_without":
+		return fmt.Sprintf("is required without %s", snakeCase(fe.Param()))
+	case "excluded_with":
+		return fmt.Sprintf("must not be set with %s", snakeCase(fe.Param()))
+	default:
+		return fmt.Sprintf("failed on the '%s' rule", fe.Tag())
+	}
+}
+
+// fieldPath returns the path of a failed field below the request, such as
+// items[0].quantity for a field of a list element
+func fieldPath(fe validator.FieldError) string {
+	namespace := fe.Namespace()
+	// The type arguments of a generic struct, as in
+	// Request[pkg.Create,pkg.Update], hold dots of their own
+	depth := 0
+	for i, r := range namespace {
+		switch r {
+		case '[':
+			depth++
+		case ']':
+			depth--
+		case '.':
+			if depth == 0 {
+				return namespace[i+1:]
+			}
+		}
+	}
+	return fe.Field()
+}
+
+// snakeCase converts the Go field name a rule refers to, such as
+// ProductID, to the JSON name the API uses for it
+func snakeCase(name string) string {
+	var b strings.Builder
+	runes := []rune(name)
+	for i, r := range runes {
+		if i > 0 && unicode.IsUpper(r) && unicode.IsLower(runes[i-1]) {
+			b.WriteByte('_')
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+	return b.String()
+}
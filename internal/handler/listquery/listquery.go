@@ -0,0 +1,87 @@
+package listquery
+
+import (
+	"fmt"
+	"maps"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+
+	"gin-swagger-api/internal/domain"
+)
+
+// filterKey matches filter[field] and filter[field][op] query parameters
+var filterKey = regexp.MustCompile(`^filter\[([a-z_]+)\](?:\[([a-z]+)\])?$`)
+
+var operators = map[string]domain.Operator{
+	"eq":   domain.OpEq,
+	"ne":   domain.OpNe,
+	"gt":   domain.OpGt,
+	"lt":   domain.OpLt,
+	"in":   domain.OpIn,
+	"like": domain.OpLike,
+}
+
+// Parse reads the filter and sort query parameters of a list request.
+//
+//	filter[status]=pending            status equals pending
+//	filter[price][gt]=10              price greater than 10
+//	filter[status][in]=pending,paid   status is one of the values
+//	sort=-total_price,id              total_price descending, then id
+//
+// Field names are not checked here; the service validates them against
+// the whitelist of the listed entity.
+func Parse(query url.Values) (domain.ListQuery, error) {
+	var list domain.ListQuery
+
+	for _, key := range slices.Sorted(maps.Keys(query)) {
+		if !strings.HasPrefix(key, "filter") {
+			continue
+		}
+
+		match := filterKey.FindStringSubmatch(key)
+		if match == nil {
+			return domain.ListQuery{}, fmt.Errorf("%w: malformed filter parameter %q", domain.ErrInvalidArgument, key)
+		}
+
+		op := domain.OpEq
+		if match[2] != "" {
+			var ok bool
+			if op, ok = operators[match[2]]; !ok {
+				return domain.ListQuery{}, fmt.Errorf("%w: unknown filter operator %q", domain.ErrInvalidArgument, match[2])
+			}
+		}
+
+		for _, value := range query[key] {
+			list.Filters = append(list.Filters, domain.Filter{Field: match[1], Op: op, Values: split(op, value)})
+		}
+	}
+
+	if sort := query.Get("sort"); sort != "" {
+		for field := range strings.SplitSeq(sort, ",") {
+			desc := strings.HasPrefix(field, "-")
+			field = strings.TrimPrefix(field, "-")
+			if field == "" {
+				return domain.ListQuery{}, fmt.Errorf("%w: malformed sort parameter %q", domain.ErrInvalidArgument, sort)
+			}
+			list.Sort = append(list.Sort, domain.Sort{Field: field, Desc: desc})
+		}
+	}
+
+	return list, nil
+}
+
+// split returns the values of a filter parameter; only "in" takes a list
+func split(op domain.Operator, value string) []any {
+	if op != domain.OpIn {
+		return []any{value}
+	}
+
+	parts := strings.Split(value, ",")
+	values := make([]any, len(parts))
+	for i, part := range parts {
+		values[i] = part
+	}
+	return values
+}
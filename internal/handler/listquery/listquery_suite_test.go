@@ -0,0 +1,13 @@
+package listquery_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestListQuery(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "ListQuery Suite")
+}
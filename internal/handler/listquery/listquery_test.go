@@ -0,0 +1,81 @@
+package listquery_test
+
+import (
+	"net/url"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"gin-swagger-api/internal/domain"
+	"gin-swagger-api/internal/handler/listquery"
+)
+
+// parse parses a raw query string
+func parse(raw string) (domain.ListQuery, error) {
+	values, err := url.ParseQuery(raw)
+	Expect(err).ToNot(HaveOccurred())
+	return listquery.Parse(values)
+}
+
+var _ = Describe("ListQuery", func() {
+	Describe("Parse", func() {
+		It("should return an empty query without filter or sort parameters", func() {
+			list, err := parse("limit=10&offset=20")
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(list).To(Equal(domain.ListQuery{}))
+		})
+
+		It("should default the operator to eq", func() {
+			list, err := parse("filter[status]=pending")
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(list.Filters).To(Equal([]domain.Filter{
+				{Field: "status", Op: domain.OpEq, Values: []any{"pending"}},
+			}))
+		})
+
+		It("should parse operators and repeated parameters", func() {
+			list, err := parse("filter[price][gt]=10&filter[price][lt]=50&filter[price][lt]=40")
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(list.Filters).To(Equal([]domain.Filter{
+				{Field: "price", Op: domain.OpGt, Values: []any{"10"}},
+				{Field: "price", Op: domain.OpLt, Values: []any{"50"}},
+				{Field: "price", Op: domain.OpLt, Values: []any{"40"}},
+			}))
+		})
+
+		It("should split in values on commas", func() {
+			list, err := parse("filter[status][in]=pending,paid")
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(list.Filters).To(Equal([]domain.Filter{
+				{Field: "status", Op: domain.OpIn, Values: []any{"pending", "paid"}},
+			}))
+		})
+
+		It("should parse sort fields with direction", func() {
+			list, err := parse("sort=-total_price,id")
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(list.Sort).To(Equal([]domain.Sort{
+				{Field: "total_price", Desc: true},
+				{Field: "id"},
+			}))
+		})
+
+		DescribeTable("should reject malformed parameters",
+			func(raw string) {
+				_, err := parse(raw)
+
+				Expect(err).To(MatchError(domain.ErrInvalidArgument))
+			},
+			Entry("missing field", "filter=pending"),
+			Entry("unclosed bracket", "filter[status=pending"),
+			Entry("unknown operator", "filter[price][between]=1"),
+			Entry("empty sort field", "sort=name,,id"),
+			Entry("bare minus", "sort=-"),
+		)
+	})
+})
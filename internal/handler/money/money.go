@@ -0,0 +1,32 @@
+package money
+
+import (
+	"encoding/json"
+
+	"gin-swagger-api/internal/domain"
+)
+
+// Amount is a decimal amount of money in a request body, sent either as a
+// JSON string such as "25000.50" or as a JSON number. It keeps the text as
+// sent so that it is parsed exactly; check it with the amount rule.
+type Amount string
+
+// UnmarshalJSON takes the text of a JSON string, or the JSON text of any
+// other value. Values other than numbers then fail the amount rule, which
+// names the field.
+func (a *Amount) UnmarshalJSON(data []byte) error {
+	var text string
+	if err := json.Unmarshal(data, &text); err != nil {
+		text = string(data)
+	}
+	*a = Amount(text)
+	return nil
+}
+
+// Money parses the amount in currency. An empty amount is zero.
+func (a Amount) Money(currency string) (domain.Money, error) {
+	if a == "" {
+		return domain.Money{Currency: currency}, nil
+	}
+	return domain.ParseMoney(string(a), currency)
+}
@@ -0,0 +1,47 @@
+package money_test
+
+import (
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"gin-swagger-api/internal/domain"
+	"gin-swagger-api/internal/handler/money"
+)
+
+var _ = Describe("Amount", func() {
+	type body struct {
+		Price money.Amount `json:"price"`
+	}
+
+	DescribeTable("should keep the text of strings and numbers",
+		func(data string, expected money.Amount) {
+			var b body
+			Expect(json.Unmarshal([]byte(data), &b)).To(Succeed())
+
+			Expect(b.Price).To(Equal(expected))
+		},
+		Entry("string", `{"price": "25000.50"}`, money.Amount("25000.50")),
+		Entry("number", `{"price": 25000.50}`, money.Amount("25000.50")),
+		Entry("string that is not a number", `{"price": "cheap"}`, money.Amount("cheap")),
+		Entry("null", `{"price": null}`, money.Amount("")),
+		Entry("other value", `{"price": true}`, money.Amount("true")),
+	)
+
+	Describe("Money", func() {
+		It("should parse the amount exactly", func() {
+			Expect(money.Amount("0.30").Money(domain.BaseCurrency)).To(Equal(domain.Money{Amount: 30, Currency: domain.BaseCurrency}))
+		})
+
+		It("should be zero when empty", func() {
+			Expect(money.Amount("").Money("USD")).To(Equal(domain.Money{Currency: "USD"}))
+		})
+
+		It("should reject malformed amounts", func() {
+			_, err := money.Amount("1.234").Money(domain.BaseCurrency)
+
+			Expect(err).To(MatchError(domain.ErrInvalidArgument))
+		})
+	})
+})
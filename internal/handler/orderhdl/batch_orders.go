@@ -0,0 +1,72 @@
+package orderhdl
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"gin-swagger-api/internal/domain"
+	"gin-swagger-api/internal/handler/batch"
+	"gin-swagger-api/internal/handler/httperr"
+)
+
+// BatchOrdersRequest represents the body of an order batch
+type BatchOrdersRequest = batch.Request[CreateOrderRequest, BatchUpdateOrderRequest]
+
+// BatchOrders godoc
+// @Summary Create, update and delete orders in a batch
+// @Description Apply up to 100 order creates, updates and deletes in one request: creates first, then updates, then deletes. Each item is priced and moves stock like the single-order endpoints.
+// @Description In all_or_nothing mode (the default) the batch runs in one transaction and stops at the first failing item; nothing is saved and the other items report 424.
+// @Description In best_effort mode each item is saved on its own.
+// @Description Each result carries the status code the single-order endpoint would have returned. The response is 200 when every item succeeded and 207 otherwise.
+// @Tags orders
+// @Accept json
+// @Produce json
+// @Param batch body BatchOrdersRequest true "Orders to create, update and delete"
+// @Param Idempotency-Key header string false "Key that makes retries return the first response instead of repeating the request"
+// @Success 200 {object} batch.Response[orderhdl.OrderResponse]
+// @Success 207 {object} batch.Response[orderhdl.OrderResponse]
+// @Failure 400 {object} httperr.Problem
+// @Failure 409 {object} httperr.Problem
+// @Failure 422 {object} httperr.Problem
+// @Failure 500 {object} httperr.Problem
+// @Router /orders/batch [post]
+func (h *Handler) BatchOrders(c *gin.Context) {
+	var req BatchOrdersRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		httperr.BadRequest(c, err)
+		return
+	}
+
+	b, err := batch.ToBatch(req,
+		func(r CreateOrderRequest) domain.Order {
+			return domain.Order{
+				UserID:     r.UserID,
+				Items:      orderItems(r.Items, r.ProductID, r.Quantity),
+				Currency:   r.Currency,
+				CouponCode: r.CouponCode,
+			}
+		},
+		func(r BatchUpdateOrderRequest) domain.BatchUpdate[domain.Order] {
+			return domain.BatchUpdate[domain.Order]{
+				Version: r.Version,
+				Value: domain.Order{
+					ID:     r.ID,
+					Items:  orderItems(r.Items, r.ProductID, r.Quantity),
+					Status: r.Status,
+				},
+			}
+		},
+	)
+	if err != nil {
+		httperr.Respond(c, err)
+		return
+	}
+
+	results, err := h.orderService.BatchOrders(c.Request.Context(), b)
+	if err != nil {
+		httperr.Respond(c, err)
+		return
+	}
+
+	resp := batch.NewResponse(b.Mode, results, toOrderResponse)
+	c.JSON(resp.Status(), resp)
+}
@@ -0,0 +1,92 @@
+package orderhdl_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"gin-swagger-api/internal/domain"
+	"gin-swagger-api/internal/handler/batch"
+	"gin-swagger-api/internal/handler/orderhdl"
+	"gin-swagger-api/internal/testutil"
+	mockordersvc "gin-swagger-api/mock/service/ordersvc"
+)
+
+var _ = Describe("Handler BatchOrders", func() {
+	var (
+		mockService *mockordersvc.MockService
+		handler     *orderhdl.Handler
+		ctx         context.Context
+	)
+
+	BeforeEach(func() {
+		gin.SetMode(gin.TestMode)
+		mockService = mockordersvc.NewMockService(GinkgoT())
+		handler = orderhdl.NewHandler(mockService)
+		ctx = context.Background()
+	})
+
+	post := func(body string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodPost, "/api/v1/orders/batch", strings.NewReader(body)).WithContext(ctx)
+		c.Request.Header.Set("Content-Type", "application/json")
+		handler.BatchOrders(c)
+		return w
+	}
+
+	Describe("BatchOrders", func() {
+		It("should convert orders like the single-order endpoints", func() {
+			created := &domain.Order{ID: "5", UserID: 1, Items: []domain.OrderItem{domain.NewOrderItem(2, 3, testutil.THB("10.00"))}, TotalPrice: testutil.THB("30.00"), Status: "pending"}
+			mockService.EXPECT().
+				BatchOrders(ctx, domain.Batch[domain.Order]{
+					Mode:   domain.BatchBestEffort,
+					Create: []domain.Order{{UserID: 1, Items: []domain.OrderItem{{ProductID: 2, Quantity: 3}}}},
+					Update: []domain.BatchUpdate[domain.Order]{{
+						Version: "abc",
+						Value:   domain.Order{ID: "4", Items: []domain.OrderItem{{ProductID: 2, Quantity: 1}, {ProductID: 3, Quantity: 2}}, Status: "paid"},
+					}},
+					Delete: []domain.BatchRef{{ID: "6", Version: "def"}},
+				}).
+				Return(&domain.BatchResults[domain.Order]{
+					Create: []domain.BatchResult[domain.Order]{{Value: created}},
+					Update: []domain.BatchResult[domain.Order]{{Err: domain.ErrInvalidTransition}},
+					Delete: []domain.BatchResult[domain.Order]{{}},
+				}, nil)
+
+			w := post(`{
+				"mode": "best_effort",
+				"create": [{"user_id": 1, "product_id": 2, "quantity": 3}],
+				"update": [{"id": "4", "version": "abc", "items": [{"product_id": 2, "quantity": 1}, {"product_id": 3, "quantity": 2}], "status": "paid"}],
+				"delete": [{"id": "6", "version": "def"}]
+			}`)
+
+			Expect(w.Code).To(Equal(http.StatusMultiStatus))
+
+			var response batch.Response[orderhdl.OrderResponse]
+			Expect(json.Unmarshal(w.Body.Bytes(), &response)).To(Succeed())
+			Expect(response.Create[0].Status).To(Equal(http.StatusCreated))
+			Expect(response.Create[0].Data.ID).To(Equal("5"))
+			Expect(response.Create[0].Version).To(Equal(created.Version()))
+			Expect(response.Update[0].Status).To(Equal(http.StatusConflict))
+			Expect(response.Delete[0].Status).To(Equal(http.StatusNoContent))
+		})
+
+		It("should reject more than the largest batch", func() {
+			items := make([]string, domain.MaxBatchSize+1)
+			for i := range items {
+				items[i] = `{"id": "1"}`
+			}
+
+			w := post(`{"delete": [` + strings.Join(items, ",") + `]}`)
+
+			Expect(w.Code).To(Equal(http.StatusBadRequest))
+		})
+	})
+})
@@ -4,38 +4,52 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+
+	"gin-swagger-api/internal/handler/etag"
+	"gin-swagger-api/internal/handler/httperr"
+	"gin-swagger-api/internal/port/service/ordersvc"
 )
 
 // CreateOrder godoc
 // @Summary Create a new order
-// @Description Create a new order with the provided information
+// @Description Create a new order with the provided information.
+// @Description Send the lines of the order in items, or a single product with product_id and quantity.
+// @Description Items are priced from the current product prices and the total is their sum; clients cannot set them.
+// @Description Prices are converted from the base currency (THB) to currency at the current exchange rate, which the order records; a currency that is not supported or has no rate is rejected with 422.
+// @Description The quantities are taken out of product stock; 409 when not enough is in stock.
+// @Description A coupon_code takes its discount off the total; a code that does not exist, is outside its validity window or needs a larger order is rejected with 422, and one whose usage limit is reached with 409.
+// @Description New orders are pending; any other status is rejected with 400.
+// @Description A user or product that does not exist, a product out of stock or a product listed twice is rejected with 422 naming the field.
 // @Tags orders
 // @Accept json
 // @Produce json
 // @Param order body CreateOrderRequest true "Order information"
+// @Param Idempotency-Key header string false "Key that makes retries return the first response instead of repeating the request"
 // @Success 201 {object} OrderResponse
-// @Failure 400 {object} ErrorResponse
-// @Failure 500 {object} ErrorResponse
+// @Header 201 {string} ETag "Entity tag of the current order version"
+// @Failure 400 {object} httperr.Problem
+// @Failure 409 {object} httperr.Problem
+// @Failure 422 {object} httperr.Problem
+// @Failure 500 {object} httperr.Problem
 // @Router /orders [post]
 func (h *Handler) CreateOrder(c *gin.Context) {
 	var req CreateOrderRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		httperr.BadRequest(c, err)
 		return
 	}
 
-	order, err := h.orderService.CreateOrder(
-		c.Request.Context(),
-		req.UserID,
-		req.ProductID,
-		req.Quantity,
-		req.TotalPrice,
-		req.Status,
-	)
+	order, err := h.orderService.CreateOrder(c.Request.Context(), ordersvc.CreateOrderInput{
+		UserID:     req.UserID,
+		Items:      orderItems(req.Items, req.ProductID, req.Quantity),
+		Currency:   req.Currency,
+		CouponCode: req.CouponCode,
+	})
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, ErrorResponse{Error: err.Error()})
+		httperr.Respond(c, err)
 		return
 	}
 
+	etag.Set(c, order.Version())
 	c.JSON(http.StatusCreated, toOrderResponse(*order))
 }
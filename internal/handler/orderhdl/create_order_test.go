@@ -5,6 +5,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 
@@ -13,7 +14,10 @@ import (
 	. "github.com/onsi/gomega"
 
 	"gin-swagger-api/internal/domain"
+	"gin-swagger-api/internal/handler/httperr"
 	"gin-swagger-api/internal/handler/orderhdl"
+	portordersvc "gin-swagger-api/internal/port/service/ordersvc"
+	"gin-swagger-api/internal/testutil"
 	mockordersvc "gin-swagger-api/mock/service/ordersvc"
 )
 
@@ -35,21 +39,19 @@ var _ = Describe("Handler CreateOrder", func() {
 		Context("when creating an order with valid data", func() {
 			It("should create order successfully", func() {
 				req := orderhdl.CreateOrderRequest{
-					UserID:     1,
-					ProductID:  1,
-					Quantity:   2,
-					TotalPrice: 50000.00,
-					Status:     "pending",
+					UserID:    1,
+					ProductID: 1,
+					Quantity:  2,
+					Status:    "pending",
 				}
 				order := &domain.Order{
 					ID:         "1",
 					UserID:     1,
-					ProductID:  1,
-					Quantity:   2,
-					TotalPrice: 50000.00,
+					Items:      []domain.OrderItem{domain.NewOrderItem(1, 2, testutil.THB("25000.00"))},
+					TotalPrice: testutil.THB("50000.00"),
 					Status:     "pending",
 				}
-				mockService.EXPECT().CreateOrder(ctx, 1, 1, 2, 50000.00, "pending").Return(order, nil)
+				mockService.EXPECT().CreateOrder(ctx, portordersvc.CreateOrderInput{UserID: 1, Items: []domain.OrderItem{{ProductID: 1, Quantity: 2}}}).Return(order, nil)
 
 				bodyBytes, _ := json.Marshal(req)
 				w := httptest.NewRecorder()
@@ -67,7 +69,174 @@ var _ = Describe("Handler CreateOrder", func() {
 				Expect(err).ToNot(HaveOccurred())
 				Expect(response.ID).To(Equal("1"))
 				Expect(response.UserID).To(Equal(1))
-				Expect(response.TotalPrice).To(Equal(50000.00))
+				Expect(response.TotalPrice).To(Equal("50000.00"))
+				Expect(response.Currency).To(Equal("THB"))
+				Expect(response.Items).To(Equal([]orderhdl.OrderItemResponse{
+					{ProductID: 1, Quantity: 2, UnitPrice: "25000.00", LineTotal: "50000.00"},
+				}))
+				Expect(response.ProductID).To(Equal(1))
+				Expect(response.Quantity).To(Equal(2))
+			})
+		})
+
+		Context("when creating an order with several items", func() {
+			It("should pass every item and return them", func() {
+				items := []domain.OrderItem{
+					domain.NewOrderItem(1, 2, testutil.THB("25000.00")),
+					domain.NewOrderItem(2, 1, testutil.THB("500.00")),
+				}
+				order := &domain.Order{ID: "1", UserID: 1, Items: items, TotalPrice: testutil.THB("50500.00"), Status: "pending"}
+				mockService.EXPECT().CreateOrder(ctx, portordersvc.CreateOrderInput{UserID: 1, Items: []domain.OrderItem{
+					{ProductID: 1, Quantity: 2},
+					{ProductID: 2, Quantity: 1},
+				}}).Return(order, nil)
+
+				w := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(w)
+				c.Request = httptest.NewRequest(http.MethodPost, "/api/v1/orders", bytes.NewBufferString(`{"user_id": 1, "items": [{"product_id": 1, "quantity": 2}, {"product_id": 2, "quantity": 1}]}`))
+				c.Request.Header.Set("Content-Type", "application/json")
+				c.Request = c.Request.WithContext(ctx)
+
+				handler.CreateOrder(c)
+
+				Expect(w.Code).To(Equal(http.StatusCreated))
+
+				var response map[string]any
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(response["items"]).To(HaveLen(2))
+				Expect(response).ToNot(HaveKey("product_id"))
+				Expect(response).ToNot(HaveKey("quantity"))
+			})
+
+			It("should place the order in the requested currency", func() {
+				usd := domain.MustParseMoney("1375.00", "USD")
+				order := &domain.Order{
+					ID:           "1",
+					UserID:       1,
+					Items:        []domain.OrderItem{domain.NewOrderItem(1, 2, domain.MustParseMoney("687.50", "USD"))},
+					TotalPrice:   usd,
+					Currency:     "USD",
+					ExchangeRate: "0.0275",
+					Status:       "pending",
+				}
+				mockService.EXPECT().CreateOrder(ctx, portordersvc.CreateOrderInput{UserID: 1, Items: []domain.OrderItem{{ProductID: 1, Quantity: 2}}, Currency: "USD"}).Return(order, nil)
+
+				w := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(w)
+				c.Request = httptest.NewRequest(http.MethodPost, "/api/v1/orders", bytes.NewBufferString(`{"user_id": 1, "product_id": 1, "quantity": 2, "currency": "USD"}`))
+				c.Request.Header.Set("Content-Type", "application/json")
+				c.Request = c.Request.WithContext(ctx)
+
+				handler.CreateOrder(c)
+
+				Expect(w.Code).To(Equal(http.StatusCreated))
+
+				var response orderhdl.OrderResponse
+				Expect(json.Unmarshal(w.Body.Bytes(), &response)).To(Succeed())
+				Expect(response.TotalPrice).To(Equal("1375.00"))
+				Expect(response.Currency).To(Equal("USD"))
+				Expect(response.ExchangeRate).To(Equal("0.0275"))
+			})
+
+			It("should apply the coupon code and report the discount", func() {
+				order := &domain.Order{
+					ID:         "1",
+					UserID:     1,
+					Items:      []domain.OrderItem{domain.NewOrderItem(1, 2, testutil.THB("25000.00"))},
+					TotalPrice: testutil.THB("45000.00"),
+					CouponCode: "SAVE10",
+					Discount:   testutil.THB("5000.00"),
+					Status:     "pending",
+				}
+				mockService.EXPECT().CreateOrder(ctx, portordersvc.CreateOrderInput{UserID: 1, Items: []domain.OrderItem{{ProductID: 1, Quantity: 2}}, CouponCode: "save10"}).Return(order, nil)
+
+				w := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(w)
+				c.Request = httptest.NewRequest(http.MethodPost, "/api/v1/orders", bytes.NewBufferString(`{"user_id": 1, "product_id": 1, "quantity": 2, "coupon_code": "save10"}`))
+				c.Request.Header.Set("Content-Type", "application/json")
+				c.Request = c.Request.WithContext(ctx)
+
+				handler.CreateOrder(c)
+
+				Expect(w.Code).To(Equal(http.StatusCreated))
+
+				var response orderhdl.OrderResponse
+				Expect(json.Unmarshal(w.Body.Bytes(), &response)).To(Succeed())
+				Expect(response.TotalPrice).To(Equal("45000.00"))
+				Expect(response.CouponCode).To(Equal("SAVE10"))
+				Expect(response.Discount).To(Equal("5000.00"))
+			})
+
+			It("should return 409 when the coupon is used up", func() {
+				mockService.EXPECT().CreateOrder(ctx, portordersvc.CreateOrderInput{UserID: 1, Items: []domain.OrderItem{{ProductID: 1, Quantity: 2}}, CouponCode: "SAVE10"}).Return(nil, fmt.Errorf("coupon SAVE10 %w", domain.ErrCouponUsedUp))
+
+				w := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(w)
+				c.Request = httptest.NewRequest(http.MethodPost, "/api/v1/orders", bytes.NewBufferString(`{"user_id": 1, "product_id": 1, "quantity": 2, "coupon_code": "SAVE10"}`))
+				c.Request.Header.Set("Content-Type", "application/json")
+				c.Request = c.Request.WithContext(ctx)
+
+				handler.CreateOrder(c)
+
+				Expect(w.Code).To(Equal(http.StatusConflict))
+			})
+		})
+
+		DescribeTable("should reject malformed items naming the field",
+			func(body string, expected httperr.FieldError) {
+				w := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(w)
+				c.Request = httptest.NewRequest(http.MethodPost, "/api/v1/orders", bytes.NewBufferString(body))
+				c.Request.Header.Set("Content-Type", "application/json")
+				c.Request = c.Request.WithContext(ctx)
+
+				handler.CreateOrder(c)
+
+				Expect(w.Code).To(Equal(http.StatusBadRequest))
+
+				var response httperr.Problem
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(response.Errors).To(ContainElement(expected))
+			},
+			Entry("quantity of an item",
+				`{"user_id": 1, "items": [{"product_id": 1, "quantity": 0}]}`,
+				httperr.FieldError{Field: "items[0].quantity", Message: "is required"}),
+			Entry("empty items",
+				`{"user_id": 1, "items": []}`,
+				httperr.FieldError{Field: "items", Message: "must be at least 1"}),
+			Entry("items and a single product",
+				`{"user_id": 1, "items": [{"product_id": 1, "quantity": 1}], "product_id": 1, "quantity": 1}`,
+				httperr.FieldError{Field: "product_id", Message: "must not be set with items"}),
+			Entry("neither items nor a product",
+				`{"user_id": 1}`,
+				httperr.FieldError{Field: "items", Message: "is required without product_id"}),
+			Entry("a product without quantity",
+				`{"user_id": 1, "product_id": 1}`,
+				httperr.FieldError{Field: "quantity", Message: "is required with product_id"}),
+		)
+
+		Context("when the client sends a total price", func() {
+			It("should ignore it and return the priced order", func() {
+				order := &domain.Order{ID: "1", UserID: 1, Items: []domain.OrderItem{domain.NewOrderItem(1, 2, testutil.THB("25000.00"))}, TotalPrice: testutil.THB("50000.00"), Status: "pending"}
+				mockService.EXPECT().CreateOrder(ctx, portordersvc.CreateOrderInput{UserID: 1, Items: []domain.OrderItem{{ProductID: 1, Quantity: 2}}}).Return(order, nil)
+
+				w := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(w)
+				c.Request = httptest.NewRequest(http.MethodPost, "/api/v1/orders", bytes.NewBufferString(`{"user_id": 1, "product_id": 1, "quantity": 2, "total_price": 0.01, "status": "pending"}`))
+				c.Request.Header.Set("Content-Type", "application/json")
+				c.Request = c.Request.WithContext(ctx)
+
+				handler.CreateOrder(c)
+
+				Expect(w.Code).To(Equal(http.StatusCreated))
+
+				var response orderhdl.OrderResponse
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(response.UnitPrice).To(Equal("25000.00"))
+				Expect(response.TotalPrice).To(Equal("50000.00"))
 			})
 		})
 
@@ -89,18 +258,57 @@ var _ = Describe("Handler CreateOrder", func() {
 
 				Expect(w.Code).To(Equal(http.StatusBadRequest))
 			})
+
+			It("should reject an order that does not start pending", func() {
+				w := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(w)
+				c.Request = httptest.NewRequest(http.MethodPost, "/api/v1/orders", bytes.NewBufferString(`{"user_id": 1, "product_id": 1, "quantity": 2, "status": "paid"}`))
+				c.Request.Header.Set("Content-Type", "application/json")
+				c.Request = c.Request.WithContext(ctx)
+
+				handler.CreateOrder(c)
+
+				Expect(w.Code).To(Equal(http.StatusBadRequest))
+				Expect(w.Body.String()).To(ContainSubstring(`"field":"status"`))
+			})
+		})
+
+		Context("when the user or product does not exist", func() {
+			It("should return unprocessable entity naming the fields", func() {
+				mockService.EXPECT().CreateOrder(ctx, portordersvc.CreateOrderInput{UserID: 7, Items: []domain.OrderItem{{ProductID: 9, Quantity: 2}}}).Return(nil, domain.ValidationError{
+					{Field: "user_id", Message: "user 7 does not exist"},
+					{Field: "product_id", Message: "product 9 does not exist"},
+				})
+
+				w := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(w)
+				c.Request = httptest.NewRequest(http.MethodPost, "/api/v1/orders", bytes.NewBufferString(`{"user_id": 7, "product_id": 9, "quantity": 2}`))
+				c.Request.Header.Set("Content-Type", "application/json")
+				c.Request = c.Request.WithContext(ctx)
+
+				handler.CreateOrder(c)
+
+				Expect(w.Code).To(Equal(http.StatusUnprocessableEntity))
+
+				var response httperr.Problem
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(response.Errors).To(Equal([]httperr.FieldError{
+					{Field: "user_id", Message: "user 7 does not exist"},
+					{Field: "product_id", Message: "product 9 does not exist"},
+				}))
+			})
 		})
 
 		Context("when service returns error", func() {
 			It("should return internal server error", func() {
 				req := orderhdl.CreateOrderRequest{
-					UserID:     1,
-					ProductID:  1,
-					Quantity:   2,
-					TotalPrice: 50000.00,
-					Status:     "pending",
+					UserID:    1,
+					ProductID: 1,
+					Quantity:  2,
+					Status:    "pending",
 				}
-				mockService.EXPECT().CreateOrder(ctx, 1, 1, 2, 50000.00, "pending").Return(nil, errors.New("database error"))
+				mockService.EXPECT().CreateOrder(ctx, portordersvc.CreateOrderInput{UserID: 1, Items: []domain.OrderItem{{ProductID: 1, Quantity: 2}}}).Return(nil, errors.New("database error"))
 
 				bodyBytes, _ := json.Marshal(req)
 				w := httptest.NewRecorder()
@@ -113,10 +321,10 @@ var _ = Describe("Handler CreateOrder", func() {
 
 				Expect(w.Code).To(Equal(http.StatusInternalServerError))
 
-				var response orderhdl.ErrorResponse
+				var response httperr.Problem
 				err := json.Unmarshal(w.Body.Bytes(), &response)
 				Expect(err).ToNot(HaveOccurred())
-				Expect(response.Error).To(Equal("database error"))
+				Expect(response.Detail).To(Equal("Internal Server Error"))
 			})
 		})
 	})
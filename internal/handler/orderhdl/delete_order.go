@@ -4,25 +4,40 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+
+	"gin-swagger-api/internal/handler/etag"
+	"gin-swagger-api/internal/handler/httperr"
 )
 
 // DeleteOrder godoc
 // @Summary Delete an order
-// @Description Delete an order by ID
+// @Description Soft-delete an order by ID. A pending or paid order returns its quantity to product stock; shipped and delivered goods do not come back.
+// @Description An admin can still list it with include_deleted=true and restore it until it is purged.
 // @Tags orders
 // @Accept json
 // @Produce json
 // @Param id path string true "Order ID"
+// @Param If-Match header string false "ETag the order must still have; required when the server enforces preconditions"
 // @Success 204
-// @Failure 404 {object} ErrorResponse
-// @Failure 500 {object} ErrorResponse
+// @Failure 400 {object} httperr.Problem
+// @Failure 404 {object} httperr.Problem
+// @Failure 409 {object} httperr.Problem
+// @Failure 412 {object} httperr.Problem
+// @Failure 428 {object} httperr.Problem
+// @Failure 500 {object} httperr.Problem
 // @Router /orders/{id} [delete]
 func (h *Handler) DeleteOrder(c *gin.Context) {
 	id := c.Param("id")
 
-	err := h.orderService.DeleteOrder(c.Request.Context(), id)
+	version, err := etag.IfMatch(c)
+	if err != nil {
+		httperr.Respond(c, err)
+		return
+	}
+
+	err = h.orderService.DeleteOrder(c.Request.Context(), id, version)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, ErrorResponse{Error: err.Error()})
+		httperr.Respond(c, err)
 		return
 	}
 
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 
@@ -11,6 +12,9 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	"gin-swagger-api/internal/domain"
+	"gin-swagger-api/internal/handler/etag"
+	"gin-swagger-api/internal/handler/httperr"
 	"gin-swagger-api/internal/handler/orderhdl"
 	mockordersvc "gin-swagger-api/mock/service/ordersvc"
 )
@@ -34,7 +38,7 @@ var _ = Describe("Handler DeleteOrder", func() {
 	Describe("DeleteOrder", func() {
 		Context("when deleting an existing order", func() {
 			It("should delete order successfully", func() {
-				mockService.EXPECT().DeleteOrder(ctx, orderID).Return(nil)
+				mockService.EXPECT().DeleteOrder(ctx, orderID, "").Return(nil)
 
 				w := httptest.NewRecorder()
 				c, _ := gin.CreateTestContext(w)
@@ -49,9 +53,25 @@ var _ = Describe("Handler DeleteOrder", func() {
 			})
 		})
 
+		Context("when order does not exist", func() {
+			It("should return not found error", func() {
+				mockService.EXPECT().DeleteOrder(ctx, orderID, "").Return(fmt.Errorf("order %w", domain.ErrNotFound))
+
+				w := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(w)
+				c.Request = httptest.NewRequest(http.MethodDelete, "/api/v1/orders/"+orderID, nil)
+				c.Request = c.Request.WithContext(ctx)
+				c.Params = gin.Params{{Key: "id", Value: orderID}}
+
+				handler.DeleteOrder(c)
+
+				Expect(w.Code).To(Equal(http.StatusNotFound))
+			})
+		})
+
 		Context("when service returns error", func() {
 			It("should return internal server error", func() {
-				mockService.EXPECT().DeleteOrder(ctx, orderID).Return(errors.New("delete failed"))
+				mockService.EXPECT().DeleteOrder(ctx, orderID, "").Return(errors.New("delete failed"))
 
 				w := httptest.NewRecorder()
 				c, _ := gin.CreateTestContext(w)
@@ -63,10 +83,55 @@ var _ = Describe("Handler DeleteOrder", func() {
 
 				Expect(w.Code).To(Equal(http.StatusInternalServerError))
 
-				var response orderhdl.ErrorResponse
+				var response httperr.Problem
 				err := json.Unmarshal(w.Body.Bytes(), &response)
 				Expect(err).ToNot(HaveOccurred())
-				Expect(response.Error).To(Equal("delete failed"))
+				Expect(response.Detail).To(Equal("Internal Server Error"))
+			})
+		})
+
+		Context("when If-Match is sent", func() {
+			It("should pass the expected version to the service", func() {
+				mockService.EXPECT().DeleteOrder(ctx, orderID, "abc123").Return(nil)
+
+				w := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(w)
+				c.Request = httptest.NewRequest(http.MethodDelete, "/api/v1/orders/"+orderID, nil)
+				c.Request.Header.Set(etag.IfMatchHeader, `"abc123"`)
+				c.Request = c.Request.WithContext(ctx)
+				c.Params = gin.Params{{Key: "id", Value: orderID}}
+
+				handler.DeleteOrder(c)
+
+				Expect(w.Code).To(Or(Equal(http.StatusOK), Equal(http.StatusNoContent)))
+			})
+
+			It("should return precondition failed when the order has changed", func() {
+				mockService.EXPECT().DeleteOrder(ctx, orderID, "abc123").Return(fmt.Errorf("order version %w", domain.ErrPreconditionFailed))
+
+				w := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(w)
+				c.Request = httptest.NewRequest(http.MethodDelete, "/api/v1/orders/"+orderID, nil)
+				c.Request.Header.Set(etag.IfMatchHeader, `"abc123"`)
+				c.Request = c.Request.WithContext(ctx)
+				c.Params = gin.Params{{Key: "id", Value: orderID}}
+
+				handler.DeleteOrder(c)
+
+				Expect(w.Code).To(Equal(http.StatusPreconditionFailed))
+			})
+
+			It("should reject a malformed header before calling the service", func() {
+				w := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(w)
+				c.Request = httptest.NewRequest(http.MethodDelete, "/api/v1/orders/"+orderID, nil)
+				c.Request.Header.Set(etag.IfMatchHeader, "abc123")
+				c.Request = c.Request.WithContext(ctx)
+				c.Params = gin.Params{{Key: "id", Value: orderID}}
+
+				handler.DeleteOrder(c)
+
+				Expect(w.Code).To(Equal(http.StatusBadRequest))
 			})
 		})
 	})
@@ -0,0 +1,30 @@
+package orderhdl
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"gin-swagger-api/internal/domain"
+)
+
+// parseExpand reads the relations to embed in orders from the expand query
+// parameter, a comma separated list that may also be repeated
+func parseExpand(c *gin.Context) (domain.OrderExpand, error) {
+	var expand domain.OrderExpand
+	for _, value := range c.QueryArray("expand") {
+		for name := range strings.SplitSeq(value, ",") {
+			switch name = strings.TrimSpace(name); name {
+			case "":
+			case "user":
+				expand.User = true
+			case "product":
+				expand.Product = true
+			default:
+				return domain.OrderExpand{}, fmt.Errorf("%w: unknown expand %q, use user or product", domain.ErrInvalidArgument, name)
+			}
+		}
+	}
+	return expand, nil
+}
@@ -0,0 +1,141 @@
+package orderhdl_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"gin-swagger-api/internal/domain"
+	"gin-swagger-api/internal/handler/orderhdl"
+	"gin-swagger-api/internal/testutil"
+	mockordersvc "gin-swagger-api/mock/service/ordersvc"
+)
+
+var _ = Describe("Handler ExportOrders", func() {
+	var (
+		mockService *mockordersvc.MockService
+		handler     *orderhdl.Handler
+		ctx         context.Context
+		createdAt   time.Time
+		orders      []domain.Order
+	)
+
+	BeforeEach(func() {
+		gin.SetMode(gin.TestMode)
+		mockService = mockordersvc.NewMockService(GinkgoT())
+		handler = orderhdl.NewHandler(mockService)
+		ctx = context.Background()
+		createdAt = time.Date(2026, 1, 15, 9, 30, 0, 0, time.UTC)
+		orders = []domain.Order{{
+			ID:         "1",
+			UserID:     2,
+			Items:      []domain.OrderItem{domain.NewOrderItem(3, 2, testutil.THB("10.00")), domain.NewOrderItem(4, 1, testutil.THB("5.50"))},
+			TotalPrice: testutil.THB("25.50"),
+			Status:     "paid",
+			CreatedAt:  createdAt,
+		}}
+	})
+
+	newRequest := func(target, accept string) (*gin.Context, *httptest.ResponseRecorder) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodGet, target, nil).WithContext(ctx)
+		if accept != "" {
+			c.Request.Header.Set("Accept", accept)
+		}
+		return c, w
+	}
+
+	Describe("ExportOrders", func() {
+		Context("when CSV is accepted", func() {
+			It("should write one row per order item", func() {
+				mockService.EXPECT().
+					ExportOrders(ctx, domain.ListQuery{}, mock.Anything).
+					RunAndReturn(func(_ context.Context, _ domain.ListQuery, fn func([]domain.Order) error) error {
+						return fn(orders)
+					})
+
+				c, w := newRequest("/api/v1/orders/export", "text/csv")
+
+				handler.ExportOrders(c)
+
+				Expect(w.Code).To(Equal(http.StatusOK))
+				Expect(w.Header().Get("Content-Disposition")).To(Equal(`attachment; filename="orders.csv"`))
+				Expect(w.Body.String()).To(Equal(
+					"order_id,user_id,status,total_price,currency,created_at,product_id,quantity,unit_price,line_total\n" +
+						"1,2,paid,25.50,THB,2026-01-15T09:30:00Z,3,2,10.00,20.00\n" +
+						"1,2,paid,25.50,THB,2026-01-15T09:30:00Z,4,1,5.50,5.50\n"))
+			})
+		})
+
+		Context("when NDJSON is accepted", func() {
+			It("should write one order per line", func() {
+				mockService.EXPECT().
+					ExportOrders(ctx, domain.ListQuery{}, mock.Anything).
+					RunAndReturn(func(_ context.Context, _ domain.ListQuery, fn func([]domain.Order) error) error {
+						return fn(orders)
+					})
+
+				c, w := newRequest("/api/v1/orders/export", "application/x-ndjson")
+
+				handler.ExportOrders(c)
+
+				Expect(w.Code).To(Equal(http.StatusOK))
+				Expect(w.Header().Get("Content-Type")).To(Equal("application/x-ndjson"))
+				Expect(w.Body.String()).To(HavePrefix(`{"id":"1","user_id":2,`))
+				Expect(w.Body.String()).To(ContainSubstring(`"created_at":"2026-01-15T09:30:00Z"`))
+				Expect(w.Body.String()).To(HaveSuffix("}\n"))
+			})
+		})
+
+		Context("with status and date range filters", func() {
+			It("should pass the parsed list query to the service", func() {
+				mockService.EXPECT().
+					ExportOrders(ctx, domain.ListQuery{
+						Filters: []domain.Filter{
+							{Field: "created_at", Op: domain.OpGt, Values: []any{"2026-01-01"}},
+							{Field: "status", Op: domain.OpEq, Values: []any{"paid"}},
+						},
+					}, mock.Anything).
+					Return(nil)
+
+				c, w := newRequest("/api/v1/orders/export?filter[status]=paid&filter[created_at][gt]=2026-01-01", "")
+
+				handler.ExportOrders(c)
+
+				Expect(w.Code).To(Equal(http.StatusOK))
+			})
+		})
+
+		Context("when the service rejects the query", func() {
+			It("should return bad request error", func() {
+				mockService.EXPECT().
+					ExportOrders(ctx, mock.Anything, mock.Anything).
+					Return(fmt.Errorf("%w: exports are ordered by id and cannot be sorted", domain.ErrInvalidArgument))
+
+				c, w := newRequest("/api/v1/orders/export?sort=status", "")
+
+				handler.ExportOrders(c)
+
+				Expect(w.Code).To(Equal(http.StatusBadRequest))
+			})
+		})
+
+		Context("when the format is not acceptable", func() {
+			It("should return not acceptable error", func() {
+				c, w := newRequest("/api/v1/orders/export", "application/json")
+
+				handler.ExportOrders(c)
+
+				Expect(w.Code).To(Equal(http.StatusNotAcceptable))
+			})
+		})
+	})
+})
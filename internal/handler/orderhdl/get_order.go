@@ -4,27 +4,43 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+
+	"gin-swagger-api/internal/handler/etag"
+	"gin-swagger-api/internal/handler/httperr"
 )
 
 // GetOrder godoc
 // @Summary Get order by ID
-// @Description Get an order by its ID
+// @Description Get an order by its ID.
+// @Description Embed related resources with expand=user,product: the user who placed the order and the product of each item.
 // @Tags orders
 // @Accept json
 // @Produce json
 // @Param id path string true "Order ID"
+// @Param expand query string false "Comma separated relations to embed: user, product"
+// @Param include_deleted query bool false "Also return soft-deleted orders; admins only"
 // @Success 200 {object} OrderResponse
-// @Failure 404 {object} ErrorResponse
-// @Failure 500 {object} ErrorResponse
+// @Header 200 {string} ETag "Entity tag of the current order version"
+// @Failure 400 {object} httperr.Problem
+// @Failure 403 {object} httperr.Problem
+// @Failure 404 {object} httperr.Problem
+// @Failure 500 {object} httperr.Problem
 // @Router /orders/{id} [get]
 func (h *Handler) GetOrder(c *gin.Context) {
 	id := c.Param("id")
 
-	order, err := h.orderService.GetOrder(c.Request.Context(), id)
+	expand, err := parseExpand(c)
+	if err != nil {
+		httperr.Respond(c, err)
+		return
+	}
+
+	order, err := h.orderService.GetOrder(c.Request.Context(), id, expand)
 	if err != nil {
-		c.JSON(http.StatusNotFound, ErrorResponse{Error: "order not found"})
+		httperr.Respond(c, err)
 		return
 	}
 
+	etag.Set(c, order.Version())
 	c.JSON(http.StatusOK, toOrderResponse(*order))
 }
@@ -0,0 +1,40 @@
+package orderhdl
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"gin-swagger-api/internal/handler/httperr"
+)
+
+// GetOrderHistory godoc
+// @Summary Get the status history of an order
+// @Description List the status changes of an order, oldest first, with who made them and why
+// @Tags orders
+// @Accept json
+// @Produce json
+// @Param id path string true "Order ID"
+// @Param include_deleted query bool false "Also return the history of a soft-deleted order; admins only"
+// @Success 200 {array} OrderEventResponse
+// @Failure 400 {object} httperr.Problem
+// @Failure 403 {object} httperr.Problem
+// @Failure 404 {object} httperr.Problem
+// @Failure 500 {object} httperr.Problem
+// @Router /orders/{id}/history [get]
+func (h *Handler) GetOrderHistory(c *gin.Context) {
+	id := c.Param("id")
+
+	events, err := h.orderService.GetOrderHistory(c.Request.Context(), id)
+	if err != nil {
+		httperr.Respond(c, err)
+		return
+	}
+
+	response := make([]OrderEventResponse, len(events))
+	for i, event := range events {
+		response[i] = toOrderEventResponse(event)
+	}
+
+	c.JSON(http.StatusOK, response)
+}
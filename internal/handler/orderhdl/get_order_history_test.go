@@ -0,0 +1,87 @@
+package orderhdl_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"gin-swagger-api/internal/domain"
+	"gin-swagger-api/internal/handler/orderhdl"
+	mockordersvc "gin-swagger-api/mock/service/ordersvc"
+)
+
+var _ = Describe("Handler GetOrderHistory", func() {
+	var (
+		mockService *mockordersvc.MockService
+		handler     *orderhdl.Handler
+		ctx         context.Context
+		orderID     string
+		send        func() *httptest.ResponseRecorder
+	)
+
+	BeforeEach(func() {
+		gin.SetMode(gin.TestMode)
+		mockService = mockordersvc.NewMockService(GinkgoT())
+		handler = orderhdl.NewHandler(mockService)
+		ctx = context.Background()
+		orderID = "42"
+
+		send = func() *httptest.ResponseRecorder {
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest(http.MethodGet, "/api/v1/orders/"+orderID+"/history", nil)
+			c.Request = c.Request.WithContext(ctx)
+			c.Params = gin.Params{{Key: "id", Value: orderID}}
+
+			handler.GetOrderHistory(c)
+			return w
+		}
+	})
+
+	It("should list who changed the status, when and why", func() {
+		cancelledAt := time.Date(2024, 1, 2, 15, 4, 5, 0, time.UTC)
+		mockService.EXPECT().GetOrderHistory(ctx, orderID).Return([]domain.OrderEvent{
+			{ID: "1", OrderID: 42, ToStatus: "pending", Actor: "api-key:1a2b3c4d", CreatedAt: cancelledAt.Add(-time.Hour)},
+			{ID: "2", OrderID: 42, FromStatus: "pending", ToStatus: "cancelled", Actor: "api-key:5e6f7a8b", Reason: "duplicate", CreatedAt: cancelledAt},
+		}, nil)
+
+		w := send()
+
+		Expect(w.Code).To(Equal(http.StatusOK))
+
+		var response []orderhdl.OrderEventResponse
+		Expect(json.Unmarshal(w.Body.Bytes(), &response)).To(Succeed())
+		Expect(response).To(HaveLen(2))
+		Expect(response[1]).To(Equal(orderhdl.OrderEventResponse{
+			FromStatus: "pending",
+			ToStatus:   "cancelled",
+			Actor:      "api-key:5e6f7a8b",
+			Reason:     "duplicate",
+			CreatedAt:  cancelledAt,
+		}))
+	})
+
+	It("should return an empty list for an order without history", func() {
+		mockService.EXPECT().GetOrderHistory(ctx, orderID).Return([]domain.OrderEvent{}, nil)
+
+		w := send()
+
+		Expect(w.Code).To(Equal(http.StatusOK))
+		Expect(w.Body.String()).To(Equal("[]"))
+	})
+
+	It("should return not found for a missing order", func() {
+		mockService.EXPECT().GetOrderHistory(ctx, orderID).Return(nil, fmt.Errorf("order %w", domain.ErrNotFound))
+
+		w := send()
+
+		Expect(w.Code).To(Equal(http.StatusNotFound))
+	})
+})
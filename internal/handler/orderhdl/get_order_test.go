@@ -3,7 +3,7 @@ package orderhdl_test
 import (
 	"context"
 	"encoding/json"
-	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 
@@ -12,7 +12,10 @@ import (
 	. "github.com/onsi/gomega"
 
 	"gin-swagger-api/internal/domain"
+	"gin-swagger-api/internal/handler/etag"
+	"gin-swagger-api/internal/handler/httperr"
 	"gin-swagger-api/internal/handler/orderhdl"
+	"gin-swagger-api/internal/testutil"
 	mockordersvc "gin-swagger-api/mock/service/ordersvc"
 )
 
@@ -38,12 +41,11 @@ var _ = Describe("Handler GetOrder", func() {
 				order := &domain.Order{
 					ID:         orderID,
 					UserID:     1,
-					ProductID:  1,
-					Quantity:   2,
-					TotalPrice: 50000.00,
+					Items:      []domain.OrderItem{domain.NewOrderItem(1, 2, testutil.THB("25000.00"))},
+					TotalPrice: testutil.THB("50000.00"),
 					Status:     "pending",
 				}
-				mockService.EXPECT().GetOrder(ctx, orderID).Return(order, nil)
+				mockService.EXPECT().GetOrder(ctx, orderID, domain.OrderExpand{}).Return(order, nil)
 
 				w := httptest.NewRecorder()
 				c, _ := gin.CreateTestContext(w)
@@ -60,13 +62,13 @@ var _ = Describe("Handler GetOrder", func() {
 				Expect(err).ToNot(HaveOccurred())
 				Expect(response.ID).To(Equal(orderID))
 				Expect(response.UserID).To(Equal(1))
-				Expect(response.TotalPrice).To(Equal(50000.00))
+				Expect(response.TotalPrice).To(Equal("50000.00"))
 			})
 		})
 
 		Context("when order does not exist", func() {
 			It("should return not found error", func() {
-				mockService.EXPECT().GetOrder(ctx, orderID).Return(nil, errors.New("order not found"))
+				mockService.EXPECT().GetOrder(ctx, orderID, domain.OrderExpand{}).Return(nil, fmt.Errorf("order %w", domain.ErrNotFound))
 
 				w := httptest.NewRecorder()
 				c, _ := gin.CreateTestContext(w)
@@ -78,10 +80,98 @@ var _ = Describe("Handler GetOrder", func() {
 
 				Expect(w.Code).To(Equal(http.StatusNotFound))
 
-				var response orderhdl.ErrorResponse
+				var response httperr.Problem
 				err := json.Unmarshal(w.Body.Bytes(), &response)
 				Expect(err).ToNot(HaveOccurred())
-				Expect(response.Error).To(Equal("order not found"))
+				Expect(response.Detail).To(Equal("order not found"))
+			})
+		})
+
+		Context("when relations are expanded", func() {
+			It("should embed the user and the product of each item", func() {
+				item := domain.NewOrderItem(1, 2, testutil.THB("25000.00"))
+				item.Product = &domain.Product{ID: "1", Name: "Laptop", Price: testutil.THB("25000.00"), Stock: 8}
+				order := &domain.Order{
+					ID:         orderID,
+					UserID:     1,
+					Items:      []domain.OrderItem{item},
+					TotalPrice: testutil.THB("50000.00"),
+					Status:     "pending",
+					User:       &domain.User{ID: "1", Name: "John Doe", Email: "john@example.com"},
+				}
+				mockService.EXPECT().GetOrder(ctx, orderID, domain.OrderExpand{User: true, Product: true}).Return(order, nil)
+
+				w := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(w)
+				c.Request = httptest.NewRequest(http.MethodGet, "/api/v1/orders/"+orderID+"?expand=user,product", nil)
+				c.Request = c.Request.WithContext(ctx)
+				c.Params = gin.Params{{Key: "id", Value: orderID}}
+
+				handler.GetOrder(c)
+
+				Expect(w.Code).To(Equal(http.StatusOK))
+
+				var response orderhdl.OrderResponse
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(response.User).ToNot(BeNil())
+				Expect(response.User.Email).To(Equal("john@example.com"))
+				Expect(response.Items).To(HaveLen(1))
+				Expect(response.Items[0].Product).ToNot(BeNil())
+				Expect(response.Items[0].Product.Name).To(Equal("Laptop"))
+			})
+
+			It("should leave relations out when they are not expanded", func() {
+				order := &domain.Order{ID: orderID, UserID: 1, Items: []domain.OrderItem{domain.NewOrderItem(1, 2, testutil.THB("100.00"))}, TotalPrice: testutil.THB("200"), Status: "pending"}
+				mockService.EXPECT().GetOrder(ctx, orderID, domain.OrderExpand{}).Return(order, nil)
+
+				w := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(w)
+				c.Request = httptest.NewRequest(http.MethodGet, "/api/v1/orders/"+orderID, nil)
+				c.Request = c.Request.WithContext(ctx)
+				c.Params = gin.Params{{Key: "id", Value: orderID}}
+
+				handler.GetOrder(c)
+
+				Expect(w.Code).To(Equal(http.StatusOK))
+				Expect(w.Body.String()).ToNot(ContainSubstring(`"user":`))
+				Expect(w.Body.String()).ToNot(ContainSubstring(`"product":`))
+			})
+
+			It("should return bad request for an unknown relation", func() {
+				w := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(w)
+				c.Request = httptest.NewRequest(http.MethodGet, "/api/v1/orders/"+orderID+"?expand=payments", nil)
+				c.Request = c.Request.WithContext(ctx)
+				c.Params = gin.Params{{Key: "id", Value: orderID}}
+
+				handler.GetOrder(c)
+
+				Expect(w.Code).To(Equal(http.StatusBadRequest))
+
+				var response httperr.Problem
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(response.Type).To(Equal("/problems/invalid-argument"))
+				Expect(response.Detail).To(ContainSubstring(`"payments"`))
+			})
+		})
+
+		Context("when the order is returned", func() {
+			It("should send its version as a strong entity tag", func() {
+				order := &domain.Order{ID: orderID, UserID: 1, Items: []domain.OrderItem{domain.NewOrderItem(1, 2, testutil.THB("100.00"))}, TotalPrice: testutil.THB("200"), Status: "pending"}
+				mockService.EXPECT().GetOrder(ctx, orderID, domain.OrderExpand{}).Return(order, nil)
+
+				w := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(w)
+				c.Request = httptest.NewRequest(http.MethodGet, "/api/v1/orders/"+orderID, nil)
+				c.Request = c.Request.WithContext(ctx)
+				c.Params = gin.Params{{Key: "id", Value: orderID}}
+
+				handler.GetOrder(c)
+
+				Expect(w.Code).To(Equal(http.StatusOK))
+				Expect(w.Header().Get(etag.Header)).To(Equal(`"` + order.Version() + `"`))
 			})
 		})
 	})
@@ -4,28 +4,57 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+
+	"gin-swagger-api/internal/handler/httperr"
+	"gin-swagger-api/internal/handler/listquery"
+	"gin-swagger-api/internal/handler/pagination"
 )
 
 // GetOrders godoc
 // @Summary List all orders
-// @Description Get a list of all orders
+// @Description Get a list of all orders, paginated by offset or cursor.
+// @Description Filter with filter[field]=value or filter[field][op]=value where op is one of eq, ne, in (comma separated values), gt and lt (numeric fields) or like (text fields, case-insensitive substring).
+// @Description Filterable and sortable fields: id, user_id, total_price (in THB, compared with the total of each order converted at its rate), currency, status, created_at (a date or RFC 3339 time).
+// @Description Example: ?filter[status]=pending&filter[total_price][gt]=100&sort=-total_price
+// @Description Embed related resources with expand=user,product; each relation is loaded with one query for the whole page.
 // @Tags orders
 // @Accept json
 // @Produce json
-// @Success 200 {array} OrderResponse
-// @Failure 500 {object} ErrorResponse
+// @Param limit query int false "Page size (default 20, max 100)"
+// @Param offset query int false "Number of items to skip"
+// @Param cursor query string false "Opaque cursor from meta.next_cursor or meta.prev_cursor; overrides offset and cannot be combined with sort"
+// @Param sort query string false "Comma separated sort fields, prefixed with - for descending order"
+// @Param expand query string false "Comma separated relations to embed: user, product"
+// @Param include_deleted query bool false "Also return soft-deleted orders; admins only"
+// @Success 200 {object} pagination.Response[orderhdl.OrderResponse]
+// @Failure 400 {object} httperr.Problem
+// @Failure 403 {object} httperr.Problem
+// @Failure 500 {object} httperr.Problem
 // @Router /orders [get]
 func (h *Handler) GetOrders(c *gin.Context) {
-	orders, err := h.orderService.GetOrders(c.Request.Context())
+	var query pagination.Query
+	if err := c.ShouldBindQuery(&query); err != nil {
+		httperr.BadRequest(c, err)
+		return
+	}
+
+	list, err := listquery.Parse(c.Request.URL.Query())
+	if err != nil {
+		httperr.Respond(c, err)
+		return
+	}
+
+	expand, err := parseExpand(c)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, ErrorResponse{Error: err.Error()})
+		httperr.Respond(c, err)
 		return
 	}
 
-	response := make([]OrderResponse, len(orders))
-	for i, order := range orders {
-		response[i] = toOrderResponse(order)
+	page, err := h.orderService.GetOrders(c.Request.Context(), list, query.PageRequest(), expand)
+	if err != nil {
+		httperr.Respond(c, err)
+		return
 	}
 
-	c.JSON(http.StatusOK, response)
+	c.JSON(http.StatusOK, pagination.NewResponse(c, page, toOrderResponse))
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 
@@ -12,7 +13,10 @@ import (
 	. "github.com/onsi/gomega"
 
 	"gin-swagger-api/internal/domain"
+	"gin-swagger-api/internal/handler/httperr"
 	"gin-swagger-api/internal/handler/orderhdl"
+	"gin-swagger-api/internal/handler/pagination"
+	"gin-swagger-api/internal/testutil"
 	mockordersvc "gin-swagger-api/mock/service/ordersvc"
 )
 
@@ -37,21 +41,21 @@ var _ = Describe("Handler GetOrders", func() {
 					{
 						ID:         "1",
 						UserID:     1,
-						ProductID:  1,
-						Quantity:   2,
-						TotalPrice: 50000.00,
+						Items:      []domain.OrderItem{domain.NewOrderItem(1, 2, testutil.THB("25000.00"))},
+						TotalPrice: testutil.THB("50000.00"),
 						Status:     "pending",
 					},
 					{
 						ID:         "2",
 						UserID:     2,
-						ProductID:  2,
-						Quantity:   1,
-						TotalPrice: 599.99,
+						Items:      []domain.OrderItem{domain.NewOrderItem(2, 1, testutil.THB("599.99"))},
+						TotalPrice: testutil.THB("599.99"),
 						Status:     "completed",
 					},
 				}
-				mockService.EXPECT().GetOrders(ctx).Return(orders, nil)
+				mockService.EXPECT().
+					GetOrders(ctx, domain.ListQuery{}, domain.PageRequest{}, domain.OrderExpand{}).
+					Return(&domain.Page[domain.Order]{Items: orders, Total: 2, Limit: domain.DefaultPageSize}, nil)
 
 				w := httptest.NewRecorder()
 				c, _ := gin.CreateTestContext(w)
@@ -62,20 +66,149 @@ var _ = Describe("Handler GetOrders", func() {
 
 				Expect(w.Code).To(Equal(http.StatusOK))
 
-				var response []orderhdl.OrderResponse
+				var response pagination.Response[orderhdl.OrderResponse]
 				err := json.Unmarshal(w.Body.Bytes(), &response)
 				Expect(err).ToNot(HaveOccurred())
-				Expect(response).To(HaveLen(2))
-				Expect(response[0].ID).To(Equal("1"))
-				Expect(response[0].Status).To(Equal("pending"))
-				Expect(response[1].ID).To(Equal("2"))
-				Expect(response[1].Status).To(Equal("completed"))
+				Expect(response.Data).To(HaveLen(2))
+				Expect(response.Meta.Total).To(Equal(2))
+				Expect(response.Meta.Limit).To(Equal(domain.DefaultPageSize))
+				Expect(response.Links.Self).To(Equal("/api/v1/orders"))
+				Expect(response.Data[0].ID).To(Equal("1"))
+				Expect(response.Data[0].Status).To(Equal("pending"))
+				Expect(response.Data[1].ID).To(Equal("2"))
+				Expect(response.Data[1].Status).To(Equal("completed"))
+			})
+		})
+
+		Context("when paging parameters are given", func() {
+			It("should pass them to the service", func() {
+				mockService.EXPECT().
+					GetOrders(ctx, domain.ListQuery{}, domain.PageRequest{Limit: 1, Offset: 1}, domain.OrderExpand{}).
+					Return(&domain.Page[domain.Order]{Items: []domain.Order{}, Total: 3, Limit: 1, Offset: 1}, nil)
+
+				w := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(w)
+				c.Request = httptest.NewRequest(http.MethodGet, "/api/v1/orders?limit=1&offset=1", nil)
+				c.Request = c.Request.WithContext(ctx)
+
+				handler.GetOrders(c)
+
+				Expect(w.Code).To(Equal(http.StatusOK))
+
+				var response pagination.Response[orderhdl.OrderResponse]
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(response.Links.Next).To(Equal("/api/v1/orders?limit=1&offset=2"))
+				Expect(response.Links.Prev).To(Equal("/api/v1/orders?limit=1&offset=0"))
+			})
+
+			It("should return bad request for an invalid limit", func() {
+				w := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(w)
+				c.Request = httptest.NewRequest(http.MethodGet, "/api/v1/orders?limit=-1", nil)
+				c.Request = c.Request.WithContext(ctx)
+
+				handler.GetOrders(c)
+
+				Expect(w.Code).To(Equal(http.StatusBadRequest))
+
+				var response httperr.Problem
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(response.Errors).To(ContainElement(httperr.FieldError{Field: "limit", Message: "must be at least 1"}))
+			})
+		})
+
+		Context("when relations are expanded", func() {
+			It("should pass every requested relation to the service", func() {
+				mockService.EXPECT().
+					GetOrders(ctx, domain.ListQuery{}, domain.PageRequest{}, domain.OrderExpand{User: true, Product: true}).
+					Return(&domain.Page[domain.Order]{Items: []domain.Order{}, Limit: domain.DefaultPageSize}, nil)
+
+				w := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(w)
+				c.Request = httptest.NewRequest(http.MethodGet, "/api/v1/orders?expand=user&expand=product", nil)
+				c.Request = c.Request.WithContext(ctx)
+
+				handler.GetOrders(c)
+
+				Expect(w.Code).To(Equal(http.StatusOK))
+			})
+
+			It("should return bad request for an unknown relation", func() {
+				w := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(w)
+				c.Request = httptest.NewRequest(http.MethodGet, "/api/v1/orders?expand=user,items", nil)
+				c.Request = c.Request.WithContext(ctx)
+
+				handler.GetOrders(c)
+
+				Expect(w.Code).To(Equal(http.StatusBadRequest))
+			})
+		})
+
+		Context("when filter parameters are given", func() {
+			It("should pass the parsed list query to the service", func() {
+				mockService.EXPECT().
+					GetOrders(ctx, domain.ListQuery{
+						Filters: []domain.Filter{
+							{Field: "status", Op: domain.OpIn, Values: []any{"pending", "paid"}},
+							{Field: "user_id", Op: domain.OpEq, Values: []any{"5"}},
+						},
+						Sort: []domain.Sort{{Field: "total_price", Desc: true}},
+					}, domain.PageRequest{}, domain.OrderExpand{}).
+					Return(&domain.Page[domain.Order]{Items: []domain.Order{}, Total: 1, Limit: domain.DefaultPageSize}, nil)
+
+				w := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(w)
+				c.Request = httptest.NewRequest(http.MethodGet, "/api/v1/orders?filter[user_id]=5&filter[status][in]=pending,paid&sort=-total_price", nil)
+				c.Request = c.Request.WithContext(ctx)
+
+				handler.GetOrders(c)
+
+				Expect(w.Code).To(Equal(http.StatusOK))
+			})
+
+			It("should return bad request for a malformed filter", func() {
+				w := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(w)
+				c.Request = httptest.NewRequest(http.MethodGet, "/api/v1/orders?filter[id][between]=1", nil)
+				c.Request = c.Request.WithContext(ctx)
+
+				handler.GetOrders(c)
+
+				Expect(w.Code).To(Equal(http.StatusBadRequest))
+
+				var response httperr.Problem
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(response.Type).To(Equal("/problems/invalid-argument"))
+			})
+
+			It("should return bad request when the service rejects a field", func() {
+				mockService.EXPECT().
+					GetOrders(ctx, domain.ListQuery{Sort: []domain.Sort{{Field: "secret"}}}, domain.PageRequest{}, domain.OrderExpand{}).
+					Return(nil, fmt.Errorf("%w: unknown sort field %q", domain.ErrInvalidArgument, "secret"))
+
+				w := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(w)
+				c.Request = httptest.NewRequest(http.MethodGet, "/api/v1/orders?sort=secret", nil)
+				c.Request = c.Request.WithContext(ctx)
+
+				handler.GetOrders(c)
+
+				Expect(w.Code).To(Equal(http.StatusBadRequest))
+
+				var response httperr.Problem
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(response.Detail).To(ContainSubstring(`unknown sort field "secret"`))
 			})
 		})
 
 		Context("when service returns error", func() {
 			It("should return internal server error", func() {
-				mockService.EXPECT().GetOrders(ctx).Return(nil, errors.New("service error"))
+				mockService.EXPECT().GetOrders(ctx, domain.ListQuery{}, domain.PageRequest{}, domain.OrderExpand{}).Return(nil, errors.New("service error"))
 
 				w := httptest.NewRecorder()
 				c, _ := gin.CreateTestContext(w)
@@ -86,10 +219,10 @@ var _ = Describe("Handler GetOrders", func() {
 
 				Expect(w.Code).To(Equal(http.StatusInternalServerError))
 
-				var response orderhdl.ErrorResponse
+				var response httperr.Problem
 				err := json.Unmarshal(w.Body.Bytes(), &response)
 				Expect(err).ToNot(HaveOccurred())
-				Expect(response.Error).To(Equal("service error"))
+				Expect(response.Detail).To(Equal("Internal Server Error"))
 			})
 		})
 	})
@@ -26,9 +26,19 @@ func (h *Handler) RegisterRoutes(rg *gin.RouterGroup) {
 	orders.Use(middleware.Auth())       // Apply auth to all order routes (example)
 	{
 		orders.POST("", h.CreateOrder)
+		orders.POST("/batch", h.BatchOrders)
+		orders.GET("/export", h.ExportOrders)
 		orders.GET("/:id", h.GetOrder)
-		orders.PUT("/:id", h.UpdateOrder)
-		orders.DELETE("/:id", h.DeleteOrder)
+		orders.GET("/:id/history", h.GetOrderHistory)
+		orders.PUT("/:id", middleware.RequireIfMatch(), h.UpdateOrder)
+		orders.PATCH("/:id", middleware.RequireIfMatch(), h.PatchOrder)
+		orders.DELETE("/:id", middleware.RequireIfMatch(), h.DeleteOrder)
+		orders.POST("/:id/restore", middleware.RequireAdmin(), h.RestoreOrder)
+		orders.POST("/:id/purge", middleware.RequireAdmin(), h.PurgeOrder)
+		orders.POST("/:id/pay", middleware.RequireIfMatch(), h.PayOrder)
+		orders.POST("/:id/ship", middleware.RequireIfMatch(), h.ShipOrder)
+		orders.POST("/:id/deliver", middleware.RequireIfMatch(), h.DeliverOrder)
+		orders.POST("/:id/cancel", middleware.RequireIfMatch(), h.CancelOrder)
 		orders.GET("", h.GetOrders)
 	}
 }
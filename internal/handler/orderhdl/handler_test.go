@@ -11,6 +11,7 @@ import (
 	"github.com/gin-gonic/gin"
 	"gin-swagger-api/internal/domain"
 	"gin-swagger-api/internal/handler/orderhdl"
+	"gin-swagger-api/internal/middleware"
 	mockordersvc "gin-swagger-api/mock/service/ordersvc"
 )
 
@@ -62,6 +63,38 @@ var _ = Describe("OrderHandler RegisterRoutes", func() {
 				}
 			}
 			Expect(found).To(BeTrue(), "Route GET /api/v1/orders should be registered")
+
+			// Verify GET /orders/export route exists
+			found = false
+			for _, route := range routes {
+				if route.Method == "GET" && route.Path == "/api/v1/orders/export" {
+					found = true
+					break
+				}
+			}
+			Expect(found).To(BeTrue(), "Route GET /api/v1/orders/export should be registered")
+
+			// Verify POST /orders/batch route exists
+			found = false
+			for _, route := range routes {
+				if route.Method == "POST" && route.Path == "/api/v1/orders/batch" {
+					found = true
+					break
+				}
+			}
+			Expect(found).To(BeTrue(), "Route POST /api/v1/orders/batch should be registered")
+
+			// Verify the admin restore and purge routes exist
+			for _, path := range []string{"/api/v1/orders/:id/restore", "/api/v1/orders/:id/purge"} {
+				found = false
+				for _, route := range routes {
+					if route.Method == "POST" && route.Path == path {
+						found = true
+						break
+					}
+				}
+				Expect(found).To(BeTrue(), "Route POST %s should be registered", path)
+			}
 		})
 
 		It("should apply routes under correct group prefix", func() {
@@ -92,8 +125,8 @@ var _ = Describe("OrderHandler RegisterRoutes", func() {
 
 			// Mock the service call that will happen after auth passes
 			mockService.EXPECT().
-				GetOrders(mock.Anything).
-				Return([]domain.Order{}, nil).
+				GetOrders(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+				Return(&domain.Page[domain.Order]{}, nil).
 				Once()
 
 			// Test with API key - should not get 401
@@ -104,5 +137,28 @@ var _ = Describe("OrderHandler RegisterRoutes", func() {
 
 			Expect(w.Code).NotTo(Equal(http.StatusUnauthorized))
 		})
+
+		It("should require If-Match on every versioned write when preconditions are enforced", func() {
+			router.Use(middleware.Preconditions(true))
+			v1 := router.Group("/api/v1")
+			handler.RegisterRoutes(v1)
+
+			for _, route := range [][2]string{
+				{http.MethodPut, "/api/v1/orders/1"},
+				{http.MethodPatch, "/api/v1/orders/1"},
+				{http.MethodDelete, "/api/v1/orders/1"},
+				{http.MethodPost, "/api/v1/orders/1/pay"},
+				{http.MethodPost, "/api/v1/orders/1/ship"},
+				{http.MethodPost, "/api/v1/orders/1/deliver"},
+				{http.MethodPost, "/api/v1/orders/1/cancel"},
+			} {
+				w := httptest.NewRecorder()
+				req := httptest.NewRequest(route[0], route[1], nil)
+				req.Header.Set("X-API-Key", "test-key")
+				router.ServeHTTP(w, req)
+
+				Expect(w.Code).To(Equal(http.StatusPreconditionRequired), "%s %s", route[0], route[1])
+			}
+		})
 	})
 })
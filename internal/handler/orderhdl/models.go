@@ -1,48 +1,226 @@
 package orderhdl
 
-import "gin-swagger-api/internal/domain"
+import (
+	"slices"
+	"time"
 
-// OrderResponse represents the API response for an order
+	"gin-swagger-api/internal/domain"
+	"gin-swagger-api/internal/handler/patch"
+	"gin-swagger-api/internal/handler/producthdl"
+	"gin-swagger-api/internal/handler/userhdl"
+)
+
+// OrderResponse represents the API response for an order. Orders with a
+// single item also report it in product_id, quantity and unit_price, as
+// before orders had several items. Amounts are decimal strings in the
+// currency given, converted from the product prices at exchange_rate
+// units per unit of the base currency when the order was placed. Orders
+// placed with a coupon report its code and the discount taken off the
+// total. User is only embedded when expanded. DeletedAt is only set on
+// soft-deleted orders.
 type OrderResponse struct {
-	ID         string  `json:"id" example:"1"`
-	UserID     int     `json:"user_id" example:"1"`
-	ProductID  int     `json:"product_id" example:"1"`
-	Quantity   int     `json:"quantity" example:"2"`
-	TotalPrice float64 `json:"total_price" example:"50000.00"`
-	Status     string  `json:"status" example:"pending"`
+	ID           string                `json:"id" example:"1"`
+	UserID       int                   `json:"user_id" example:"1"`
+	User         *userhdl.UserResponse `json:"user,omitempty"`
+	Items        []OrderItemResponse   `json:"items"`
+	ProductID    int                   `json:"product_id,omitempty" example:"1"`
+	Quantity     int                   `json:"quantity,omitempty" example:"2"`
+	UnitPrice    string                `json:"unit_price,omitempty" example:"25000.00"`
+	TotalPrice   string                `json:"total_price" example:"50000.00"`
+	Currency     string                `json:"currency" example:"THB"`
+	ExchangeRate string                `json:"exchange_rate" example:"1"`
+	CouponCode   string                `json:"coupon_code,omitempty" example:"SAVE10"`
+	Discount     string                `json:"discount,omitempty" example:"5000.00"`
+	Status       string                `json:"status" enums:"pending,paid,shipped,delivered,cancelled" example:"pending"`
+	CreatedAt    time.Time             `json:"created_at" example:"2024-01-02T15:04:05Z"`
+	DeletedAt    *time.Time            `json:"deleted_at,omitempty" example:"2026-01-15T10:00:00Z"`
+}
+
+// OrderItemResponse represents a line of an order. Product is only
+// embedded when expanded.
+type OrderItemResponse struct {
+	ProductID int                         `json:"product_id" example:"1"`
+	Product   *producthdl.ProductResponse `json:"product,omitempty"`
+	Quantity  int                         `json:"quantity" example:"2"`
+	UnitPrice string                      `json:"unit_price" example:"25000.00"`
+	LineTotal string                      `json:"line_total" example:"50000.00"`
+}
+
+// OrderItemRequest represents a line of an order in a request
+type OrderItemRequest struct {
+	ProductID int `json:"product_id" binding:"required" example:"1"`
+	Quantity  int `json:"quantity" binding:"required,gt=0" example:"2"`
 }
 
-// CreateOrderRequest represents the request body for creating an order
+// CreateOrderRequest represents the request body for creating an order.
+// Send either items or, for a single product, product_id and quantity.
+// Items are priced from the products in currency, the base currency when
+// it is empty; a client total_price is ignored. A coupon_code takes its
+// discount off the total. New orders are always pending.
 type CreateOrderRequest struct {
-	UserID     int     `json:"user_id" binding:"required" example:"1"`
-	ProductID  int     `json:"product_id" binding:"required" example:"1"`
-	Quantity   int     `json:"quantity" binding:"required,gt=0" example:"2"`
-	TotalPrice float64 `json:"total_price" binding:"required,gt=0" example:"50000.00"`
-	Status     string  `json:"status" example:"pending"`
+	UserID     int                `json:"user_id" binding:"required" example:"1"`
+	Items      []OrderItemRequest `json:"items" binding:"required_without=ProductID,excluded_with=ProductID,omitempty,min=1,max=100,dive"`
+	ProductID  int                `json:"product_id" binding:"required_without=Items,excluded_with=Items" example:"1"`
+	Quantity   int                `json:"quantity" binding:"required_with=ProductID,excluded_with=Items,omitempty,gt=0" example:"2"`
+	Currency   string             `json:"currency" enums:"THB,USD,EUR" example:"USD"`
+	CouponCode string             `json:"coupon_code" example:"SAVE10"`
+	Status     string             `json:"status" binding:"omitempty,oneof=pending" enums:"pending" example:"pending"`
 }
 
-// UpdateOrderRequest represents the request body for updating an order
+// UpdateOrderRequest represents the request body for updating an order.
+// Send either items, which replace the items of the order, or for a single
+// product a quantity and product_id; product_id can be left out when the
+// order has a single item. Products already on the order keep the unit
+// price they were placed at; a client total_price is ignored. A status
+// change must follow the order lifecycle; an empty status keeps the
+// current one.
 type UpdateOrderRequest struct {
-	UserID     int     `json:"user_id" example:"1"`
-	ProductID  int     `json:"product_id" example:"1"`
-	Quantity   int     `json:"quantity" example:"2"`
-	TotalPrice float64 `json:"total_price" example:"50000.00"`
-	Status     string  `json:"status" example:"completed"`
+	Items     []OrderItemRequest `json:"items" binding:"required_without=Quantity,excluded_with=Quantity,omitempty,min=1,max=100,dive"`
+	ProductID int                `json:"product_id" binding:"excluded_with=Items" example:"1"`
+	Quantity  int                `json:"quantity" binding:"required_without=Items,excluded_with=Items,omitempty,gt=0" example:"2"`
+	Status    string             `json:"status" enums:"pending,paid,shipped,delivered,cancelled" example:"paid"`
+}
+
+// BatchUpdateOrderRequest represents an order to update in a batch, with
+// the fields of UpdateOrderRequest. A version, the order's ETag without
+// quotes, must match the current version.
+type BatchUpdateOrderRequest struct {
+	ID        string             `json:"id" binding:"required" example:"1"`
+	Version   string             `json:"version" example:"5d41402abc4b2a76"`
+	Items     []OrderItemRequest `json:"items" binding:"required_without=Quantity,excluded_with=Quantity,omitempty,min=1,max=100,dive"`
+	ProductID int                `json:"product_id" binding:"excluded_with=Items" example:"1"`
+	Quantity  int                `json:"quantity" binding:"required_without=Items,excluded_with=Items,omitempty,gt=0" example:"2"`
+	Status    string             `json:"status" enums:"pending,paid,shipped,delivered,cancelled" example:"paid"`
+}
+
+// PatchOrderRequest is the order document a PATCH request is applied to.
+// Quantity is only present for single-item orders and changes that item.
+type PatchOrderRequest struct {
+	Items    []OrderItemRequest `json:"items" binding:"min=1,max=100,dive"`
+	Quantity *int               `json:"quantity,omitempty" binding:"omitempty,gt=0" example:"2"`
+	Status   string             `json:"status" enums:"pending,paid,shipped,delivered,cancelled" example:"paid"`
+}
+
+// TransitionOrderRequest represents the optional request body of a status
+// transition
+type TransitionOrderRequest struct {
+	Reason string `json:"reason" binding:"max=500" example:"customer changed their mind"`
+}
+
+// OrderEventResponse represents a status change in the order history
+type OrderEventResponse struct {
+	FromStatus string    `json:"from_status,omitempty" enums:"pending,paid,shipped,delivered,cancelled" example:"paid"`
+	ToStatus   string    `json:"to_status" enums:"pending,paid,shipped,delivered,cancelled" example:"cancelled"`
+	Actor      string    `json:"actor,omitempty" example:"api-key:1a2b3c4d"`
+	Reason     string    `json:"reason,omitempty" example:"customer changed their mind"`
+	CreatedAt  time.Time `json:"created_at" example:"2024-01-02T15:04:05Z"`
+}
+
+// orderItems converts the request lines, or the single product_id and
+// quantity when no lines are sent, to order items to price
+func orderItems(lines []OrderItemRequest, productID, quantity int) []domain.OrderItem {
+	if len(lines) == 0 {
+		return []domain.OrderItem{{ProductID: productID, Quantity: quantity}}
+	}
+
+	items := make([]domain.OrderItem, len(lines))
+	for i, line := range lines {
+		items[i] = domain.OrderItem{ProductID: line.ProductID, Quantity: line.Quantity}
+	}
+	return items
+}
+
+// toPatchOrderRequest converts domain.Order to the document PATCH applies to
+func toPatchOrderRequest(order domain.Order) PatchOrderRequest {
+	req := PatchOrderRequest{
+		Items:  make([]OrderItemRequest, len(order.Items)),
+		Status: order.Status,
+	}
+	for i, item := range order.Items {
+		req.Items[i] = OrderItemRequest{ProductID: item.ProductID, Quantity: item.Quantity}
+	}
+	if len(order.Items) == 1 {
+		quantity := order.Items[0].Quantity
+		req.Quantity = &quantity
+	}
+	return req
 }
 
-// ErrorResponse represents an error response
-type ErrorResponse struct {
-	Error string `json:"error" example:"error message"`
+// toOrderPatch returns the fields of req that differ from original. A
+// changed quantity applies to the only item of the order.
+func (req PatchOrderRequest) toOrderPatch(original PatchOrderRequest) domain.OrderPatch {
+	orderPatch := domain.OrderPatch{
+		Status: patch.Changed(original.Status, req.Status),
+	}
+	switch {
+	case !slices.Equal(original.Items, req.Items):
+		orderPatch.Items = orderItems(req.Items, 0, 0)
+	case req.Quantity != nil && (original.Quantity == nil || *original.Quantity != *req.Quantity):
+		orderPatch.Items = orderItems(nil, 0, *req.Quantity)
+	}
+	return orderPatch
 }
 
 // toOrderResponse converts domain.Order to OrderResponse
 func toOrderResponse(order domain.Order) OrderResponse {
-	return OrderResponse{
-		ID:         order.ID,
-		UserID:     order.UserID,
-		ProductID:  order.ProductID,
-		Quantity:   order.Quantity,
-		TotalPrice: order.TotalPrice,
-		Status:     order.Status,
+	pricing := order.Pricing()
+	resp := OrderResponse{
+		ID:           order.ID,
+		UserID:       order.UserID,
+		Items:        make([]OrderItemResponse, len(order.Items)),
+		TotalPrice:   order.TotalPrice.String(),
+		Currency:     pricing.Currency,
+		ExchangeRate: string(pricing.Rate),
+		Status:       order.Status,
+		CreatedAt:    order.CreatedAt,
+		DeletedAt:    order.DeletedAt,
+	}
+	if order.CouponCode != "" {
+		resp.CouponCode = order.CouponCode
+		resp.Discount = order.Discount.String()
+	}
+	if order.User != nil {
+		resp.User = &userhdl.UserResponse{
+			ID:        order.User.ID,
+			Name:      order.User.Name,
+			Email:     order.User.Email,
+			DeletedAt: order.User.DeletedAt,
+		}
+	}
+	for i, item := range order.Items {
+		resp.Items[i] = OrderItemResponse{
+			ProductID: item.ProductID,
+			Quantity:  item.Quantity,
+			UnitPrice: item.UnitPrice.String(),
+			LineTotal: item.LineTotal.String(),
+		}
+		if item.Product != nil {
+			resp.Items[i].Product = &producthdl.ProductResponse{
+				ID:          item.Product.ID,
+				Name:        item.Product.Name,
+				Description: item.Product.Description,
+				Price:       item.Product.Price.String(),
+				Currency:    item.Product.Price.Currency,
+				Stock:       item.Product.Stock,
+				DeletedAt:   item.Product.DeletedAt,
+			}
+		}
+	}
+	if len(order.Items) == 1 {
+		resp.ProductID = order.Items[0].ProductID
+		resp.Quantity = order.Items[0].Quantity
+		resp.UnitPrice = order.Items[0].UnitPrice.String()
+	}
+	return resp
+}
+
+// toOrderEventResponse converts domain.OrderEvent to OrderEventResponse
+func toOrderEventResponse(event domain.OrderEvent) OrderEventResponse {
+	return OrderEventResponse{
+		FromStatus: event.FromStatus,
+		ToStatus:   event.ToStatus,
+		Actor:      event.Actor,
+		Reason:     event.Reason,
+		CreatedAt:  event.CreatedAt,
 	}
 }
@@ -0,0 +1,82 @@
+package orderhdl
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+
+	"gin-swagger-api/internal/domain"
+	"gin-swagger-api/internal/handler/etag"
+	"gin-swagger-api/internal/handler/httperr"
+	"gin-swagger-api/internal/handler/patch"
+)
+
+// PatchOrder godoc
+// @Summary Partially update an order
+// @Description Update only the supplied fields of an order.
+// @Description Send a JSON Merge Patch object (application/merge-patch+json or application/json) or a JSON Patch array (application/json-patch+json).
+// @Description Fields cannot be removed or set to null.
+// @Description Patched items replace the items of the order; quantity is only present for single-item orders and changes that item.
+// @Description Products already on the order keep the unit price they were placed at; added products are priced at their current price.
+// @Description Stock follows quantity changes and is returned when the status becomes cancelled; 409 when not enough is in stock.
+// @Description Items can only change while the order is pending or paid; 409 otherwise.
+// @Description The status must follow the order lifecycle (pending → paid → shipped → delivered, cancel while pending or paid); 409 otherwise.
+// @Tags orders
+// @Accept json,application/merge-patch+json,application/json-patch+json
+// @Produce json
+// @Param id path string true "Order ID"
+// @Param If-Match header string false "ETag the order must still have; required when the server enforces preconditions"
+// @Param patch body PatchOrderRequest true "Fields to change"
+// @Success 200 {object} OrderResponse
+// @Header 200 {string} ETag "Entity tag of the current order version"
+// @Failure 400 {object} httperr.Problem
+// @Failure 404 {object} httperr.Problem
+// @Failure 409 {object} httperr.Problem
+// @Failure 412 {object} httperr.Problem
+// @Failure 415 {object} httperr.Problem
+// @Failure 422 {object} httperr.Problem
+// @Failure 428 {object} httperr.Problem
+// @Failure 500 {object} httperr.Problem
+// @Router /orders/{id} [patch]
+func (h *Handler) PatchOrder(c *gin.Context) {
+	id := c.Param("id")
+
+	body, err := c.GetRawData()
+	if err != nil {
+		httperr.BadRequest(c, err)
+		return
+	}
+
+	current, err := h.orderService.GetOrder(c.Request.Context(), id, domain.OrderExpand{})
+	if err != nil {
+		httperr.Respond(c, err)
+		return
+	}
+
+	version, err := etag.Check(c, current.Version())
+	if err != nil {
+		httperr.Respond(c, err)
+		return
+	}
+
+	original := toPatchOrderRequest(*current)
+	var req PatchOrderRequest
+	if err := patch.Apply(c.ContentType(), body, original, &req); err != nil {
+		httperr.Respond(c, err)
+		return
+	}
+	if err := binding.Validator.ValidateStruct(&req); err != nil {
+		httperr.BadRequest(c, err)
+		return
+	}
+
+	order, err := h.orderService.PatchOrder(c.Request.Context(), id, version, req.toOrderPatch(original))
+	if err != nil {
+		httperr.Respond(c, err)
+		return
+	}
+
+	etag.Set(c, order.Version())
+	c.JSON(http.StatusOK, toOrderResponse(*order))
+}
@@ -0,0 +1,206 @@
+package orderhdl_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/gin-gonic/gin"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"gin-swagger-api/internal/domain"
+	"gin-swagger-api/internal/handler/etag"
+	"gin-swagger-api/internal/handler/httperr"
+	"gin-swagger-api/internal/handler/orderhdl"
+	"gin-swagger-api/internal/handler/patch"
+	"gin-swagger-api/internal/testutil"
+	mockordersvc "gin-swagger-api/mock/service/ordersvc"
+)
+
+var _ = Describe("Handler PatchOrder", func() {
+	var (
+		mockService *mockordersvc.MockService
+		handler     *orderhdl.Handler
+		ctx         context.Context
+		orderID     string
+		current     *domain.Order
+		sendPatch   func(contentType, body string) *httptest.ResponseRecorder
+	)
+
+	BeforeEach(func() {
+		gin.SetMode(gin.TestMode)
+		mockService = mockordersvc.NewMockService(GinkgoT())
+		handler = orderhdl.NewHandler(mockService)
+		ctx = context.Background()
+		orderID = "1"
+		current = &domain.Order{ID: orderID, UserID: 1, Items: []domain.OrderItem{domain.NewOrderItem(2, 2, testutil.THB("100.00"))}, TotalPrice: testutil.THB("200.0"), Status: "pending"}
+
+		sendPatch = func(contentType, body string) *httptest.ResponseRecorder {
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest(http.MethodPatch, "/api/v1/orders/"+orderID, bytes.NewBufferString(body))
+			c.Request.Header.Set("Content-Type", contentType)
+			c.Request = c.Request.WithContext(ctx)
+			c.Params = gin.Params{{Key: "id", Value: orderID}}
+
+			handler.PatchOrder(c)
+			return w
+		}
+	})
+
+	Describe("PatchOrder", func() {
+		Context("when sending a merge patch", func() {
+			It("should update only the supplied fields", func() {
+				status := "completed"
+				patched := *current
+				patched.Status = status
+				mockService.EXPECT().GetOrder(ctx, orderID, domain.OrderExpand{}).Return(current, nil)
+				mockService.EXPECT().PatchOrder(ctx, orderID, "", domain.OrderPatch{Status: &status}).Return(&patched, nil)
+
+				w := sendPatch(patch.MergePatchContentType, `{"status": "completed"}`)
+
+				Expect(w.Code).To(Equal(http.StatusOK))
+
+				var response orderhdl.OrderResponse
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(response.Status).To(Equal("completed"))
+				Expect(response.Quantity).To(Equal(2))
+				Expect(response.TotalPrice).To(Equal("200.00"))
+			})
+		})
+
+		Context("when sending a JSON patch", func() {
+			It("should apply the operations", func() {
+				mockService.EXPECT().GetOrder(ctx, orderID, domain.OrderExpand{}).Return(current, nil)
+				mockService.EXPECT().PatchOrder(ctx, orderID, "", domain.OrderPatch{Items: []domain.OrderItem{{Quantity: 3}}}).Return(current, nil)
+
+				w := sendPatch(patch.JSONPatchContentType, `[
+					{"op": "test", "path": "/status", "value": "pending"},
+					{"op": "replace", "path": "/quantity", "value": 3}
+				]`)
+
+				Expect(w.Code).To(Equal(http.StatusOK))
+			})
+		})
+
+		Context("when the patch changes the items", func() {
+			It("should pass all items of the patched order", func() {
+				mockService.EXPECT().GetOrder(ctx, orderID, domain.OrderExpand{}).Return(current, nil)
+				mockService.EXPECT().PatchOrder(ctx, orderID, "", domain.OrderPatch{Items: []domain.OrderItem{
+					{ProductID: 2, Quantity: 3},
+					{ProductID: 5, Quantity: 1},
+				}}).Return(current, nil)
+
+				w := sendPatch(patch.JSONPatchContentType, `[
+					{"op": "replace", "path": "/items/0/quantity", "value": 3},
+					{"op": "add", "path": "/items/-", "value": {"product_id": 5, "quantity": 1}}
+				]`)
+
+				Expect(w.Code).To(Equal(http.StatusOK))
+			})
+
+			It("should name the field of an invalid item", func() {
+				mockService.EXPECT().GetOrder(ctx, orderID, domain.OrderExpand{}).Return(current, nil)
+
+				w := sendPatch(patch.MergePatchContentType, `{"items": [{"product_id": 2, "quantity": -1}]}`)
+
+				Expect(w.Code).To(Equal(http.StatusBadRequest))
+
+				var response httperr.Problem
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(response.Errors).To(Equal([]httperr.FieldError{
+					{Field: "items[0].quantity", Message: "must be greater than 0"},
+				}))
+			})
+		})
+
+		Context("when the patch changes a read-only field", func() {
+			It("should return unprocessable entity", func() {
+				mockService.EXPECT().GetOrder(ctx, orderID, domain.OrderExpand{}).Return(current, nil)
+
+				w := sendPatch(patch.MergePatchContentType, `{"user_id": 2}`)
+
+				Expect(w.Code).To(Equal(http.StatusUnprocessableEntity))
+
+				var response httperr.Problem
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(response.Detail).To(ContainSubstring(`unknown field "user_id"`))
+			})
+		})
+
+		Context("when the patch sets the total price", func() {
+			It("should reject the client total", func() {
+				mockService.EXPECT().GetOrder(ctx, orderID, domain.OrderExpand{}).Return(current, nil)
+
+				w := sendPatch(patch.MergePatchContentType, `{"total_price": 0.01}`)
+
+				Expect(w.Code).To(Equal(http.StatusUnprocessableEntity))
+			})
+		})
+
+		Context("when the patched order is invalid", func() {
+			It("should return bad request", func() {
+				mockService.EXPECT().GetOrder(ctx, orderID, domain.OrderExpand{}).Return(current, nil)
+
+				w := sendPatch(patch.MergePatchContentType, `{"quantity": 0}`)
+
+				Expect(w.Code).To(Equal(http.StatusBadRequest))
+			})
+		})
+
+		Context("when service returns error", func() {
+			It("should return internal server error", func() {
+				mockService.EXPECT().GetOrder(ctx, orderID, domain.OrderExpand{}).Return(current, nil)
+				mockService.EXPECT().PatchOrder(ctx, orderID, "", domain.OrderPatch{Items: []domain.OrderItem{{Quantity: 5}}}).Return(nil, errors.New("service error"))
+
+				w := sendPatch(patch.MergePatchContentType, `{"quantity": 5}`)
+
+				Expect(w.Code).To(Equal(http.StatusInternalServerError))
+			})
+		})
+
+		Context("when If-Match is sent", func() {
+			var sendConditionalPatch func(ifMatch string) *httptest.ResponseRecorder
+
+			BeforeEach(func() {
+				sendConditionalPatch = func(ifMatch string) *httptest.ResponseRecorder {
+					w := httptest.NewRecorder()
+					c, _ := gin.CreateTestContext(w)
+					c.Request = httptest.NewRequest(http.MethodPatch, "/api/v1/orders/"+orderID, bytes.NewBufferString(`{"quantity": 5}`))
+					c.Request.Header.Set("Content-Type", patch.MergePatchContentType)
+					c.Request.Header.Set(etag.IfMatchHeader, ifMatch)
+					c.Request = c.Request.WithContext(ctx)
+					c.Params = gin.Params{{Key: "id", Value: orderID}}
+
+					handler.PatchOrder(c)
+					return w
+				}
+			})
+
+			It("should pass the current version to the service", func() {
+				mockService.EXPECT().GetOrder(ctx, orderID, domain.OrderExpand{}).Return(current, nil)
+				mockService.EXPECT().PatchOrder(ctx, orderID, current.Version(), domain.OrderPatch{Items: []domain.OrderItem{{Quantity: 5}}}).Return(current, nil)
+
+				w := sendConditionalPatch(`"` + current.Version() + `"`)
+
+				Expect(w.Code).To(Equal(http.StatusOK))
+				Expect(w.Header().Get(etag.Header)).To(Equal(`"` + current.Version() + `"`))
+			})
+
+			It("should return precondition failed without patching a stale version", func() {
+				mockService.EXPECT().GetOrder(ctx, orderID, domain.OrderExpand{}).Return(current, nil)
+
+				w := sendConditionalPatch(`"stale"`)
+
+				Expect(w.Code).To(Equal(http.StatusPreconditionFailed))
+			})
+		})
+	})
+})
@@ -0,0 +1,36 @@
+package orderhdl
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"gin-swagger-api/internal/handler/httperr"
+)
+
+// PurgeOrder godoc
+// @Summary Purge a deleted order
+// @Description Remove a soft-deleted order for good; 409 when the order is not deleted.
+// @Description The stock the order held was returned when it was deleted.
+// @Description Requires an admin API key.
+// @Tags orders
+// @Param id path string true "Order ID"
+// @Param X-API-Key header string true "Admin API key"
+// @Param Idempotency-Key header string false "Key that makes retries return the first response instead of repeating the request"
+// @Success 204
+// @Failure 400 {object} httperr.Problem
+// @Failure 403 {object} httperr.Problem
+// @Failure 404 {object} httperr.Problem
+// @Failure 409 {object} httperr.Problem
+// @Failure 422 {object} httperr.Problem
+// @Failure 500 {object} httperr.Problem
+// @Router /orders/{id}/purge [post]
+func (h *Handler) PurgeOrder(c *gin.Context) {
+	id := c.Param("id")
+	if err := h.orderService.PurgeOrder(c.Request.Context(), id); err != nil {
+		httperr.Respond(c, err)
+		return
+	}
+
+	c.Data(http.StatusNoContent, "", nil)
+}
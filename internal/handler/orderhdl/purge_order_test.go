@@ -0,0 +1,77 @@
+package orderhdl_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/gin-gonic/gin"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"gin-swagger-api/internal/domain"
+	"gin-swagger-api/internal/handler/httperr"
+	"gin-swagger-api/internal/handler/orderhdl"
+	mockordersvc "gin-swagger-api/mock/service/ordersvc"
+)
+
+var _ = Describe("Handler PurgeOrder", func() {
+	var (
+		mockService *mockordersvc.MockService
+		handler     *orderhdl.Handler
+		ctx         context.Context
+		orderID     string
+	)
+
+	BeforeEach(func() {
+		gin.SetMode(gin.TestMode)
+		mockService = mockordersvc.NewMockService(GinkgoT())
+		handler = orderhdl.NewHandler(mockService)
+		ctx = context.Background()
+		orderID = "123"
+	})
+
+	// purge calls the handler for orderID
+	purge := func() *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodPost, "/api/v1/orders/"+orderID+"/purge", nil)
+		c.Request = c.Request.WithContext(ctx)
+		c.Params = gin.Params{{Key: "id", Value: orderID}}
+
+		handler.PurgeOrder(c)
+		return w
+	}
+
+	Describe("PurgeOrder", func() {
+		It("should purge the order", func() {
+			mockService.EXPECT().PurgeOrder(ctx, orderID).Return(nil)
+
+			w := purge()
+
+			Expect(w.Code).To(Equal(http.StatusNoContent))
+		})
+
+		It("should return conflict when the order is not deleted", func() {
+			mockService.EXPECT().PurgeOrder(ctx, orderID).Return(fmt.Errorf("order 123 is %w", domain.ErrNotDeleted))
+
+			w := purge()
+
+			Expect(w.Code).To(Equal(http.StatusConflict))
+
+			var problem httperr.Problem
+			Expect(json.Unmarshal(w.Body.Bytes(), &problem)).To(Succeed())
+			Expect(problem.Type).To(Equal("/problems/not-deleted"))
+		})
+
+		It("should return not found when the order does not exist", func() {
+			mockService.EXPECT().PurgeOrder(ctx, orderID).Return(fmt.Errorf("order %w", domain.ErrNotFound))
+
+			w := purge()
+
+			Expect(w.Code).To(Equal(http.StatusNotFound))
+		})
+	})
+})
@@ -0,0 +1,40 @@
+package orderhdl
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"gin-swagger-api/internal/handler/etag"
+	"gin-swagger-api/internal/handler/httperr"
+)
+
+// RestoreOrder godoc
+// @Summary Restore a deleted order
+// @Description Bring back a soft-deleted order; restoring an order that is not deleted returns it unchanged.
+// @Description Requires an admin API key.
+// @Tags orders
+// @Produce json
+// @Param id path string true "Order ID"
+// @Param X-API-Key header string true "Admin API key"
+// @Param Idempotency-Key header string false "Key that makes retries return the first response instead of repeating the request"
+// @Success 200 {object} OrderResponse
+// @Header 200 {string} ETag "Entity tag of the current order version"
+// @Failure 400 {object} httperr.Problem
+// @Failure 403 {object} httperr.Problem
+// @Failure 404 {object} httperr.Problem
+// @Failure 409 {object} httperr.Problem
+// @Failure 422 {object} httperr.Problem
+// @Failure 500 {object} httperr.Problem
+// @Router /orders/{id}/restore [post]
+func (h *Handler) RestoreOrder(c *gin.Context) {
+	id := c.Param("id")
+	order, err := h.orderService.RestoreOrder(c.Request.Context(), id)
+	if err != nil {
+		httperr.Respond(c, err)
+		return
+	}
+
+	etag.Set(c, order.Version())
+	c.JSON(http.StatusOK, toOrderResponse(*order))
+}
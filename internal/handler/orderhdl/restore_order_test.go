@@ -0,0 +1,89 @@
+package orderhdl_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/gin-gonic/gin"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"gin-swagger-api/internal/domain"
+	"gin-swagger-api/internal/handler/orderhdl"
+	"gin-swagger-api/internal/testutil"
+	mockordersvc "gin-swagger-api/mock/service/ordersvc"
+)
+
+var _ = Describe("Handler RestoreOrder", func() {
+	var (
+		mockService *mockordersvc.MockService
+		handler     *orderhdl.Handler
+		ctx         context.Context
+		orderID     string
+	)
+
+	BeforeEach(func() {
+		gin.SetMode(gin.TestMode)
+		mockService = mockordersvc.NewMockService(GinkgoT())
+		handler = orderhdl.NewHandler(mockService)
+		ctx = context.Background()
+		orderID = "123"
+	})
+
+	// restore calls the handler for orderID
+	restore := func() *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodPost, "/api/v1/orders/"+orderID+"/restore", nil)
+		c.Request = c.Request.WithContext(ctx)
+		c.Params = gin.Params{{Key: "id", Value: orderID}}
+
+		handler.RestoreOrder(c)
+		return w
+	}
+
+	Describe("RestoreOrder", func() {
+		It("should return the restored order with its ETag", func() {
+			restored := &domain.Order{ID: orderID, UserID: 1, Items: []domain.OrderItem{domain.NewOrderItem(1, 2, testutil.THB("10"))}, TotalPrice: testutil.THB("20"), Status: domain.OrderStatusPending}
+			mockService.EXPECT().RestoreOrder(ctx, orderID).Return(restored, nil)
+
+			w := restore()
+
+			Expect(w.Code).To(Equal(http.StatusOK))
+			Expect(w.Header().Get("ETag")).To(Equal(`"` + restored.Version() + `"`))
+
+			var response map[string]any
+			Expect(json.Unmarshal(w.Body.Bytes(), &response)).To(Succeed())
+			Expect(response).To(HaveKeyWithValue("id", orderID))
+			Expect(response).ToNot(HaveKey("deleted_at"))
+		})
+
+		It("should return conflict when the stock of the order is gone", func() {
+			mockService.EXPECT().RestoreOrder(ctx, orderID).Return(nil, fmt.Errorf("product 1 has %w", domain.ErrInsufficientStock))
+
+			w := restore()
+
+			Expect(w.Code).To(Equal(http.StatusConflict))
+		})
+
+		It("should return not found when the order does not exist", func() {
+			mockService.EXPECT().RestoreOrder(ctx, orderID).Return(nil, fmt.Errorf("order %w", domain.ErrNotFound))
+
+			w := restore()
+
+			Expect(w.Code).To(Equal(http.StatusNotFound))
+		})
+
+		It("should return bad request for an invalid ID", func() {
+			orderID = "abc"
+			mockService.EXPECT().RestoreOrder(ctx, orderID).Return(nil, fmt.Errorf("%w: %q", domain.ErrInvalidID, orderID))
+
+			w := restore()
+
+			Expect(w.Code).To(Equal(http.StatusBadRequest))
+		})
+	})
+})
@@ -0,0 +1,136 @@
+package orderhdl
+
+import (
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"gin-swagger-api/internal/domain"
+	"gin-swagger-api/internal/handler/etag"
+	"gin-swagger-api/internal/handler/httperr"
+)
+
+// PayOrder godoc
+// @Summary Pay an order
+// @Description Move a pending order to paid; 409 when the order is not pending.
+// @Tags orders
+// @Accept json
+// @Produce json
+// @Param id path string true "Order ID"
+// @Param If-Match header string false "ETag the order must still have"
+// @Param transition body TransitionOrderRequest false "Reason recorded in the order history"
+// @Param Idempotency-Key header string false "Key that makes retries return the first response instead of repeating the request"
+// @Success 200 {object} OrderResponse
+// @Header 200 {string} ETag "Entity tag of the current order version"
+// @Failure 400 {object} httperr.Problem
+// @Failure 404 {object} httperr.Problem
+// @Failure 409 {object} httperr.Problem
+// @Failure 412 {object} httperr.Problem
+// @Failure 422 {object} httperr.Problem
+// @Failure 428 {object} httperr.Problem
+// @Failure 500 {object} httperr.Problem
+// @Router /orders/{id}/pay [post]
+func (h *Handler) PayOrder(c *gin.Context) {
+	h.transition(c, domain.OrderStatusPaid)
+}
+
+// ShipOrder godoc
+// @Summary Ship an order
+// @Description Move a paid order to shipped; 409 when the order is not paid.
+// @Tags orders
+// @Accept json
+// @Produce json
+// @Param id path string true "Order ID"
+// @Param If-Match header string false "ETag the order must still have"
+// @Param transition body TransitionOrderRequest false "Reason recorded in the order history"
+// @Param Idempotency-Key header string false "Key that makes retries return the first response instead of repeating the request"
+// @Success 200 {object} OrderResponse
+// @Header 200 {string} ETag "Entity tag of the current order version"
+// @Failure 400 {object} httperr.Problem
+// @Failure 404 {object} httperr.Problem
+// @Failure 409 {object} httperr.Problem
+// @Failure 412 {object} httperr.Problem
+// @Failure 422 {object} httperr.Problem
+// @Failure 428 {object} httperr.Problem
+// @Failure 500 {object} httperr.Problem
+// @Router /orders/{id}/ship [post]
+func (h *Handler) ShipOrder(c *gin.Context) {
+	h.transition(c, domain.OrderStatusShipped)
+}
+
+// DeliverOrder godoc
+// @Summary Deliver an order
+// @Description Move a shipped order to delivered; 409 when the order is not shipped.
+// @Tags orders
+// @Accept json
+// @Produce json
+// @Param id path string true "Order ID"
+// @Param If-Match header string false "ETag the order must still have"
+// @Param transition body TransitionOrderRequest false "Reason recorded in the order history"
+// @Param Idempotency-Key header string false "Key that makes retries return the first response instead of repeating the request"
+// @Success 200 {object} OrderResponse
+// @Header 200 {string} ETag "Entity tag of the current order version"
+// @Failure 400 {object} httperr.Problem
+// @Failure 404 {object} httperr.Problem
+// @Failure 409 {object} httperr.Problem
+// @Failure 412 {object} httperr.Problem
+// @Failure 422 {object} httperr.Problem
+// @Failure 428 {object} httperr.Problem
+// @Failure 500 {object} httperr.Problem
+// @Router /orders/{id}/deliver [post]
+func (h *Handler) DeliverOrder(c *gin.Context) {
+	h.transition(c, domain.OrderStatusDelivered)
+}
+
+// CancelOrder godoc
+// @Summary Cancel an order
+// @Description Cancel a pending or paid order and return its quantity to product stock; 409 once the order has shipped.
+// @Tags orders
+// @Accept json
+// @Produce json
+// @Param id path string true "Order ID"
+// @Param If-Match header string false "ETag the order must still have"
+// @Param transition body TransitionOrderRequest false "Reason recorded in the order history"
+// @Param Idempotency-Key header string false "Key that makes retries return the first response instead of repeating the request"
+// @Success 200 {object} OrderResponse
+// @Header 200 {string} ETag "Entity tag of the current order version"
+// @Failure 400 {object} httperr.Problem
+// @Failure 404 {object} httperr.Problem
+// @Failure 409 {object} httperr.Problem
+// @Failure 412 {object} httperr.Problem
+// @Failure 422 {object} httperr.Problem
+// @Failure 428 {object} httperr.Problem
+// @Failure 500 {object} httperr.Problem
+// @Router /orders/{id}/cancel [post]
+func (h *Handler) CancelOrder(c *gin.Context) {
+	h.transition(c, domain.OrderStatusCancelled)
+}
+
+// transition moves the order in the path to status. The request body is
+// optional.
+func (h *Handler) transition(c *gin.Context, status string) {
+	id := c.Param("id")
+
+	var req TransitionOrderRequest
+	if err := c.ShouldBindJSON(&req); err != nil && !errors.Is(err, io.EOF) {
+		httperr.BadRequest(c, err)
+		return
+	}
+
+	version, err := etag.IfMatch(c)
+	if err != nil {
+		httperr.Respond(c, err)
+		return
+	}
+
+	order, err := h.orderService.TransitionOrder(c.Request.Context(), id, version, status, req.Reason)
+	if err != nil {
+		httperr.Respond(c, err)
+		return
+	}
+
+	etag.Set(c, order.Version())
+	c.JSON(http.StatusOK, toOrderResponse(*order))
+}
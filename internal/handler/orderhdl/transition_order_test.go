@@ -0,0 +1,131 @@
+package orderhdl_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"gin-swagger-api/internal/domain"
+	"gin-swagger-api/internal/handler/etag"
+	"gin-swagger-api/internal/handler/httperr"
+	"gin-swagger-api/internal/handler/orderhdl"
+	"gin-swagger-api/internal/testutil"
+	mockordersvc "gin-swagger-api/mock/service/ordersvc"
+)
+
+var _ = Describe("Handler order transitions", func() {
+	var (
+		mockService *mockordersvc.MockService
+		handler     *orderhdl.Handler
+		ctx         context.Context
+		orderID     string
+		send        func(action gin.HandlerFunc, ifMatch, body string) *httptest.ResponseRecorder
+	)
+
+	BeforeEach(func() {
+		gin.SetMode(gin.TestMode)
+		mockService = mockordersvc.NewMockService(GinkgoT())
+		handler = orderhdl.NewHandler(mockService)
+		ctx = context.Background()
+		orderID = "1"
+
+		send = func(action gin.HandlerFunc, ifMatch, body string) *httptest.ResponseRecorder {
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest(http.MethodPost, "/api/v1/orders/"+orderID+"/transition", strings.NewReader(body))
+			c.Request.Header.Set("Content-Type", "application/json")
+			if ifMatch != "" {
+				c.Request.Header.Set(etag.IfMatchHeader, ifMatch)
+			}
+			c.Request = c.Request.WithContext(ctx)
+			c.Params = gin.Params{{Key: "id", Value: orderID}}
+
+			action(c)
+			return w
+		}
+	})
+
+	DescribeTable("should move the order to the status of the action",
+		func(action func(h *orderhdl.Handler) gin.HandlerFunc, status string) {
+			order := &domain.Order{ID: orderID, UserID: 1, Items: []domain.OrderItem{domain.NewOrderItem(1, 2, testutil.THB("25.00"))}, TotalPrice: testutil.THB("50.00"), Status: status}
+			mockService.EXPECT().TransitionOrder(ctx, orderID, "", status, "").Return(order, nil)
+
+			w := send(action(handler), "", "")
+
+			Expect(w.Code).To(Equal(http.StatusOK))
+			Expect(w.Header().Get(etag.Header)).To(Equal(`"` + order.Version() + `"`))
+
+			var response orderhdl.OrderResponse
+			Expect(json.Unmarshal(w.Body.Bytes(), &response)).To(Succeed())
+			Expect(response.Status).To(Equal(status))
+		},
+		Entry("pay", func(h *orderhdl.Handler) gin.HandlerFunc { return h.PayOrder }, domain.OrderStatusPaid),
+		Entry("ship", func(h *orderhdl.Handler) gin.HandlerFunc { return h.ShipOrder }, domain.OrderStatusShipped),
+		Entry("deliver", func(h *orderhdl.Handler) gin.HandlerFunc { return h.DeliverOrder }, domain.OrderStatusDelivered),
+		Entry("cancel", func(h *orderhdl.Handler) gin.HandlerFunc { return h.CancelOrder }, domain.OrderStatusCancelled),
+	)
+
+	It("should return conflict for a transition the lifecycle does not allow", func() {
+		mockService.EXPECT().
+			TransitionOrder(ctx, orderID, "", domain.OrderStatusShipped, "").
+			Return(nil, fmt.Errorf("%w: order cannot go from pending to shipped", domain.ErrInvalidTransition))
+
+		w := send(handler.ShipOrder, "", "")
+
+		Expect(w.Code).To(Equal(http.StatusConflict))
+
+		var response httperr.Problem
+		Expect(json.Unmarshal(w.Body.Bytes(), &response)).To(Succeed())
+		Expect(response.Type).To(Equal("/problems/invalid-transition"))
+		Expect(response.Detail).To(ContainSubstring("pending to shipped"))
+	})
+
+	It("should pass the expected version from If-Match", func() {
+		order := &domain.Order{ID: orderID, Status: domain.OrderStatusCancelled}
+		mockService.EXPECT().TransitionOrder(ctx, orderID, "abc123", domain.OrderStatusCancelled, "").Return(order, nil)
+
+		w := send(handler.CancelOrder, `"abc123"`, "")
+
+		Expect(w.Code).To(Equal(http.StatusOK))
+	})
+
+	It("should record the reason given in the body", func() {
+		order := &domain.Order{ID: orderID, Status: domain.OrderStatusCancelled}
+		mockService.EXPECT().
+			TransitionOrder(ctx, orderID, "", domain.OrderStatusCancelled, "customer changed their mind").
+			Return(order, nil)
+
+		w := send(handler.CancelOrder, "", `{"reason": "customer changed their mind"}`)
+
+		Expect(w.Code).To(Equal(http.StatusOK))
+	})
+
+	It("should reject a reason that is too long", func() {
+		w := send(handler.CancelOrder, "", `{"reason": "`+strings.Repeat("x", 501)+`"}`)
+
+		Expect(w.Code).To(Equal(http.StatusBadRequest))
+	})
+
+	It("should reject a malformed If-Match without calling the service", func() {
+		w := send(handler.PayOrder, "abc123", "")
+
+		Expect(w.Code).To(Equal(http.StatusBadRequest))
+	})
+
+	It("should return not found when the order does not exist", func() {
+		mockService.EXPECT().
+			TransitionOrder(ctx, orderID, "", domain.OrderStatusPaid, "").
+			Return(nil, fmt.Errorf("order %w", domain.ErrNotFound))
+
+		w := send(handler.PayOrder, "", "")
+
+		Expect(w.Code).To(Equal(http.StatusNotFound))
+	})
+})
@@ -4,43 +4,62 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+
+	"gin-swagger-api/internal/handler/etag"
+	"gin-swagger-api/internal/handler/httperr"
 )
 
 // UpdateOrder godoc
 // @Summary Update an order
-// @Description Update an order's information by ID
+// @Description Update an order's information by ID.
+// @Description Items replace the items of the order. For a single product send quantity and product_id instead; product_id can be left out when the order has a single item.
+// @Description Products already on the order keep the unit price they were placed at; added products are priced at their current price.
+// @Description Stock follows quantity changes and is returned when the status becomes cancelled; 409 when not enough is in stock.
+// @Description Items can only change while the order is pending or paid; 409 otherwise.
+// @Description The status must follow the order lifecycle (pending → paid → shipped → delivered, cancel while pending or paid); 409 otherwise.
 // @Tags orders
 // @Accept json
 // @Produce json
 // @Param id path string true "Order ID"
+// @Param If-Match header string false "ETag the order must still have; required when the server enforces preconditions"
 // @Param order body UpdateOrderRequest true "Order information"
 // @Success 200 {object} OrderResponse
-// @Failure 400 {object} ErrorResponse
-// @Failure 404 {object} ErrorResponse
-// @Failure 500 {object} ErrorResponse
+// @Header 200 {string} ETag "Entity tag of the current order version"
+// @Failure 400 {object} httperr.Problem
+// @Failure 404 {object} httperr.Problem
+// @Failure 409 {object} httperr.Problem
+// @Failure 412 {object} httperr.Problem
+// @Failure 422 {object} httperr.Problem
+// @Failure 428 {object} httperr.Problem
+// @Failure 500 {object} httperr.Problem
 // @Router /orders/{id} [put]
 func (h *Handler) UpdateOrder(c *gin.Context) {
 	id := c.Param("id")
 
+	version, err := etag.IfMatch(c)
+	if err != nil {
+		httperr.Respond(c, err)
+		return
+	}
+
 	var req UpdateOrderRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		httperr.BadRequest(c, err)
 		return
 	}
 
 	order, err := h.orderService.UpdateOrder(
 		c.Request.Context(),
 		id,
-		req.UserID,
-		req.ProductID,
-		req.Quantity,
-		req.TotalPrice,
+		version,
+		orderItems(req.Items, req.ProductID, req.Quantity),
 		req.Status,
 	)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, ErrorResponse{Error: err.Error()})
+		httperr.Respond(c, err)
 		return
 	}
 
+	etag.Set(c, order.Version())
 	c.JSON(http.StatusOK, toOrderResponse(*order))
 }
@@ -12,8 +12,12 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	"fmt"
 	"gin-swagger-api/internal/domain"
+	"gin-swagger-api/internal/handler/etag"
+	"gin-swagger-api/internal/handler/httperr"
 	"gin-swagger-api/internal/handler/orderhdl"
+	"gin-swagger-api/internal/testutil"
 	mockordersvc "gin-swagger-api/mock/service/ordersvc"
 )
 
@@ -37,21 +41,18 @@ var _ = Describe("Handler UpdateOrder", func() {
 		Context("when updating an order with valid data", func() {
 			It("should update order successfully", func() {
 				req := orderhdl.UpdateOrderRequest{
-					UserID:     1,
-					ProductID:  1,
-					Quantity:   3,
-					TotalPrice: 75000.00,
-					Status:     "completed",
+					ProductID: 1,
+					Quantity:  3,
+					Status:    "completed",
 				}
 				order := &domain.Order{
 					ID:         orderID,
 					UserID:     1,
-					ProductID:  1,
-					Quantity:   3,
-					TotalPrice: 75000.00,
+					Items:      []domain.OrderItem{domain.NewOrderItem(1, 3, testutil.THB("25000.00"))},
+					TotalPrice: testutil.THB("75000.00"),
 					Status:     "completed",
 				}
-				mockService.EXPECT().UpdateOrder(ctx, orderID, 1, 1, 3, 75000.00, "completed").Return(order, nil)
+				mockService.EXPECT().UpdateOrder(ctx, orderID, "", []domain.OrderItem{{ProductID: 1, Quantity: 3}}, "completed").Return(order, nil)
 
 				bodyBytes, _ := json.Marshal(req)
 				w := httptest.NewRecorder()
@@ -70,7 +71,48 @@ var _ = Describe("Handler UpdateOrder", func() {
 				Expect(err).ToNot(HaveOccurred())
 				Expect(response.ID).To(Equal(orderID))
 				Expect(response.Status).To(Equal("completed"))
-				Expect(response.TotalPrice).To(Equal(75000.00))
+				Expect(response.TotalPrice).To(Equal("75000.00"))
+			})
+		})
+
+		Context("when updating the items of an order", func() {
+			It("should pass the items to replace", func() {
+				order := &domain.Order{ID: orderID, UserID: 1, Items: []domain.OrderItem{domain.NewOrderItem(3, 1, testutil.THB("10.00"))}, TotalPrice: testutil.THB("10.00"), Status: "pending"}
+				mockService.EXPECT().UpdateOrder(ctx, orderID, "", []domain.OrderItem{{ProductID: 3, Quantity: 1}}, "").Return(order, nil)
+
+				w := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(w)
+				c.Request = httptest.NewRequest(http.MethodPut, "/api/v1/orders/"+orderID, bytes.NewBufferString(`{"items": [{"product_id": 3, "quantity": 1}]}`))
+				c.Request.Header.Set("Content-Type", "application/json")
+				c.Request = c.Request.WithContext(ctx)
+				c.Params = gin.Params{{Key: "id", Value: orderID}}
+
+				handler.UpdateOrder(c)
+
+				Expect(w.Code).To(Equal(http.StatusOK))
+
+				var response orderhdl.OrderResponse
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(response.Items).To(Equal([]orderhdl.OrderItemResponse{
+					{ProductID: 3, Quantity: 1, UnitPrice: "10.00", LineTotal: "10.00"},
+				}))
+			})
+
+			It("should apply a quantity alone to the only item", func() {
+				order := &domain.Order{ID: orderID, UserID: 1, Items: []domain.OrderItem{domain.NewOrderItem(3, 4, testutil.THB("10.00"))}, TotalPrice: testutil.THB("40.00"), Status: "pending"}
+				mockService.EXPECT().UpdateOrder(ctx, orderID, "", []domain.OrderItem{{Quantity: 4}}, "").Return(order, nil)
+
+				w := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(w)
+				c.Request = httptest.NewRequest(http.MethodPut, "/api/v1/orders/"+orderID, bytes.NewBufferString(`{"quantity": 4}`))
+				c.Request.Header.Set("Content-Type", "application/json")
+				c.Request = c.Request.WithContext(ctx)
+				c.Params = gin.Params{{Key: "id", Value: orderID}}
+
+				handler.UpdateOrder(c)
+
+				Expect(w.Code).To(Equal(http.StatusOK))
 			})
 		})
 
@@ -94,13 +136,11 @@ var _ = Describe("Handler UpdateOrder", func() {
 		Context("when service returns error", func() {
 			It("should return internal server error", func() {
 				req := orderhdl.UpdateOrderRequest{
-					UserID:     1,
-					ProductID:  1,
-					Quantity:   3,
-					TotalPrice: 75000.00,
-					Status:     "completed",
+					ProductID: 1,
+					Quantity:  3,
+					Status:    "completed",
 				}
-				mockService.EXPECT().UpdateOrder(ctx, orderID, 1, 1, 3, 75000.00, "completed").Return(nil, errors.New("update failed"))
+				mockService.EXPECT().UpdateOrder(ctx, orderID, "", []domain.OrderItem{{ProductID: 1, Quantity: 3}}, "completed").Return(nil, errors.New("update failed"))
 
 				bodyBytes, _ := json.Marshal(req)
 				w := httptest.NewRecorder()
@@ -114,10 +154,53 @@ var _ = Describe("Handler UpdateOrder", func() {
 
 				Expect(w.Code).To(Equal(http.StatusInternalServerError))
 
-				var response orderhdl.ErrorResponse
+				var response httperr.Problem
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(response.Detail).To(Equal("Internal Server Error"))
+			})
+		})
+
+		Context("when If-Match is sent", func() {
+			var sendUpdate func() *httptest.ResponseRecorder
+
+			BeforeEach(func() {
+				sendUpdate = func() *httptest.ResponseRecorder {
+					bodyBytes, _ := json.Marshal(orderhdl.UpdateOrderRequest{ProductID: 1, Quantity: 3, Status: "completed"})
+					w := httptest.NewRecorder()
+					c, _ := gin.CreateTestContext(w)
+					c.Request = httptest.NewRequest(http.MethodPut, "/api/v1/orders/"+orderID, bytes.NewBuffer(bodyBytes))
+					c.Request.Header.Set("Content-Type", "application/json")
+					c.Request.Header.Set(etag.IfMatchHeader, `"abc123"`)
+					c.Request = c.Request.WithContext(ctx)
+					c.Params = gin.Params{{Key: "id", Value: orderID}}
+
+					handler.UpdateOrder(c)
+					return w
+				}
+			})
+
+			It("should pass the expected version and return the new entity tag", func() {
+				order := &domain.Order{ID: orderID, UserID: 1, Items: []domain.OrderItem{domain.NewOrderItem(1, 3, testutil.THB("25000.00"))}, TotalPrice: testutil.THB("75000.00"), Status: "completed"}
+				mockService.EXPECT().UpdateOrder(ctx, orderID, "abc123", []domain.OrderItem{{ProductID: 1, Quantity: 3}}, "completed").Return(order, nil)
+
+				w := sendUpdate()
+
+				Expect(w.Code).To(Equal(http.StatusOK))
+				Expect(w.Header().Get(etag.Header)).To(Equal(`"` + order.Version() + `"`))
+			})
+
+			It("should return precondition failed when the order has changed", func() {
+				mockService.EXPECT().UpdateOrder(ctx, orderID, "abc123", []domain.OrderItem{{ProductID: 1, Quantity: 3}}, "completed").Return(nil, fmt.Errorf("order version %w", domain.ErrPreconditionFailed))
+
+				w := sendUpdate()
+
+				Expect(w.Code).To(Equal(http.StatusPreconditionFailed))
+
+				var response httperr.Problem
 				err := json.Unmarshal(w.Body.Bytes(), &response)
 				Expect(err).ToNot(HaveOccurred())
-				Expect(response.Error).To(Equal("update failed"))
+				Expect(response.Type).To(Equal("/problems/precondition-failed"))
 			})
 		})
 	})
@@ -0,0 +1,115 @@
+package pagination
+
+import (
+	"net/url"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"gin-swagger-api/internal/domain"
+)
+
+// Query represents the paging query parameters of list endpoints
+type Query struct {
+	Limit  int    `form:"limit" binding:"omitempty,min=1"`
+	Offset int    `form:"offset" binding:"omitempty,min=0"`
+	Cursor string `form:"cursor"`
+}
+
+// PageRequest converts the query to a domain.PageRequest
+func (q Query) PageRequest() domain.PageRequest {
+	return domain.PageRequest{
+		Limit:  q.Limit,
+		Offset: q.Offset,
+		Cursor: q.Cursor,
+	}
+}
+
+// OffsetQuery represents the paging query parameters of list endpoints that
+// only page by offset
+type OffsetQuery struct {
+	Limit  int `form:"limit" binding:"omitempty,min=1"`
+	Offset int `form:"offset" binding:"omitempty,min=0"`
+}
+
+// PageRequest converts the query to a domain.PageRequest
+func (q OffsetQuery) PageRequest() domain.PageRequest {
+	return domain.PageRequest{
+		Limit:  q.Limit,
+		Offset: q.Offset,
+	}
+}
+
+// Response represents a page of items with paging metadata
+type Response[T any] struct {
+	Data  []T   `json:"data"`
+	Meta  Meta  `json:"meta"`
+	Links Links `json:"links"`
+}
+
+// Meta describes the returned page
+type Meta struct {
+	Total      int    `json:"total" example:"42"`
+	Limit      int    `json:"limit" example:"20"`
+	Offset     int    `json:"offset" example:"0"`
+	NextCursor string `json:"next_cursor,omitempty" example:"YToyMA"`
+	PrevCursor string `json:"prev_cursor,omitempty" example:"YjoyMQ"`
+}
+
+// Links holds URLs of the current and adjacent pages
+type Links struct {
+	Self string `json:"self" example:"/api/v1/users?limit=20&offset=0"`
+	Next string `json:"next,omitempty" example:"/api/v1/users?limit=20&offset=20"`
+	Prev string `json:"prev,omitempty"`
+}
+
+// NewResponse builds a paged response, converting each item with convert.
+// Links keep the paging mode of the request: cursor requests get cursor
+// links and offset requests get offset links.
+func NewResponse[S any, T any](c *gin.Context, page *domain.Page[S], convert func(S) T) Response[T] {
+	data := make([]T, len(page.Items))
+	for i, item := range page.Items {
+		data[i] = convert(item)
+	}
+
+	links := Links{Self: c.Request.URL.RequestURI()}
+	if c.Query("cursor") != "" {
+		if page.NextCursor != "" {
+			links.Next = pageURL(c, page.Limit, "cursor", page.NextCursor)
+		}
+		if page.PrevCursor != "" {
+			links.Prev = pageURL(c, page.Limit, "cursor", page.PrevCursor)
+		}
+	} else {
+		if page.Offset+page.Limit < page.Total {
+			links.Next = pageURL(c, page.Limit, "offset", strconv.Itoa(page.Offset+page.Limit))
+		}
+		if page.Offset > 0 {
+			links.Prev = pageURL(c, page.Limit, "offset", strconv.Itoa(max(page.Offset-page.Limit, 0)))
+		}
+	}
+
+	return Response[T]{
+		Data: data,
+		Meta: Meta{
+			Total:      page.Total,
+			Limit:      page.Limit,
+			Offset:     page.Offset,
+			NextCursor: page.NextCursor,
+			PrevCursor: page.PrevCursor,
+		},
+		Links: links,
+	}
+}
+
+// pageURL returns the request URL with its paging parameters replaced
+func pageURL(c *gin.Context, limit int, key, value string) string {
+	query := c.Request.URL.Query()
+	query.Del("cursor")
+	query.Del("offset")
+	query.Set("limit", strconv.Itoa(limit))
+	query.Set(key, value)
+
+	u := url.URL{Path: c.Request.URL.Path, RawQuery: query.Encode()}
+	return u.String()
+}
@@ -0,0 +1,89 @@
+package pagination_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"gin-swagger-api/internal/domain"
+	"gin-swagger-api/internal/handler/pagination"
+)
+
+var _ = Describe("Pagination", func() {
+	var newContext func(target string) *gin.Context
+
+	BeforeEach(func() {
+		gin.SetMode(gin.TestMode)
+		newContext = func(target string) *gin.Context {
+			c, _ := gin.CreateTestContext(httptest.NewRecorder())
+			c.Request = httptest.NewRequest(http.MethodGet, target, nil)
+			return c
+		}
+	})
+
+	Describe("Query", func() {
+		It("should convert to a page request", func() {
+			query := pagination.Query{Limit: 10, Offset: 5, Cursor: "abc"}
+
+			Expect(query.PageRequest()).To(Equal(domain.PageRequest{Limit: 10, Offset: 5, Cursor: "abc"}))
+		})
+	})
+
+	Describe("OffsetQuery", func() {
+		It("should convert to a page request without a cursor", func() {
+			query := pagination.OffsetQuery{Limit: 10, Offset: 5}
+
+			Expect(query.PageRequest()).To(Equal(domain.PageRequest{Limit: 10, Offset: 5}))
+		})
+	})
+
+	Describe("NewResponse", func() {
+		It("should convert items and copy the page metadata", func() {
+			c := newContext("/api/v1/items")
+			page := &domain.Page[int]{Items: []int{1, 2}, Total: 2, Limit: 20}
+
+			response := pagination.NewResponse(c, page, strconv.Itoa)
+
+			Expect(response.Data).To(Equal([]string{"1", "2"}))
+			Expect(response.Meta).To(Equal(pagination.Meta{Total: 2, Limit: 20}))
+			Expect(response.Links).To(Equal(pagination.Links{Self: "/api/v1/items"}))
+		})
+
+		It("should build offset links for offset requests", func() {
+			c := newContext("/api/v1/items?limit=10&offset=10&sort=name")
+			page := &domain.Page[int]{Items: []int{11}, Total: 25, Limit: 10, Offset: 10}
+
+			response := pagination.NewResponse(c, page, strconv.Itoa)
+
+			Expect(response.Links.Self).To(Equal("/api/v1/items?limit=10&offset=10&sort=name"))
+			Expect(response.Links.Next).To(Equal("/api/v1/items?limit=10&offset=20&sort=name"))
+			Expect(response.Links.Prev).To(Equal("/api/v1/items?limit=10&offset=0&sort=name"))
+		})
+
+		It("should omit the next link on the last page", func() {
+			c := newContext("/api/v1/items?limit=10&offset=20")
+			page := &domain.Page[int]{Items: []int{21}, Total: 21, Limit: 10, Offset: 20}
+
+			response := pagination.NewResponse(c, page, strconv.Itoa)
+
+			Expect(response.Links.Next).To(BeEmpty())
+			Expect(response.Links.Prev).To(Equal("/api/v1/items?limit=10&offset=10"))
+		})
+
+		It("should build cursor links for cursor requests", func() {
+			c := newContext("/api/v1/items?cursor=YTox&limit=2")
+			page := &domain.Page[int]{Items: []int{2, 3}, Total: 5, Limit: 2, NextCursor: "YToz", PrevCursor: "Yjoy"}
+
+			response := pagination.NewResponse(c, page, strconv.Itoa)
+
+			Expect(response.Meta.NextCursor).To(Equal("YToz"))
+			Expect(response.Meta.PrevCursor).To(Equal("Yjoy"))
+			Expect(response.Links.Next).To(Equal("/api/v1/items?cursor=YToz&limit=2"))
+			Expect(response.Links.Prev).To(Equal("/api/v1/items?cursor=Yjoy&limit=2"))
+		})
+	})
+})
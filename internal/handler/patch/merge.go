@@ -0,0 +1,39 @@
+package patch
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"gin-swagger-api/internal/domain"
+)
+
+// MergePatch applies an RFC 7396 JSON Merge Patch to a decoded document
+func MergePatch(doc any, body []byte) (any, error) {
+	var patch any
+	if err := json.Unmarshal(body, &patch); err != nil {
+		return nil, fmt.Errorf("%w: malformed merge patch", domain.ErrInvalidArgument)
+	}
+	return merge(doc, patch), nil
+}
+
+// merge merges patch into target; null members remove the target member
+func merge(target, patch any) any {
+	members, ok := patch.(map[string]any)
+	if !ok {
+		return patch
+	}
+
+	result, ok := target.(map[string]any)
+	if !ok {
+		result = map[string]any{}
+	}
+
+	for name, value := range members {
+		if value == nil {
+			delete(result, name)
+			continue
+		}
+		result[name] = merge(result[name], value)
+	}
+	return result
+}
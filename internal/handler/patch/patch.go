@@ -0,0 +1,97 @@
+package patch
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+
+	"gin-swagger-api/internal/domain"
+	"gin-swagger-api/internal/handler/httperr"
+)
+
+const (
+	// MergePatchContentType is the media type of RFC 7396 JSON Merge Patch
+	MergePatchContentType = "application/merge-patch+json"
+	// JSONPatchContentType is the media type of RFC 6902 JSON Patch
+	JSONPatchContentType = "application/json-patch+json"
+)
+
+// Apply applies a patch document to the JSON form of original and decodes
+// the result into target. Plain application/json bodies are treated as
+// merge patches. Fields of original cannot be removed or set to null, and
+// fields it does not have cannot be added.
+func Apply(contentType string, body []byte, original, target any) error {
+	raw, err := json.Marshal(original)
+	if err != nil {
+		return err
+	}
+
+	var doc any
+	if err := json.Unmarshal(raw, &doc); err != nil {
+		return err
+	}
+
+	switch contentType {
+	case MergePatchContentType, "application/json":
+		doc, err = MergePatch(doc, body)
+	case JSONPatchContentType:
+		doc, err = JSONPatch(doc, body)
+	default:
+		return fmt.Errorf("%w: %q, use %s or %s", httperr.ErrUnsupportedMediaType, contentType, MergePatchContentType, JSONPatchContentType)
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := checkFields(raw, doc); err != nil {
+		return err
+	}
+
+	patched, err := json.Marshal(doc)
+	if err != nil {
+		return err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(patched))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(target); err != nil {
+		var typeErr *json.UnmarshalTypeError
+		if errors.As(err, &typeErr) {
+			return fmt.Errorf("%w: invalid value for %q", domain.ErrValidation, typeErr.Field)
+		}
+		return fmt.Errorf("%w: %s", domain.ErrValidation, err.Error())
+	}
+	return nil
+}
+
+// checkFields verifies that every field of the original document is
+// still present and not null
+func checkFields(original []byte, doc any) error {
+	var fields map[string]any
+	if err := json.Unmarshal(original, &fields); err != nil {
+		return err
+	}
+
+	patched, ok := doc.(map[string]any)
+	if !ok {
+		return fmt.Errorf("%w: patched document must be an object", domain.ErrValidation)
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(fields)) {
+		if patched[name] == nil {
+			return fmt.Errorf("%w: %q cannot be removed", domain.ErrValidation, name)
+		}
+	}
+	return nil
+}
+
+// Changed returns a pointer to after when it differs from before
+func Changed[T comparable](before, after T) *T {
+	if before == after {
+		return nil
+	}
+	return &after
+}
@@ -0,0 +1,163 @@
+package patch_test
+
+import (
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"gin-swagger-api/internal/domain"
+	"gin-swagger-api/internal/handler/httperr"
+	"gin-swagger-api/internal/handler/patch"
+)
+
+type document struct {
+	Name  string  `json:"name"`
+	Price float64 `json:"price"`
+	Stock int     `json:"stock"`
+}
+
+// decode decodes a JSON value for use as a document
+func decode(raw string) any {
+	var doc any
+	Expect(json.Unmarshal([]byte(raw), &doc)).To(Succeed())
+	return doc
+}
+
+var _ = Describe("Patch", func() {
+	original := document{Name: "Laptop", Price: 999.99, Stock: 10}
+
+	Describe("Apply", func() {
+		It("should apply a merge patch", func() {
+			var result document
+			err := patch.Apply(patch.MergePatchContentType, []byte(`{"price": 10}`), original, &result)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(Equal(document{Name: "Laptop", Price: 10, Stock: 10}))
+		})
+
+		It("should treat plain JSON as a merge patch", func() {
+			var result document
+			err := patch.Apply("application/json", []byte(`{"stock": 3}`), original, &result)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.Stock).To(Equal(3))
+		})
+
+		It("should apply a JSON patch", func() {
+			var result document
+			body := `[{"op": "test", "path": "/stock", "value": 10}, {"op": "replace", "path": "/name", "value": "Notebook"}]`
+			err := patch.Apply(patch.JSONPatchContentType, []byte(body), original, &result)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(Equal(document{Name: "Notebook", Price: 999.99, Stock: 10}))
+		})
+
+		DescribeTable("should reject patches that cannot be applied",
+			func(contentType, body string, expected error, message string) {
+				var result document
+				err := patch.Apply(contentType, []byte(body), original, &result)
+
+				Expect(err).To(MatchError(expected))
+				Expect(err.Error()).To(ContainSubstring(message))
+			},
+			Entry("unsupported media type", "text/plain", `price=10`, httperr.ErrUnsupportedMediaType, `"text/plain"`),
+			Entry("malformed merge patch", patch.MergePatchContentType, `{`, domain.ErrInvalidArgument, "malformed merge patch"),
+			Entry("null member", patch.MergePatchContentType, `{"name": null}`, domain.ErrValidation, `"name" cannot be removed`),
+			Entry("removed member", patch.JSONPatchContentType, `[{"op": "remove", "path": "/stock"}]`, domain.ErrValidation, `"stock" cannot be removed`),
+			Entry("unknown field", patch.MergePatchContentType, `{"color": "red"}`, domain.ErrValidation, `unknown field "color"`),
+			Entry("wrong type", patch.MergePatchContentType, `{"price": "cheap"}`, domain.ErrValidation, `invalid value for "price"`),
+			Entry("non-object result", patch.MergePatchContentType, `[1]`, domain.ErrValidation, "must be an object"),
+			Entry("failed test", patch.JSONPatchContentType, `[{"op": "test", "path": "/stock", "value": 5}]`, domain.ErrConflict, `test failed at "/stock"`),
+		)
+	})
+
+	Describe("MergePatch", func() {
+		// Examples from RFC 7396 Appendix A
+		DescribeTable("should merge the patch into the document",
+			func(doc, body, expected string) {
+				result, err := patch.MergePatch(decode(doc), []byte(body))
+
+				Expect(err).ToNot(HaveOccurred())
+				Expect(result).To(Equal(decode(expected)))
+			},
+			Entry("replace member", `{"a":"b"}`, `{"a":"c"}`, `{"a":"c"}`),
+			Entry("add member", `{"a":"b"}`, `{"b":"c"}`, `{"a":"b","b":"c"}`),
+			Entry("remove member", `{"a":"b"}`, `{"a":null}`, `{}`),
+			Entry("remove one of two", `{"a":"b","b":"c"}`, `{"a":null}`, `{"b":"c"}`),
+			Entry("replace array", `{"a":["b"]}`, `{"a":"c"}`, `{"a":"c"}`),
+			Entry("replace with array", `{"a":"c"}`, `{"a":["b"]}`, `{"a":["b"]}`),
+			Entry("nested objects", `{"a":{"b":"c"}}`, `{"a":{"b":"d","c":null}}`, `{"a":{"b":"d"}}`),
+			Entry("arrays are not merged", `{"a":[{"b":"c"}]}`, `{"a":[1]}`, `{"a":[1]}`),
+			Entry("replace document", `{"a":"foo"}`, `"bar"`, `"bar"`),
+			Entry("null inside new object", `{}`, `{"a":{"bb":{"ccc":null}}}`, `{"a":{"bb":{}}}`),
+		)
+	})
+
+	Describe("JSONPatch", func() {
+		// Examples from RFC 6902 Appendix A
+		DescribeTable("should apply the operations",
+			func(doc, body, expected string) {
+				result, err := patch.JSONPatch(decode(doc), []byte(body))
+
+				Expect(err).ToNot(HaveOccurred())
+				Expect(result).To(Equal(decode(expected)))
+			},
+			Entry("add object member", `{"foo":"bar"}`, `[{"op":"add","path":"/baz","value":"qux"}]`, `{"baz":"qux","foo":"bar"}`),
+			Entry("add array element", `{"foo":["bar","baz"]}`, `[{"op":"add","path":"/foo/1","value":"qux"}]`, `{"foo":["bar","qux","baz"]}`),
+			Entry("append array element", `{"foo":["bar"]}`, `[{"op":"add","path":"/foo/-","value":"baz"}]`, `{"foo":["bar","baz"]}`),
+			Entry("remove object member", `{"baz":"qux","foo":"bar"}`, `[{"op":"remove","path":"/baz"}]`, `{"foo":"bar"}`),
+			Entry("remove array element", `{"foo":["bar","qux","baz"]}`, `[{"op":"remove","path":"/foo/1"}]`, `{"foo":["bar","baz"]}`),
+			Entry("replace value", `{"baz":"qux","foo":"bar"}`, `[{"op":"replace","path":"/baz","value":"boo"}]`, `{"baz":"boo","foo":"bar"}`),
+			Entry("move value", `{"foo":{"bar":"baz","waldo":"fred"},"qux":{"corge":"grault"}}`,
+				`[{"op":"move","from":"/foo/waldo","path":"/qux/thud"}]`,
+				`{"foo":{"bar":"baz"},"qux":{"corge":"grault","thud":"fred"}}`),
+			Entry("move array element", `{"foo":["all","grass","cows","eat"]}`, `[{"op":"move","from":"/foo/1","path":"/foo/3"}]`, `{"foo":["all","cows","eat","grass"]}`),
+			Entry("copy value", `{"foo":{"bar":1}}`, `[{"op":"copy","from":"/foo","path":"/baz"}]`, `{"foo":{"bar":1},"baz":{"bar":1}}`),
+			Entry("test value", `{"baz":"qux","foo":["a",2,"c"]}`, `[{"op":"test","path":"/baz","value":"qux"},{"op":"test","path":"/foo/1","value":2}]`, `{"baz":"qux","foo":["a",2,"c"]}`),
+			Entry("add nested member", `{"foo":"bar"}`, `[{"op":"add","path":"/child","value":{"grandchild":{}}}]`, `{"foo":"bar","child":{"grandchild":{}}}`),
+			Entry("escaped pointer", `{"/":9,"~1":10}`, `[{"op":"test","path":"/~01","value":10},{"op":"replace","path":"/~1","value":1}]`, `{"/":1,"~1":10}`),
+			Entry("add null value", `{"foo":"bar"}`, `[{"op":"add","path":"/baz","value":null}]`, `{"foo":"bar","baz":null}`),
+		)
+
+		DescribeTable("should reject invalid operations",
+			func(doc, body string, expected error) {
+				_, err := patch.JSONPatch(decode(doc), []byte(body))
+
+				Expect(err).To(MatchError(expected))
+			},
+			Entry("malformed document", `{}`, `{"op":"add"}`, domain.ErrInvalidArgument),
+			Entry("unknown operation", `{}`, `[{"op":"merge","path":"/a","value":1}]`, domain.ErrInvalidArgument),
+			Entry("missing value", `{}`, `[{"op":"add","path":"/a"}]`, domain.ErrInvalidArgument),
+			Entry("malformed path", `{}`, `[{"op":"add","path":"a","value":1}]`, domain.ErrInvalidArgument),
+			Entry("add to missing parent", `{"foo":"bar"}`, `[{"op":"add","path":"/baz/bat","value":"qux"}]`, domain.ErrValidation),
+			Entry("replace missing member", `{"foo":"bar"}`, `[{"op":"replace","path":"/baz","value":"qux"}]`, domain.ErrValidation),
+			Entry("remove missing member", `{"foo":"bar"}`, `[{"op":"remove","path":"/baz"}]`, domain.ErrValidation),
+			Entry("array index out of bounds", `{"foo":["bar"]}`, `[{"op":"add","path":"/foo/5","value":1}]`, domain.ErrValidation),
+			Entry("move into own child", `{"foo":{"bar":1}}`, `[{"op":"move","from":"/foo","path":"/foo/bar"}]`, domain.ErrInvalidArgument),
+			Entry("failed test", `{"foo":"bar"}`, `[{"op":"test","path":"/foo","value":"baz"}]`, domain.ErrConflict),
+		)
+
+		It("should not apply any operation when one fails", func() {
+			doc := decode(`{"foo":"bar"}`)
+
+			_, err := patch.JSONPatch(doc, []byte(`[{"op":"replace","path":"/foo","value":"baz"},{"op":"remove","path":"/missing"}]`))
+
+			Expect(err).To(MatchError(domain.ErrValidation))
+			Expect(err.Error()).To(HavePrefix("operation 1:"))
+		})
+	})
+
+	Describe("Changed", func() {
+		It("should return nil for an unchanged value", func() {
+			Expect(patch.Changed(5, 5)).To(BeNil())
+		})
+
+		It("should return the new value when it changed", func() {
+			changed := patch.Changed("a", "b")
+
+			Expect(changed).ToNot(BeNil())
+			Expect(*changed).To(Equal("b"))
+		})
+	})
+})
@@ -0,0 +1,63 @@
+package producthdl
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"gin-swagger-api/internal/domain"
+	"gin-swagger-api/internal/handler/batch"
+	"gin-swagger-api/internal/handler/httperr"
+)
+
+// BatchProductsRequest represents the body of a product batch
+type BatchProductsRequest = batch.Request[CreateProductRequest, BatchUpdateProductRequest]
+
+// BatchProducts godoc
+// @Summary Create, update and delete products in a batch
+// @Description Apply up to 100 product creates, updates and deletes in one request: creates first, then updates, then deletes.
+// @Description In all_or_nothing mode (the default) the batch runs in one transaction and stops at the first failing item; nothing is saved and the other items report 424.
+// @Description In best_effort mode each item is saved on its own.
+// @Description Each result carries the status code the single-product endpoint would have returned. The response is 200 when every item succeeded and 207 otherwise.
+// @Tags products
+// @Accept json
+// @Produce json
+// @Param batch body BatchProductsRequest true "Products to create, update and delete"
+// @Param Idempotency-Key header string false "Key that makes retries return the first response instead of repeating the request"
+// @Success 200 {object} batch.Response[producthdl.ProductResponse]
+// @Success 207 {object} batch.Response[producthdl.ProductResponse]
+// @Failure 400 {object} httperr.Problem
+// @Failure 409 {object} httperr.Problem
+// @Failure 422 {object} httperr.Problem
+// @Failure 500 {object} httperr.Problem
+// @Router /products/batch [post]
+func (h *Handler) BatchProducts(c *gin.Context) {
+	var req BatchProductsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		httperr.BadRequest(c, err)
+		return
+	}
+
+	b, err := batch.ToBatch(req,
+		func(r CreateProductRequest) domain.Product {
+			return domain.Product{Name: r.Name, Description: r.Description, Price: toPrice(r.Price), Stock: r.Stock}
+		},
+		func(r BatchUpdateProductRequest) domain.BatchUpdate[domain.Product] {
+			return domain.BatchUpdate[domain.Product]{
+				Version: r.Version,
+				Value:   domain.Product{ID: r.ID, Name: r.Name, Description: r.Description, Price: toPrice(r.Price), Stock: r.Stock},
+			}
+		},
+	)
+	if err != nil {
+		httperr.Respond(c, err)
+		return
+	}
+
+	results, err := h.productService.BatchProducts(c.Request.Context(), b)
+	if err != nil {
+		httperr.Respond(c, err)
+		return
+	}
+
+	resp := batch.NewResponse(b.Mode, results, toProductResponse)
+	c.JSON(resp.Status(), resp)
+}
@@ -0,0 +1,87 @@
+package producthdl_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"gin-swagger-api/config"
+	"gin-swagger-api/internal/domain"
+	"gin-swagger-api/internal/handler/batch"
+	"gin-swagger-api/internal/handler/producthdl"
+	"gin-swagger-api/internal/testutil"
+	mockproductsvc "gin-swagger-api/mock/service/productsvc"
+)
+
+var _ = Describe("Handler BatchProducts", func() {
+	var (
+		mockService *mockproductsvc.MockService
+		handler     *producthdl.Handler
+		ctx         context.Context
+	)
+
+	BeforeEach(func() {
+		gin.SetMode(gin.TestMode)
+		mockService = mockproductsvc.NewMockService(GinkgoT())
+		handler = producthdl.NewHandler(mockService, &config.Config{MaxUploadSize: 1 << 20})
+		ctx = context.Background()
+	})
+
+	post := func(body string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodPost, "/api/v1/products/batch", strings.NewReader(body)).WithContext(ctx)
+		c.Request.Header.Set("Content-Type", "application/json")
+		handler.BatchProducts(c)
+		return w
+	}
+
+	Describe("BatchProducts", func() {
+		It("should pass the batch to the service and report each item", func() {
+			mockService.EXPECT().
+				BatchProducts(ctx, domain.Batch[domain.Product]{
+					Mode:   domain.BatchAllOrNothing,
+					Create: []domain.Product{{Name: "Mouse", Price: testutil.THB("20"), Stock: 5}},
+					Update: []domain.BatchUpdate[domain.Product]{{Value: domain.Product{ID: "2", Name: "Keyboard", Price: testutil.THB("50")}}},
+					Delete: []domain.BatchRef{},
+				}).
+				Return(&domain.BatchResults[domain.Product]{
+					Create: []domain.BatchResult[domain.Product]{{Err: domain.ErrBatchAborted}},
+					Update: []domain.BatchResult[domain.Product]{{Err: domain.ErrPreconditionFailed}},
+				}, nil)
+
+			w := post(`{
+				"create": [{"name": "Mouse", "price": 20, "stock": 5}],
+				"update": [{"id": "2", "name": "Keyboard", "price": 50}]
+			}`)
+
+			Expect(w.Code).To(Equal(http.StatusMultiStatus))
+
+			var response batch.Response[producthdl.ProductResponse]
+			Expect(json.Unmarshal(w.Body.Bytes(), &response)).To(Succeed())
+			Expect(response.Failed).To(Equal(2))
+			Expect(response.Create[0].Status).To(Equal(http.StatusFailedDependency))
+			Expect(response.Update[0].Status).To(Equal(http.StatusPreconditionFailed))
+		})
+
+		It("should validate creates like a single product creation", func() {
+			w := post(`{"create": [{"name": "Mouse", "price": 0}]}`)
+
+			Expect(w.Code).To(Equal(http.StatusBadRequest))
+			Expect(w.Body.String()).To(ContainSubstring(`"field":"create[0].price"`))
+		})
+
+		It("should require the id of updates", func() {
+			w := post(`{"update": [{"name": "Keyboard"}]}`)
+
+			Expect(w.Code).To(Equal(http.StatusBadRequest))
+			Expect(w.Body.String()).To(ContainSubstring(`"field":"update[0].id"`))
+		})
+	})
+})
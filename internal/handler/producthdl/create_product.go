@@ -4,12 +4,10 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
-)
 
-// ErrorResponse represents an error response
-type ErrorResponse struct {
-	Error string `json:"error" example:"error message"`
-}
+	"gin-swagger-api/internal/handler/etag"
+	"gin-swagger-api/internal/handler/httperr"
+)
 
 // CreateProduct godoc
 // @Summary Create a new product
@@ -18,14 +16,18 @@ type ErrorResponse struct {
 // @Accept json
 // @Produce json
 // @Param product body CreateProductRequest true "Product information"
+// @Param Idempotency-Key header string false "Key that makes retries return the first response instead of repeating the request"
 // @Success 201 {object} ProductResponse
-// @Failure 400 {object} ErrorResponse
-// @Failure 500 {object} ErrorResponse
+// @Header 201 {string} ETag "Entity tag of the current product version"
+// @Failure 400 {object} httperr.Problem
+// @Failure 409 {object} httperr.Problem
+// @Failure 422 {object} httperr.Problem
+// @Failure 500 {object} httperr.Problem
 // @Router /products [post]
 func (h *Handler) CreateProduct(c *gin.Context) {
 	var req CreateProductRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		httperr.BadRequest(c, err)
 		return
 	}
 
@@ -33,13 +35,14 @@ func (h *Handler) CreateProduct(c *gin.Context) {
 		c.Request.Context(),
 		req.Name,
 		req.Description,
-		req.Price,
+		toPrice(req.Price),
 		req.Stock,
 	)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, ErrorResponse{Error: err.Error()})
+		httperr.Respond(c, err)
 		return
 	}
 
+	etag.Set(c, product.Version())
 	c.JSON(http.StatusCreated, toProductResponse(*product))
 }
@@ -12,8 +12,11 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	"gin-swagger-api/config"
 	"gin-swagger-api/internal/domain"
+	"gin-swagger-api/internal/handler/httperr"
 	"gin-swagger-api/internal/handler/producthdl"
+	"gin-swagger-api/internal/testutil"
 	mockproductsvc "gin-swagger-api/mock/service/productsvc"
 )
 
@@ -27,7 +30,7 @@ var _ = Describe("Handler CreateProduct", func() {
 	BeforeEach(func() {
 		gin.SetMode(gin.TestMode)
 		mockService = mockproductsvc.NewMockService(GinkgoT())
-		handler = producthdl.NewHandler(mockService)
+		handler = producthdl.NewHandler(mockService, &config.Config{MaxUploadSize: 1 << 20})
 		ctx = context.Background()
 	})
 
@@ -37,17 +40,17 @@ var _ = Describe("Handler CreateProduct", func() {
 				req := producthdl.CreateProductRequest{
 					Name:        "Laptop",
 					Description: "Gaming laptop",
-					Price:       25000.50,
+					Price:       "25000.50",
 					Stock:       10,
 				}
 				product := &domain.Product{
 					ID:          "1",
 					Name:        "Laptop",
 					Description: "Gaming laptop",
-					Price:       25000.50,
+					Price:       testutil.THB("25000.50"),
 					Stock:       10,
 				}
-				mockService.EXPECT().CreateProduct(ctx, "Laptop", "Gaming laptop", 25000.50, 10).Return(product, nil)
+				mockService.EXPECT().CreateProduct(ctx, "Laptop", "Gaming laptop", testutil.THB("25000.50"), 10).Return(product, nil)
 
 				bodyBytes, _ := json.Marshal(req)
 				w := httptest.NewRecorder()
@@ -65,7 +68,7 @@ var _ = Describe("Handler CreateProduct", func() {
 				Expect(err).ToNot(HaveOccurred())
 				Expect(response.ID).To(Equal("1"))
 				Expect(response.Name).To(Equal("Laptop"))
-				Expect(response.Price).To(Equal(25000.50))
+				Expect(response.Price).To(Equal("25000.50"))
 			})
 		})
 
@@ -94,10 +97,10 @@ var _ = Describe("Handler CreateProduct", func() {
 				req := producthdl.CreateProductRequest{
 					Name:        "Laptop",
 					Description: "Gaming laptop",
-					Price:       25000.50,
+					Price:       "25000.50",
 					Stock:       10,
 				}
-				mockService.EXPECT().CreateProduct(ctx, "Laptop", "Gaming laptop", 25000.50, 10).Return(nil, errors.New("database error"))
+				mockService.EXPECT().CreateProduct(ctx, "Laptop", "Gaming laptop", testutil.THB("25000.50"), 10).Return(nil, errors.New("database error"))
 
 				bodyBytes, _ := json.Marshal(req)
 				w := httptest.NewRecorder()
@@ -110,10 +113,10 @@ var _ = Describe("Handler CreateProduct", func() {
 
 				Expect(w.Code).To(Equal(http.StatusInternalServerError))
 
-				var response producthdl.ErrorResponse
+				var response httperr.Problem
 				err := json.Unmarshal(w.Body.Bytes(), &response)
 				Expect(err).ToNot(HaveOccurred())
-				Expect(response.Error).To(Equal("database error"))
+				Expect(response.Detail).To(Equal("Internal Server Error"))
 			})
 		})
 	})
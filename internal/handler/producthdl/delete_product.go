@@ -4,25 +4,39 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+
+	"gin-swagger-api/internal/handler/etag"
+	"gin-swagger-api/internal/handler/httperr"
 )
 
 // DeleteProduct godoc
 // @Summary Delete a product
-// @Description Delete a product by ID
+// @Description Soft-delete a product by ID: it disappears from reads and can no longer be ordered, but an admin can still list it with include_deleted=true and restore it until it is purged.
+// @Description Orders with an item for the product are kept as they are.
 // @Tags products
 // @Accept json
 // @Produce json
 // @Param id path string true "Product ID"
+// @Param If-Match header string false "ETag the product must still have; required when the server enforces preconditions"
 // @Success 204
-// @Failure 404 {object} ErrorResponse
-// @Failure 500 {object} ErrorResponse
+// @Failure 400 {object} httperr.Problem
+// @Failure 404 {object} httperr.Problem
+// @Failure 412 {object} httperr.Problem
+// @Failure 428 {object} httperr.Problem
+// @Failure 500 {object} httperr.Problem
 // @Router /products/{id} [delete]
 func (h *Handler) DeleteProduct(c *gin.Context) {
 	id := c.Param("id")
 
-	err := h.productService.DeleteProduct(c.Request.Context(), id)
+	version, err := etag.IfMatch(c)
+	if err != nil {
+		httperr.Respond(c, err)
+		return
+	}
+
+	err = h.productService.DeleteProduct(c.Request.Context(), id, version)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, ErrorResponse{Error: err.Error()})
+		httperr.Respond(c, err)
 		return
 	}
 
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 
@@ -11,6 +12,10 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	"gin-swagger-api/config"
+	"gin-swagger-api/internal/domain"
+	"gin-swagger-api/internal/handler/etag"
+	"gin-swagger-api/internal/handler/httperr"
 	"gin-swagger-api/internal/handler/producthdl"
 	mockproductsvc "gin-swagger-api/mock/service/productsvc"
 )
@@ -26,7 +31,7 @@ var _ = Describe("Handler DeleteProduct", func() {
 	BeforeEach(func() {
 		gin.SetMode(gin.TestMode)
 		mockService = mockproductsvc.NewMockService(GinkgoT())
-		handler = producthdl.NewHandler(mockService)
+		handler = producthdl.NewHandler(mockService, &config.Config{MaxUploadSize: 1 << 20})
 		ctx = context.Background()
 		productID = "1"
 	})
@@ -34,7 +39,7 @@ var _ = Describe("Handler DeleteProduct", func() {
 	Describe("DeleteProduct", func() {
 		Context("when deleting an existing product", func() {
 			It("should delete product successfully", func() {
-				mockService.EXPECT().DeleteProduct(ctx, productID).Return(nil)
+				mockService.EXPECT().DeleteProduct(ctx, productID, "").Return(nil)
 
 				w := httptest.NewRecorder()
 				c, _ := gin.CreateTestContext(w)
@@ -49,9 +54,25 @@ var _ = Describe("Handler DeleteProduct", func() {
 			})
 		})
 
+		Context("when product does not exist", func() {
+			It("should return not found error", func() {
+				mockService.EXPECT().DeleteProduct(ctx, productID, "").Return(fmt.Errorf("product %w", domain.ErrNotFound))
+
+				w := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(w)
+				c.Request = httptest.NewRequest(http.MethodDelete, "/api/v1/products/"+productID, nil)
+				c.Request = c.Request.WithContext(ctx)
+				c.Params = gin.Params{{Key: "id", Value: productID}}
+
+				handler.DeleteProduct(c)
+
+				Expect(w.Code).To(Equal(http.StatusNotFound))
+			})
+		})
+
 		Context("when service returns error", func() {
 			It("should return internal server error", func() {
-				mockService.EXPECT().DeleteProduct(ctx, productID).Return(errors.New("delete failed"))
+				mockService.EXPECT().DeleteProduct(ctx, productID, "").Return(errors.New("delete failed"))
 
 				w := httptest.NewRecorder()
 				c, _ := gin.CreateTestContext(w)
@@ -63,10 +84,55 @@ var _ = Describe("Handler DeleteProduct", func() {
 
 				Expect(w.Code).To(Equal(http.StatusInternalServerError))
 
-				var response producthdl.ErrorResponse
+				var response httperr.Problem
 				err := json.Unmarshal(w.Body.Bytes(), &response)
 				Expect(err).ToNot(HaveOccurred())
-				Expect(response.Error).To(Equal("delete failed"))
+				Expect(response.Detail).To(Equal("Internal Server Error"))
+			})
+		})
+
+		Context("when If-Match is sent", func() {
+			It("should pass the expected version to the service", func() {
+				mockService.EXPECT().DeleteProduct(ctx, productID, "abc123").Return(nil)
+
+				w := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(w)
+				c.Request = httptest.NewRequest(http.MethodDelete, "/api/v1/products/"+productID, nil)
+				c.Request.Header.Set(etag.IfMatchHeader, `"abc123"`)
+				c.Request = c.Request.WithContext(ctx)
+				c.Params = gin.Params{{Key: "id", Value: productID}}
+
+				handler.DeleteProduct(c)
+
+				Expect(w.Code).To(Or(Equal(http.StatusOK), Equal(http.StatusNoContent)))
+			})
+
+			It("should return precondition failed when the product has changed", func() {
+				mockService.EXPECT().DeleteProduct(ctx, productID, "abc123").Return(fmt.Errorf("product version %w", domain.ErrPreconditionFailed))
+
+				w := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(w)
+				c.Request = httptest.NewRequest(http.MethodDelete, "/api/v1/products/"+productID, nil)
+				c.Request.Header.Set(etag.IfMatchHeader, `"abc123"`)
+				c.Request = c.Request.WithContext(ctx)
+				c.Params = gin.Params{{Key: "id", Value: productID}}
+
+				handler.DeleteProduct(c)
+
+				Expect(w.Code).To(Equal(http.StatusPreconditionFailed))
+			})
+
+			It("should reject a malformed header before calling the service", func() {
+				w := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(w)
+				c.Request = httptest.NewRequest(http.MethodDelete, "/api/v1/products/"+productID, nil)
+				c.Request.Header.Set(etag.IfMatchHeader, "abc123")
+				c.Request = c.Request.WithContext(ctx)
+				c.Params = gin.Params{{Key: "id", Value: productID}}
+
+				handler.DeleteProduct(c)
+
+				Expect(w.Code).To(Equal(http.StatusBadRequest))
 			})
 		})
 	})
@@ -0,0 +1,56 @@
+package producthdl
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"gin-swagger-api/internal/domain"
+	"gin-swagger-api/internal/handler/export"
+	"gin-swagger-api/internal/handler/httperr"
+	"gin-swagger-api/internal/handler/listquery"
+)
+
+// productTable exports a product as one CSV record
+var productTable = export.Table[domain.Product]{
+	Name:    "products",
+	Columns: []string{"id", "name", "description", "price", "stock"},
+	Records: func(product domain.Product) [][]string {
+		return [][]string{{
+			product.ID,
+			product.Name,
+			product.Description,
+			product.Price.String(),
+			strconv.Itoa(product.Stock),
+		}}
+	},
+	Value: func(product domain.Product) any { return toProductResponse(product) },
+}
+
+// ExportProducts godoc
+// @Summary Export products
+// @Description Stream every product matching the filters as CSV or NDJSON, chosen by the Accept header (CSV by default).
+// @Description Products are read from the database in batches, so exports of any size use little memory. They are ordered by id and cannot be sorted.
+// @Description Filter like the product list, with filter[field]=value or filter[field][op]=value on id, name, description, price and stock.
+// @Description Example: ?filter[stock][gt]=0
+// @Tags products
+// @Produce text/csv
+// @Produce application/x-ndjson
+// @Param include_deleted query bool false "Also return soft-deleted products; admins only"
+// @Success 200 {string} string "CSV with a header row, or one JSON product per line"
+// @Failure 400 {object} httperr.Problem
+// @Failure 403 {object} httperr.Problem
+// @Failure 406 {object} httperr.Problem
+// @Failure 500 {object} httperr.Problem
+// @Router /products/export [get]
+func (h *Handler) ExportProducts(c *gin.Context) {
+	list, err := listquery.Parse(c.Request.URL.Query())
+	if err != nil {
+		httperr.Respond(c, err)
+		return
+	}
+
+	export.Stream(c, productTable, func(emit func([]domain.Product) error) error {
+		return h.productService.ExportProducts(c.Request.Context(), list, emit)
+	})
+}
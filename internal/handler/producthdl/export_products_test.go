@@ -0,0 +1,78 @@
+package producthdl_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/gin-gonic/gin"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"gin-swagger-api/config"
+	"gin-swagger-api/internal/domain"
+	"gin-swagger-api/internal/handler/producthdl"
+	"gin-swagger-api/internal/testutil"
+	mockproductsvc "gin-swagger-api/mock/service/productsvc"
+)
+
+var _ = Describe("Handler ExportProducts", func() {
+	var (
+		mockService *mockproductsvc.MockService
+		handler     *producthdl.Handler
+		ctx         context.Context
+	)
+
+	BeforeEach(func() {
+		gin.SetMode(gin.TestMode)
+		mockService = mockproductsvc.NewMockService(GinkgoT())
+		handler = producthdl.NewHandler(mockService, &config.Config{MaxUploadSize: 1 << 20})
+		ctx = context.Background()
+	})
+
+	export := func(target, accept string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodGet, target, nil).WithContext(ctx)
+		if accept != "" {
+			c.Request.Header.Set("Accept", accept)
+		}
+		handler.ExportProducts(c)
+		return w
+	}
+
+	Describe("ExportProducts", func() {
+		BeforeEach(func() {
+			mockService.EXPECT().
+				ExportProducts(ctx, domain.ListQuery{}, mock.Anything).
+				RunAndReturn(func(_ context.Context, _ domain.ListQuery, fn func([]domain.Product) error) error {
+					return fn([]domain.Product{{ID: "1", Name: "Laptop", Description: "Gaming laptop", Price: testutil.THB("25000.5"), Stock: 10}})
+				}).
+				Maybe()
+		})
+
+		It("should stream products as CSV by default", func() {
+			w := export("/api/v1/products/export", "")
+
+			Expect(w.Code).To(Equal(http.StatusOK))
+			Expect(w.Header().Get("Content-Disposition")).To(Equal(`attachment; filename="products.csv"`))
+			Expect(w.Body.String()).To(Equal(
+				"id,name,description,price,stock\n1,Laptop,Gaming laptop,25000.50,10\n"))
+		})
+
+		It("should stream products as NDJSON when accepted", func() {
+			w := export("/api/v1/products/export", "application/x-ndjson")
+
+			Expect(w.Code).To(Equal(http.StatusOK))
+			Expect(w.Body.String()).To(Equal(
+				`{"id":"1","name":"Laptop","description":"Gaming laptop","price":"25000.50","currency":"THB","stock":10}` + "\n"))
+		})
+
+		It("should return not acceptable error for other formats", func() {
+			w := export("/api/v1/products/export", "application/xml")
+
+			Expect(w.Code).To(Equal(http.StatusNotAcceptable))
+		})
+	})
+})
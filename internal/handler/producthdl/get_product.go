@@ -4,6 +4,9 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+
+	"gin-swagger-api/internal/handler/etag"
+	"gin-swagger-api/internal/handler/httperr"
 )
 
 // GetProduct godoc
@@ -13,18 +16,23 @@ import (
 // @Accept json
 // @Produce json
 // @Param id path string true "Product ID"
+// @Param include_deleted query bool false "Also return soft-deleted products; admins only"
 // @Success 200 {object} ProductResponse
-// @Failure 404 {object} ErrorResponse
-// @Failure 500 {object} ErrorResponse
+// @Header 200 {string} ETag "Entity tag of the current product version"
+// @Failure 400 {object} httperr.Problem
+// @Failure 403 {object} httperr.Problem
+// @Failure 404 {object} httperr.Problem
+// @Failure 500 {object} httperr.Problem
 // @Router /products/{id} [get]
 func (h *Handler) GetProduct(c *gin.Context) {
 	id := c.Param("id")
 
 	product, err := h.productService.GetProduct(c.Request.Context(), id)
 	if err != nil {
-		c.JSON(http.StatusNotFound, ErrorResponse{Error: "product not found"})
+		httperr.Respond(c, err)
 		return
 	}
 
+	etag.Set(c, product.Version())
 	c.JSON(http.StatusOK, toProductResponse(*product))
 }
@@ -0,0 +1,46 @@
+package producthdl
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"gin-swagger-api/internal/handler/httperr"
+	"gin-swagger-api/internal/handler/pagination"
+)
+
+// GetProductOrders godoc
+// @Summary Get the orders for a product
+// @Description Get the orders with an item for a product, paginated by offset.
+// @Description Quantity, unit_price and line_total describe the order's line for the product.
+// @Tags products
+// @Produce json
+// @Param id path string true "Product ID"
+// @Param limit query int false "Page size (default 20, max 100)"
+// @Param offset query int false "Number of items to skip"
+// @Param include_deleted query bool false "Also return soft-deleted orders, and the orders of a soft-deleted product; admins only"
+// @Success 200 {object} pagination.Response[producthdl.ProductOrderResponse]
+// @Failure 400 {object} httperr.Problem
+// @Failure 403 {object} httperr.Problem
+// @Failure 404 {object} httperr.Problem
+// @Failure 500 {object} httperr.Problem
+// @Router /products/{id}/orders [get]
+func (h *Handler) GetProductOrders(c *gin.Context) {
+	var query pagination.OffsetQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		httperr.BadRequest(c, err)
+		return
+	}
+
+	id := c.Param("id")
+	page, err := h.productService.GetProductOrders(c.Request.Context(), id, query.PageRequest())
+	if err != nil {
+		httperr.Respond(c, err)
+		return
+	}
+
+	// The service has validated the ID
+	productID, _ := strconv.Atoi(id)
+	c.JSON(http.StatusOK, pagination.NewResponse(c, page, toProductOrderResponse(productID)))
+}
@@ -0,0 +1,124 @@
+package producthdl_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/gin-gonic/gin"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"gin-swagger-api/config"
+	"gin-swagger-api/internal/domain"
+	"gin-swagger-api/internal/handler/httperr"
+	"gin-swagger-api/internal/handler/pagination"
+	"gin-swagger-api/internal/handler/producthdl"
+	"gin-swagger-api/internal/testutil"
+	mockproductsvc "gin-swagger-api/mock/service/productsvc"
+)
+
+var _ = Describe("Handler GetProductOrders", func() {
+	var (
+		mockService *mockproductsvc.MockService
+		handler     *producthdl.Handler
+		ctx         context.Context
+		productID   string
+	)
+
+	BeforeEach(func() {
+		gin.SetMode(gin.TestMode)
+		mockService = mockproductsvc.NewMockService(GinkgoT())
+		handler = producthdl.NewHandler(mockService, &config.Config{MaxUploadSize: 1 << 20})
+		ctx = context.Background()
+		productID = "2"
+	})
+
+	Describe("GetProductOrders", func() {
+		Context("when the product has orders", func() {
+			It("should return each order with its line for the product", func() {
+				orders := []domain.Order{{
+					ID:     "7",
+					UserID: 3,
+					Items: []domain.OrderItem{
+						domain.NewOrderItem(1, 1, testutil.THB("10.00")),
+						domain.NewOrderItem(2, 3, testutil.THB("25.00")),
+					},
+					TotalPrice: testutil.THB("85.00"),
+					Status:     "shipped",
+				}}
+				mockService.EXPECT().
+					GetProductOrders(ctx, productID, domain.PageRequest{Limit: 10}).
+					Return(&domain.Page[domain.Order]{Items: orders, Total: 1, Limit: 10}, nil)
+
+				w := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(w)
+				c.Request = httptest.NewRequest(http.MethodGet, "/api/v1/products/"+productID+"/orders?limit=10", nil)
+				c.Request = c.Request.WithContext(ctx)
+				c.Params = gin.Params{{Key: "id", Value: productID}}
+
+				handler.GetProductOrders(c)
+
+				Expect(w.Code).To(Equal(http.StatusOK))
+
+				var response pagination.Response[producthdl.ProductOrderResponse]
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(response.Data).To(Equal([]producthdl.ProductOrderResponse{{
+					ID:         "7",
+					UserID:     3,
+					Quantity:   3,
+					UnitPrice:  "25.00",
+					LineTotal:  "75.00",
+					TotalPrice: "85.00",
+					Currency:   "THB",
+					Status:     "shipped",
+				}}))
+				Expect(response.Meta.Total).To(Equal(1))
+			})
+		})
+
+		Context("when the product does not exist", func() {
+			It("should return not found error", func() {
+				mockService.EXPECT().
+					GetProductOrders(ctx, productID, domain.PageRequest{}).
+					Return(nil, fmt.Errorf("product %w", domain.ErrNotFound))
+
+				w := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(w)
+				c.Request = httptest.NewRequest(http.MethodGet, "/api/v1/products/"+productID+"/orders", nil)
+				c.Request = c.Request.WithContext(ctx)
+				c.Params = gin.Params{{Key: "id", Value: productID}}
+
+				handler.GetProductOrders(c)
+
+				Expect(w.Code).To(Equal(http.StatusNotFound))
+
+				var response httperr.Problem
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(response.Detail).To(Equal("product not found"))
+			})
+		})
+
+		Context("when the product ID is invalid", func() {
+			It("should return bad request", func() {
+				mockService.EXPECT().
+					GetProductOrders(ctx, "abc", domain.PageRequest{}).
+					Return(nil, fmt.Errorf("%w: %q", domain.ErrInvalidID, "abc"))
+
+				w := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(w)
+				c.Request = httptest.NewRequest(http.MethodGet, "/api/v1/products/abc/orders", nil)
+				c.Request = c.Request.WithContext(ctx)
+				c.Params = gin.Params{{Key: "id", Value: "abc"}}
+
+				handler.GetProductOrders(c)
+
+				Expect(w.Code).To(Equal(http.StatusBadRequest))
+			})
+		})
+	})
+})
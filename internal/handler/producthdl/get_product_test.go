@@ -3,7 +3,7 @@ package producthdl_test
 import (
 	"context"
 	"encoding/json"
-	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 
@@ -11,8 +11,12 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	"gin-swagger-api/config"
 	"gin-swagger-api/internal/domain"
+	"gin-swagger-api/internal/handler/etag"
+	"gin-swagger-api/internal/handler/httperr"
 	"gin-swagger-api/internal/handler/producthdl"
+	"gin-swagger-api/internal/testutil"
 	mockproductsvc "gin-swagger-api/mock/service/productsvc"
 )
 
@@ -27,7 +31,7 @@ var _ = Describe("Handler GetProduct", func() {
 	BeforeEach(func() {
 		gin.SetMode(gin.TestMode)
 		mockService = mockproductsvc.NewMockService(GinkgoT())
-		handler = producthdl.NewHandler(mockService)
+		handler = producthdl.NewHandler(mockService, &config.Config{MaxUploadSize: 1 << 20})
 		ctx = context.Background()
 		productID = "1"
 	})
@@ -39,7 +43,7 @@ var _ = Describe("Handler GetProduct", func() {
 					ID:          productID,
 					Name:        "Laptop",
 					Description: "Gaming laptop",
-					Price:       25000.50,
+					Price:       testutil.THB("25000.50"),
 					Stock:       10,
 				}
 				mockService.EXPECT().GetProduct(ctx, productID).Return(product, nil)
@@ -59,13 +63,13 @@ var _ = Describe("Handler GetProduct", func() {
 				Expect(err).ToNot(HaveOccurred())
 				Expect(response.ID).To(Equal(productID))
 				Expect(response.Name).To(Equal("Laptop"))
-				Expect(response.Price).To(Equal(25000.50))
+				Expect(response.Price).To(Equal("25000.50"))
 			})
 		})
 
 		Context("when product does not exist", func() {
 			It("should return not found error", func() {
-				mockService.EXPECT().GetProduct(ctx, productID).Return(nil, errors.New("product not found"))
+				mockService.EXPECT().GetProduct(ctx, productID).Return(nil, fmt.Errorf("product %w", domain.ErrNotFound))
 
 				w := httptest.NewRecorder()
 				c, _ := gin.CreateTestContext(w)
@@ -77,10 +81,28 @@ var _ = Describe("Handler GetProduct", func() {
 
 				Expect(w.Code).To(Equal(http.StatusNotFound))
 
-				var response producthdl.ErrorResponse
+				var response httperr.Problem
 				err := json.Unmarshal(w.Body.Bytes(), &response)
 				Expect(err).ToNot(HaveOccurred())
-				Expect(response.Error).To(Equal("product not found"))
+				Expect(response.Detail).To(Equal("product not found"))
+			})
+		})
+
+		Context("when the product is returned", func() {
+			It("should send its version as a strong entity tag", func() {
+				product := &domain.Product{ID: productID, Name: "Laptop", Description: "Gaming laptop", Price: testutil.THB("999.99"), Stock: 10}
+				mockService.EXPECT().GetProduct(ctx, productID).Return(product, nil)
+
+				w := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(w)
+				c.Request = httptest.NewRequest(http.MethodGet, "/api/v1/products/"+productID, nil)
+				c.Request = c.Request.WithContext(ctx)
+				c.Params = gin.Params{{Key: "id", Value: productID}}
+
+				handler.GetProduct(c)
+
+				Expect(w.Code).To(Equal(http.StatusOK))
+				Expect(w.Header().Get(etag.Header)).To(Equal(`"` + product.Version() + `"`))
 			})
 		})
 	})
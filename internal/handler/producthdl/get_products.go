@@ -4,28 +4,49 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+
+	"gin-swagger-api/internal/handler/httperr"
+	"gin-swagger-api/internal/handler/listquery"
+	"gin-swagger-api/internal/handler/pagination"
 )
 
 // GetProducts godoc
 // @Summary List all products
-// @Description Get a list of all products
+// @Description Get a list of all products, paginated by offset or cursor.
+// @Description Filter with filter[field]=value or filter[field][op]=value where op is one of eq, ne, in (comma separated values), gt and lt (numeric fields) or like (text fields, case-insensitive substring).
+// @Description Filterable and sortable fields: id, name, description, price, stock.
+// @Description Example: ?filter[price][gt]=10&filter[price][lt]=50&filter[stock][gt]=0&sort=name
 // @Tags products
 // @Accept json
 // @Produce json
-// @Success 200 {array} ProductResponse
-// @Failure 500 {object} ErrorResponse
+// @Param limit query int false "Page size (default 20, max 100)"
+// @Param offset query int false "Number of items to skip"
+// @Param cursor query string false "Opaque cursor from meta.next_cursor or meta.prev_cursor; overrides offset and cannot be combined with sort"
+// @Param sort query string false "Comma separated sort fields, prefixed with - for descending order"
+// @Param include_deleted query bool false "Also return soft-deleted products; admins only"
+// @Success 200 {object} pagination.Response[producthdl.ProductResponse]
+// @Failure 400 {object} httperr.Problem
+// @Failure 403 {object} httperr.Problem
+// @Failure 500 {object} httperr.Problem
 // @Router /products [get]
 func (h *Handler) GetProducts(c *gin.Context) {
-	products, err := h.productService.GetProducts(c.Request.Context())
+	var query pagination.Query
+	if err := c.ShouldBindQuery(&query); err != nil {
+		httperr.BadRequest(c, err)
+		return
+	}
+
+	list, err := listquery.Parse(c.Request.URL.Query())
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, ErrorResponse{Error: err.Error()})
+		httperr.Respond(c, err)
 		return
 	}
 
-	response := make([]ProductResponse, len(products))
-	for i, product := range products {
-		response[i] = toProductResponse(product)
+	page, err := h.productService.GetProducts(c.Request.Context(), list, query.PageRequest())
+	if err != nil {
+		httperr.Respond(c, err)
+		return
 	}
 
-	c.JSON(http.StatusOK, response)
+	c.JSON(http.StatusOK, pagination.NewResponse(c, page, toProductResponse))
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 
@@ -11,8 +12,12 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	"gin-swagger-api/config"
 	"gin-swagger-api/internal/domain"
+	"gin-swagger-api/internal/handler/httperr"
+	"gin-swagger-api/internal/handler/pagination"
 	"gin-swagger-api/internal/handler/producthdl"
+	"gin-swagger-api/internal/testutil"
 	mockproductsvc "gin-swagger-api/mock/service/productsvc"
 )
 
@@ -26,7 +31,7 @@ var _ = Describe("Handler GetProducts", func() {
 	BeforeEach(func() {
 		gin.SetMode(gin.TestMode)
 		mockService = mockproductsvc.NewMockService(GinkgoT())
-		handler = producthdl.NewHandler(mockService)
+		handler = producthdl.NewHandler(mockService, &config.Config{MaxUploadSize: 1 << 20})
 		ctx = context.Background()
 	})
 
@@ -38,18 +43,20 @@ var _ = Describe("Handler GetProducts", func() {
 						ID:          "1",
 						Name:        "Laptop",
 						Description: "Gaming laptop",
-						Price:       25000.50,
+						Price:       testutil.THB("25000.50"),
 						Stock:       10,
 					},
 					{
 						ID:          "2",
 						Name:        "Mouse",
 						Description: "Wireless mouse",
-						Price:       599.99,
+						Price:       testutil.THB("599.99"),
 						Stock:       50,
 					},
 				}
-				mockService.EXPECT().GetProducts(ctx).Return(products, nil)
+				mockService.EXPECT().
+					GetProducts(ctx, domain.ListQuery{}, domain.PageRequest{}).
+					Return(&domain.Page[domain.Product]{Items: products, Total: 2, Limit: domain.DefaultPageSize}, nil)
 
 				w := httptest.NewRecorder()
 				c, _ := gin.CreateTestContext(w)
@@ -60,20 +67,121 @@ var _ = Describe("Handler GetProducts", func() {
 
 				Expect(w.Code).To(Equal(http.StatusOK))
 
-				var response []producthdl.ProductResponse
+				var response pagination.Response[producthdl.ProductResponse]
 				err := json.Unmarshal(w.Body.Bytes(), &response)
 				Expect(err).ToNot(HaveOccurred())
-				Expect(response).To(HaveLen(2))
-				Expect(response[0].ID).To(Equal("1"))
-				Expect(response[0].Name).To(Equal("Laptop"))
-				Expect(response[1].ID).To(Equal("2"))
-				Expect(response[1].Name).To(Equal("Mouse"))
+				Expect(response.Data).To(HaveLen(2))
+				Expect(response.Meta.Total).To(Equal(2))
+				Expect(response.Meta.Limit).To(Equal(domain.DefaultPageSize))
+				Expect(response.Links.Self).To(Equal("/api/v1/products"))
+				Expect(response.Data[0].ID).To(Equal("1"))
+				Expect(response.Data[0].Name).To(Equal("Laptop"))
+				Expect(response.Data[1].ID).To(Equal("2"))
+				Expect(response.Data[1].Name).To(Equal("Mouse"))
+			})
+		})
+
+		Context("when paging parameters are given", func() {
+			It("should pass them to the service", func() {
+				mockService.EXPECT().
+					GetProducts(ctx, domain.ListQuery{}, domain.PageRequest{Limit: 1, Offset: 1}).
+					Return(&domain.Page[domain.Product]{Items: []domain.Product{}, Total: 3, Limit: 1, Offset: 1}, nil)
+
+				w := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(w)
+				c.Request = httptest.NewRequest(http.MethodGet, "/api/v1/products?limit=1&offset=1", nil)
+				c.Request = c.Request.WithContext(ctx)
+
+				handler.GetProducts(c)
+
+				Expect(w.Code).To(Equal(http.StatusOK))
+
+				var response pagination.Response[producthdl.ProductResponse]
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(response.Links.Next).To(Equal("/api/v1/products?limit=1&offset=2"))
+				Expect(response.Links.Prev).To(Equal("/api/v1/products?limit=1&offset=0"))
+			})
+
+			It("should return bad request for an invalid limit", func() {
+				w := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(w)
+				c.Request = httptest.NewRequest(http.MethodGet, "/api/v1/products?limit=-1", nil)
+				c.Request = c.Request.WithContext(ctx)
+
+				handler.GetProducts(c)
+
+				Expect(w.Code).To(Equal(http.StatusBadRequest))
+
+				var response httperr.Problem
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(response.Errors).To(ContainElement(httperr.FieldError{Field: "limit", Message: "must be at least 1"}))
+			})
+		})
+
+		Context("when filter parameters are given", func() {
+			It("should pass the parsed list query to the service", func() {
+				mockService.EXPECT().
+					GetProducts(ctx, domain.ListQuery{
+						Filters: []domain.Filter{
+							{Field: "price", Op: domain.OpGt, Values: []any{"10"}},
+							{Field: "stock", Op: domain.OpGt, Values: []any{"0"}},
+						},
+						Sort: []domain.Sort{{Field: "name"}},
+					}, domain.PageRequest{}).
+					Return(&domain.Page[domain.Product]{Items: []domain.Product{}, Total: 1, Limit: domain.DefaultPageSize}, nil)
+
+				w := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(w)
+				c.Request = httptest.NewRequest(http.MethodGet, "/api/v1/products?filter[price][gt]=10&filter[stock][gt]=0&sort=name", nil)
+				c.Request = c.Request.WithContext(ctx)
+
+				handler.GetProducts(c)
+
+				Expect(w.Code).To(Equal(http.StatusOK))
+			})
+
+			It("should return bad request for a malformed filter", func() {
+				w := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(w)
+				c.Request = httptest.NewRequest(http.MethodGet, "/api/v1/products?filter[id][between]=1", nil)
+				c.Request = c.Request.WithContext(ctx)
+
+				handler.GetProducts(c)
+
+				Expect(w.Code).To(Equal(http.StatusBadRequest))
+
+				var response httperr.Problem
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(response.Type).To(Equal("/problems/invalid-argument"))
+			})
+
+			It("should return bad request when the service rejects a field", func() {
+				mockService.EXPECT().
+					GetProducts(ctx, domain.ListQuery{Sort: []domain.Sort{{Field: "secret"}}}, domain.PageRequest{}).
+					Return(nil, fmt.Errorf("%w: unknown sort field %q", domain.ErrInvalidArgument, "secret"))
+
+				w := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(w)
+				c.Request = httptest.NewRequest(http.MethodGet, "/api/v1/products?sort=secret", nil)
+				c.Request = c.Request.WithContext(ctx)
+
+				handler.GetProducts(c)
+
+				Expect(w.Code).To(Equal(http.StatusBadRequest))
+
+				var response httperr.Problem
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(response.Detail).To(ContainSubstring(`unknown sort field "secret"`))
 			})
 		})
 
 		Context("when service returns error", func() {
 			It("should return internal server error", func() {
-				mockService.EXPECT().GetProducts(ctx).Return(nil, errors.New("service error"))
+				mockService.EXPECT().GetProducts(ctx, domain.ListQuery{}, domain.PageRequest{}).Return(nil, errors.New("service error"))
 
 				w := httptest.NewRecorder()
 				c, _ := gin.CreateTestContext(w)
@@ -84,10 +192,10 @@ var _ = Describe("Handler GetProducts", func() {
 
 				Expect(w.Code).To(Equal(http.StatusInternalServerError))
 
-				var response producthdl.ErrorResponse
+				var response httperr.Problem
 				err := json.Unmarshal(w.Body.Bytes(), &response)
 				Expect(err).ToNot(HaveOccurred())
-				Expect(response.Error).To(Equal("service error"))
+				Expect(response.Detail).To(Equal("Internal Server Error"))
 			})
 		})
 	})
@@ -1,6 +1,7 @@
 package producthdl
 
 import (
+	"gin-swagger-api/config"
 	"gin-swagger-api/internal/middleware"
 	"gin-swagger-api/internal/port/service/productsvc"
 
@@ -10,12 +11,15 @@ import (
 // Handler handles product-related HTTP requests
 type Handler struct {
 	productService productsvc.Service
+	maxUploadSize  int64
 }
 
-// NewHandler creates a new product handler
-func NewHandler(productService productsvc.Service) *Handler {
+// NewHandler creates a new product handler. Uploads are limited to the
+// configured MaxUploadSize.
+func NewHandler(productService productsvc.Service, cfg *config.Config) *Handler {
 	return &Handler{
 		productService: productService,
+		maxUploadSize:  cfg.MaxUploadSize,
 	}
 }
 
@@ -25,9 +29,16 @@ func (h *Handler) RegisterRoutes(rg *gin.RouterGroup) {
 	products.Use(middleware.Logger()) // Apply logger to all product routes
 	{
 		products.POST("", h.CreateProduct)
+		products.POST("/batch", h.BatchProducts)
+		products.POST("/import", h.ImportProducts)
+		products.GET("/export", h.ExportProducts)
 		products.GET("/:id", h.GetProduct)
-		products.PUT("/:id", h.UpdateProduct)
-		products.DELETE("/:id", h.DeleteProduct)
+		products.PUT("/:id", middleware.RequireIfMatch(), h.UpdateProduct)
+		products.PATCH("/:id", middleware.RequireIfMatch(), h.PatchProduct)
+		products.DELETE("/:id", middleware.RequireIfMatch(), h.DeleteProduct)
+		products.POST("/:id/restore", middleware.RequireAdmin(), h.RestoreProduct)
+		products.POST("/:id/purge", middleware.RequireAdmin(), h.PurgeProduct)
 		products.GET("", h.GetProducts)
+		products.GET("/:id/orders", h.GetProductOrders)
 	}
 }
@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/mock"
 
 	"github.com/gin-gonic/gin"
+	"gin-swagger-api/config"
 	"gin-swagger-api/internal/domain"
 	"gin-swagger-api/internal/handler/producthdl"
 	mockproductsvc "gin-swagger-api/mock/service/productsvc"
@@ -24,7 +25,7 @@ var _ = Describe("ProductHandler RegisterRoutes", func() {
 	BeforeEach(func() {
 		gin.SetMode(gin.TestMode)
 		mockService = mockproductsvc.NewMockService(GinkgoT())
-		handler = producthdl.NewHandler(mockService)
+		handler = producthdl.NewHandler(mockService, &config.Config{MaxUploadSize: 1 << 20})
 		router = gin.New()
 	})
 
@@ -62,6 +63,58 @@ var _ = Describe("ProductHandler RegisterRoutes", func() {
 				}
 			}
 			Expect(found).To(BeTrue(), "Route GET /api/v1/products should be registered")
+
+			// Verify GET /products/:id/orders (nested list) route exists
+			found = false
+			for _, route := range routes {
+				if route.Method == "GET" && route.Path == "/api/v1/products/:id/orders" {
+					found = true
+					break
+				}
+			}
+			Expect(found).To(BeTrue(), "Route GET /api/v1/products/:id/orders should be registered")
+
+			// Verify GET /products/export route exists
+			found = false
+			for _, route := range routes {
+				if route.Method == "GET" && route.Path == "/api/v1/products/export" {
+					found = true
+					break
+				}
+			}
+			Expect(found).To(BeTrue(), "Route GET /api/v1/products/export should be registered")
+
+			// Verify POST /products/batch route exists
+			found = false
+			for _, route := range routes {
+				if route.Method == "POST" && route.Path == "/api/v1/products/batch" {
+					found = true
+					break
+				}
+			}
+			Expect(found).To(BeTrue(), "Route POST /api/v1/products/batch should be registered")
+
+			// Verify the admin restore and purge routes exist
+			for _, path := range []string{"/api/v1/products/:id/restore", "/api/v1/products/:id/purge"} {
+				found = false
+				for _, route := range routes {
+					if route.Method == "POST" && route.Path == path {
+						found = true
+						break
+					}
+				}
+				Expect(found).To(BeTrue(), "Route POST %s should be registered", path)
+			}
+
+			// Verify POST /products/import route exists
+			found = false
+			for _, route := range routes {
+				if route.Method == "POST" && route.Path == "/api/v1/products/import" {
+					found = true
+					break
+				}
+			}
+			Expect(found).To(BeTrue(), "Route POST /api/v1/products/import should be registered")
 		})
 
 		It("should apply routes under correct group prefix", func() {
@@ -80,8 +133,8 @@ var _ = Describe("ProductHandler RegisterRoutes", func() {
 
 			// Mock the service call
 			mockService.EXPECT().
-				GetProducts(mock.Anything).
-				Return([]domain.Product{}, nil).
+				GetProducts(mock.Anything, mock.Anything, mock.Anything).
+				Return(&domain.Page[domain.Product]{}, nil).
 				Once()
 
 			w := httptest.NewRecorder()
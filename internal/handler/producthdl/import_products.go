@@ -0,0 +1,85 @@
+package producthdl
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/gin-gonic/gin"
+
+	"gin-swagger-api/internal/domain"
+	"gin-swagger-api/internal/handler/httperr"
+)
+
+// ImportProducts godoc
+// @Summary Import products
+// @Description Create and update products from an uploaded CSV or JSON Lines file, sent as the multipart field "file" and recognised by its .csv or .jsonl extension.
+// @Description CSV needs a header row naming any of the columns id, name, description, price and stock; JSON Lines has one object per line with the same keys.
+// @Description Rows without an id create a product and are validated like a product creation. Rows with an id update only the fields they have a value for; blank or missing fields, such as the stock, are left as they are.
+// @Description Rows that fail are reported and skipped; the other rows are saved together in one transaction. With dry_run=true nothing is saved.
+// @Tags products
+// @Accept multipart/form-data
+// @Produce json
+// @Param file formData file true "CSV or JSON Lines file of products"
+// @Param dry_run query bool false "Report what the import would do without saving it"
+// @Param Idempotency-Key header string false "Key that makes retries return the first response instead of repeating the request"
+// @Success 200 {object} ImportReportResponse
+// @Failure 400 {object} httperr.Problem
+// @Failure 409 {object} httperr.Problem
+// @Failure 413 {object} httperr.Problem
+// @Failure 415 {object} httperr.Problem
+// @Failure 422 {object} httperr.Problem
+// @Failure 500 {object} httperr.Problem
+// @Router /products/import [post]
+func (h *Handler) ImportProducts(c *gin.Context) {
+	var query ImportProductsQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		httperr.BadRequest(c, err)
+		return
+	}
+
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, h.maxUploadSize)
+	header, err := c.FormFile("file")
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			httperr.Abort(c, http.StatusRequestEntityTooLarge, fmt.Sprintf("upload is larger than %d bytes", h.maxUploadSize))
+			return
+		}
+		httperr.Respond(c, fmt.Errorf("%w: a file must be uploaded in the multipart field \"file\"", domain.ErrInvalidArgument))
+		return
+	}
+
+	format, err := uploadFormat(header)
+	if err != nil {
+		httperr.Respond(c, err)
+		return
+	}
+
+	file, err := header.Open()
+	if err != nil {
+		httperr.Respond(c, err)
+		return
+	}
+	defer file.Close()
+
+	rows, failures, err := readUpload(file, format)
+	if err != nil {
+		httperr.Respond(c, err)
+		return
+	}
+
+	results, err := h.productService.ImportProducts(c.Request.Context(), rows, query.DryRun)
+	if err != nil {
+		httperr.Respond(c, err)
+		return
+	}
+
+	results = append(results, failures...)
+	slices.SortFunc(results, func(a, b domain.ImportResult) int {
+		return cmp.Compare(a.Row, b.Row)
+	})
+	c.JSON(http.StatusOK, toImportReportResponse(query.DryRun, results))
+}
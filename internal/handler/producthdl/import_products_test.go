@@ -0,0 +1,218 @@
+package producthdl_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"gin-swagger-api/config"
+	"gin-swagger-api/internal/domain"
+	"gin-swagger-api/internal/handler/httperr"
+	"gin-swagger-api/internal/handler/producthdl"
+	"gin-swagger-api/internal/testutil"
+	mockproductsvc "gin-swagger-api/mock/service/productsvc"
+)
+
+var _ = Describe("Handler ImportProducts", func() {
+	var (
+		mockService *mockproductsvc.MockService
+		handler     *producthdl.Handler
+		ctx         context.Context
+	)
+
+	BeforeEach(func() {
+		gin.SetMode(gin.TestMode)
+		mockService = mockproductsvc.NewMockService(GinkgoT())
+		handler = producthdl.NewHandler(mockService, &config.Config{MaxUploadSize: 1 << 20})
+		ctx = context.Background()
+	})
+
+	upload := func(target, filename, content string) *httptest.ResponseRecorder {
+		body := &bytes.Buffer{}
+		form := multipart.NewWriter(body)
+		if filename != "" {
+			part, err := form.CreateFormFile("file", filename)
+			Expect(err).ToNot(HaveOccurred())
+			_, err = part.Write([]byte(content))
+			Expect(err).ToNot(HaveOccurred())
+		}
+		Expect(form.Close()).To(Succeed())
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodPost, target, body).WithContext(ctx)
+		c.Request.Header.Set("Content-Type", form.FormDataContentType())
+
+		handler.ImportProducts(c)
+		return w
+	}
+
+	report := func(w *httptest.ResponseRecorder) producthdl.ImportReportResponse {
+		var response producthdl.ImportReportResponse
+		Expect(json.Unmarshal(w.Body.Bytes(), &response)).To(Succeed())
+		return response
+	}
+
+	Describe("ImportProducts", func() {
+		Context("with a CSV upload", func() {
+			It("should import valid rows and report invalid ones", func() {
+				name, price := "Keyboard, mechanical", testutil.THB("50.5")
+				mockService.EXPECT().
+					ImportProducts(ctx, []domain.ProductImportRow{
+						{Row: 2, Product: domain.Product{Name: "Mouse", Description: "Wireless", Price: testutil.THB("20"), Stock: 5}},
+						{Row: 5, Product: domain.Product{ID: "7"}, Patch: domain.ProductPatch{Name: &name, Price: &price}},
+					}, false).
+					Return([]domain.ImportResult{
+						{Row: 2, Status: domain.ImportCreated, ID: "10"},
+						{Row: 5, Status: domain.ImportUpdated, ID: "7"},
+					}, nil)
+
+				w := upload("/api/v1/products/import", "products.csv",
+					"name,description,price,stock,id\n"+
+						"Mouse,Wireless,20,5,\n"+
+						"Monitor,,-1,2,\n"+
+						"Cable,,abc,1,\n"+
+						"\"Keyboard, mechanical\",,50.5,,7\n")
+
+				Expect(w.Code).To(Equal(http.StatusOK))
+				Expect(report(w)).To(Equal(producthdl.ImportReportResponse{
+					Created: 1,
+					Updated: 1,
+					Failed:  2,
+					Rows: []producthdl.ImportRowResponse{
+						{Row: 2, Status: "created", ID: "10"},
+						{Row: 3, Status: "failed", Errors: []httperr.FieldError{{Field: "price", Message: "must be a positive amount with at most 2 decimal places"}}},
+						{Row: 4, Status: "failed", Errors: []httperr.FieldError{{Field: "price", Message: "must be a positive amount with at most 2 decimal places"}}},
+						{Row: 5, Status: "updated", ID: "7"},
+					},
+				}))
+			})
+
+			It("should validate the columns an update row has", func() {
+				mockService.EXPECT().
+					ImportProducts(ctx, []domain.ProductImportRow(nil), false).
+					Return(nil, nil)
+
+				w := upload("/api/v1/products/import", "products.csv", "id,stock\n7,-1\n")
+
+				Expect(w.Code).To(Equal(http.StatusOK))
+				Expect(report(w)).To(Equal(producthdl.ImportReportResponse{
+					Failed: 1,
+					Rows: []producthdl.ImportRowResponse{
+						{Row: 2, Status: "failed", Errors: []httperr.FieldError{{Field: "stock", Message: "must be greater than or equal to 0"}}},
+					},
+				}))
+			})
+
+			It("should reject unknown columns", func() {
+				w := upload("/api/v1/products/import", "products.csv", "name,colour\nMouse,red\n")
+
+				Expect(w.Code).To(Equal(http.StatusBadRequest))
+				Expect(w.Body.String()).To(ContainSubstring(`unknown column \"colour\"`))
+			})
+		})
+
+		Context("with a JSON Lines upload", func() {
+			It("should import each line", func() {
+				stock := 0
+				mockService.EXPECT().
+					ImportProducts(ctx, []domain.ProductImportRow{
+						{Row: 1, Product: domain.Product{Name: "Mouse", Price: testutil.THB("20"), Stock: 5}},
+						{Row: 3, Product: domain.Product{ID: "7"}, Patch: domain.ProductPatch{Stock: &stock}},
+					}, false).
+					Return([]domain.ImportResult{
+						{Row: 1, Status: domain.ImportCreated, ID: "10"},
+						{Row: 3, Status: domain.ImportUpdated, ID: "7"},
+					}, nil)
+
+				w := upload("/api/v1/products/import", "products.jsonl",
+					`{"name":"Mouse","price":20,"stock":5}`+"\n"+
+						`{"name":"Monitor","price":"cheap"}`+"\n"+
+						`{"id":7,"stock":0}`+"\n"+
+						"\n"+
+						`{"name":"Lamp","price":5,"colour":"red"}`+"\n"+
+						`not json`+"\n")
+
+				Expect(w.Code).To(Equal(http.StatusOK))
+				response := report(w)
+				Expect(response.Created).To(Equal(1))
+				Expect(response.Updated).To(Equal(1))
+				Expect(response.Failed).To(Equal(3))
+				Expect(response.Rows[1]).To(Equal(producthdl.ImportRowResponse{
+					Row: 2, Status: "failed", Errors: []httperr.FieldError{{Field: "price", Message: "must be a positive amount with at most 2 decimal places"}},
+				}))
+				Expect(response.Rows[3]).To(Equal(producthdl.ImportRowResponse{
+					Row: 5, Status: "failed", Errors: []httperr.FieldError{{Field: "colour", Message: "is not a product field"}},
+				}))
+				Expect(response.Rows[4].Row).To(Equal(6))
+			})
+		})
+
+		Context("in dry run mode", func() {
+			It("should pass the dry run flag to the service", func() {
+				mockService.EXPECT().
+					ImportProducts(ctx, mock.Anything, true).
+					Return([]domain.ImportResult{{Row: 2, Status: domain.ImportCreated}}, nil)
+
+				w := upload("/api/v1/products/import?dry_run=true", "products.csv", "name,price\nMouse,20\n")
+
+				Expect(w.Code).To(Equal(http.StatusOK))
+				Expect(report(w)).To(Equal(producthdl.ImportReportResponse{
+					DryRun:  true,
+					Created: 1,
+					Rows:    []producthdl.ImportRowResponse{{Row: 2, Status: "created"}},
+				}))
+			})
+		})
+
+		Context("when the upload is invalid", func() {
+			It("should return bad request error without a file", func() {
+				w := upload("/api/v1/products/import", "", "")
+
+				Expect(w.Code).To(Equal(http.StatusBadRequest))
+			})
+
+			It("should return bad request error for a file without rows", func() {
+				w := upload("/api/v1/products/import", "products.csv", "name,price\n")
+
+				Expect(w.Code).To(Equal(http.StatusBadRequest))
+			})
+
+			It("should return unsupported media type error for other files", func() {
+				w := upload("/api/v1/products/import", "products.xlsx", "binary")
+
+				Expect(w.Code).To(Equal(http.StatusUnsupportedMediaType))
+			})
+
+			It("should return request entity too large error above the upload limit", func() {
+				handler = producthdl.NewHandler(mockService, &config.Config{MaxUploadSize: 64})
+
+				w := upload("/api/v1/products/import", "products.csv", "name,price\n"+strings.Repeat("Mouse,20\n", 20))
+
+				Expect(w.Code).To(Equal(http.StatusRequestEntityTooLarge))
+			})
+		})
+
+		Context("when the service fails", func() {
+			It("should return internal server error", func() {
+				mockService.EXPECT().
+					ImportProducts(ctx, mock.Anything, false).
+					Return(nil, errors.New("database error"))
+
+				w := upload("/api/v1/products/import", "products.csv", "name,price\nMouse,20\n")
+
+				Expect(w.Code).To(Equal(http.StatusInternalServerError))
+			})
+		})
+	})
+})
@@ -1,30 +1,104 @@
 package producthdl
 
-import "gin-swagger-api/internal/domain"
+import (
+	"time"
 
-// ProductResponse represents the API response for a product
+	"gin-swagger-api/internal/domain"
+	"gin-swagger-api/internal/handler/httperr"
+	"gin-swagger-api/internal/handler/money"
+	"gin-swagger-api/internal/handler/patch"
+)
+
+// ProductResponse represents the API response for a product. The price is
+// a decimal string in the currency given. DeletedAt is only set on
+// soft-deleted products.
 type ProductResponse struct {
-	ID          string  `json:"id" example:"1"`
-	Name        string  `json:"name" example:"Laptop"`
-	Description string  `json:"description" example:"Gaming laptop"`
-	Price       float64 `json:"price" example:"25000.50"`
-	Stock       int     `json:"stock" example:"10"`
+	ID          string     `json:"id" example:"1"`
+	Name        string     `json:"name" example:"Laptop"`
+	Description string     `json:"description" example:"Gaming laptop"`
+	Price       string     `json:"price" example:"25000.50"`
+	Currency    string     `json:"currency" example:"THB"`
+	Stock       int        `json:"stock" example:"10"`
+	DeletedAt   *time.Time `json:"deleted_at,omitempty" example:"2026-01-15T10:00:00Z"`
+}
+
+// ProductOrderResponse represents an order in the list of orders for a
+// product. Quantity, UnitPrice and LineTotal describe the order's line for
+// the product; TotalPrice covers the whole order.
+type ProductOrderResponse struct {
+	ID         string `json:"id" example:"1"`
+	UserID     int    `json:"user_id" example:"1"`
+	Quantity   int    `json:"quantity" example:"2"`
+	UnitPrice  string `json:"unit_price" example:"25000.50"`
+	LineTotal  string `json:"line_total" example:"50001.00"`
+	TotalPrice string `json:"total_price" example:"50001.00"`
+	Currency   string `json:"currency" example:"THB"`
+	Status     string `json:"status" enums:"pending,paid,shipped,delivered,cancelled" example:"pending"`
 }
 
-// CreateProductRequest represents the request body for creating a product
+// CreateProductRequest represents the request body for creating a product.
+// The price is an amount of the base currency, sent as a decimal string or
+// number with at most two decimal places.
 type CreateProductRequest struct {
-	Name        string  `json:"name" binding:"required" example:"Laptop"`
-	Description string  `json:"description" example:"Gaming laptop"`
-	Price       float64 `json:"price" binding:"required,gt=0" example:"25000.50"`
-	Stock       int     `json:"stock" binding:"gte=0" example:"10"`
+	Name        string       `json:"name" binding:"required" example:"Laptop"`
+	Description string       `json:"description" example:"Gaming laptop"`
+	Price       money.Amount `json:"price" binding:"required,amount" example:"25000.50"`
+	Stock       int          `json:"stock" binding:"gte=0" example:"10"`
 }
 
 // UpdateProductRequest represents the request body for updating a product
 type UpdateProductRequest struct {
-	Name        string  `json:"name" example:"Laptop"`
-	Description string  `json:"description" example:"Gaming laptop"`
-	Price       float64 `json:"price" example:"25000.50"`
-	Stock       int     `json:"stock" example:"10"`
+	Name        string       `json:"name" example:"Laptop"`
+	Description string       `json:"description" example:"Gaming laptop"`
+	Price       money.Amount `json:"price" binding:"omitempty,amount" example:"25000.50"`
+	Stock       int          `json:"stock" example:"10"`
+}
+
+// BatchUpdateProductRequest represents a product to update in a batch. A
+// version, the product's ETag without quotes, must match the current
+// version.
+type BatchUpdateProductRequest struct {
+	ID          string       `json:"id" binding:"required" example:"1"`
+	Version     string       `json:"version" example:"5d41402abc4b2a76"`
+	Name        string       `json:"name" example:"Laptop"`
+	Description string       `json:"description" example:"Gaming laptop"`
+	Price       money.Amount `json:"price" binding:"omitempty,amount" example:"25000.50"`
+	Stock       int          `json:"stock" example:"10"`
+}
+
+// PatchProductRequest is the product document a PATCH request is applied to
+type PatchProductRequest struct {
+	Name        string       `json:"name" binding:"required" example:"Laptop"`
+	Description string       `json:"description" example:"Gaming laptop"`
+	Price       money.Amount `json:"price" binding:"required,amount" example:"25000.50"`
+	Stock       int          `json:"stock" binding:"gte=0" example:"10"`
+}
+
+// toPrice converts a price checked by the amount rule to the base
+// currency. An empty price is zero.
+func toPrice(price money.Amount) domain.Money {
+	m, _ := price.Money(domain.BaseCurrency)
+	return m
+}
+
+// toPatchProductRequest converts domain.Product to the document PATCH applies to
+func toPatchProductRequest(product domain.Product) PatchProductRequest {
+	return PatchProductRequest{
+		Name:        product.Name,
+		Description: product.Description,
+		Price:       money.Amount(product.Price.String()),
+		Stock:       product.Stock,
+	}
+}
+
+// toProductPatch returns the fields of req that differ from original
+func (req PatchProductRequest) toProductPatch(original PatchProductRequest) domain.ProductPatch {
+	return domain.ProductPatch{
+		Name:        patch.Changed(original.Name, req.Name),
+		Description: patch.Changed(original.Description, req.Description),
+		Price:       patch.Changed(toPrice(original.Price), toPrice(req.Price)),
+		Stock:       patch.Changed(original.Stock, req.Stock),
+	}
 }
 
 // toProductResponse converts domain.Product to ProductResponse
@@ -33,7 +107,82 @@ func toProductResponse(product domain.Product) ProductResponse {
 		ID:          product.ID,
 		Name:        product.Name,
 		Description: product.Description,
-		Price:       product.Price,
+		Price:       product.Price.String(),
+		Currency:    product.Price.Currency,
 		Stock:       product.Stock,
+		DeletedAt:   product.DeletedAt,
+	}
+}
+
+// toProductOrderResponse converts domain.Order to ProductOrderResponse,
+// taking the line for productID
+func toProductOrderResponse(productID int) func(domain.Order) ProductOrderResponse {
+	return func(order domain.Order) ProductOrderResponse {
+		item, _ := order.Item(productID)
+		return ProductOrderResponse{
+			ID:         order.ID,
+			UserID:     order.UserID,
+			Quantity:   item.Quantity,
+			UnitPrice:  item.UnitPrice.String(),
+			LineTotal:  item.LineTotal.String(),
+			TotalPrice: order.TotalPrice.String(),
+			Currency:   order.TotalPrice.Currency,
+			Status:     order.Status,
+		}
+	}
+}
+
+// ImportProductsQuery represents the query parameters of a product import
+type ImportProductsQuery struct {
+	DryRun bool `form:"dry_run"`
+}
+
+// ImportReportResponse reports what a product import did with each row
+type ImportReportResponse struct {
+	DryRun  bool                `json:"dry_run" example:"false"`
+	Created int                 `json:"created" example:"2"`
+	Updated int                 `json:"updated" example:"1"`
+	Failed  int                 `json:"failed" example:"1"`
+	Rows    []ImportRowResponse `json:"rows"`
+}
+
+// ImportRowResponse reports the outcome of one row of an import. Row is
+// the line of the upload the row starts on.
+type ImportRowResponse struct {
+	Row    int                  `json:"row" example:"2"`
+	Status string               `json:"status" enums:"created,updated,failed" example:"created"`
+	ID     string               `json:"id,omitempty" example:"12"`
+	Errors []httperr.FieldError `json:"errors,omitempty"`
+}
+
+// toImportReportResponse converts import results, in row order, to
+// ImportReportResponse
+func toImportReportResponse(dryRun bool, results []domain.ImportResult) ImportReportResponse {
+	resp := ImportReportResponse{
+		DryRun: dryRun,
+		Rows:   make([]ImportRowResponse, len(results)),
+	}
+	for i, result := range results {
+		switch result.Status {
+		case domain.ImportCreated:
+			resp.Created++
+		case domain.ImportUpdated:
+			resp.Updated++
+		case domain.ImportFailed:
+			resp.Failed++
+		}
+
+		resp.Rows[i] = ImportRowResponse{
+			Row:    result.Row,
+			Status: result.Status,
+			ID:     result.ID,
+		}
+		for _, fe := range result.Errors {
+			resp.Rows[i].Errors = append(resp.Rows[i].Errors, httperr.FieldError{
+				Field:   fe.Field,
+				Message: fe.Message,
+			})
+		}
 	}
+	return resp
 }
@@ -0,0 +1,76 @@
+package producthdl
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+
+	"gin-swagger-api/internal/handler/etag"
+	"gin-swagger-api/internal/handler/httperr"
+	"gin-swagger-api/internal/handler/patch"
+)
+
+// PatchProduct godoc
+// @Summary Partially update a product
+// @Description Update only the supplied fields of a product.
+// @Description Send a JSON Merge Patch object (application/merge-patch+json or application/json) or a JSON Patch array (application/json-patch+json).
+// @Description Fields cannot be removed or set to null.
+// @Tags products
+// @Accept json,application/merge-patch+json,application/json-patch+json
+// @Produce json
+// @Param id path string true "Product ID"
+// @Param If-Match header string false "ETag the product must still have; required when the server enforces preconditions"
+// @Param patch body PatchProductRequest true "Fields to change"
+// @Success 200 {object} ProductResponse
+// @Header 200 {string} ETag "Entity tag of the current product version"
+// @Failure 400 {object} httperr.Problem
+// @Failure 404 {object} httperr.Problem
+// @Failure 409 {object} httperr.Problem
+// @Failure 412 {object} httperr.Problem
+// @Failure 415 {object} httperr.Problem
+// @Failure 422 {object} httperr.Problem
+// @Failure 428 {object} httperr.Problem
+// @Failure 500 {object} httperr.Problem
+// @Router /products/{id} [patch]
+func (h *Handler) PatchProduct(c *gin.Context) {
+	id := c.Param("id")
+
+	body, err := c.GetRawData()
+	if err != nil {
+		httperr.BadRequest(c, err)
+		return
+	}
+
+	current, err := h.productService.GetProduct(c.Request.Context(), id)
+	if err != nil {
+		httperr.Respond(c, err)
+		return
+	}
+
+	version, err := etag.Check(c, current.Version())
+	if err != nil {
+		httperr.Respond(c, err)
+		return
+	}
+
+	original := toPatchProductRequest(*current)
+	var req PatchProductRequest
+	if err := patch.Apply(c.ContentType(), body, original, &req); err != nil {
+		httperr.Respond(c, err)
+		return
+	}
+	if err := binding.Validator.ValidateStruct(&req); err != nil {
+		httperr.BadRequest(c, err)
+		return
+	}
+
+	product, err := h.productService.PatchProduct(c.Request.Context(), id, version, req.toProductPatch(original))
+	if err != nil {
+		httperr.Respond(c, err)
+		return
+	}
+
+	etag.Set(c, product.Version())
+	c.JSON(http.StatusOK, toProductResponse(*product))
+}
@@ -0,0 +1,214 @@
+package producthdl_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/gin-gonic/gin"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"gin-swagger-api/config"
+	"gin-swagger-api/internal/domain"
+	"gin-swagger-api/internal/handler/etag"
+	"gin-swagger-api/internal/handler/httperr"
+	"gin-swagger-api/internal/handler/patch"
+	"gin-swagger-api/internal/handler/producthdl"
+	"gin-swagger-api/internal/testutil"
+	mockproductsvc "gin-swagger-api/mock/service/productsvc"
+)
+
+var _ = Describe("Handler PatchProduct", func() {
+	var (
+		mockService *mockproductsvc.MockService
+		handler     *producthdl.Handler
+		ctx         context.Context
+		productID   string
+		current     *domain.Product
+		sendPatch   func(contentType, body string) *httptest.ResponseRecorder
+	)
+
+	BeforeEach(func() {
+		gin.SetMode(gin.TestMode)
+		mockService = mockproductsvc.NewMockService(GinkgoT())
+		handler = producthdl.NewHandler(mockService, &config.Config{MaxUploadSize: 1 << 20})
+		ctx = context.Background()
+		productID = "1"
+		current = &domain.Product{ID: productID, Name: "Laptop", Description: "Gaming laptop", Price: testutil.THB("999.99"), Stock: 10}
+
+		sendPatch = func(contentType, body string) *httptest.ResponseRecorder {
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest(http.MethodPatch, "/api/v1/products/"+productID, bytes.NewBufferString(body))
+			c.Request.Header.Set("Content-Type", contentType)
+			c.Request = c.Request.WithContext(ctx)
+			c.Params = gin.Params{{Key: "id", Value: productID}}
+
+			handler.PatchProduct(c)
+			return w
+		}
+	})
+
+	Describe("PatchProduct", func() {
+		Context("when sending a merge patch", func() {
+			It("should update only the supplied fields", func() {
+				price := testutil.THB("10")
+				patched := *current
+				patched.Price = price
+				mockService.EXPECT().GetProduct(ctx, productID).Return(current, nil)
+				mockService.EXPECT().PatchProduct(ctx, productID, "", domain.ProductPatch{Price: &price}).Return(&patched, nil)
+
+				w := sendPatch(patch.MergePatchContentType, `{"price": 10}`)
+
+				Expect(w.Code).To(Equal(http.StatusOK))
+
+				var response producthdl.ProductResponse
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(response).To(Equal(producthdl.ProductResponse{
+					ID:          productID,
+					Name:        "Laptop",
+					Description: "Gaming laptop",
+					Price:       "10.00",
+					Currency:    "THB",
+					Stock:       10,
+				}))
+			})
+
+			It("should skip fields that keep their value", func() {
+				mockService.EXPECT().GetProduct(ctx, productID).Return(current, nil)
+				mockService.EXPECT().PatchProduct(ctx, productID, "", domain.ProductPatch{}).Return(current, nil)
+
+				w := sendPatch("application/json", `{"name": "Laptop", "price": 999.99}`)
+
+				Expect(w.Code).To(Equal(http.StatusOK))
+			})
+		})
+
+		Context("when sending a JSON patch", func() {
+			It("should apply the operations", func() {
+				name := "Notebook"
+				stock := 9
+				patched := *current
+				patched.Name = name
+				patched.Stock = stock
+				mockService.EXPECT().GetProduct(ctx, productID).Return(current, nil)
+				mockService.EXPECT().PatchProduct(ctx, productID, "", domain.ProductPatch{Name: &name, Stock: &stock}).Return(&patched, nil)
+
+				w := sendPatch(patch.JSONPatchContentType, `[
+					{"op": "test", "path": "/stock", "value": 10},
+					{"op": "replace", "path": "/stock", "value": 9},
+					{"op": "replace", "path": "/name", "value": "Notebook"}
+				]`)
+
+				Expect(w.Code).To(Equal(http.StatusOK))
+			})
+
+			It("should return conflict when a test operation fails", func() {
+				mockService.EXPECT().GetProduct(ctx, productID).Return(current, nil)
+
+				w := sendPatch(patch.JSONPatchContentType, `[{"op": "test", "path": "/stock", "value": 3}]`)
+
+				Expect(w.Code).To(Equal(http.StatusConflict))
+			})
+		})
+
+		Context("when the patched product is invalid", func() {
+			It("should return bad request with field errors", func() {
+				mockService.EXPECT().GetProduct(ctx, productID).Return(current, nil)
+
+				w := sendPatch(patch.MergePatchContentType, `{"price": -1}`)
+
+				Expect(w.Code).To(Equal(http.StatusBadRequest))
+
+				var response httperr.Problem
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(response.Errors).To(ConsistOf(httperr.FieldError{Field: "price", Message: "must be a positive amount with at most 2 decimal places"}))
+			})
+
+			It("should return unprocessable entity when a field is removed", func() {
+				mockService.EXPECT().GetProduct(ctx, productID).Return(current, nil)
+
+				w := sendPatch(patch.MergePatchContentType, `{"name": null}`)
+
+				Expect(w.Code).To(Equal(http.StatusUnprocessableEntity))
+
+				var response httperr.Problem
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(response.Detail).To(ContainSubstring(`"name" cannot be removed`))
+			})
+
+			It("should return unprocessable entity for an unknown field", func() {
+				mockService.EXPECT().GetProduct(ctx, productID).Return(current, nil)
+
+				w := sendPatch(patch.MergePatchContentType, `{"id": "2"}`)
+
+				Expect(w.Code).To(Equal(http.StatusUnprocessableEntity))
+			})
+		})
+
+		Context("when the content type is not supported", func() {
+			It("should return unsupported media type", func() {
+				mockService.EXPECT().GetProduct(ctx, productID).Return(current, nil)
+
+				w := sendPatch("text/plain", `price=10`)
+
+				Expect(w.Code).To(Equal(http.StatusUnsupportedMediaType))
+			})
+		})
+
+		Context("when product does not exist", func() {
+			It("should return not found", func() {
+				mockService.EXPECT().GetProduct(ctx, productID).Return(nil, fmt.Errorf("product %w", domain.ErrNotFound))
+
+				w := sendPatch(patch.MergePatchContentType, `{"price": 10}`)
+
+				Expect(w.Code).To(Equal(http.StatusNotFound))
+			})
+		})
+
+		Context("when If-Match is sent", func() {
+			var sendConditionalPatch func(ifMatch string) *httptest.ResponseRecorder
+
+			BeforeEach(func() {
+				sendConditionalPatch = func(ifMatch string) *httptest.ResponseRecorder {
+					w := httptest.NewRecorder()
+					c, _ := gin.CreateTestContext(w)
+					c.Request = httptest.NewRequest(http.MethodPatch, "/api/v1/products/"+productID, bytes.NewBufferString(`{"stock": 3}`))
+					c.Request.Header.Set("Content-Type", patch.MergePatchContentType)
+					c.Request.Header.Set(etag.IfMatchHeader, ifMatch)
+					c.Request = c.Request.WithContext(ctx)
+					c.Params = gin.Params{{Key: "id", Value: productID}}
+
+					handler.PatchProduct(c)
+					return w
+				}
+			})
+
+			It("should pass the current version to the service", func() {
+				stock := 3
+				mockService.EXPECT().GetProduct(ctx, productID).Return(current, nil)
+				mockService.EXPECT().PatchProduct(ctx, productID, current.Version(), domain.ProductPatch{Stock: &stock}).Return(current, nil)
+
+				w := sendConditionalPatch(`"` + current.Version() + `"`)
+
+				Expect(w.Code).To(Equal(http.StatusOK))
+				Expect(w.Header().Get(etag.Header)).To(Equal(`"` + current.Version() + `"`))
+			})
+
+			It("should return precondition failed without patching a stale version", func() {
+				mockService.EXPECT().GetProduct(ctx, productID).Return(current, nil)
+
+				w := sendConditionalPatch(`"stale"`)
+
+				Expect(w.Code).To(Equal(http.StatusPreconditionFailed))
+			})
+		})
+	})
+})
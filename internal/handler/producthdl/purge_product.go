@@ -0,0 +1,39 @@
+package producthdl
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"gin-swagger-api/internal/handler/httperr"
+)
+
+// PurgeProduct godoc
+// @Summary Purge a deleted product
+// @Description Remove a soft-deleted product for good; 409 when the product is not deleted.
+// @Description What happens to the orders with an item for the product depends on PRODUCT_DELETE_POLICY, applied in the same transaction as the purge:
+// @Description restrict (default) refuses with 409 and counts the blocking orders in blocking_orders,
+// @Description cascade deletes those orders and returns the stock of the ones that have not shipped,
+// @Description nullify keeps them, with their prices and totals, without the reference to the product.
+// @Description Requires an admin API key.
+// @Tags products
+// @Param id path string true "Product ID"
+// @Param X-API-Key header string true "Admin API key"
+// @Param Idempotency-Key header string false "Key that makes retries return the first response instead of repeating the request"
+// @Success 204
+// @Failure 400 {object} httperr.Problem
+// @Failure 403 {object} httperr.Problem
+// @Failure 404 {object} httperr.Problem
+// @Failure 409 {object} httperr.Problem
+// @Failure 422 {object} httperr.Problem
+// @Failure 500 {object} httperr.Problem
+// @Router /products/{id}/purge [post]
+func (h *Handler) PurgeProduct(c *gin.Context) {
+	id := c.Param("id")
+	if err := h.productService.PurgeProduct(c.Request.Context(), id); err != nil {
+		httperr.Respond(c, err)
+		return
+	}
+
+	c.Data(http.StatusNoContent, "", nil)
+}
@@ -0,0 +1,91 @@
+package producthdl_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/gin-gonic/gin"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"gin-swagger-api/config"
+	"gin-swagger-api/internal/domain"
+	"gin-swagger-api/internal/handler/httperr"
+	"gin-swagger-api/internal/handler/producthdl"
+	mockproductsvc "gin-swagger-api/mock/service/productsvc"
+)
+
+var _ = Describe("Handler PurgeProduct", func() {
+	var (
+		mockService *mockproductsvc.MockService
+		handler     *producthdl.Handler
+		ctx         context.Context
+		productID   string
+	)
+
+	BeforeEach(func() {
+		gin.SetMode(gin.TestMode)
+		mockService = mockproductsvc.NewMockService(GinkgoT())
+		handler = producthdl.NewHandler(mockService, &config.Config{MaxUploadSize: 1 << 20})
+		ctx = context.Background()
+		productID = "123"
+	})
+
+	// purge calls the handler for productID
+	purge := func() *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodPost, "/api/v1/products/"+productID+"/purge", nil)
+		c.Request = c.Request.WithContext(ctx)
+		c.Params = gin.Params{{Key: "id", Value: productID}}
+
+		handler.PurgeProduct(c)
+		return w
+	}
+
+	Describe("PurgeProduct", func() {
+		It("should purge the product", func() {
+			mockService.EXPECT().PurgeProduct(ctx, productID).Return(nil)
+
+			w := purge()
+
+			Expect(w.Code).To(Equal(http.StatusNoContent))
+		})
+
+		It("should return conflict when the product is not deleted", func() {
+			mockService.EXPECT().PurgeProduct(ctx, productID).Return(fmt.Errorf("product 123 is %w", domain.ErrNotDeleted))
+
+			w := purge()
+
+			Expect(w.Code).To(Equal(http.StatusConflict))
+
+			var problem httperr.Problem
+			Expect(json.Unmarshal(w.Body.Bytes(), &problem)).To(Succeed())
+			Expect(problem.Type).To(Equal("/problems/not-deleted"))
+		})
+
+		It("should return conflict with the count of blocking orders", func() {
+			mockService.EXPECT().PurgeProduct(ctx, productID).Return(&domain.InUseError{Entity: "product", Orders: 2})
+
+			w := purge()
+
+			Expect(w.Code).To(Equal(http.StatusConflict))
+
+			var problem httperr.Problem
+			Expect(json.Unmarshal(w.Body.Bytes(), &problem)).To(Succeed())
+			Expect(problem.Type).To(Equal("/problems/in-use"))
+			Expect(problem.Extensions).To(HaveKeyWithValue("blocking_orders", BeNumerically("==", 2)))
+		})
+
+		It("should return not found when the product does not exist", func() {
+			mockService.EXPECT().PurgeProduct(ctx, productID).Return(fmt.Errorf("product %w", domain.ErrNotFound))
+
+			w := purge()
+
+			Expect(w.Code).To(Equal(http.StatusNotFound))
+		})
+	})
+})
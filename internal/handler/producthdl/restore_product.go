@@ -0,0 +1,40 @@
+package producthdl
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"gin-swagger-api/internal/handler/etag"
+	"gin-swagger-api/internal/handler/httperr"
+)
+
+// RestoreProduct godoc
+// @Summary Restore a deleted product
+// @Description Bring back a soft-deleted product; restoring a product that is not deleted returns it unchanged.
+// @Description Requires an admin API key.
+// @Tags products
+// @Produce json
+// @Param id path string true "Product ID"
+// @Param X-API-Key header string true "Admin API key"
+// @Param Idempotency-Key header string false "Key that makes retries return the first response instead of repeating the request"
+// @Success 200 {object} ProductResponse
+// @Header 200 {string} ETag "Entity tag of the current product version"
+// @Failure 400 {object} httperr.Problem
+// @Failure 403 {object} httperr.Problem
+// @Failure 404 {object} httperr.Problem
+// @Failure 409 {object} httperr.Problem
+// @Failure 422 {object} httperr.Problem
+// @Failure 500 {object} httperr.Problem
+// @Router /products/{id}/restore [post]
+func (h *Handler) RestoreProduct(c *gin.Context) {
+	id := c.Param("id")
+	product, err := h.productService.RestoreProduct(c.Request.Context(), id)
+	if err != nil {
+		httperr.Respond(c, err)
+		return
+	}
+
+	etag.Set(c, product.Version())
+	c.JSON(http.StatusOK, toProductResponse(*product))
+}
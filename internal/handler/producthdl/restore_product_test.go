@@ -0,0 +1,82 @@
+package producthdl_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/gin-gonic/gin"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"gin-swagger-api/config"
+	"gin-swagger-api/internal/domain"
+	"gin-swagger-api/internal/handler/producthdl"
+	"gin-swagger-api/internal/testutil"
+	mockproductsvc "gin-swagger-api/mock/service/productsvc"
+)
+
+var _ = Describe("Handler RestoreProduct", func() {
+	var (
+		mockService *mockproductsvc.MockService
+		handler     *producthdl.Handler
+		ctx         context.Context
+		productID   string
+	)
+
+	BeforeEach(func() {
+		gin.SetMode(gin.TestMode)
+		mockService = mockproductsvc.NewMockService(GinkgoT())
+		handler = producthdl.NewHandler(mockService, &config.Config{MaxUploadSize: 1 << 20})
+		ctx = context.Background()
+		productID = "123"
+	})
+
+	// restore calls the handler for productID
+	restore := func() *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodPost, "/api/v1/products/"+productID+"/restore", nil)
+		c.Request = c.Request.WithContext(ctx)
+		c.Params = gin.Params{{Key: "id", Value: productID}}
+
+		handler.RestoreProduct(c)
+		return w
+	}
+
+	Describe("RestoreProduct", func() {
+		It("should return the restored product with its ETag", func() {
+			restored := &domain.Product{ID: productID, Name: "Laptop", Price: testutil.THB("999.99"), Stock: 10}
+			mockService.EXPECT().RestoreProduct(ctx, productID).Return(restored, nil)
+
+			w := restore()
+
+			Expect(w.Code).To(Equal(http.StatusOK))
+			Expect(w.Header().Get("ETag")).To(Equal(`"` + restored.Version() + `"`))
+
+			var response map[string]any
+			Expect(json.Unmarshal(w.Body.Bytes(), &response)).To(Succeed())
+			Expect(response).To(HaveKeyWithValue("id", productID))
+			Expect(response).ToNot(HaveKey("deleted_at"))
+		})
+
+		It("should return not found when the product does not exist", func() {
+			mockService.EXPECT().RestoreProduct(ctx, productID).Return(nil, fmt.Errorf("product %w", domain.ErrNotFound))
+
+			w := restore()
+
+			Expect(w.Code).To(Equal(http.StatusNotFound))
+		})
+
+		It("should return bad request for an invalid ID", func() {
+			productID = "abc"
+			mockService.EXPECT().RestoreProduct(ctx, productID).Return(nil, fmt.Errorf("%w: %q", domain.ErrInvalidID, productID))
+
+			w := restore()
+
+			Expect(w.Code).To(Equal(http.StatusBadRequest))
+		})
+	})
+})
@@ -5,6 +5,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 
@@ -12,8 +13,12 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	"gin-swagger-api/config"
 	"gin-swagger-api/internal/domain"
+	"gin-swagger-api/internal/handler/etag"
+	"gin-swagger-api/internal/handler/httperr"
 	"gin-swagger-api/internal/handler/producthdl"
+	"gin-swagger-api/internal/testutil"
 	mockproductsvc "gin-swagger-api/mock/service/productsvc"
 )
 
@@ -28,7 +33,7 @@ var _ = Describe("Handler UpdateProduct", func() {
 	BeforeEach(func() {
 		gin.SetMode(gin.TestMode)
 		mockService = mockproductsvc.NewMockService(GinkgoT())
-		handler = producthdl.NewHandler(mockService)
+		handler = producthdl.NewHandler(mockService, &config.Config{MaxUploadSize: 1 << 20})
 		ctx = context.Background()
 		productID = "1"
 	})
@@ -39,17 +44,17 @@ var _ = Describe("Handler UpdateProduct", func() {
 				req := producthdl.UpdateProductRequest{
 					Name:        "Gaming Laptop",
 					Description: "Updated gaming laptop",
-					Price:       29999.99,
+					Price:       "29999.99",
 					Stock:       5,
 				}
 				product := &domain.Product{
 					ID:          productID,
 					Name:        "Gaming Laptop",
 					Description: "Updated gaming laptop",
-					Price:       29999.99,
+					Price:       testutil.THB("29999.99"),
 					Stock:       5,
 				}
-				mockService.EXPECT().UpdateProduct(ctx, productID, "Gaming Laptop", "Updated gaming laptop", 29999.99, 5).Return(product, nil)
+				mockService.EXPECT().UpdateProduct(ctx, productID, "", "Gaming Laptop", "Updated gaming laptop", testutil.THB("29999.99"), 5).Return(product, nil)
 
 				bodyBytes, _ := json.Marshal(req)
 				w := httptest.NewRecorder()
@@ -68,7 +73,7 @@ var _ = Describe("Handler UpdateProduct", func() {
 				Expect(err).ToNot(HaveOccurred())
 				Expect(response.ID).To(Equal(productID))
 				Expect(response.Name).To(Equal("Gaming Laptop"))
-				Expect(response.Price).To(Equal(29999.99))
+				Expect(response.Price).To(Equal("29999.99"))
 			})
 		})
 
@@ -94,10 +99,10 @@ var _ = Describe("Handler UpdateProduct", func() {
 				req := producthdl.UpdateProductRequest{
 					Name:        "Gaming Laptop",
 					Description: "Updated gaming laptop",
-					Price:       29999.99,
+					Price:       "29999.99",
 					Stock:       5,
 				}
-				mockService.EXPECT().UpdateProduct(ctx, productID, "Gaming Laptop", "Updated gaming laptop", 29999.99, 5).Return(nil, errors.New("update failed"))
+				mockService.EXPECT().UpdateProduct(ctx, productID, "", "Gaming Laptop", "Updated gaming laptop", testutil.THB("29999.99"), 5).Return(nil, errors.New("update failed"))
 
 				bodyBytes, _ := json.Marshal(req)
 				w := httptest.NewRecorder()
@@ -111,10 +116,53 @@ var _ = Describe("Handler UpdateProduct", func() {
 
 				Expect(w.Code).To(Equal(http.StatusInternalServerError))
 
-				var response producthdl.ErrorResponse
+				var response httperr.Problem
 				err := json.Unmarshal(w.Body.Bytes(), &response)
 				Expect(err).ToNot(HaveOccurred())
-				Expect(response.Error).To(Equal("update failed"))
+				Expect(response.Detail).To(Equal("Internal Server Error"))
+			})
+		})
+
+		Context("when If-Match is sent", func() {
+			var sendUpdate func() *httptest.ResponseRecorder
+
+			BeforeEach(func() {
+				sendUpdate = func() *httptest.ResponseRecorder {
+					bodyBytes, _ := json.Marshal(producthdl.UpdateProductRequest{Name: "Gaming Laptop", Description: "Updated gaming laptop", Price: "29999.99", Stock: 5})
+					w := httptest.NewRecorder()
+					c, _ := gin.CreateTestContext(w)
+					c.Request = httptest.NewRequest(http.MethodPut, "/api/v1/products/"+productID, bytes.NewBuffer(bodyBytes))
+					c.Request.Header.Set("Content-Type", "application/json")
+					c.Request.Header.Set(etag.IfMatchHeader, `"abc123"`)
+					c.Request = c.Request.WithContext(ctx)
+					c.Params = gin.Params{{Key: "id", Value: productID}}
+
+					handler.UpdateProduct(c)
+					return w
+				}
+			})
+
+			It("should pass the expected version and return the new entity tag", func() {
+				product := &domain.Product{ID: productID, Name: "Gaming Laptop", Description: "Updated gaming laptop", Price: testutil.THB("29999.99"), Stock: 5}
+				mockService.EXPECT().UpdateProduct(ctx, productID, "abc123", "Gaming Laptop", "Updated gaming laptop", testutil.THB("29999.99"), 5).Return(product, nil)
+
+				w := sendUpdate()
+
+				Expect(w.Code).To(Equal(http.StatusOK))
+				Expect(w.Header().Get(etag.Header)).To(Equal(`"` + product.Version() + `"`))
+			})
+
+			It("should return precondition failed when the product has changed", func() {
+				mockService.EXPECT().UpdateProduct(ctx, productID, "abc123", "Gaming Laptop", "Updated gaming laptop", testutil.THB("29999.99"), 5).Return(nil, fmt.Errorf("product version %w", domain.ErrPreconditionFailed))
+
+				w := sendUpdate()
+
+				Expect(w.Code).To(Equal(http.StatusPreconditionFailed))
+
+				var response httperr.Problem
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(response.Type).To(Equal("/problems/precondition-failed"))
 			})
 		})
 	})
@@ -0,0 +1,246 @@
+package producthdl
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin/binding"
+
+	"gin-swagger-api/internal/domain"
+	"gin-swagger-api/internal/handler/httperr"
+	"gin-swagger-api/internal/handler/money"
+)
+
+// Formats of an import upload
+const (
+	formatCSV   = "csv"
+	formatJSONL = "jsonl"
+)
+
+// importColumns are the CSV columns and JSON keys of an import row
+var importColumns = []string{"id", "name", "description", "price", "stock"}
+
+// importRow is a row of an import upload. Fields the row leaves out, or
+// blank in a CSV, are nil. A row without an id is validated like a
+// CreateProductRequest; a row with an id updates the fields it has of that
+// product, each validated as in a creation.
+type importRow struct {
+	ID          json.Number   `json:"id"`
+	Name        *string       `json:"name" binding:"omitnil,required"`
+	Description *string       `json:"description"`
+	Price       *money.Amount `json:"price" binding:"omitnil,amount"`
+	Stock       *int          `json:"stock" binding:"omitnil,gte=0"`
+}
+
+// toCreateProductRequest returns the product a row without an id creates
+func (row importRow) toCreateProductRequest() CreateProductRequest {
+	var req CreateProductRequest
+	if row.Name != nil {
+		req.Name = *row.Name
+	}
+	if row.Description != nil {
+		req.Description = *row.Description
+	}
+	if row.Price != nil {
+		req.Price = *row.Price
+	}
+	if row.Stock != nil {
+		req.Stock = *row.Stock
+	}
+	return req
+}
+
+// toProductPatch returns the fields a row with an id updates
+func (row importRow) toProductPatch() domain.ProductPatch {
+	productPatch := domain.ProductPatch{Name: row.Name, Description: row.Description, Stock: row.Stock}
+	if row.Price != nil {
+		price := toPrice(*row.Price)
+		productPatch.Price = &price
+	}
+	return productPatch
+}
+
+// uploadFormat returns the format of an uploaded file from its extension,
+// or from its content type when the extension is not known
+func uploadFormat(header *multipart.FileHeader) (string, error) {
+	switch strings.ToLower(filepath.Ext(header.Filename)) {
+	case ".csv":
+		return formatCSV, nil
+	case ".jsonl", ".ndjson":
+		return formatJSONL, nil
+	}
+
+	mediaType, _, _ := mime.ParseMediaType(header.Header.Get("Content-Type"))
+	switch mediaType {
+	case "text/csv":
+		return formatCSV, nil
+	case "application/jsonl", "application/x-ndjson":
+		return formatJSONL, nil
+	}
+	return "", fmt.Errorf("%w: upload a .csv or .jsonl file", httperr.ErrUnsupportedMediaType)
+}
+
+// readUpload reads the rows of an upload. Rows that cannot be parsed or
+// fail validation are returned as failed results instead of rows.
+func readUpload(r io.Reader, format string) ([]domain.ProductImportRow, []domain.ImportResult, error) {
+	var rows []domain.ProductImportRow
+	var failures []domain.ImportResult
+	add := func(line int, row importRow, fields []domain.FieldError) {
+		create := row.ID == ""
+		if fields == nil && create {
+			fields = httperr.FieldErrors(binding.Validator.ValidateStruct(row.toCreateProductRequest()))
+		} else if fields == nil {
+			fields = httperr.FieldErrors(binding.Validator.ValidateStruct(row))
+		}
+		if fields != nil {
+			failures = append(failures, domain.ImportResult{Row: line, Status: domain.ImportFailed, Errors: fields})
+			return
+		}
+		if !create {
+			rows = append(rows, domain.ProductImportRow{
+				Row:     line,
+				Product: domain.Product{ID: row.ID.String()},
+				Patch:   row.toProductPatch(),
+			})
+			return
+		}
+		req := row.toCreateProductRequest()
+		rows = append(rows, domain.ProductImportRow{
+			Row: line,
+			Product: domain.Product{
+				Name:        req.Name,
+				Description: req.Description,
+				Price:       toPrice(req.Price),
+				Stock:       req.Stock,
+			},
+		})
+	}
+
+	var err error
+	if format == formatCSV {
+		err = readCSV(r, add)
+	} else {
+		err = readJSONL(r, add)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(rows)+len(failures) == 0 {
+		return nil, nil, fmt.Errorf("%w: upload has no rows", domain.ErrInvalidArgument)
+	}
+	return rows, failures, nil
+}
+
+// readCSV reads CSV with a header row naming some of importColumns in any
+// order. Rows are numbered by the line they start on.
+func readCSV(r io.Reader, add func(line int, row importRow, fields []domain.FieldError)) error {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return fmt.Errorf("%w: upload has no rows", domain.ErrInvalidArgument)
+	}
+	if err != nil {
+		return fmt.Errorf("%w: malformed CSV header: %v", domain.ErrInvalidArgument, err)
+	}
+	for i, column := range header {
+		header[i] = strings.ToLower(strings.TrimSpace(column))
+		if !slices.Contains(importColumns, header[i]) {
+			return fmt.Errorf("%w: unknown column %q, expected %s", domain.ErrInvalidArgument, column, strings.Join(importColumns, ", "))
+		}
+	}
+
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		var parseErr *csv.ParseError
+		if errors.As(err, &parseErr) {
+			add(parseErr.StartLine, importRow{}, []domain.FieldError{{Field: "row", Message: parseErr.Err.Error()}})
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		line, _ := reader.FieldPos(0)
+
+		var row importRow
+		var fields []domain.FieldError
+		for i, value := range record {
+			value = strings.TrimSpace(value)
+			if value == "" {
+				continue
+			}
+			switch header[i] {
+			case "id":
+				row.ID = json.Number(value)
+			case "name":
+				row.Name = &value
+			case "description":
+				row.Description = &value
+			case "price":
+				price := money.Amount(value)
+				row.Price = &price
+			case "stock":
+				stock, err := strconv.Atoi(value)
+				if err != nil {
+					fields = append(fields, domain.FieldError{Field: "stock", Message: "must be an integer"})
+					continue
+				}
+				row.Stock = &stock
+			}
+		}
+		add(line, row, fields)
+	}
+}
+
+// readJSONL reads one JSON object per line, skipping blank lines
+func readJSONL(r io.Reader, add func(line int, row importRow, fields []domain.FieldError)) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, 1<<20)
+
+	for line := 1; scanner.Scan(); line++ {
+		text := bytes.TrimSpace(scanner.Bytes())
+		if len(text) == 0 {
+			continue
+		}
+
+		var row importRow
+		decoder := json.NewDecoder(bytes.NewReader(text))
+		decoder.DisallowUnknownFields()
+		if err := decoder.Decode(&row); err != nil {
+			add(line, importRow{}, []domain.FieldError{jsonFieldError(err)})
+			continue
+		}
+		add(line, row, nil)
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("%w: malformed JSON Lines: %v", domain.ErrInvalidArgument, err)
+	}
+	return nil
+}
+
+// jsonFieldError describes why a JSON line could not be decoded
+func jsonFieldError(err error) domain.FieldError {
+	var typeErr *json.UnmarshalTypeError
+	if errors.As(err, &typeErr) && typeErr.Field != "" {
+		return domain.FieldError{Field: typeErr.Field, Message: "has the wrong type"}
+	}
+	if field, ok := strings.CutPrefix(err.Error(), "json: unknown field "); ok {
+		return domain.FieldError{Field: strings.Trim(field, `"`), Message: "is not a product field"}
+	}
+	return domain.FieldError{Field: "row", Message: "must be a JSON object"}
+}
@@ -0,0 +1,31 @@
+package ratehdl
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"gin-swagger-api/internal/handler/httperr"
+)
+
+// DeleteRate godoc
+// @Summary Delete an exchange rate
+// @Description Remove the rate of a currency, so that no new orders can be placed in it. Orders already placed keep their rate.
+// @Description The rate of the base currency cannot be deleted. Requires an admin API key.
+// @Tags exchange-rates
+// @Param currency path string true "ISO 4217 currency code" Enums(USD, EUR)
+// @Param X-API-Key header string true "Admin API key"
+// @Success 204
+// @Failure 400 {object} httperr.Problem
+// @Failure 403 {object} httperr.Problem
+// @Failure 404 {object} httperr.Problem
+// @Failure 500 {object} httperr.Problem
+// @Router /exchange-rates/{currency} [delete]
+func (h *Handler) DeleteRate(c *gin.Context) {
+	if err := h.rateService.DeleteRate(c.Request.Context(), c.Param("currency")); err != nil {
+		httperr.Respond(c, err)
+		return
+	}
+
+	c.Data(http.StatusNoContent, "", nil)
+}
@@ -0,0 +1,70 @@
+package ratehdl_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/gin-gonic/gin"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"gin-swagger-api/internal/domain"
+	"gin-swagger-api/internal/handler/ratehdl"
+	mockratesvc "gin-swagger-api/mock/service/ratesvc"
+)
+
+var _ = Describe("Handler DeleteRate", func() {
+	var (
+		mockService *mockratesvc.MockService
+		handler     *ratehdl.Handler
+		ctx         context.Context
+	)
+
+	BeforeEach(func() {
+		gin.SetMode(gin.TestMode)
+		mockService = mockratesvc.NewMockService(GinkgoT())
+		handler = ratehdl.NewHandler(mockService)
+		ctx = context.Background()
+	})
+
+	// deleteRate calls the handler for currency
+	deleteRate := func(currency string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodDelete, "/api/v1/exchange-rates/"+currency, nil)
+		c.Request = c.Request.WithContext(ctx)
+		c.Params = gin.Params{{Key: "currency", Value: currency}}
+
+		handler.DeleteRate(c)
+		return w
+	}
+
+	Describe("DeleteRate", func() {
+		It("should delete the rate of a currency", func() {
+			mockService.EXPECT().DeleteRate(ctx, "EUR").Return(nil)
+
+			w := deleteRate("EUR")
+
+			Expect(w.Code).To(Equal(http.StatusNoContent))
+		})
+
+		It("should return not found for a currency without a rate", func() {
+			mockService.EXPECT().DeleteRate(ctx, "USD").Return(fmt.Errorf("exchange rate %w", domain.ErrNotFound))
+
+			w := deleteRate("USD")
+
+			Expect(w.Code).To(Equal(http.StatusNotFound))
+		})
+
+		It("should return internal server error when the service fails", func() {
+			mockService.EXPECT().DeleteRate(ctx, "USD").Return(errors.New("write failed"))
+
+			w := deleteRate("USD")
+
+			Expect(w.Code).To(Equal(http.StatusInternalServerError))
+		})
+	})
+})
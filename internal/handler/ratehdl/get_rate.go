@@ -0,0 +1,30 @@
+package ratehdl
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"gin-swagger-api/internal/handler/httperr"
+)
+
+// GetRate godoc
+// @Summary Get an exchange rate
+// @Description Get the rate of a currency; the base currency always has rate 1.
+// @Tags exchange-rates
+// @Produce json
+// @Param currency path string true "ISO 4217 currency code" Enums(THB, USD, EUR)
+// @Success 200 {object} ExchangeRateResponse
+// @Failure 400 {object} httperr.Problem
+// @Failure 404 {object} httperr.Problem
+// @Failure 500 {object} httperr.Problem
+// @Router /exchange-rates/{currency} [get]
+func (h *Handler) GetRate(c *gin.Context) {
+	rate, err := h.rateService.GetRate(c.Request.Context(), c.Param("currency"))
+	if err != nil {
+		httperr.Respond(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toExchangeRateResponse(*rate))
+}
@@ -0,0 +1,74 @@
+package ratehdl_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/gin-gonic/gin"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"gin-swagger-api/internal/domain"
+	"gin-swagger-api/internal/handler/ratehdl"
+	mockratesvc "gin-swagger-api/mock/service/ratesvc"
+)
+
+var _ = Describe("Handler GetRate", func() {
+	var (
+		mockService *mockratesvc.MockService
+		handler     *ratehdl.Handler
+		ctx         context.Context
+	)
+
+	BeforeEach(func() {
+		gin.SetMode(gin.TestMode)
+		mockService = mockratesvc.NewMockService(GinkgoT())
+		handler = ratehdl.NewHandler(mockService)
+		ctx = context.Background()
+	})
+
+	// getRate calls the handler for currency
+	getRate := func(currency string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodGet, "/api/v1/exchange-rates/"+currency, nil)
+		c.Request = c.Request.WithContext(ctx)
+		c.Params = gin.Params{{Key: "currency", Value: currency}}
+
+		handler.GetRate(c)
+		return w
+	}
+
+	Describe("GetRate", func() {
+		It("should return the rate of a currency", func() {
+			mockService.EXPECT().GetRate(ctx, "usd").Return(&domain.ExchangeRate{Currency: "USD", Rate: "0.0275"}, nil)
+
+			w := getRate("usd")
+
+			Expect(w.Code).To(Equal(http.StatusOK))
+			var resp ratehdl.ExchangeRateResponse
+			Expect(json.Unmarshal(w.Body.Bytes(), &resp)).To(Succeed())
+			Expect(resp.Currency).To(Equal("USD"))
+			Expect(resp.Rate).To(Equal("0.0275"))
+		})
+
+		It("should return not found for a currency without a rate", func() {
+			mockService.EXPECT().GetRate(ctx, "EUR").Return(nil, fmt.Errorf("exchange rate %w", domain.ErrNotFound))
+
+			w := getRate("EUR")
+
+			Expect(w.Code).To(Equal(http.StatusNotFound))
+		})
+
+		It("should return bad request for an unsupported currency", func() {
+			mockService.EXPECT().GetRate(ctx, "XYZ").Return(nil, fmt.Errorf("%w: currency \"XYZ\" is not supported", domain.ErrInvalidArgument))
+
+			w := getRate("XYZ")
+
+			Expect(w.Code).To(Equal(http.StatusBadRequest))
+		})
+	})
+})
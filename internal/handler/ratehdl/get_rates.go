@@ -0,0 +1,28 @@
+package ratehdl
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"gin-swagger-api/internal/handler/httperr"
+)
+
+// GetRates godoc
+// @Summary Get all exchange rates
+// @Description Get the rates that convert product prices from the base currency to the other currencies orders can be placed in, ordered by currency.
+// @Description The base currency is not listed; its rate is always 1.
+// @Tags exchange-rates
+// @Produce json
+// @Success 200 {object} ExchangeRatesResponse
+// @Failure 500 {object} httperr.Problem
+// @Router /exchange-rates [get]
+func (h *Handler) GetRates(c *gin.Context) {
+	rates, err := h.rateService.GetRates(c.Request.Context())
+	if err != nil {
+		httperr.Respond(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toExchangeRatesResponse(rates))
+}
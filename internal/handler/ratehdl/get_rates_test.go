@@ -0,0 +1,84 @@
+package ratehdl_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"gin-swagger-api/internal/domain"
+	"gin-swagger-api/internal/handler/ratehdl"
+	mockratesvc "gin-swagger-api/mock/service/ratesvc"
+)
+
+var _ = Describe("Handler GetRates", func() {
+	var (
+		mockService *mockratesvc.MockService
+		handler     *ratehdl.Handler
+		ctx         context.Context
+	)
+
+	BeforeEach(func() {
+		gin.SetMode(gin.TestMode)
+		mockService = mockratesvc.NewMockService(GinkgoT())
+		handler = ratehdl.NewHandler(mockService)
+		ctx = context.Background()
+	})
+
+	// getRates calls the handler
+	getRates := func() *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodGet, "/api/v1/exchange-rates", nil)
+		c.Request = c.Request.WithContext(ctx)
+
+		handler.GetRates(c)
+		return w
+	}
+
+	Describe("GetRates", func() {
+		It("should return the rates with the base currency", func() {
+			updatedAt := time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)
+			mockService.EXPECT().GetRates(ctx).Return([]domain.ExchangeRate{
+				{Currency: "EUR", Rate: "0.0255", UpdatedAt: updatedAt},
+				{Currency: "USD", Rate: "0.0275", UpdatedAt: updatedAt},
+			}, nil)
+
+			w := getRates()
+
+			Expect(w.Code).To(Equal(http.StatusOK))
+			var resp ratehdl.ExchangeRatesResponse
+			Expect(json.Unmarshal(w.Body.Bytes(), &resp)).To(Succeed())
+			Expect(resp).To(Equal(ratehdl.ExchangeRatesResponse{
+				Base: "THB",
+				Rates: []ratehdl.ExchangeRateResponse{
+					{Currency: "EUR", Rate: "0.0255", UpdatedAt: updatedAt},
+					{Currency: "USD", Rate: "0.0275", UpdatedAt: updatedAt},
+				},
+			}))
+		})
+
+		It("should return an empty list when there are no rates", func() {
+			mockService.EXPECT().GetRates(ctx).Return(nil, nil)
+
+			w := getRates()
+
+			Expect(w.Code).To(Equal(http.StatusOK))
+			Expect(w.Body.String()).To(MatchJSON(`{"base": "THB", "rates": []}`))
+		})
+
+		It("should return internal server error when the service fails", func() {
+			mockService.EXPECT().GetRates(ctx).Return(nil, errors.New("read failed"))
+
+			w := getRates()
+
+			Expect(w.Code).To(Equal(http.StatusInternalServerError))
+		})
+	})
+})
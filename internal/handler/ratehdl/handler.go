@@ -0,0 +1,33 @@
+package ratehdl
+
+import (
+	"gin-swagger-api/internal/middleware"
+	"gin-swagger-api/internal/port/service/ratesvc"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Handler handles exchange rate HTTP requests
+type Handler struct {
+	rateService ratesvc.Service
+}
+
+// NewHandler creates a new exchange rate handler
+func NewHandler(rateService ratesvc.Service) *Handler {
+	return &Handler{
+		rateService: rateService,
+	}
+}
+
+// RegisterRoutes registers all exchange rate routes. Changing a rate
+// requires an admin API key.
+func (h *Handler) RegisterRoutes(rg *gin.RouterGroup) {
+	rates := rg.Group("/exchange-rates")
+	rates.Use(middleware.Logger()) // Apply logger to all exchange rate routes
+	{
+		rates.GET("", h.GetRates)
+		rates.GET("/:currency", h.GetRate)
+		rates.PUT("/:currency", middleware.RequireAdmin(), h.SetRate)
+		rates.DELETE("/:currency", middleware.RequireAdmin(), h.DeleteRate)
+	}
+}
@@ -0,0 +1,87 @@
+package ratehdl_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"gin-swagger-api/internal/domain"
+	"gin-swagger-api/internal/handler/ratehdl"
+	"gin-swagger-api/internal/middleware"
+	mockratesvc "gin-swagger-api/mock/service/ratesvc"
+)
+
+var _ = Describe("RateHandler RegisterRoutes", func() {
+	var (
+		mockService *mockratesvc.MockService
+		handler     *ratehdl.Handler
+		router      *gin.Engine
+	)
+
+	BeforeEach(func() {
+		gin.SetMode(gin.TestMode)
+		mockService = mockratesvc.NewMockService(GinkgoT())
+		handler = ratehdl.NewHandler(mockService)
+		router = gin.New()
+		router.Use(middleware.Admin([]string{"secret"}))
+		handler.RegisterRoutes(router.Group("/api/v1"))
+	})
+
+	Describe("RegisterRoutes", func() {
+		It("should register the exchange rate routes", func() {
+			routes := make([]string, 0)
+			for _, route := range router.Routes() {
+				routes = append(routes, route.Method+" "+route.Path)
+			}
+
+			Expect(routes).To(ConsistOf(
+				"GET /api/v1/exchange-rates",
+				"GET /api/v1/exchange-rates/:currency",
+				"PUT /api/v1/exchange-rates/:currency",
+				"DELETE /api/v1/exchange-rates/:currency",
+			))
+		})
+
+		It("should respond to registered routes", func() {
+			mockService.EXPECT().
+				GetRates(mock.Anything).
+				Return([]domain.ExchangeRate{}, nil).
+				Once()
+
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/exchange-rates", nil)
+			router.ServeHTTP(w, req)
+
+			Expect(w.Code).To(Equal(http.StatusOK))
+		})
+
+		It("should require an admin API key to change a rate", func() {
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPut, "/api/v1/exchange-rates/USD", strings.NewReader(`{"rate": "0.03"}`))
+			req.Header.Set("Content-Type", "application/json")
+			router.ServeHTTP(w, req)
+
+			Expect(w.Code).To(Equal(http.StatusForbidden))
+		})
+
+		It("should change a rate with an admin API key", func() {
+			mockService.EXPECT().
+				SetRate(mock.Anything, "USD", "0.03").
+				Return(&domain.ExchangeRate{Currency: "USD", Rate: "0.03"}, nil).
+				Once()
+
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPut, "/api/v1/exchange-rates/USD", strings.NewReader(`{"rate": "0.03"}`))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("X-API-Key", "secret")
+			router.ServeHTTP(w, req)
+
+			Expect(w.Code).To(Equal(http.StatusOK))
+		})
+	})
+})
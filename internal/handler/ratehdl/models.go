@@ -0,0 +1,48 @@
+package ratehdl
+
+import (
+	"time"
+
+	"gin-swagger-api/internal/domain"
+)
+
+// ExchangeRateResponse represents the API response for an exchange rate.
+// Rate is a decimal string of units of the currency per unit of the base
+// currency.
+type ExchangeRateResponse struct {
+	Currency  string    `json:"currency" example:"USD"`
+	Rate      string    `json:"rate" example:"0.0275"`
+	UpdatedAt time.Time `json:"updated_at" example:"2026-01-02T00:00:00Z"`
+}
+
+// ExchangeRatesResponse represents the API response for the rate table
+type ExchangeRatesResponse struct {
+	Base  string                 `json:"base" example:"THB"`
+	Rates []ExchangeRateResponse `json:"rates"`
+}
+
+// SetExchangeRateRequest represents the request body for setting a rate
+type SetExchangeRateRequest struct {
+	Rate string `json:"rate" binding:"required" example:"0.0275"`
+}
+
+// toExchangeRateResponse converts a domain exchange rate to its response
+func toExchangeRateResponse(rate domain.ExchangeRate) ExchangeRateResponse {
+	return ExchangeRateResponse{
+		Currency:  rate.Currency,
+		Rate:      string(rate.Rate),
+		UpdatedAt: rate.UpdatedAt,
+	}
+}
+
+// toExchangeRatesResponse converts the rate table to its response
+func toExchangeRatesResponse(rates []domain.ExchangeRate) ExchangeRatesResponse {
+	resp := ExchangeRatesResponse{
+		Base:  domain.BaseCurrency,
+		Rates: make([]ExchangeRateResponse, 0, len(rates)),
+	}
+	for _, rate := range rates {
+		resp.Rates = append(resp.Rates, toExchangeRateResponse(rate))
+	}
+	return resp
+}
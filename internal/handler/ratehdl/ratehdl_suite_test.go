@@ -0,0 +1,19 @@
+package ratehdl_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"gin-swagger-api/internal/handler/httperr"
+)
+
+func TestRateHdl(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "RateHdl Suite")
+}
+
+var _ = BeforeSuite(func() {
+	Expect(httperr.SetupValidator()).To(Succeed())
+})
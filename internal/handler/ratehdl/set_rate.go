@@ -0,0 +1,41 @@
+package ratehdl
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"gin-swagger-api/internal/handler/httperr"
+)
+
+// SetRate godoc
+// @Summary Set an exchange rate
+// @Description Add or replace the rate of a currency, a positive decimal with at most 10 places giving the units of the currency per unit of the base currency.
+// @Description Orders already placed keep the rate they were placed at. The rate of the base currency cannot be changed.
+// @Description Requires an admin API key.
+// @Tags exchange-rates
+// @Accept json
+// @Produce json
+// @Param currency path string true "ISO 4217 currency code" Enums(USD, EUR)
+// @Param rate body SetExchangeRateRequest true "Exchange rate"
+// @Param X-API-Key header string true "Admin API key"
+// @Success 200 {object} ExchangeRateResponse
+// @Failure 400 {object} httperr.Problem
+// @Failure 403 {object} httperr.Problem
+// @Failure 500 {object} httperr.Problem
+// @Router /exchange-rates/{currency} [put]
+func (h *Handler) SetRate(c *gin.Context) {
+	var req SetExchangeRateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		httperr.BadRequest(c, err)
+		return
+	}
+
+	rate, err := h.rateService.SetRate(c.Request.Context(), c.Param("currency"), req.Rate)
+	if err != nil {
+		httperr.Respond(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toExchangeRateResponse(*rate))
+}
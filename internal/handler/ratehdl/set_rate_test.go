@@ -0,0 +1,86 @@
+package ratehdl_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"gin-swagger-api/internal/domain"
+	"gin-swagger-api/internal/handler/ratehdl"
+	mockratesvc "gin-swagger-api/mock/service/ratesvc"
+)
+
+var _ = Describe("Handler SetRate", func() {
+	var (
+		mockService *mockratesvc.MockService
+		handler     *ratehdl.Handler
+		ctx         context.Context
+	)
+
+	BeforeEach(func() {
+		gin.SetMode(gin.TestMode)
+		mockService = mockratesvc.NewMockService(GinkgoT())
+		handler = ratehdl.NewHandler(mockService)
+		ctx = context.Background()
+	})
+
+	// setRate calls the handler for currency with body
+	setRate := func(currency, body string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodPut, "/api/v1/exchange-rates/"+currency, strings.NewReader(body))
+		c.Request.Header.Set("Content-Type", "application/json")
+		c.Request = c.Request.WithContext(ctx)
+		c.Params = gin.Params{{Key: "currency", Value: currency}}
+
+		handler.SetRate(c)
+		return w
+	}
+
+	Describe("SetRate", func() {
+		It("should set the rate of a currency", func() {
+			updatedAt := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+			mockService.EXPECT().SetRate(ctx, "USD", "0.0280").
+				Return(&domain.ExchangeRate{Currency: "USD", Rate: "0.028", UpdatedAt: updatedAt}, nil)
+
+			w := setRate("USD", `{"rate": "0.0280"}`)
+
+			Expect(w.Code).To(Equal(http.StatusOK))
+			var resp ratehdl.ExchangeRateResponse
+			Expect(json.Unmarshal(w.Body.Bytes(), &resp)).To(Succeed())
+			Expect(resp).To(Equal(ratehdl.ExchangeRateResponse{Currency: "USD", Rate: "0.028", UpdatedAt: updatedAt}))
+		})
+
+		It("should return bad request without a rate", func() {
+			w := setRate("USD", `{}`)
+
+			Expect(w.Code).To(Equal(http.StatusBadRequest))
+		})
+
+		It("should return bad request for a rate the service rejects", func() {
+			mockService.EXPECT().SetRate(ctx, "USD", "-1").
+				Return(nil, fmt.Errorf("%w: rate must be positive", domain.ErrInvalidArgument))
+
+			w := setRate("USD", `{"rate": "-1"}`)
+
+			Expect(w.Code).To(Equal(http.StatusBadRequest))
+		})
+
+		It("should return bad request for the base currency", func() {
+			mockService.EXPECT().SetRate(ctx, "THB", "2").
+				Return(nil, fmt.Errorf("%w: the rate of THB is always 1", domain.ErrInvalidArgument))
+
+			w := setRate("THB", `{"rate": "2"}`)
+
+			Expect(w.Code).To(Equal(http.StatusBadRequest))
+		})
+	})
+})
@@ -0,0 +1,46 @@
+package reporthdl
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"gin-swagger-api/internal/handler/httperr"
+)
+
+// GetSalesReport godoc
+// @Summary Get a sales report
+// @Description Get the revenue, order count and units sold of the orders placed in a date range, leaving cancelled and deleted orders out.
+// @Description Group the sales by product, user, status, day, week or month with group_by; rows are ordered by period, otherwise by revenue. Periods are keyed by their first day in UTC and weeks start on Monday.
+// @Description An order with items for several products counts once for each product and once in the total.
+// @Description The range defaults to the 30 days up to now. Bounds are dates (2006-01-02) or RFC 3339 times; a date to includes the whole day.
+// @Tags reports
+// @Produce json
+// @Param from query string false "Start of the range, inclusive"
+// @Param to query string false "End of the range; a date is inclusive, a time exclusive"
+// @Param group_by query string false "Dimension to group by" Enums(product, user, status, day, week, month)
+// @Success 200 {object} SalesReportResponse
+// @Failure 400 {object} httperr.Problem
+// @Failure 500 {object} httperr.Problem
+// @Router /reports/sales [get]
+func (h *Handler) GetSalesReport(c *gin.Context) {
+	var query SalesReportQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		httperr.BadRequest(c, err)
+		return
+	}
+
+	salesQuery, err := query.salesQuery()
+	if err != nil {
+		httperr.Respond(c, err)
+		return
+	}
+
+	report, err := h.reportService.GetSalesReport(c.Request.Context(), salesQuery)
+	if err != nil {
+		httperr.Respond(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toSalesReportResponse(*report))
+}
@@ -0,0 +1,138 @@
+package reporthdl_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"gin-swagger-api/internal/domain"
+	"gin-swagger-api/internal/handler/httperr"
+	"gin-swagger-api/internal/handler/reporthdl"
+	"gin-swagger-api/internal/testutil"
+	mockreportsvc "gin-swagger-api/mock/service/reportsvc"
+)
+
+var _ = Describe("Handler GetSalesReport", func() {
+	var (
+		mockService *mockreportsvc.MockService
+		handler     *reporthdl.Handler
+		ctx         context.Context
+		from        time.Time
+		to          time.Time
+	)
+
+	BeforeEach(func() {
+		gin.SetMode(gin.TestMode)
+		mockService = mockreportsvc.NewMockService(GinkgoT())
+		handler = reporthdl.NewHandler(mockService)
+		ctx = context.Background()
+		from = time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC)
+		to = time.Date(2026, time.February, 1, 0, 0, 0, 0, time.UTC)
+	})
+
+	get := func(target string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodGet, target, nil)
+		c.Request = c.Request.WithContext(ctx)
+
+		handler.GetSalesReport(c)
+		return w
+	}
+
+	Describe("GetSalesReport", func() {
+		Context("when the report is grouped", func() {
+			It("should return the rows and the total", func() {
+				query := domain.SalesQuery{From: from, To: to, GroupBy: domain.SalesByProduct}
+				mockService.EXPECT().GetSalesReport(ctx, query).Return(&domain.SalesReport{
+					From:    from,
+					To:      to,
+					GroupBy: domain.SalesByProduct,
+					Rows: []domain.SalesRow{
+						{Key: "1", Revenue: testutil.THB("2900.00"), Orders: 2, Units: 3},
+						{Key: "2", Revenue: testutil.THB("75.00"), Orders: 2, Units: 3},
+					},
+					Total: domain.SalesRow{Revenue: testutil.THB("2975.00"), Orders: 3, Units: 6},
+				}, nil)
+
+				w := get("/api/v1/reports/sales?from=2026-01-01&to=2026-01-31&group_by=product")
+
+				Expect(w.Code).To(Equal(http.StatusOK))
+
+				var response reporthdl.SalesReportResponse
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(response).To(Equal(reporthdl.SalesReportResponse{
+					From:    from,
+					To:      to,
+					GroupBy: "product",
+					Rows: []reporthdl.SalesRowResponse{
+						{Key: "1", Revenue: "2900.00", Currency: "THB", Orders: 2, Units: 3},
+						{Key: "2", Revenue: "75.00", Currency: "THB", Orders: 2, Units: 3},
+					},
+					Total: reporthdl.SalesRowResponse{Revenue: "2975.00", Currency: "THB", Orders: 3, Units: 6},
+				}))
+			})
+		})
+
+		Context("when the range is given as times", func() {
+			It("should pass the times through as they are", func() {
+				start := time.Date(2026, time.January, 1, 9, 30, 0, 0, time.UTC)
+				end := time.Date(2026, time.January, 1, 17, 0, 0, 0, time.UTC)
+				mockService.EXPECT().
+					GetSalesReport(ctx, domain.SalesQuery{From: start, To: end}).
+					Return(&domain.SalesReport{From: start, To: end, Rows: []domain.SalesRow{}}, nil)
+
+				w := get("/api/v1/reports/sales?from=2026-01-01T09:30:00Z&to=2026-01-01T17:00:00Z")
+
+				Expect(w.Code).To(Equal(http.StatusOK))
+				Expect(w.Body.String()).To(ContainSubstring(`"rows":[]`))
+			})
+		})
+
+		Context("when no range is given", func() {
+			It("should leave the default range to the service", func() {
+				mockService.EXPECT().
+					GetSalesReport(ctx, domain.SalesQuery{}).
+					Return(&domain.SalesReport{From: from, To: to}, nil)
+
+				w := get("/api/v1/reports/sales")
+
+				Expect(w.Code).To(Equal(http.StatusOK))
+			})
+		})
+
+		Context("when a bound is malformed", func() {
+			It("should return bad request", func() {
+				w := get("/api/v1/reports/sales?from=01/02/2026")
+
+				Expect(w.Code).To(Equal(http.StatusBadRequest))
+
+				var response httperr.Problem
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(response.Type).To(Equal("/problems/invalid-argument"))
+				Expect(response.Detail).To(ContainSubstring("from must be a date"))
+			})
+		})
+
+		Context("when the service rejects the query", func() {
+			It("should return bad request", func() {
+				mockService.EXPECT().
+					GetSalesReport(ctx, domain.SalesQuery{GroupBy: "country"}).
+					Return(nil, fmt.Errorf("%w: unknown group_by %q", domain.ErrInvalidArgument, "country"))
+
+				w := get("/api/v1/reports/sales?group_by=country")
+
+				Expect(w.Code).To(Equal(http.StatusBadRequest))
+			})
+		})
+	})
+})
@@ -0,0 +1,29 @@
+package reporthdl
+
+import (
+	"gin-swagger-api/internal/middleware"
+	"gin-swagger-api/internal/port/service/reportsvc"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Handler handles report-related HTTP requests
+type Handler struct {
+	reportService reportsvc.Service
+}
+
+// NewHandler creates a new report handler
+func NewHandler(reportService reportsvc.Service) *Handler {
+	return &Handler{
+		reportService: reportService,
+	}
+}
+
+// RegisterRoutes registers all report routes
+func (h *Handler) RegisterRoutes(rg *gin.RouterGroup) {
+	reports := rg.Group("/reports")
+	reports.Use(middleware.Logger()) // Apply logger to all report routes
+	{
+		reports.GET("/sales", h.GetSalesReport)
+	}
+}
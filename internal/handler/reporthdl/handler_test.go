@@ -0,0 +1,58 @@
+package reporthdl_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/gin-gonic/gin"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"gin-swagger-api/internal/domain"
+	"gin-swagger-api/internal/handler/reporthdl"
+	mockreportsvc "gin-swagger-api/mock/service/reportsvc"
+)
+
+var _ = Describe("ReportHandler RegisterRoutes", func() {
+	var (
+		mockService *mockreportsvc.MockService
+		handler     *reporthdl.Handler
+		router      *gin.Engine
+	)
+
+	BeforeEach(func() {
+		gin.SetMode(gin.TestMode)
+		mockService = mockreportsvc.NewMockService(GinkgoT())
+		handler = reporthdl.NewHandler(mockService)
+		router = gin.New()
+	})
+
+	Describe("RegisterRoutes", func() {
+		It("should register the sales report route", func() {
+			v1 := router.Group("/api/v1")
+			handler.RegisterRoutes(v1)
+
+			routes := router.Routes()
+			Expect(routes).To(HaveLen(1))
+			Expect(routes[0].Method).To(Equal(http.MethodGet))
+			Expect(routes[0].Path).To(Equal("/api/v1/reports/sales"))
+		})
+
+		It("should respond to registered routes", func() {
+			v1 := router.Group("/api/v1")
+			handler.RegisterRoutes(v1)
+
+			mockService.EXPECT().
+				GetSalesReport(mock.Anything, mock.Anything).
+				Return(&domain.SalesReport{}, nil).
+				Once()
+
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/reports/sales", nil)
+			router.ServeHTTP(w, req)
+
+			Expect(w.Code).To(Equal(http.StatusOK))
+		})
+	})
+})
@@ -0,0 +1,95 @@
+package reporthdl
+
+import (
+	"fmt"
+	"time"
+
+	"gin-swagger-api/internal/domain"
+)
+
+// dateLayout is the layout of date-only range bounds
+const dateLayout = "2006-01-02"
+
+// SalesReportQuery represents the query parameters of the sales report
+type SalesReportQuery struct {
+	From    string `form:"from" example:"2026-01-01"`
+	To      string `form:"to" example:"2026-01-31"`
+	GroupBy string `form:"group_by" example:"product"`
+}
+
+// SalesReportResponse represents the API response for a sales report
+type SalesReportResponse struct {
+	From    time.Time          `json:"from" example:"2026-01-01T00:00:00Z"`
+	To      time.Time          `json:"to" example:"2026-02-01T00:00:00Z"`
+	GroupBy string             `json:"group_by,omitempty" enums:"product,user,status,day,week,month" example:"product"`
+	Rows    []SalesRowResponse `json:"rows"`
+	Total   SalesRowResponse   `json:"total"`
+}
+
+// SalesRowResponse represents the sales of one group of a report. Key is
+// empty for the total. Revenue is a decimal string in the currency given.
+type SalesRowResponse struct {
+	Key      string `json:"key,omitempty" example:"1"`
+	Revenue  string `json:"revenue" example:"2975.00"`
+	Currency string `json:"currency" example:"THB"`
+	Orders   int    `json:"orders" example:"3"`
+	Units    int    `json:"units" example:"6"`
+}
+
+// salesQuery converts the query parameters to a domain.SalesQuery. A
+// date-only to includes the whole day.
+func (q SalesReportQuery) salesQuery() (domain.SalesQuery, error) {
+	from, _, err := parseBound("from", q.From)
+	if err != nil {
+		return domain.SalesQuery{}, err
+	}
+	to, dateOnly, err := parseBound("to", q.To)
+	if err != nil {
+		return domain.SalesQuery{}, err
+	}
+	if dateOnly {
+		to = to.AddDate(0, 0, 1)
+	}
+	return domain.SalesQuery{From: from, To: to, GroupBy: q.GroupBy}, nil
+}
+
+// parseBound parses a range bound given as a UTC date or an RFC 3339
+// time. It reports whether the bound was a date.
+func parseBound(name, value string) (time.Time, bool, error) {
+	if value == "" {
+		return time.Time{}, false, nil
+	}
+	if t, err := time.Parse(dateLayout, value); err == nil {
+		return t, true, nil
+	}
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, false, nil
+	}
+	return time.Time{}, false, fmt.Errorf("%w: %s must be a date (2006-01-02) or an RFC 3339 time", domain.ErrInvalidArgument, name)
+}
+
+// toSalesReportResponse converts domain.SalesReport to SalesReportResponse
+func toSalesReportResponse(report domain.SalesReport) SalesReportResponse {
+	rows := make([]SalesRowResponse, len(report.Rows))
+	for i, row := range report.Rows {
+		rows[i] = toSalesRowResponse(row)
+	}
+	return SalesReportResponse{
+		From:    report.From,
+		To:      report.To,
+		GroupBy: report.GroupBy,
+		Rows:    rows,
+		Total:   toSalesRowResponse(report.Total),
+	}
+}
+
+// toSalesRowResponse converts domain.SalesRow to SalesRowResponse
+func toSalesRowResponse(row domain.SalesRow) SalesRowResponse {
+	return SalesRowResponse{
+		Key:      row.Key,
+		Revenue:  row.Revenue.String(),
+		Currency: row.Revenue.Currency,
+		Orders:   row.Orders,
+		Units:    row.Units,
+	}
+}
@@ -0,0 +1,19 @@
+package reporthdl_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"gin-swagger-api/internal/handler/httperr"
+)
+
+func TestReportHdl(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "ReportHdl Suite")
+}
+
+var _ = BeforeSuite(func() {
+	Expect(httperr.SetupValidator()).To(Succeed())
+})
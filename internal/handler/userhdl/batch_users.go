@@ -0,0 +1,63 @@
+package userhdl
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"gin-swagger-api/internal/domain"
+	"gin-swagger-api/internal/handler/batch"
+	"gin-swagger-api/internal/handler/httperr"
+)
+
+// BatchUsersRequest represents the body of a user batch
+type BatchUsersRequest = batch.Request[CreateUserRequest, BatchUpdateUserRequest]
+
+// BatchUsers godoc
+// @Summary Create, update and delete users in a batch
+// @Description Apply up to 100 user creates, updates and deletes in one request: creates first, then updates, then deletes.
+// @Description In all_or_nothing mode (the default) the batch runs in one transaction and stops at the first failing item; nothing is saved and the other items report 424.
+// @Description In best_effort mode each item is saved on its own.
+// @Description Each result carries the status code the single-user endpoint would have returned. The response is 200 when every item succeeded and 207 otherwise.
+// @Tags users
+// @Accept json
+// @Produce json
+// @Param batch body BatchUsersRequest true "Users to create, update and delete"
+// @Param Idempotency-Key header string false "Key that makes retries return the first response instead of repeating the request"
+// @Success 200 {object} batch.Response[userhdl.UserResponse]
+// @Success 207 {object} batch.Response[userhdl.UserResponse]
+// @Failure 400 {object} httperr.Problem
+// @Failure 409 {object} httperr.Problem
+// @Failure 422 {object} httperr.Problem
+// @Failure 500 {object} httperr.Problem
+// @Router /users/batch [post]
+func (h *Handler) BatchUsers(c *gin.Context) {
+	var req BatchUsersRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		httperr.BadRequest(c, err)
+		return
+	}
+
+	b, err := batch.ToBatch(req,
+		func(r CreateUserRequest) domain.User {
+			return domain.User{Name: r.Name, Email: r.Email}
+		},
+		func(r BatchUpdateUserRequest) domain.BatchUpdate[domain.User] {
+			return domain.BatchUpdate[domain.User]{
+				Version: r.Version,
+				Value:   domain.User{ID: r.ID, Name: r.Name, Email: r.Email},
+			}
+		},
+	)
+	if err != nil {
+		httperr.Respond(c, err)
+		return
+	}
+
+	results, err := h.userService.BatchUsers(c.Request.Context(), b)
+	if err != nil {
+		httperr.Respond(c, err)
+		return
+	}
+
+	resp := batch.NewResponse(b.Mode, results, toUserResponse)
+	c.JSON(resp.Status(), resp)
+}
@@ -0,0 +1,160 @@
+package userhdl_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"gin-swagger-api/internal/domain"
+	"gin-swagger-api/internal/handler/batch"
+	"gin-swagger-api/internal/handler/httperr"
+	"gin-swagger-api/internal/handler/userhdl"
+	mockusersvc "gin-swagger-api/mock/service/usersvc"
+)
+
+var _ = Describe("Handler BatchUsers", func() {
+	var (
+		mockService *mockusersvc.MockService
+		handler     *userhdl.Handler
+		ctx         context.Context
+	)
+
+	BeforeEach(func() {
+		gin.SetMode(gin.TestMode)
+		mockService = mockusersvc.NewMockService(GinkgoT())
+		handler = userhdl.NewHandler(mockService)
+		ctx = context.Background()
+	})
+
+	post := func(body string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodPost, "/api/v1/users/batch", strings.NewReader(body)).WithContext(ctx)
+		c.Request.Header.Set("Content-Type", "application/json")
+		handler.BatchUsers(c)
+		return w
+	}
+
+	Describe("BatchUsers", func() {
+		Context("when every item succeeds", func() {
+			It("should return OK with a result per item", func() {
+				created := &domain.User{ID: "1", Name: "John Doe", Email: "john@example.com"}
+				updated := &domain.User{ID: "2", Name: "Jane Doe", Email: "jane@example.com"}
+				mockService.EXPECT().
+					BatchUsers(ctx, domain.Batch[domain.User]{
+						Mode:   domain.BatchAllOrNothing,
+						Create: []domain.User{{Name: "John Doe", Email: "john@example.com"}},
+						Update: []domain.BatchUpdate[domain.User]{{Version: "abc", Value: domain.User{ID: "2", Name: "Jane Doe", Email: "jane@example.com"}}},
+						Delete: []domain.BatchRef{{ID: "3"}},
+					}).
+					Return(&domain.BatchResults[domain.User]{
+						Create: []domain.BatchResult[domain.User]{{Value: created}},
+						Update: []domain.BatchResult[domain.User]{{Value: updated}},
+						Delete: []domain.BatchResult[domain.User]{{}},
+					}, nil)
+
+				w := post(`{
+					"create": [{"name": "John Doe", "email": "john@example.com"}],
+					"update": [{"id": "2", "version": "abc", "name": "Jane Doe", "email": "jane@example.com"}],
+					"delete": [{"id": "3"}]
+				}`)
+
+				Expect(w.Code).To(Equal(http.StatusOK))
+
+				var response batch.Response[userhdl.UserResponse]
+				Expect(json.Unmarshal(w.Body.Bytes(), &response)).To(Succeed())
+				Expect(response.Mode).To(Equal("all_or_nothing"))
+				Expect(response.Succeeded).To(Equal(3))
+				Expect(response.Create).To(Equal([]batch.Result[userhdl.UserResponse]{{
+					Status:  http.StatusCreated,
+					Version: created.Version(),
+					Data:    &userhdl.UserResponse{ID: "1", Name: "John Doe", Email: "john@example.com"},
+				}}))
+				Expect(response.Update[0].Status).To(Equal(http.StatusOK))
+				Expect(response.Delete[0].Status).To(Equal(http.StatusNoContent))
+			})
+		})
+
+		Context("when an item fails", func() {
+			It("should return multi-status with the problem of each failed item", func() {
+				mockService.EXPECT().
+					BatchUsers(ctx, domain.Batch[domain.User]{
+						Mode:   domain.BatchBestEffort,
+						Create: []domain.User{{Name: "John Doe", Email: "john@example.com"}, {Name: "Jane Doe", Email: "jane@example.com"}},
+						Update: []domain.BatchUpdate[domain.User]{},
+						Delete: []domain.BatchRef{},
+					}).
+					Return(&domain.BatchResults[domain.User]{
+						Create: []domain.BatchResult[domain.User]{
+							{Value: &domain.User{ID: "1", Name: "John Doe", Email: "john@example.com"}},
+							{Err: fmt.Errorf("user %w", domain.ErrConflict)},
+						},
+					}, nil)
+
+				w := post(`{"mode": "best_effort", "create": [
+					{"name": "John Doe", "email": "john@example.com"},
+					{"name": "Jane Doe", "email": "jane@example.com"}
+				]}`)
+
+				Expect(w.Code).To(Equal(http.StatusMultiStatus))
+
+				var response batch.Response[userhdl.UserResponse]
+				Expect(json.Unmarshal(w.Body.Bytes(), &response)).To(Succeed())
+				Expect(response.Succeeded).To(Equal(1))
+				Expect(response.Failed).To(Equal(1))
+				Expect(response.Create[1].Status).To(Equal(http.StatusConflict))
+				Expect(response.Create[1].Error.Type).To(Equal("/problems/conflict"))
+			})
+		})
+
+		Context("when the request is invalid", func() {
+			It("should return bad request error naming the failed item", func() {
+				w := post(`{"create": [{"name": "John Doe", "email": "john@example.com"}, {"name": "Jane Doe", "email": "not-an-email"}]}`)
+
+				Expect(w.Code).To(Equal(http.StatusBadRequest))
+
+				var problem httperr.Problem
+				Expect(json.Unmarshal(w.Body.Bytes(), &problem)).To(Succeed())
+				Expect(problem.Errors).To(ContainElement(httperr.FieldError{Field: "create[1].email", Message: "must be a valid email address"}))
+			})
+
+			It("should return bad request error for an unknown mode", func() {
+				w := post(`{"mode": "sometimes", "delete": [{"id": "1"}]}`)
+
+				Expect(w.Code).To(Equal(http.StatusBadRequest))
+			})
+
+			It("should return bad request error for an empty batch", func() {
+				w := post(`{}`)
+
+				Expect(w.Code).To(Equal(http.StatusBadRequest))
+				Expect(w.Body.String()).To(ContainSubstring("a batch holds 1 to 100 items"))
+			})
+		})
+
+		Context("when the service fails", func() {
+			It("should return internal server error", func() {
+				mockService.EXPECT().
+					BatchUsers(ctx, domain.Batch[domain.User]{
+						Mode:   domain.BatchAllOrNothing,
+						Create: []domain.User{},
+						Update: []domain.BatchUpdate[domain.User]{},
+						Delete: []domain.BatchRef{{ID: "1"}},
+					}).
+					Return(nil, errors.New("commit failed"))
+
+				w := post(`{"delete": [{"id": "1"}]}`)
+
+				Expect(w.Code).To(Equal(http.StatusInternalServerError))
+			})
+		})
+	})
+})
@@ -4,6 +4,9 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+
+	"gin-swagger-api/internal/handler/etag"
+	"gin-swagger-api/internal/handler/httperr"
 )
 
 // CreateUser godoc
@@ -13,22 +16,27 @@ import (
 // @Accept json
 // @Produce json
 // @Param user body CreateUserRequest true "User to create"
+// @Param Idempotency-Key header string false "Key that makes retries return the first response instead of repeating the request"
 // @Success 201 {object} UserResponse
-// @Failure 400 {object} ErrorResponse
-// @Failure 500 {object} ErrorResponse
+// @Header 201 {string} ETag "Entity tag of the current user version"
+// @Failure 400 {object} httperr.Problem
+// @Failure 409 {object} httperr.Problem
+// @Failure 422 {object} httperr.Problem
+// @Failure 500 {object} httperr.Problem
 // @Router /users [post]
 func (h *Handler) CreateUser(c *gin.Context) {
 	var req CreateUserRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		httperr.BadRequest(c, err)
 		return
 	}
 
 	user, err := h.userService.CreateUser(c.Request.Context(), req.Name, req.Email)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, ErrorResponse{Error: err.Error()})
+		httperr.Respond(c, err)
 		return
 	}
 
+	etag.Set(c, user.Version())
 	c.JSON(http.StatusCreated, toUserResponse(*user))
 }
@@ -13,6 +13,7 @@ import (
 	. "github.com/onsi/gomega"
 
 	"gin-swagger-api/internal/domain"
+	"gin-swagger-api/internal/handler/httperr"
 	"gin-swagger-api/internal/handler/userhdl"
 	mockusersvc "gin-swagger-api/mock/service/usersvc"
 )
@@ -77,6 +78,12 @@ var _ = Describe("Handler CreateUser", func() {
 				handler.CreateUser(c)
 
 				Expect(w.Code).To(Equal(http.StatusBadRequest))
+				Expect(w.Header().Get("Content-Type")).To(HavePrefix(httperr.ContentType))
+
+				var response httperr.Problem
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(response.Errors).To(ConsistOf(httperr.FieldError{Field: "email", Message: "is required"}))
 			})
 		})
 
@@ -99,10 +106,10 @@ var _ = Describe("Handler CreateUser", func() {
 
 				Expect(w.Code).To(Equal(http.StatusInternalServerError))
 
-				var response userhdl.ErrorResponse
+				var response httperr.Problem
 				err := json.Unmarshal(w.Body.Bytes(), &response)
 				Expect(err).ToNot(HaveOccurred())
-				Expect(response.Error).To(Equal("database error"))
+				Expect(response.Detail).To(Equal("Internal Server Error"))
 			})
 		})
 	})
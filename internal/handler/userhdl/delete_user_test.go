@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 
@@ -11,6 +12,9 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	"gin-swagger-api/internal/domain"
+	"gin-swagger-api/internal/handler/etag"
+	"gin-swagger-api/internal/handler/httperr"
 	"gin-swagger-api/internal/handler/userhdl"
 	mockusersvc "gin-swagger-api/mock/service/usersvc"
 )
@@ -34,7 +38,7 @@ var _ = Describe("Handler DeleteUser", func() {
 	Describe("DeleteUser", func() {
 		Context("when deleting an existing user", func() {
 			It("should delete user successfully", func() {
-				mockService.EXPECT().DeleteUser(ctx, userID).Return(nil)
+				mockService.EXPECT().DeleteUser(ctx, userID, "").Return(nil)
 
 				w := httptest.NewRecorder()
 				c, _ := gin.CreateTestContext(w)
@@ -48,9 +52,25 @@ var _ = Describe("Handler DeleteUser", func() {
 			})
 		})
 
+		Context("when user does not exist", func() {
+			It("should return not found error", func() {
+				mockService.EXPECT().DeleteUser(ctx, userID, "").Return(fmt.Errorf("user %w", domain.ErrNotFound))
+
+				w := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(w)
+				c.Request = httptest.NewRequest(http.MethodDelete, "/api/v1/users/"+userID, nil)
+				c.Request = c.Request.WithContext(ctx)
+				c.Params = gin.Params{{Key: "id", Value: userID}}
+
+				handler.DeleteUser(c)
+
+				Expect(w.Code).To(Equal(http.StatusNotFound))
+			})
+		})
+
 		Context("when service returns error", func() {
 			It("should return internal server error", func() {
-				mockService.EXPECT().DeleteUser(ctx, userID).Return(errors.New("delete failed"))
+				mockService.EXPECT().DeleteUser(ctx, userID, "").Return(errors.New("delete failed"))
 
 				w := httptest.NewRecorder()
 				c, _ := gin.CreateTestContext(w)
@@ -62,10 +82,55 @@ var _ = Describe("Handler DeleteUser", func() {
 
 				Expect(w.Code).To(Equal(http.StatusInternalServerError))
 
-				var response userhdl.ErrorResponse
+				var response httperr.Problem
 				err := json.Unmarshal(w.Body.Bytes(), &response)
 				Expect(err).ToNot(HaveOccurred())
-				Expect(response.Error).To(Equal("delete failed"))
+				Expect(response.Detail).To(Equal("Internal Server Error"))
+			})
+		})
+
+		Context("when If-Match is sent", func() {
+			It("should pass the expected version to the service", func() {
+				mockService.EXPECT().DeleteUser(ctx, userID, "abc123").Return(nil)
+
+				w := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(w)
+				c.Request = httptest.NewRequest(http.MethodDelete, "/api/v1/users/"+userID, nil)
+				c.Request.Header.Set(etag.IfMatchHeader, `"abc123"`)
+				c.Request = c.Request.WithContext(ctx)
+				c.Params = gin.Params{{Key: "id", Value: userID}}
+
+				handler.DeleteUser(c)
+
+				Expect(w.Code).To(Or(Equal(http.StatusOK), Equal(http.StatusNoContent)))
+			})
+
+			It("should return precondition failed when the user has changed", func() {
+				mockService.EXPECT().DeleteUser(ctx, userID, "abc123").Return(fmt.Errorf("user version %w", domain.ErrPreconditionFailed))
+
+				w := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(w)
+				c.Request = httptest.NewRequest(http.MethodDelete, "/api/v1/users/"+userID, nil)
+				c.Request.Header.Set(etag.IfMatchHeader, `"abc123"`)
+				c.Request = c.Request.WithContext(ctx)
+				c.Params = gin.Params{{Key: "id", Value: userID}}
+
+				handler.DeleteUser(c)
+
+				Expect(w.Code).To(Equal(http.StatusPreconditionFailed))
+			})
+
+			It("should reject a malformed header before calling the service", func() {
+				w := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(w)
+				c.Request = httptest.NewRequest(http.MethodDelete, "/api/v1/users/"+userID, nil)
+				c.Request.Header.Set(etag.IfMatchHeader, "abc123")
+				c.Request = c.Request.WithContext(ctx)
+				c.Params = gin.Params{{Key: "id", Value: userID}}
+
+				handler.DeleteUser(c)
+
+				Expect(w.Code).To(Equal(http.StatusBadRequest))
 			})
 		})
 	})
@@ -0,0 +1,48 @@
+package userhdl
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"gin-swagger-api/internal/domain"
+	"gin-swagger-api/internal/handler/export"
+	"gin-swagger-api/internal/handler/httperr"
+	"gin-swagger-api/internal/handler/listquery"
+)
+
+// userTable exports a user as one CSV record
+var userTable = export.Table[domain.User]{
+	Name:    "users",
+	Columns: []string{"id", "name", "email"},
+	Records: func(user domain.User) [][]string {
+		return [][]string{{user.ID, user.Name, user.Email}}
+	},
+	Value: func(user domain.User) any { return toUserResponse(user) },
+}
+
+// ExportUsers godoc
+// @Summary Export users
+// @Description Stream every user matching the filters as CSV or NDJSON, chosen by the Accept header (CSV by default).
+// @Description Users are read from the database in batches, so exports of any size use little memory. They are ordered by id and cannot be sorted.
+// @Description Filter like the user list, with filter[field]=value or filter[field][op]=value on id, name and email.
+// @Description Example: ?filter[email][like]=example.com
+// @Tags users
+// @Produce text/csv
+// @Produce application/x-ndjson
+// @Param include_deleted query bool false "Also return soft-deleted users; admins only"
+// @Success 200 {string} string "CSV with a header row, or one JSON user per line"
+// @Failure 400 {object} httperr.Problem
+// @Failure 403 {object} httperr.Problem
+// @Failure 406 {object} httperr.Problem
+// @Failure 500 {object} httperr.Problem
+// @Router /users/export [get]
+func (h *Handler) ExportUsers(c *gin.Context) {
+	list, err := listquery.Parse(c.Request.URL.Query())
+	if err != nil {
+		httperr.Respond(c, err)
+		return
+	}
+
+	export.Stream(c, userTable, func(emit func([]domain.User) error) error {
+		return h.userService.ExportUsers(c.Request.Context(), list, emit)
+	})
+}
@@ -0,0 +1,81 @@
+package userhdl_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/gin-gonic/gin"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"gin-swagger-api/internal/domain"
+	"gin-swagger-api/internal/handler/userhdl"
+	mockusersvc "gin-swagger-api/mock/service/usersvc"
+)
+
+var _ = Describe("Handler ExportUsers", func() {
+	var (
+		mockService *mockusersvc.MockService
+		handler     *userhdl.Handler
+		ctx         context.Context
+	)
+
+	BeforeEach(func() {
+		gin.SetMode(gin.TestMode)
+		mockService = mockusersvc.NewMockService(GinkgoT())
+		handler = userhdl.NewHandler(mockService)
+		ctx = context.Background()
+	})
+
+	export := func(target, accept string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodGet, target, nil).WithContext(ctx)
+		if accept != "" {
+			c.Request.Header.Set("Accept", accept)
+		}
+		handler.ExportUsers(c)
+		return w
+	}
+
+	Describe("ExportUsers", func() {
+		BeforeEach(func() {
+			mockService.EXPECT().
+				ExportUsers(ctx, domain.ListQuery{}, mock.Anything).
+				RunAndReturn(func(_ context.Context, _ domain.ListQuery, fn func([]domain.User) error) error {
+					if err := fn([]domain.User{{ID: "1", Name: "John Doe", Email: "john@example.com"}}); err != nil {
+						return err
+					}
+					return fn([]domain.User{{ID: "2", Name: "Doe, Jane", Email: "jane@example.com"}})
+				}).
+				Maybe()
+		})
+
+		It("should stream users as CSV by default", func() {
+			w := export("/api/v1/users/export", "")
+
+			Expect(w.Code).To(Equal(http.StatusOK))
+			Expect(w.Header().Get("Content-Type")).To(Equal("text/csv"))
+			Expect(w.Header().Get("Content-Disposition")).To(Equal(`attachment; filename="users.csv"`))
+			Expect(w.Body.String()).To(Equal(
+				"id,name,email\n1,John Doe,john@example.com\n2,\"Doe, Jane\",jane@example.com\n"))
+		})
+
+		It("should stream users as NDJSON when accepted", func() {
+			w := export("/api/v1/users/export", "application/x-ndjson")
+
+			Expect(w.Code).To(Equal(http.StatusOK))
+			Expect(w.Body.String()).To(Equal(
+				`{"id":"1","name":"John Doe","email":"john@example.com"}` + "\n" +
+					`{"id":"2","name":"Doe, Jane","email":"jane@example.com"}` + "\n"))
+		})
+
+		It("should return bad request error for a malformed filter", func() {
+			w := export("/api/v1/users/export?filter[name", "")
+
+			Expect(w.Code).To(Equal(http.StatusBadRequest))
+		})
+	})
+})
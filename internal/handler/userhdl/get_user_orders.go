@@ -0,0 +1,41 @@
+package userhdl
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"gin-swagger-api/internal/handler/httperr"
+	"gin-swagger-api/internal/handler/pagination"
+)
+
+// GetUserOrders godoc
+// @Summary Get the orders of a user
+// @Description Get the orders placed by a user, paginated by offset.
+// @Tags users
+// @Produce json
+// @Param id path string true "User ID"
+// @Param limit query int false "Page size (default 20, max 100)"
+// @Param offset query int false "Number of items to skip"
+// @Param include_deleted query bool false "Also return soft-deleted orders, and the orders of a soft-deleted user; admins only"
+// @Success 200 {object} pagination.Response[userhdl.UserOrderResponse]
+// @Failure 400 {object} httperr.Problem
+// @Failure 403 {object} httperr.Problem
+// @Failure 404 {object} httperr.Problem
+// @Failure 500 {object} httperr.Problem
+// @Router /users/{id}/orders [get]
+func (h *Handler) GetUserOrders(c *gin.Context) {
+	var query pagination.OffsetQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		httperr.BadRequest(c, err)
+		return
+	}
+
+	page, err := h.userService.GetUserOrders(c.Request.Context(), c.Param("id"), query.PageRequest())
+	if err != nil {
+		httperr.Respond(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, pagination.NewResponse(c, page, toUserOrderResponse))
+}
@@ -0,0 +1,113 @@
+package userhdl_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/gin-gonic/gin"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"gin-swagger-api/internal/domain"
+	"gin-swagger-api/internal/handler/httperr"
+	"gin-swagger-api/internal/handler/pagination"
+	"gin-swagger-api/internal/handler/userhdl"
+	"gin-swagger-api/internal/testutil"
+	mockusersvc "gin-swagger-api/mock/service/usersvc"
+)
+
+var _ = Describe("Handler GetUserOrders", func() {
+	var (
+		mockService *mockusersvc.MockService
+		handler     *userhdl.Handler
+		ctx         context.Context
+		userID      string
+	)
+
+	BeforeEach(func() {
+		gin.SetMode(gin.TestMode)
+		mockService = mockusersvc.NewMockService(GinkgoT())
+		handler = userhdl.NewHandler(mockService)
+		ctx = context.Background()
+		userID = "1"
+	})
+
+	Describe("GetUserOrders", func() {
+		Context("when the user has orders", func() {
+			It("should return a page of the user's orders", func() {
+				orders := []domain.Order{
+					{ID: "3", UserID: 1, Items: []domain.OrderItem{domain.NewOrderItem(2, 2, testutil.THB("50.00"))}, TotalPrice: testutil.THB("100.00"), Status: "pending"},
+					{ID: "4", UserID: 1, Items: []domain.OrderItem{domain.NewOrderItem(5, 1, testutil.THB("20.00"))}, TotalPrice: testutil.THB("20.00"), Status: "paid"},
+				}
+				mockService.EXPECT().
+					GetUserOrders(ctx, userID, domain.PageRequest{Limit: 2, Offset: 2}).
+					Return(&domain.Page[domain.Order]{Items: orders, Total: 5, Limit: 2, Offset: 2}, nil)
+
+				w := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(w)
+				c.Request = httptest.NewRequest(http.MethodGet, "/api/v1/users/"+userID+"/orders?limit=2&offset=2", nil)
+				c.Request = c.Request.WithContext(ctx)
+				c.Params = gin.Params{{Key: "id", Value: userID}}
+
+				handler.GetUserOrders(c)
+
+				Expect(w.Code).To(Equal(http.StatusOK))
+
+				var response pagination.Response[userhdl.UserOrderResponse]
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(response.Data).To(HaveLen(2))
+				Expect(response.Data[0]).To(Equal(userhdl.UserOrderResponse{
+					ID:         "3",
+					Items:      []userhdl.UserOrderItemResponse{{ProductID: 2, Quantity: 2, UnitPrice: "50.00", LineTotal: "100.00"}},
+					TotalPrice: "100.00",
+					Currency:   "THB",
+					Status:     "pending",
+				}))
+				Expect(response.Meta.Total).To(Equal(5))
+				Expect(response.Links.Next).To(Equal("/api/v1/users/1/orders?limit=2&offset=4"))
+				Expect(response.Links.Prev).To(Equal("/api/v1/users/1/orders?limit=2&offset=0"))
+			})
+		})
+
+		Context("when the user does not exist", func() {
+			It("should return not found error", func() {
+				mockService.EXPECT().
+					GetUserOrders(ctx, userID, domain.PageRequest{}).
+					Return(nil, fmt.Errorf("user %w", domain.ErrNotFound))
+
+				w := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(w)
+				c.Request = httptest.NewRequest(http.MethodGet, "/api/v1/users/"+userID+"/orders", nil)
+				c.Request = c.Request.WithContext(ctx)
+				c.Params = gin.Params{{Key: "id", Value: userID}}
+
+				handler.GetUserOrders(c)
+
+				Expect(w.Code).To(Equal(http.StatusNotFound))
+
+				var response httperr.Problem
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(response.Detail).To(Equal("user not found"))
+			})
+		})
+
+		Context("when paging parameters are invalid", func() {
+			It("should return bad request for a negative offset", func() {
+				w := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(w)
+				c.Request = httptest.NewRequest(http.MethodGet, "/api/v1/users/"+userID+"/orders?offset=-1", nil)
+				c.Request = c.Request.WithContext(ctx)
+				c.Params = gin.Params{{Key: "id", Value: userID}}
+
+				handler.GetUserOrders(c)
+
+				Expect(w.Code).To(Equal(http.StatusBadRequest))
+			})
+		})
+	})
+})
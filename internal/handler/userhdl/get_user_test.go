@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 
@@ -12,6 +13,8 @@ import (
 	. "github.com/onsi/gomega"
 
 	"gin-swagger-api/internal/domain"
+	"gin-swagger-api/internal/handler/etag"
+	"gin-swagger-api/internal/handler/httperr"
 	"gin-swagger-api/internal/handler/userhdl"
 	mockusersvc "gin-swagger-api/mock/service/usersvc"
 )
@@ -56,9 +59,46 @@ var _ = Describe("Handler GetUser", func() {
 			})
 		})
 
+		Context("when user does not exist", func() {
+			It("should return not found error", func() {
+				mockService.EXPECT().GetUser(ctx, userID).Return(nil, fmt.Errorf("user %w", domain.ErrNotFound))
+
+				w := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(w)
+				c.Request = httptest.NewRequest(http.MethodGet, "/api/v1/users/"+userID, nil)
+				c.Request = c.Request.WithContext(ctx)
+				c.Params = gin.Params{{Key: "id", Value: userID}}
+
+				handler.GetUser(c)
+
+				Expect(w.Code).To(Equal(http.StatusNotFound))
+
+				var response httperr.Problem
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(response.Detail).To(Equal("user not found"))
+			})
+		})
+
+		Context("when user ID is invalid", func() {
+			It("should return bad request error", func() {
+				mockService.EXPECT().GetUser(ctx, "abc").Return(nil, fmt.Errorf("%w: %q", domain.ErrInvalidID, "abc"))
+
+				w := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(w)
+				c.Request = httptest.NewRequest(http.MethodGet, "/api/v1/users/abc", nil)
+				c.Request = c.Request.WithContext(ctx)
+				c.Params = gin.Params{{Key: "id", Value: "abc"}}
+
+				handler.GetUser(c)
+
+				Expect(w.Code).To(Equal(http.StatusBadRequest))
+			})
+		})
+
 		Context("when service returns error", func() {
-			It("should return internal server error", func() {
-				mockService.EXPECT().GetUser(ctx, userID).Return(nil, errors.New("user not found"))
+			It("should return internal server error without leaking details", func() {
+				mockService.EXPECT().GetUser(ctx, userID).Return(nil, errors.New("database error"))
 
 				w := httptest.NewRecorder()
 				c, _ := gin.CreateTestContext(w)
@@ -70,10 +110,28 @@ var _ = Describe("Handler GetUser", func() {
 
 				Expect(w.Code).To(Equal(http.StatusInternalServerError))
 
-				var response userhdl.ErrorResponse
+				var response httperr.Problem
 				err := json.Unmarshal(w.Body.Bytes(), &response)
 				Expect(err).ToNot(HaveOccurred())
-				Expect(response.Error).To(Equal("user not found"))
+				Expect(response.Detail).To(Equal("Internal Server Error"))
+			})
+		})
+
+		Context("when the user is returned", func() {
+			It("should send its version as a strong entity tag", func() {
+				user := &domain.User{ID: userID, Name: "John Doe", Email: "john@example.com"}
+				mockService.EXPECT().GetUser(ctx, userID).Return(user, nil)
+
+				w := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(w)
+				c.Request = httptest.NewRequest(http.MethodGet, "/api/v1/users/"+userID, nil)
+				c.Request = c.Request.WithContext(ctx)
+				c.Params = gin.Params{{Key: "id", Value: userID}}
+
+				handler.GetUser(c)
+
+				Expect(w.Code).To(Equal(http.StatusOK))
+				Expect(w.Header().Get(etag.Header)).To(Equal(`"` + user.Version() + `"`))
 			})
 		})
 	})
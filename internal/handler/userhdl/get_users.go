@@ -4,27 +4,48 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+
+	"gin-swagger-api/internal/handler/httperr"
+	"gin-swagger-api/internal/handler/listquery"
+	"gin-swagger-api/internal/handler/pagination"
 )
 
 // GetUsers godoc
 // @Summary Get all users
-// @Description Get all users from the system
+// @Description Get all users from the system, paginated by offset or cursor.
+// @Description Filter with filter[field]=value or filter[field][op]=value where op is one of eq, ne, in (comma separated values), gt and lt (numeric fields) or like (text fields, case-insensitive substring).
+// @Description Filterable and sortable fields: id, name, email.
+// @Description Example: ?filter[email][like]=example.com&sort=name
 // @Tags users
 // @Produce json
-// @Success 200 {array} UserResponse
-// @Failure 500 {object} ErrorResponse
+// @Param limit query int false "Page size (default 20, max 100)"
+// @Param offset query int false "Number of items to skip"
+// @Param cursor query string false "Opaque cursor from meta.next_cursor or meta.prev_cursor; overrides offset and cannot be combined with sort"
+// @Param sort query string false "Comma separated sort fields, prefixed with - for descending order"
+// @Param include_deleted query bool false "Also return soft-deleted users; admins only"
+// @Success 200 {object} pagination.Response[userhdl.UserResponse]
+// @Failure 400 {object} httperr.Problem
+// @Failure 403 {object} httperr.Problem
+// @Failure 500 {object} httperr.Problem
 // @Router /users [get]
 func (h *Handler) GetUsers(c *gin.Context) {
-	users, err := h.userService.GetUsers(c.Request.Context())
+	var query pagination.Query
+	if err := c.ShouldBindQuery(&query); err != nil {
+		httperr.BadRequest(c, err)
+		return
+	}
+
+	list, err := listquery.Parse(c.Request.URL.Query())
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, ErrorResponse{Error: err.Error()})
+		httperr.Respond(c, err)
 		return
 	}
 
-	response := make([]UserResponse, 0, len(users))
-	for _, user := range users {
-		response = append(response, toUserResponse(user))
+	page, err := h.userService.GetUsers(c.Request.Context(), list, query.PageRequest())
+	if err != nil {
+		httperr.Respond(c, err)
+		return
 	}
 
-	c.JSON(http.StatusOK, response)
+	c.JSON(http.StatusOK, pagination.NewResponse(c, page, toUserResponse))
 }
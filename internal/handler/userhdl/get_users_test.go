@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 
@@ -12,6 +13,8 @@ import (
 	. "github.com/onsi/gomega"
 
 	"gin-swagger-api/internal/domain"
+	"gin-swagger-api/internal/handler/httperr"
+	"gin-swagger-api/internal/handler/pagination"
 	"gin-swagger-api/internal/handler/userhdl"
 	mockusersvc "gin-swagger-api/mock/service/usersvc"
 )
@@ -37,7 +40,9 @@ var _ = Describe("Handler GetUsers", func() {
 					{ID: "1", Name: "John Doe", Email: "john@example.com"},
 					{ID: "2", Name: "Jane Smith", Email: "jane@example.com"},
 				}
-				mockService.EXPECT().GetUsers(ctx).Return(users, nil)
+				mockService.EXPECT().
+					GetUsers(ctx, domain.ListQuery{}, domain.PageRequest{}).
+					Return(&domain.Page[domain.User]{Items: users, Total: 2, Limit: domain.DefaultPageSize}, nil)
 
 				w := httptest.NewRecorder()
 				c, _ := gin.CreateTestContext(w)
@@ -48,18 +53,118 @@ var _ = Describe("Handler GetUsers", func() {
 
 				Expect(w.Code).To(Equal(http.StatusOK))
 
-				var response []userhdl.UserResponse
+				var response pagination.Response[userhdl.UserResponse]
 				err := json.Unmarshal(w.Body.Bytes(), &response)
 				Expect(err).ToNot(HaveOccurred())
-				Expect(response).To(HaveLen(2))
-				Expect(response[0].ID).To(Equal("1"))
-				Expect(response[0].Name).To(Equal("John Doe"))
+				Expect(response.Data).To(HaveLen(2))
+				Expect(response.Meta.Total).To(Equal(2))
+				Expect(response.Meta.Limit).To(Equal(domain.DefaultPageSize))
+				Expect(response.Links.Self).To(Equal("/api/v1/users"))
+				Expect(response.Data[0].ID).To(Equal("1"))
+				Expect(response.Data[0].Name).To(Equal("John Doe"))
+			})
+		})
+
+		Context("when paging parameters are given", func() {
+			It("should pass them to the service", func() {
+				mockService.EXPECT().
+					GetUsers(ctx, domain.ListQuery{}, domain.PageRequest{Limit: 1, Offset: 1}).
+					Return(&domain.Page[domain.User]{Items: []domain.User{}, Total: 3, Limit: 1, Offset: 1}, nil)
+
+				w := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(w)
+				c.Request = httptest.NewRequest(http.MethodGet, "/api/v1/users?limit=1&offset=1", nil)
+				c.Request = c.Request.WithContext(ctx)
+
+				handler.GetUsers(c)
+
+				Expect(w.Code).To(Equal(http.StatusOK))
+
+				var response pagination.Response[userhdl.UserResponse]
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(response.Links.Next).To(Equal("/api/v1/users?limit=1&offset=2"))
+				Expect(response.Links.Prev).To(Equal("/api/v1/users?limit=1&offset=0"))
+			})
+
+			It("should return bad request for an invalid limit", func() {
+				w := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(w)
+				c.Request = httptest.NewRequest(http.MethodGet, "/api/v1/users?limit=-1", nil)
+				c.Request = c.Request.WithContext(ctx)
+
+				handler.GetUsers(c)
+
+				Expect(w.Code).To(Equal(http.StatusBadRequest))
+
+				var response httperr.Problem
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(response.Errors).To(ContainElement(httperr.FieldError{Field: "limit", Message: "must be at least 1"}))
+			})
+		})
+
+		Context("when filter parameters are given", func() {
+			It("should pass the parsed list query to the service", func() {
+				mockService.EXPECT().
+					GetUsers(ctx, domain.ListQuery{
+						Filters: []domain.Filter{
+							{Field: "email", Op: domain.OpLike, Values: []any{"example.com"}},
+						},
+						Sort: []domain.Sort{{Field: "name", Desc: true}},
+					}, domain.PageRequest{}).
+					Return(&domain.Page[domain.User]{Items: []domain.User{}, Total: 1, Limit: domain.DefaultPageSize}, nil)
+
+				w := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(w)
+				c.Request = httptest.NewRequest(http.MethodGet, "/api/v1/users?filter[email][like]=example.com&sort=-name", nil)
+				c.Request = c.Request.WithContext(ctx)
+
+				handler.GetUsers(c)
+
+				Expect(w.Code).To(Equal(http.StatusOK))
+			})
+
+			It("should return bad request for a malformed filter", func() {
+				w := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(w)
+				c.Request = httptest.NewRequest(http.MethodGet, "/api/v1/users?filter[id][between]=1", nil)
+				c.Request = c.Request.WithContext(ctx)
+
+				handler.GetUsers(c)
+
+				Expect(w.Code).To(Equal(http.StatusBadRequest))
+
+				var response httperr.Problem
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(response.Type).To(Equal("/problems/invalid-argument"))
+			})
+
+			It("should return bad request when the service rejects a field", func() {
+				mockService.EXPECT().
+					GetUsers(ctx, domain.ListQuery{Sort: []domain.Sort{{Field: "secret"}}}, domain.PageRequest{}).
+					Return(nil, fmt.Errorf("%w: unknown sort field %q", domain.ErrInvalidArgument, "secret"))
+
+				w := httptest.NewRecorder()
+				c, _ := gin.CreateTestContext(w)
+				c.Request = httptest.NewRequest(http.MethodGet, "/api/v1/users?sort=secret", nil)
+				c.Request = c.Request.WithContext(ctx)
+
+				handler.GetUsers(c)
+
+				Expect(w.Code).To(Equal(http.StatusBadRequest))
+
+				var response httperr.Problem
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(response.Detail).To(ContainSubstring(`unknown sort field "secret"`))
 			})
 		})
 
 		Context("when service returns error", func() {
 			It("should return internal server error", func() {
-				mockService.EXPECT().GetUsers(ctx).Return(nil, errors.New("service error"))
+				mockService.EXPECT().GetUsers(ctx, domain.ListQuery{}, domain.PageRequest{}).Return(nil, errors.New("service error"))
 
 				w := httptest.NewRecorder()
 				c, _ := gin.CreateTestContext(w)
@@ -70,10 +175,10 @@ var _ = Describe("Handler GetUsers", func() {
 
 				Expect(w.Code).To(Equal(http.StatusInternalServerError))
 
-				var response userhdl.ErrorResponse
+				var response httperr.Problem
 				err := json.Unmarshal(w.Body.Bytes(), &response)
 				Expect(err).ToNot(HaveOccurred())
-				Expect(response.Error).To(Equal("service error"))
+				Expect(response.Detail).To(Equal("Internal Server Error"))
 			})
 		})
 	})
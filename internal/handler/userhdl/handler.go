@@ -25,9 +25,14 @@ func (h *Handler) RegisterRoutes(rg *gin.RouterGroup) {
 	users.Use(middleware.Logger()) // Apply logger to all user routes
 	{
 		users.POST("", h.CreateUser)
+		users.POST("/batch", h.BatchUsers)
+		users.GET("/export", h.ExportUsers)
 		users.GET("/:id", h.GetUser)
-		users.PUT("/:id", h.UpdateUser)
-		users.DELETE("/:id", h.DeleteUser)
+		users.PUT("/:id", middleware.RequireIfMatch(), h.UpdateUser)
+		users.DELETE("/:id", middleware.RequireIfMatch(), h.DeleteUser)
+		users.POST("/:id/restore", middleware.RequireAdmin(), h.RestoreUser)
+		users.POST("/:id/purge", middleware.RequireAdmin(), h.PurgeUser)
 		users.GET("", h.GetUsers)
+		users.GET("/:id/orders", h.GetUserOrders)
 	}
 }
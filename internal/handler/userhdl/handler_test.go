@@ -65,6 +65,48 @@ var _ = Describe("UserHandler RegisterRoutes", func() {
 				}
 			}
 			Expect(found).To(BeTrue(), "Route GET /api/v1/users should be registered")
+
+			// Verify GET /users/:id/orders (nested list) route exists
+			found = false
+			for _, route := range routes {
+				if route.Method == "GET" && route.Path == "/api/v1/users/:id/orders" {
+					found = true
+					break
+				}
+			}
+			Expect(found).To(BeTrue(), "Route GET /api/v1/users/:id/orders should be registered")
+
+			// Verify GET /users/export route exists
+			found = false
+			for _, route := range routes {
+				if route.Method == "GET" && route.Path == "/api/v1/users/export" {
+					found = true
+					break
+				}
+			}
+			Expect(found).To(BeTrue(), "Route GET /api/v1/users/export should be registered")
+
+			// Verify POST /users/batch route exists
+			found = false
+			for _, route := range routes {
+				if route.Method == "POST" && route.Path == "/api/v1/users/batch" {
+					found = true
+					break
+				}
+			}
+			Expect(found).To(BeTrue(), "Route POST /api/v1/users/batch should be registered")
+
+			// Verify the admin restore and purge routes exist
+			for _, path := range []string{"/api/v1/users/:id/restore", "/api/v1/users/:id/purge"} {
+				found = false
+				for _, route := range routes {
+					if route.Method == "POST" && route.Path == path {
+						found = true
+						break
+					}
+				}
+				Expect(found).To(BeTrue(), "Route POST %s should be registered", path)
+			}
 		})
 
 		It("should apply routes under correct group prefix", func() {
@@ -84,8 +126,8 @@ var _ = Describe("UserHandler RegisterRoutes", func() {
 
 			// Mock the service call
 			mockService.EXPECT().
-				GetUsers(mock.Anything).
-				Return([]domain.User{}, nil).
+				GetUsers(mock.Anything, mock.Anything, mock.Anything).
+				Return(&domain.Page[domain.User]{}, nil).
 				Once()
 
 			// Test that routes are accessible (even if they fail without proper setup)
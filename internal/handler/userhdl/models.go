@@ -1,12 +1,36 @@
 package userhdl
 
-import "gin-swagger-api/internal/domain"
+import (
+	"time"
 
-// UserResponse represents the API response for a user
+	"gin-swagger-api/internal/domain"
+)
+
+// UserResponse represents the API response for a user. DeletedAt is only
+// set on soft-deleted users.
 type UserResponse struct {
-	ID    string `json:"id" example:"1"`
-	Name  string `json:"name" example:"John Doe"`
-	Email string `json:"email" example:"john@example.com"`
+	ID        string     `json:"id" example:"1"`
+	Name      string     `json:"name" example:"John Doe"`
+	Email     string     `json:"email" example:"john@example.com"`
+	DeletedAt *time.Time `json:"deleted_at,omitempty" example:"2026-01-15T10:00:00Z"`
+}
+
+// UserOrderResponse represents an order in the list of a user's orders.
+// Amounts are decimal strings in the currency given.
+type UserOrderResponse struct {
+	ID         string                  `json:"id" example:"1"`
+	Items      []UserOrderItemResponse `json:"items"`
+	TotalPrice string                  `json:"total_price" example:"50000.00"`
+	Currency   string                  `json:"currency" example:"THB"`
+	Status     string                  `json:"status" enums:"pending,paid,shipped,delivered,cancelled" example:"pending"`
+}
+
+// UserOrderItemResponse represents a line of a user's order
+type UserOrderItemResponse struct {
+	ProductID int    `json:"product_id" example:"1"`
+	Quantity  int    `json:"quantity" example:"2"`
+	UnitPrice string `json:"unit_price" example:"25000.00"`
+	LineTotal string `json:"line_total" example:"50000.00"`
 }
 
 // CreateUserRequest represents the request body for creating a user
@@ -21,16 +45,41 @@ type UpdateUserRequest struct {
 	Email string `json:"email" example:"john@example.com"`
 }
 
-// ErrorResponse represents an error response
-type ErrorResponse struct {
-	Error string `json:"error" example:"error message"`
+// BatchUpdateUserRequest represents a user to update in a batch. A
+// version, the user's ETag without quotes, must match the current version.
+type BatchUpdateUserRequest struct {
+	ID      string `json:"id" binding:"required" example:"1"`
+	Version string `json:"version" example:"5d41402abc4b2a76"`
+	Name    string `json:"name" example:"John Doe"`
+	Email   string `json:"email" example:"john@example.com"`
 }
 
 // toUserResponse converts domain.User to UserResponse
 func toUserResponse(user domain.User) UserResponse {
 	return UserResponse{
-		ID:    user.ID,
-		Name:  user.Name,
-		Email: user.Email,
+		ID:        user.ID,
+		Name:      user.Name,
+		Email:     user.Email,
+		DeletedAt: user.DeletedAt,
+	}
+}
+
+// toUserOrderResponse converts domain.Order to UserOrderResponse
+func toUserOrderResponse(order domain.Order) UserOrderResponse {
+	items := make([]UserOrderItemResponse, len(order.Items))
+	for i, item := range order.Items {
+		items[i] = UserOrderItemResponse{
+			ProductID: item.ProductID,
+			Quantity:  item.Quantity,
+			UnitPrice: item.UnitPrice.String(),
+			LineTotal: item.LineTotal.String(),
+		}
+	}
+	return UserOrderResponse{
+		ID:         order.ID,
+		Items:      items,
+		TotalPrice: order.TotalPrice.String(),
+		Currency:   order.TotalPrice.Currency,
+		Status:     order.Status,
 	}
 }
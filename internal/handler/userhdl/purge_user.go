@@ -0,0 +1,39 @@
+package userhdl
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"gin-swagger-api/internal/handler/httperr"
+)
+
+// PurgeUser godoc
+// @Summary Purge a deleted user
+// @Description Remove a soft-deleted user for good; 409 when the user is not deleted.
+// @Description What happens to the orders the user placed depends on USER_DELETE_POLICY, applied in the same transaction as the purge:
+// @Description restrict (default) refuses with 409 and counts the blocking orders in blocking_orders,
+// @Description cascade deletes those orders and returns the stock of the ones that have not shipped,
+// @Description nullify keeps them, with their prices and totals, without the reference to the user.
+// @Description Requires an admin API key.
+// @Tags users
+// @Param id path string true "User ID"
+// @Param X-API-Key header string true "Admin API key"
+// @Param Idempotency-Key header string false "Key that makes retries return the first response instead of repeating the request"
+// @Success 204
+// @Failure 400 {object} httperr.Problem
+// @Failure 403 {object} httperr.Problem
+// @Failure 404 {object} httperr.Problem
+// @Failure 409 {object} httperr.Problem
+// @Failure 422 {object} httperr.Problem
+// @Failure 500 {object} httperr.Problem
+// @Router /users/{id}/purge [post]
+func (h *Handler) PurgeUser(c *gin.Context) {
+	id := c.Param("id")
+	if err := h.userService.PurgeUser(c.Request.Context(), id); err != nil {
+		httperr.Respond(c, err)
+		return
+	}
+
+	c.Data(http.StatusNoContent, "", nil)
+}
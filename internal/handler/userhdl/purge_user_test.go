@@ -0,0 +1,90 @@
+package userhdl_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/gin-gonic/gin"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"gin-swagger-api/internal/domain"
+	"gin-swagger-api/internal/handler/httperr"
+	"gin-swagger-api/internal/handler/userhdl"
+	mockusersvc "gin-swagger-api/mock/service/usersvc"
+)
+
+var _ = Describe("Handler PurgeUser", func() {
+	var (
+		mockService *mockusersvc.MockService
+		handler     *userhdl.Handler
+		ctx         context.Context
+		userID      string
+	)
+
+	BeforeEach(func() {
+		gin.SetMode(gin.TestMode)
+		mockService = mockusersvc.NewMockService(GinkgoT())
+		handler = userhdl.NewHandler(mockService)
+		ctx = context.Background()
+		userID = "123"
+	})
+
+	// purge calls the handler for userID
+	purge := func() *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodPost, "/api/v1/users/"+userID+"/purge", nil)
+		c.Request = c.Request.WithContext(ctx)
+		c.Params = gin.Params{{Key: "id", Value: userID}}
+
+		handler.PurgeUser(c)
+		return w
+	}
+
+	Describe("PurgeUser", func() {
+		It("should purge the user", func() {
+			mockService.EXPECT().PurgeUser(ctx, userID).Return(nil)
+
+			w := purge()
+
+			Expect(w.Code).To(Equal(http.StatusNoContent))
+		})
+
+		It("should return conflict when the user is not deleted", func() {
+			mockService.EXPECT().PurgeUser(ctx, userID).Return(fmt.Errorf("user 123 is %w", domain.ErrNotDeleted))
+
+			w := purge()
+
+			Expect(w.Code).To(Equal(http.StatusConflict))
+
+			var problem httperr.Problem
+			Expect(json.Unmarshal(w.Body.Bytes(), &problem)).To(Succeed())
+			Expect(problem.Type).To(Equal("/problems/not-deleted"))
+		})
+
+		It("should return conflict with the count of blocking orders", func() {
+			mockService.EXPECT().PurgeUser(ctx, userID).Return(&domain.InUseError{Entity: "user", Orders: 2})
+
+			w := purge()
+
+			Expect(w.Code).To(Equal(http.StatusConflict))
+
+			var problem httperr.Problem
+			Expect(json.Unmarshal(w.Body.Bytes(), &problem)).To(Succeed())
+			Expect(problem.Type).To(Equal("/problems/in-use"))
+			Expect(problem.Extensions).To(HaveKeyWithValue("blocking_orders", BeNumerically("==", 2)))
+		})
+
+		It("should return not found when the user does not exist", func() {
+			mockService.EXPECT().PurgeUser(ctx, userID).Return(fmt.Errorf("user %w", domain.ErrNotFound))
+
+			w := purge()
+
+			Expect(w.Code).To(Equal(http.StatusNotFound))
+		})
+	})
+})
@@ -0,0 +1,40 @@
+package userhdl
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"gin-swagger-api/internal/handler/etag"
+	"gin-swagger-api/internal/handler/httperr"
+)
+
+// RestoreUser godoc
+// @Summary Restore a deleted user
+// @Description Bring back a soft-deleted user; restoring a user that is not deleted returns it unchanged.
+// @Description Requires an admin API key.
+// @Tags users
+// @Produce json
+// @Param id path string true "User ID"
+// @Param X-API-Key header string true "Admin API key"
+// @Param Idempotency-Key header string false "Key that makes retries return the first response instead of repeating the request"
+// @Success 200 {object} UserResponse
+// @Header 200 {string} ETag "Entity tag of the current user version"
+// @Failure 400 {object} httperr.Problem
+// @Failure 403 {object} httperr.Problem
+// @Failure 404 {object} httperr.Problem
+// @Failure 409 {object} httperr.Problem
+// @Failure 422 {object} httperr.Problem
+// @Failure 500 {object} httperr.Problem
+// @Router /users/{id}/restore [post]
+func (h *Handler) RestoreUser(c *gin.Context) {
+	id := c.Param("id")
+	user, err := h.userService.RestoreUser(c.Request.Context(), id)
+	if err != nil {
+		httperr.Respond(c, err)
+		return
+	}
+
+	etag.Set(c, user.Version())
+	c.JSON(http.StatusOK, toUserResponse(*user))
+}
@@ -0,0 +1,80 @@
+package userhdl_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/gin-gonic/gin"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"gin-swagger-api/internal/domain"
+	"gin-swagger-api/internal/handler/userhdl"
+	mockusersvc "gin-swagger-api/mock/service/usersvc"
+)
+
+var _ = Describe("Handler RestoreUser", func() {
+	var (
+		mockService *mockusersvc.MockService
+		handler     *userhdl.Handler
+		ctx         context.Context
+		userID      string
+	)
+
+	BeforeEach(func() {
+		gin.SetMode(gin.TestMode)
+		mockService = mockusersvc.NewMockService(GinkgoT())
+		handler = userhdl.NewHandler(mockService)
+		ctx = context.Background()
+		userID = "123"
+	})
+
+	// restore calls the handler for userID
+	restore := func() *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodPost, "/api/v1/users/"+userID+"/restore", nil)
+		c.Request = c.Request.WithContext(ctx)
+		c.Params = gin.Params{{Key: "id", Value: userID}}
+
+		handler.RestoreUser(c)
+		return w
+	}
+
+	Describe("RestoreUser", func() {
+		It("should return the restored user with its ETag", func() {
+			restored := &domain.User{ID: userID, Name: "John Doe", Email: "john@example.com"}
+			mockService.EXPECT().RestoreUser(ctx, userID).Return(restored, nil)
+
+			w := restore()
+
+			Expect(w.Code).To(Equal(http.StatusOK))
+			Expect(w.Header().Get("ETag")).To(Equal(`"` + restored.Version() + `"`))
+
+			var response map[string]any
+			Expect(json.Unmarshal(w.Body.Bytes(), &response)).To(Succeed())
+			Expect(response).To(HaveKeyWithValue("id", userID))
+			Expect(response).ToNot(HaveKey("deleted_at"))
+		})
+
+		It("should return not found when the user does not exist", func() {
+			mockService.EXPECT().RestoreUser(ctx, userID).Return(nil, fmt.Errorf("user %w", domain.ErrNotFound))
+
+			w := restore()
+
+			Expect(w.Code).To(Equal(http.StatusNotFound))
+		})
+
+		It("should return bad request for an invalid ID", func() {
+			userID = "abc"
+			mockService.EXPECT().RestoreUser(ctx, userID).Return(nil, fmt.Errorf("%w: %q", domain.ErrInvalidID, userID))
+
+			w := restore()
+
+			Expect(w.Code).To(Equal(http.StatusBadRequest))
+		})
+	})
+})
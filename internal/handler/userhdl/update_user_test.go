@@ -5,6 +5,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 
@@ -13,6 +14,8 @@ import (
 	. "github.com/onsi/gomega"
 
 	"gin-swagger-api/internal/domain"
+	"gin-swagger-api/internal/handler/etag"
+	"gin-swagger-api/internal/handler/httperr"
 	"gin-swagger-api/internal/handler/userhdl"
 	mockusersvc "gin-swagger-api/mock/service/usersvc"
 )
@@ -41,7 +44,7 @@ var _ = Describe("Handler UpdateUser", func() {
 					Email: "jane@example.com",
 				}
 				user := &domain.User{ID: userID, Name: "Jane Doe", Email: "jane@example.com"}
-				mockService.EXPECT().UpdateUser(ctx, userID, "Jane Doe", "jane@example.com").Return(user, nil)
+				mockService.EXPECT().UpdateUser(ctx, userID, "", "Jane Doe", "jane@example.com").Return(user, nil)
 
 				bodyBytes, _ := json.Marshal(req)
 				w := httptest.NewRecorder()
@@ -86,7 +89,7 @@ var _ = Describe("Handler UpdateUser", func() {
 					Name:  "Jane Doe",
 					Email: "jane@example.com",
 				}
-				mockService.EXPECT().UpdateUser(ctx, userID, "Jane Doe", "jane@example.com").Return(nil, errors.New("update failed"))
+				mockService.EXPECT().UpdateUser(ctx, userID, "", "Jane Doe", "jane@example.com").Return(nil, errors.New("update failed"))
 
 				bodyBytes, _ := json.Marshal(req)
 				w := httptest.NewRecorder()
@@ -100,10 +103,53 @@ var _ = Describe("Handler UpdateUser", func() {
 
 				Expect(w.Code).To(Equal(http.StatusInternalServerError))
 
-				var response userhdl.ErrorResponse
+				var response httperr.Problem
 				err := json.Unmarshal(w.Body.Bytes(), &response)
 				Expect(err).ToNot(HaveOccurred())
-				Expect(response.Error).To(Equal("update failed"))
+				Expect(response.Detail).To(Equal("Internal Server Error"))
+			})
+		})
+
+		Context("when If-Match is sent", func() {
+			var sendUpdate func() *httptest.ResponseRecorder
+
+			BeforeEach(func() {
+				sendUpdate = func() *httptest.ResponseRecorder {
+					bodyBytes, _ := json.Marshal(userhdl.UpdateUserRequest{Name: "Jane Doe", Email: "jane@example.com"})
+					w := httptest.NewRecorder()
+					c, _ := gin.CreateTestContext(w)
+					c.Request = httptest.NewRequest(http.MethodPut, "/api/v1/users/"+userID, bytes.NewBuffer(bodyBytes))
+					c.Request.Header.Set("Content-Type", "application/json")
+					c.Request.Header.Set(etag.IfMatchHeader, `"abc123"`)
+					c.Request = c.Request.WithContext(ctx)
+					c.Params = gin.Params{{Key: "id", Value: userID}}
+
+					handler.UpdateUser(c)
+					return w
+				}
+			})
+
+			It("should pass the expected version and return the new entity tag", func() {
+				user := &domain.User{ID: userID, Name: "Jane Doe", Email: "jane@example.com"}
+				mockService.EXPECT().UpdateUser(ctx, userID, "abc123", "Jane Doe", "jane@example.com").Return(user, nil)
+
+				w := sendUpdate()
+
+				Expect(w.Code).To(Equal(http.StatusOK))
+				Expect(w.Header().Get(etag.Header)).To(Equal(`"` + user.Version() + `"`))
+			})
+
+			It("should return precondition failed when the user has changed", func() {
+				mockService.EXPECT().UpdateUser(ctx, userID, "abc123", "Jane Doe", "jane@example.com").Return(nil, fmt.Errorf("user version %w", domain.ErrPreconditionFailed))
+
+				w := sendUpdate()
+
+				Expect(w.Code).To(Equal(http.StatusPreconditionFailed))
+
+				var response httperr.Problem
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(response.Type).To(Equal("/problems/precondition-failed"))
 			})
 		})
 	})
@@ -5,9 +5,15 @@ import (
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+
+	"gin-swagger-api/internal/handler/httperr"
 )
 
 func TestUserHdl(t *testing.T) {
 	RegisterFailHandler(Fail)
 	RunSpecs(t, "UserHdl Suite")
 }
+
+var _ = BeforeSuite(func() {
+	Expect(httperr.SetupValidator()).To(Succeed())
+})
@@ -0,0 +1,73 @@
+package middleware
+
+import (
+	"crypto/subtle"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"gin-swagger-api/internal/domain"
+	"gin-swagger-api/internal/handler/httperr"
+)
+
+// adminKey is the gin context key marking requests made with an admin API
+// key
+const adminKey = "admin"
+
+// includeDeletedParam is the query parameter asking reads for soft-deleted
+// resources too
+const includeDeletedParam = "include_deleted"
+
+// Admin marks the requests whose X-API-Key is one of the admin keys, so
+// RequireAdmin lets them through. A request with include_deleted=true
+// reads soft-deleted resources too, which only admins may do.
+func Admin(keys []string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		admin := isAdminKey(c.GetHeader("X-API-Key"), keys)
+		c.Set(adminKey, admin)
+
+		if raw := c.Query(includeDeletedParam); raw != "" {
+			include, err := strconv.ParseBool(raw)
+			if err != nil {
+				httperr.Abort(c, http.StatusBadRequest, includeDeletedParam+" must be true or false")
+				return
+			}
+			if include && !admin {
+				httperr.Abort(c, http.StatusForbidden, "only admins may include deleted resources")
+				return
+			}
+			if include {
+				c.Request = c.Request.WithContext(domain.WithDeleted(c.Request.Context()))
+			}
+		}
+		c.Next()
+	}
+}
+
+// RequireAdmin rejects requests that Admin did not mark as made with an
+// admin API key
+func RequireAdmin() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !c.GetBool(adminKey) {
+			httperr.Abort(c, http.StatusForbidden, "admin API key required")
+			return
+		}
+		c.Next()
+	}
+}
+
+// isAdminKey reports whether apiKey is one of the admin keys, comparing
+// in constant time
+func isAdminKey(apiKey string, keys []string) bool {
+	if apiKey == "" {
+		return false
+	}
+	admin := false
+	for _, key := range keys {
+		if subtle.ConstantTimeCompare([]byte(apiKey), []byte(key)) == 1 {
+			admin = true
+		}
+	}
+	return admin
+}
@@ -0,0 +1,94 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/gin-gonic/gin"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"gin-swagger-api/internal/domain"
+	"gin-swagger-api/internal/middleware"
+)
+
+var _ = Describe("Admin", func() {
+	var (
+		router         *gin.Engine
+		includeDeleted bool
+	)
+
+	BeforeEach(func() {
+		gin.SetMode(gin.TestMode)
+		includeDeleted = false
+
+		router = gin.New()
+		router.Use(middleware.Admin([]string{"admin-key"}))
+		router.GET("/users", func(c *gin.Context) {
+			includeDeleted = domain.IncludesDeleted(c.Request.Context())
+			c.Status(http.StatusOK)
+		})
+		router.POST("/users/:id/restore", middleware.RequireAdmin(), func(c *gin.Context) {
+			c.Status(http.StatusOK)
+		})
+	})
+
+	// send makes a request with an optional API key
+	send := func(method, target, apiKey string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, target, nil)
+		if apiKey != "" {
+			req.Header.Set("X-API-Key", apiKey)
+		}
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	Describe("include_deleted", func() {
+		It("should leave soft-deleted resources out by default", func() {
+			w := send(http.MethodGet, "/users", "admin-key")
+
+			Expect(w.Code).To(Equal(http.StatusOK))
+			Expect(includeDeleted).To(BeFalse())
+		})
+
+		It("should include soft-deleted resources for admins", func() {
+			w := send(http.MethodGet, "/users?include_deleted=true", "admin-key")
+
+			Expect(w.Code).To(Equal(http.StatusOK))
+			Expect(includeDeleted).To(BeTrue())
+		})
+
+		It("should forbid other callers to include soft-deleted resources", func() {
+			w := send(http.MethodGet, "/users?include_deleted=true", "other-key")
+
+			Expect(w.Code).To(Equal(http.StatusForbidden))
+		})
+
+		It("should let anyone leave soft-deleted resources out explicitly", func() {
+			w := send(http.MethodGet, "/users?include_deleted=false", "")
+
+			Expect(w.Code).To(Equal(http.StatusOK))
+			Expect(includeDeleted).To(BeFalse())
+		})
+
+		It("should reject a malformed flag", func() {
+			w := send(http.MethodGet, "/users?include_deleted=maybe", "admin-key")
+
+			Expect(w.Code).To(Equal(http.StatusBadRequest))
+		})
+	})
+
+	Describe("RequireAdmin", func() {
+		It("should let admins through", func() {
+			w := send(http.MethodPost, "/users/1/restore", "admin-key")
+
+			Expect(w.Code).To(Equal(http.StatusOK))
+		})
+
+		It("should forbid callers without an admin key", func() {
+			Expect(send(http.MethodPost, "/users/1/restore", "other-key").Code).To(Equal(http.StatusForbidden))
+			Expect(send(http.MethodPost, "/users/1/restore", "").Code).To(Equal(http.StatusForbidden))
+		})
+	})
+})
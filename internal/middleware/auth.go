@@ -1,9 +1,14 @@
 package middleware
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+
+	"gin-swagger-api/internal/domain"
+	"gin-swagger-api/internal/handler/httperr"
 )
 
 // Auth is a simple authentication middleware
@@ -16,15 +21,19 @@ func Auth() gin.HandlerFunc {
 		// For demo purposes, accept any non-empty API key
 		// In production, validate against a database or secret
 		if apiKey == "" {
-			c.JSON(http.StatusUnauthorized, gin.H{
-				"error": "unauthorized - missing API key",
-			})
-			c.Abort()
+			httperr.Abort(c, http.StatusUnauthorized, "missing API key")
 			return
 		}
 
 		// Set user info in context for later use
 		c.Set("api_key", apiKey)
+		c.Request = c.Request.WithContext(domain.WithActor(c.Request.Context(), actor(apiKey)))
 		c.Next()
 	}
 }
+
+// actor identifies the caller of an API key without revealing the key
+func actor(apiKey string) string {
+	sum := sha256.Sum256([]byte(apiKey))
+	return "api-key:" + hex.EncodeToString(sum[:4])
+}
@@ -0,0 +1,183 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog/log"
+
+	"gin-swagger-api/internal/domain"
+	"gin-swagger-api/internal/handler/etag"
+	"gin-swagger-api/internal/handler/httperr"
+	portidempotencyrepo "gin-swagger-api/internal/port/repository/idempotencyrepo"
+)
+
+const (
+	// IdempotencyKeyHeader is the request header carrying the key that
+	// makes retries of a POST request safe
+	IdempotencyKeyHeader = "Idempotency-Key"
+	// IdempotentReplayedHeader marks a response replayed for a retry
+	IdempotentReplayedHeader = "Idempotent-Replayed"
+	// maxIdempotencyKeyLength bounds the keys clients can send
+	maxIdempotencyKeyLength = 255
+)
+
+// replayedHeaders are the response headers recorded with the response
+var replayedHeaders = []string{"Content-Type", "Location", etag.Header}
+
+// IdempotencyConfig configures the Idempotency middleware
+type IdempotencyConfig struct {
+	// TTL is how long a stored response is replayed
+	TTL time.Duration
+	// Lease is how long a request holds its key before it completes. A
+	// request that crashes frees its key once the lease runs out.
+	Lease time.Duration
+	// MaxBodySize bounds the request bodies read to fingerprint a request
+	MaxBodySize int64
+}
+
+// Idempotency makes POST requests sent with an Idempotency-Key header safe
+// to retry. The first request with a key runs and its response is stored
+// until cfg.TTL elapses; retries get the stored response back without
+// running again. The key is bound to the method, URL and body of the first
+// request: reusing it for another request returns 422, and retrying while
+// the first request is still running returns 409. Responses with a 5xx
+// status are not stored, so the request can be retried.
+func Idempotency(repo portidempotencyrepo.Repository, cfg IdempotencyConfig) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		key := c.GetHeader(IdempotencyKeyHeader)
+		if c.Request.Method != http.MethodPost || key == "" {
+			c.Next()
+			return
+		}
+		if len(key) > maxIdempotencyKeyLength {
+			httperr.Respond(c, fmt.Errorf("%w: %s must be at most %d characters", domain.ErrInvalidArgument, IdempotencyKeyHeader, maxIdempotencyKeyLength))
+			return
+		}
+
+		body, err := io.ReadAll(http.MaxBytesReader(c.Writer, c.Request.Body, cfg.MaxBodySize))
+		if err != nil {
+			var tooLarge *http.MaxBytesError
+			if errors.As(err, &tooLarge) {
+				httperr.Abort(c, http.StatusRequestEntityTooLarge, fmt.Sprintf("request body is larger than %d bytes", cfg.MaxBodySize))
+				return
+			}
+			httperr.Abort(c, http.StatusBadRequest, "failed to read the request body")
+			return
+		}
+		c.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+		record := domain.IdempotencyRecord{
+			Key:         scopedKey(c, key),
+			Fingerprint: fingerprint(c.Request, body),
+			ExpiresAt:   time.Now().Add(cfg.Lease),
+		}
+		existing, err := repo.Reserve(c.Request.Context(), record)
+		if err != nil {
+			httperr.Respond(c, err)
+			return
+		}
+		if existing != nil {
+			replay(c, existing, record.Fingerprint)
+			return
+		}
+
+		// The stored response must not depend on the client waiting for it
+		ctx := context.WithoutCancel(c.Request.Context())
+		writer := &recordingWriter{ResponseWriter: c.Writer}
+		c.Writer = writer
+		completed := false
+		defer func() {
+			// Also runs when the handler panics
+			if !completed {
+				release(ctx, repo, record)
+			}
+		}()
+
+		c.Next()
+
+		if c.Writer.Status() >= http.StatusInternalServerError {
+			return
+		}
+		record.Status = c.Writer.Status()
+		record.Header = make(map[string]string, len(replayedHeaders))
+		for _, name := range replayedHeaders {
+			if value := c.Writer.Header().Get(name); value != "" {
+				record.Header[name] = value
+			}
+		}
+		record.Body = writer.body.Bytes()
+		record.ExpiresAt = time.Now().Add(cfg.TTL)
+		if err := repo.Complete(ctx, record); err != nil {
+			log.Error().Err(err).Str("key", record.Key).Msg("Failed to store idempotent response")
+			return
+		}
+		completed = true
+	}
+}
+
+// replay answers a request whose key is already taken
+func replay(c *gin.Context, existing *domain.IdempotencyRecord, fingerprint string) {
+	switch {
+	case existing.Fingerprint != fingerprint:
+		httperr.Respond(c, fmt.Errorf("%w: %s was first used for another request", httperr.ErrIdempotencyKeyReused, IdempotencyKeyHeader))
+	case !existing.Completed():
+		httperr.Respond(c, fmt.Errorf("%w: the first request with this %s is still in progress", domain.ErrConflict, IdempotencyKeyHeader))
+	default:
+		for name, value := range existing.Header {
+			c.Header(name, value)
+		}
+		c.Header(IdempotentReplayedHeader, "true")
+		c.Status(existing.Status)
+		_, _ = c.Writer.Write(existing.Body)
+		c.Abort()
+	}
+}
+
+// release frees the key of a request whose response was not stored
+func release(ctx context.Context, repo portidempotencyrepo.Repository, record domain.IdempotencyRecord) {
+	if err := repo.Release(ctx, record); err != nil {
+		log.Error().Err(err).Str("key", record.Key).Msg("Failed to release idempotency key")
+	}
+}
+
+// scopedKey keeps the keys of different API keys apart, so clients cannot
+// see each other's responses by guessing keys
+func scopedKey(c *gin.Context, key string) string {
+	if apiKey := c.GetHeader("X-API-Key"); apiKey != "" {
+		return actor(apiKey) + ":" + key
+	}
+	return key
+}
+
+// fingerprint identifies a request by its method, URL and body
+func fingerprint(r *http.Request, body []byte) string {
+	h := sha256.New()
+	h.Write([]byte(r.Method + " " + r.URL.RequestURI() + "\n"))
+	h.Write(body)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// recordingWriter keeps a copy of the response body
+type recordingWriter struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+}
+
+func (w *recordingWriter) Write(b []byte) (int, error) {
+	w.body.Write(b)
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *recordingWriter) WriteString(s string) (int, error) {
+	w.body.WriteString(s)
+	return w.ResponseWriter.WriteString(s)
+}
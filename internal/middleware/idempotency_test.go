@@ -0,0 +1,217 @@
+package middleware_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"gin-swagger-api/internal/domain"
+	"gin-swagger-api/internal/middleware"
+	mockidempotencyrepo "gin-swagger-api/mock/repository/idempotencyrepo"
+)
+
+var _ = Describe("Idempotency", func() {
+	var (
+		mockRepo *mockidempotencyrepo.MockRepository
+		router   *gin.Engine
+		calls    int
+		status   int
+	)
+
+	BeforeEach(func() {
+		gin.SetMode(gin.TestMode)
+		mockRepo = mockidempotencyrepo.NewMockRepository(GinkgoT())
+		calls = 0
+		status = http.StatusCreated
+
+		router = gin.New()
+		router.Use(middleware.Idempotency(mockRepo, middleware.IdempotencyConfig{TTL: time.Hour, Lease: time.Minute, MaxBodySize: 64}))
+		handle := func(c *gin.Context) {
+			calls++
+			body, _ := c.GetRawData()
+			c.Header("Location", "/api/v1/orders/1")
+			c.Data(status, "application/json", body)
+		}
+		router.POST("/orders", handle)
+		router.GET("/orders", handle)
+	})
+
+	send := func(method, key, body string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(method, "/orders", strings.NewReader(body))
+		if key != "" {
+			req.Header.Set(middleware.IdempotencyKeyHeader, key)
+		}
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	// reserved captures the record passed to Reserve
+	reserved := func(record *domain.IdempotencyRecord) func(domain.IdempotencyRecord) bool {
+		return func(r domain.IdempotencyRecord) bool {
+			*record = r
+			return true
+		}
+	}
+
+	It("should pass requests without a key through", func() {
+		w := send(http.MethodPost, "", `{"user_id":1}`)
+
+		Expect(w.Code).To(Equal(http.StatusCreated))
+		Expect(calls).To(Equal(1))
+	})
+
+	It("should ignore keys on other methods", func() {
+		w := send(http.MethodGet, "key-1", "")
+
+		Expect(w.Code).To(Equal(http.StatusCreated))
+		Expect(calls).To(Equal(1))
+	})
+
+	It("should reject keys longer than 255 characters", func() {
+		w := send(http.MethodPost, strings.Repeat("k", 256), `{"user_id":1}`)
+
+		Expect(w.Code).To(Equal(http.StatusBadRequest))
+		Expect(calls).To(BeZero())
+	})
+
+	It("should store the response of the first request", func() {
+		var record domain.IdempotencyRecord
+		mockRepo.EXPECT().Reserve(mock.Anything, mock.MatchedBy(reserved(&record))).Return(nil, nil)
+		mockRepo.EXPECT().
+			Complete(mock.Anything, mock.MatchedBy(func(r domain.IdempotencyRecord) bool {
+				return r.Key == "key-1" &&
+					r.Fingerprint == record.Fingerprint &&
+					r.Status == http.StatusCreated &&
+					r.Header["Location"] == "/api/v1/orders/1" &&
+					r.Header["Content-Type"] == "application/json" &&
+					string(r.Body) == `{"user_id":1}` &&
+					r.ExpiresAt.After(time.Now().Add(59*time.Minute))
+			})).
+			Return(nil)
+
+		w := send(http.MethodPost, "key-1", `{"user_id":1}`)
+
+		Expect(w.Code).To(Equal(http.StatusCreated))
+		Expect(w.Body.String()).To(Equal(`{"user_id":1}`))
+		Expect(calls).To(Equal(1))
+		Expect(record.ExpiresAt).To(BeTemporally("~", time.Now().Add(time.Minute), time.Second))
+	})
+
+	It("should reject a body larger than the limit without reserving the key", func() {
+		w := send(http.MethodPost, "key-1", strings.Repeat("x", 65))
+
+		Expect(w.Code).To(Equal(http.StatusRequestEntityTooLarge))
+		Expect(calls).To(BeZero())
+	})
+
+	It("should replay the stored response without running the request again", func() {
+		var record domain.IdempotencyRecord
+		mockRepo.EXPECT().Reserve(mock.Anything, mock.MatchedBy(reserved(&record))).Return(nil, nil).Once()
+		mockRepo.EXPECT().Complete(mock.Anything, mock.Anything).Return(nil)
+		send(http.MethodPost, "key-1", `{"user_id":1}`)
+
+		mockRepo.EXPECT().
+			Reserve(mock.Anything, mock.Anything).
+			Return(&domain.IdempotencyRecord{
+				Key:         "key-1",
+				Fingerprint: record.Fingerprint,
+				Status:      http.StatusCreated,
+				Header:      map[string]string{"Content-Type": "application/json", "Location": "/api/v1/orders/1"},
+				Body:        []byte(`{"user_id":1}`),
+			}, nil)
+
+		w := send(http.MethodPost, "key-1", `{"user_id":1}`)
+
+		Expect(w.Code).To(Equal(http.StatusCreated))
+		Expect(w.Body.String()).To(Equal(`{"user_id":1}`))
+		Expect(w.Header().Get("Location")).To(Equal("/api/v1/orders/1"))
+		Expect(w.Header().Get(middleware.IdempotentReplayedHeader)).To(Equal("true"))
+		Expect(calls).To(Equal(1))
+	})
+
+	It("should reject a key reused with a different body", func() {
+		mockRepo.EXPECT().
+			Reserve(mock.Anything, mock.Anything).
+			Return(&domain.IdempotencyRecord{Key: "key-1", Fingerprint: "another request", Status: http.StatusCreated}, nil)
+
+		w := send(http.MethodPost, "key-1", `{"user_id":2}`)
+
+		Expect(w.Code).To(Equal(http.StatusUnprocessableEntity))
+		Expect(w.Body.String()).To(ContainSubstring("/problems/idempotency-key-reused"))
+		Expect(calls).To(BeZero())
+	})
+
+	It("should reject a retry while the first request is in flight", func() {
+		var record domain.IdempotencyRecord
+		mockRepo.EXPECT().Reserve(mock.Anything, mock.MatchedBy(reserved(&record))).Return(nil, nil).Once()
+		mockRepo.EXPECT().Complete(mock.Anything, mock.Anything).Return(nil)
+		send(http.MethodPost, "key-1", `{"user_id":1}`)
+
+		mockRepo.EXPECT().
+			Reserve(mock.Anything, mock.Anything).
+			Return(&domain.IdempotencyRecord{Key: "key-1", Fingerprint: record.Fingerprint}, nil)
+
+		w := send(http.MethodPost, "key-1", `{"user_id":1}`)
+
+		Expect(w.Code).To(Equal(http.StatusConflict))
+		Expect(calls).To(Equal(1))
+	})
+
+	It("should release the key when the request fails with a server error", func() {
+		status = http.StatusInternalServerError
+		var record domain.IdempotencyRecord
+		mockRepo.EXPECT().Reserve(mock.Anything, mock.MatchedBy(reserved(&record))).Return(nil, nil)
+		mockRepo.EXPECT().Release(mock.Anything, mock.MatchedBy(func(r domain.IdempotencyRecord) bool {
+			return r.Key == "key-1" && r.Fingerprint == record.Fingerprint
+		})).Return(nil)
+
+		w := send(http.MethodPost, "key-1", `{"user_id":1}`)
+
+		Expect(w.Code).To(Equal(http.StatusInternalServerError))
+	})
+
+	It("should release the key when the response cannot be stored", func() {
+		var record domain.IdempotencyRecord
+		mockRepo.EXPECT().Reserve(mock.Anything, mock.MatchedBy(reserved(&record))).Return(nil, nil)
+		mockRepo.EXPECT().Complete(mock.Anything, mock.Anything).Return(errors.New("connection refused"))
+		mockRepo.EXPECT().Release(mock.Anything, mock.MatchedBy(func(r domain.IdempotencyRecord) bool {
+			return r.Key == "key-1" && r.Fingerprint == record.Fingerprint
+		})).Return(nil)
+
+		w := send(http.MethodPost, "key-1", `{"user_id":1}`)
+
+		Expect(w.Code).To(Equal(http.StatusCreated))
+	})
+
+	It("should keep the keys of different API keys apart", func() {
+		var record domain.IdempotencyRecord
+		mockRepo.EXPECT().Reserve(mock.Anything, mock.MatchedBy(reserved(&record))).Return(nil, nil)
+		mockRepo.EXPECT().Complete(mock.Anything, mock.Anything).Return(nil)
+
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodPost, "/orders", strings.NewReader(`{"user_id":1}`))
+		req.Header.Set(middleware.IdempotencyKeyHeader, "key-1")
+		req.Header.Set("X-API-Key", "secret")
+		router.ServeHTTP(w, req)
+
+		Expect(record.Key).To(HavePrefix("api-key:"))
+		Expect(record.Key).To(HaveSuffix(":key-1"))
+	})
+
+	It("should fail when the store cannot reserve the key", func() {
+		mockRepo.EXPECT().Reserve(mock.Anything, mock.Anything).Return(nil, errors.New("connection refused"))
+
+		w := send(http.MethodPost, "key-1", `{"user_id":1}`)
+
+		Expect(w.Code).To(Equal(http.StatusInternalServerError))
+		Expect(calls).To(BeZero())
+	})
+})
@@ -0,0 +1,37 @@
+package middleware
+
+import (
+	"fmt"
+
+	"github.com/gin-gonic/gin"
+
+	"gin-swagger-api/internal/handler/etag"
+	"gin-swagger-api/internal/handler/httperr"
+)
+
+// ifMatchRequiredKey is the gin context key marking that writes must send
+// an If-Match header
+const ifMatchRequiredKey = "if_match_required"
+
+// Preconditions marks whether RequireIfMatch rejects requests without an
+// If-Match header
+func Preconditions(required bool) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Set(ifMatchRequiredKey, required)
+		c.Next()
+	}
+}
+
+// RequireIfMatch rejects requests that do not send an If-Match header when
+// Preconditions requires one, so clients cannot overwrite changes they
+// have not seen. It guards the routes whose handlers check the ETag of the
+// resource.
+func RequireIfMatch() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.GetBool(ifMatchRequiredKey) && c.GetHeader(etag.IfMatchHeader) == "" {
+			httperr.Respond(c, fmt.Errorf("%w: send the ETag of the resource in %s", httperr.ErrPreconditionRequired, etag.IfMatchHeader))
+			return
+		}
+		c.Next()
+	}
+}
@@ -0,0 +1,65 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/gin-gonic/gin"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"gin-swagger-api/internal/middleware"
+)
+
+var _ = Describe("RequireIfMatch", func() {
+	// newRouter guards PATCH /products/:id with RequireIfMatch and leaves
+	// PUT /products/:id unguarded
+	newRouter := func(required bool) *gin.Engine {
+		gin.SetMode(gin.TestMode)
+		router := gin.New()
+		router.Use(middleware.Preconditions(required))
+		ok := func(c *gin.Context) { c.Status(http.StatusOK) }
+		router.PATCH("/products/:id", middleware.RequireIfMatch(), ok)
+		router.PUT("/products/:id", ok)
+		return router
+	}
+
+	// send makes a request with an optional If-Match header
+	send := func(router *gin.Engine, method, ifMatch string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, "/products/1", nil)
+		if ifMatch != "" {
+			req.Header.Set("If-Match", ifMatch)
+		}
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	Context("when If-Match is required", func() {
+		It("should reject a guarded route without If-Match", func() {
+			w := send(newRouter(true), http.MethodPatch, "")
+
+			Expect(w.Code).To(Equal(http.StatusPreconditionRequired))
+		})
+
+		It("should let a guarded route with If-Match through", func() {
+			w := send(newRouter(true), http.MethodPatch, `"abc"`)
+
+			Expect(w.Code).To(Equal(http.StatusOK))
+		})
+
+		It("should leave other routes alone", func() {
+			w := send(newRouter(true), http.MethodPut, "")
+
+			Expect(w.Code).To(Equal(http.StatusOK))
+		})
+	})
+
+	Context("when If-Match is not required", func() {
+		It("should let a guarded route without If-Match through", func() {
+			w := send(newRouter(false), http.MethodPatch, "")
+
+			Expect(w.Code).To(Equal(http.StatusOK))
+		})
+	})
+})
@@ -0,0 +1,24 @@
+package middleware
+
+import (
+	"crypto/rand"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RequestIDHeader is the header carrying the request ID
+const RequestIDHeader = "X-Request-ID"
+
+// RequestID propagates the client's request ID or generates a new one,
+// and echoes it in the response so errors can be correlated with logs
+func RequestID() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		requestID := c.GetHeader(RequestIDHeader)
+		if requestID == "" {
+			requestID = rand.Text()
+		}
+
+		c.Header(RequestIDHeader, requestID)
+		c.Next()
+	}
+}
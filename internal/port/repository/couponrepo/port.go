@@ -0,0 +1,27 @@
+package couponrepo
+
+import (
+	"context"
+
+	"gin-swagger-api/internal/domain"
+)
+
+// Repository defines the coupon repository interface.
+// Coupons are listed in ID order and looked up by their normalized code.
+// Writes that take a version only apply while the coupon is still at that
+// version; an empty version applies unconditionally. Delete fails with
+// domain.ErrConflict once the coupon has been redeemed.
+// Redeem joins the transaction carried by the context, so the redemption
+// commits or rolls back with the order it was made for. It counts the use
+// and records it in one step and fails with domain.ErrCouponUsedUp when
+// the coupon or the user has reached their limit; concurrent redemptions
+// of a coupon are serialized so neither limit can be exceeded.
+type Repository interface {
+	GetAll(ctx context.Context, page domain.PageRequest) (*domain.Page[domain.Coupon], error)
+	GetByID(ctx context.Context, id int) (*domain.Coupon, error)
+	GetByCode(ctx context.Context, code string) (*domain.Coupon, error)
+	Create(ctx context.Context, coupon domain.Coupon) (*domain.Coupon, error)
+	Update(ctx context.Context, id int, version string, coupon domain.Coupon) (*domain.Coupon, error)
+	Delete(ctx context.Context, id int, version string) error
+	Redeem(ctx context.Context, redemption domain.CouponRedemption) error
+}
@@ -0,0 +1,23 @@
+package idempotencyrepo
+
+import (
+	"context"
+
+	"gin-swagger-api/internal/domain"
+)
+
+// Repository defines the idempotency key store interface.
+// Keys are forgotten once their record expires.
+type Repository interface {
+	// Reserve records the key of a request in flight. When the key is
+	// already taken it returns the existing record and stores nothing.
+	Reserve(ctx context.Context, record domain.IdempotencyRecord) (*domain.IdempotencyRecord, error)
+	// Complete stores the response of the request holding the key. It
+	// stores nothing unless the key is still in flight with the record's
+	// fingerprint.
+	Complete(ctx context.Context, record domain.IdempotencyRecord) error
+	// Release forgets the key so the request can be retried. Like
+	// Complete it leaves a key alone that is no longer in flight with the
+	// record's fingerprint.
+	Release(ctx context.Context, record domain.IdempotencyRecord) error
+}
@@ -0,0 +1,14 @@
+package ordereventrepo
+
+import (
+	"context"
+
+	"gin-swagger-api/internal/domain"
+)
+
+// Repository defines the order event repository interface.
+// Events are append-only; they are listed oldest first.
+type Repository interface {
+	Create(ctx context.Context, event domain.OrderEvent) (*domain.OrderEvent, error)
+	GetByOrderID(ctx context.Context, orderID int) ([]domain.OrderEvent, error)
+}
@@ -6,11 +6,39 @@ import (
 	"gin-swagger-api/internal/domain"
 )
 
-// Repository defines the order repository interface
+// Repository defines the order repository interface.
+// Writes that take a version only apply while the order is still at that
+// version; an empty version applies unconditionally. Orders are returned
+// with their items, and writes that take items replace all of them.
+// Create records the user, items, total and status of the order with the
+// currency, exchange rate and coupon discount it was priced with; none of
+// the latter change afterwards.
+// Reads load the relations selected by expand with one query per relation,
+// however many orders they return. Stream holds one batch of orders in
+// memory at a time, however many match.
+// The ByUser and ByProduct writes apply to every order placed by the user
+// or with an item for the product; Detach keeps those orders but clears
+// their reference.
+// Delete marks the order deleted and keeps its items; reads skip it unless
+// their context comes from domain.WithDeleted. Restore clears the mark and
+// Purge removes the order with its items. The ByUser and ByProduct writes
+// cover soft-deleted orders too.
 type Repository interface {
-	GetAll(ctx context.Context) ([]domain.Order, error)
-	GetByID(ctx context.Context, id int) (*domain.Order, error)
-	Create(ctx context.Context, userID, productID, quantity int, totalPrice float64, status string) (*domain.Order, error)
-	Update(ctx context.Context, id, quantity int, totalPrice float64, status string) (*domain.Order, error)
-	Delete(ctx context.Context, id int) error
+	GetAll(ctx context.Context, list domain.ListQuery, page domain.PageRequest, expand domain.OrderExpand) (*domain.Page[domain.Order], error)
+	GetByID(ctx context.Context, id int, expand domain.OrderExpand) (*domain.Order, error)
+	Stream(ctx context.Context, list domain.ListQuery, batchSize int, fn func([]domain.Order) error) error
+	GetByUser(ctx context.Context, userID int, page domain.PageRequest) (*domain.Page[domain.Order], error)
+	GetByProduct(ctx context.Context, productID int, page domain.PageRequest) (*domain.Page[domain.Order], error)
+	Create(ctx context.Context, order domain.Order) (*domain.Order, error)
+	Update(ctx context.Context, id int, version string, items []domain.OrderItem, totalPrice domain.Money, status string) (*domain.Order, error)
+	Patch(ctx context.Context, id int, version string, patch domain.OrderPatch) (*domain.Order, error)
+	Delete(ctx context.Context, id int, version string) error
+	Restore(ctx context.Context, id int) (*domain.Order, error)
+	Purge(ctx context.Context, id int) error
+	CountByUser(ctx context.Context, userID int) (int, error)
+	CountByProduct(ctx context.Context, productID int) (int, error)
+	DeleteByUser(ctx context.Context, userID int) ([]domain.Order, error)
+	DeleteByProduct(ctx context.Context, productID int) ([]domain.Order, error)
+	DetachUser(ctx context.Context, userID int) error
+	DetachProduct(ctx context.Context, productID int) error
 }
@@ -6,11 +6,29 @@ import (
 	"gin-swagger-api/internal/domain"
 )
 
-// Repository defines the product repository interface
+// Repository defines the product repository interface.
+// Writes that take a version only apply while the product is still at
+// that version; an empty version applies unconditionally.
+// Stock moves join the transaction carried by the context, so callers can
+// make them together with the writes that depend on them.
+// CreateBulk inserts the products in one statement and returns them with
+// their IDs, in the given order.
+// Stream holds one batch of products in memory at a time, however many
+// match.
+// Deleted products stay stored, hidden from reads whose context does not
+// come from domain.WithDeleted, until Purge removes them; Restore brings
+// one back. Stock cannot be reserved from a soft-deleted product.
 type Repository interface {
-	GetAll(ctx context.Context) ([]domain.Product, error)
+	GetAll(ctx context.Context, list domain.ListQuery, page domain.PageRequest) (*domain.Page[domain.Product], error)
 	GetByID(ctx context.Context, id int) (*domain.Product, error)
-	Create(ctx context.Context, name, description string, price float64, stock int) (*domain.Product, error)
-	Update(ctx context.Context, id int, name, description string, price float64, stock int) (*domain.Product, error)
-	Delete(ctx context.Context, id int) error
+	Stream(ctx context.Context, list domain.ListQuery, batchSize int, fn func([]domain.Product) error) error
+	Create(ctx context.Context, name, description string, price domain.Money, stock int) (*domain.Product, error)
+	CreateBulk(ctx context.Context, products []domain.Product) ([]domain.Product, error)
+	Update(ctx context.Context, id int, version, name, description string, price domain.Money, stock int) (*domain.Product, error)
+	Patch(ctx context.Context, id int, version string, patch domain.ProductPatch) (*domain.Product, error)
+	Delete(ctx context.Context, id int, version string) error
+	Restore(ctx context.Context, id int) (*domain.Product, error)
+	Purge(ctx context.Context, id int) error
+	ReserveStock(ctx context.Context, id, quantity int) error
+	ReleaseStock(ctx context.Context, id, quantity int) error
 }
@@ -0,0 +1,20 @@
+package raterepo
+
+import (
+	"context"
+
+	"gin-swagger-api/internal/domain"
+)
+
+// Repository defines the exchange rate table interface. It holds one rate
+// per currency other than domain.BaseCurrency.
+type Repository interface {
+	// GetAll returns the rates ordered by currency
+	GetAll(ctx context.Context) ([]domain.ExchangeRate, error)
+	// Get returns the rate of a currency, or domain.ErrNotFound
+	Get(ctx context.Context, currency string) (*domain.ExchangeRate, error)
+	// Set adds the rate of its currency or replaces it
+	Set(ctx context.Context, rate domain.ExchangeRate) (*domain.ExchangeRate, error)
+	// Delete removes the rate of a currency, or returns domain.ErrNotFound
+	Delete(ctx context.Context, currency string) error
+}
@@ -0,0 +1,15 @@
+package reportrepo
+
+import (
+	"context"
+
+	"gin-swagger-api/internal/domain"
+)
+
+// Repository defines the report repository interface.
+// Reports are aggregated by the database and leave cancelled and deleted orders out.
+// Sales returns one row per group of the query's dimension, or a single
+// row with an empty key when the query has none.
+type Repository interface {
+	Sales(ctx context.Context, query domain.SalesQuery) ([]domain.SalesRow, error)
+}
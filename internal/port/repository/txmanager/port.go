@@ -0,0 +1,14 @@
+package txmanager
+
+import "context"
+
+// Manager defines the transaction manager interface.
+// Repositories called with the context passed to fn take part in the
+// transaction, so writes across several repositories commit or roll back
+// together.
+type Manager interface {
+	// WithinTx runs fn in a transaction that is committed when fn returns
+	// nil and rolled back otherwise. Called inside a transaction it joins
+	// the outer one.
+	WithinTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
@@ -6,11 +6,23 @@ import (
 	"gin-swagger-api/internal/domain"
 )
 
-// Repository defines the user repository interface
+// Repository defines the user repository interface.
+// Writes that take a version only apply while the user is still at that
+// version; an empty version applies unconditionally.
+// CreateBulk inserts the users in one statement and returns them with
+// their IDs, in the given order.
+// Stream holds one batch of users in memory at a time, however many match.
+// Delete only soft-deletes: reads skip soft-deleted users unless their
+// context comes from domain.WithDeleted. Restore undoes a soft delete and
+// Purge removes the user for good.
 type Repository interface {
-	GetAll(ctx context.Context) ([]domain.User, error)
+	GetAll(ctx context.Context, list domain.ListQuery, page domain.PageRequest) (*domain.Page[domain.User], error)
 	GetByID(ctx context.Context, id int) (*domain.User, error)
+	Stream(ctx context.Context, list domain.ListQuery, batchSize int, fn func([]domain.User) error) error
 	Create(ctx context.Context, name, email string) (*domain.User, error)
-	Update(ctx context.Context, id int, name, email string) (*domain.User, error)
-	Delete(ctx context.Context, id int) error
+	CreateBulk(ctx context.Context, users []domain.User) ([]domain.User, error)
+	Update(ctx context.Context, id int, version, name, email string) (*domain.User, error)
+	Delete(ctx context.Context, id int, version string) error
+	Restore(ctx context.Context, id int) (*domain.User, error)
+	Purge(ctx context.Context, id int) error
 }
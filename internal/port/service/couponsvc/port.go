@@ -0,0 +1,22 @@
+package couponsvc
+
+import (
+	"context"
+
+	"gin-swagger-api/internal/domain"
+)
+
+// Service defines the coupon service interface.
+// Codes are matched regardless of case and stored upper-cased. Coupons
+// that fail domain.Coupon.Validate are rejected with a
+// domain.ValidationError. Writes that take a version fail with
+// domain.ErrPreconditionFailed when the coupon has changed since; an
+// empty version skips the check. A coupon that has been redeemed cannot
+// be deleted, only ended by setting ends_at.
+type Service interface {
+	GetCoupons(ctx context.Context, page domain.PageRequest) (*domain.Page[domain.Coupon], error)
+	GetCoupon(ctx context.Context, id string) (*domain.Coupon, error)
+	CreateCoupon(ctx context.Context, coupon domain.Coupon) (*domain.Coupon, error)
+	UpdateCoupon(ctx context.Context, id, version string, coupon domain.Coupon) (*domain.Coupon, error)
+	DeleteCoupon(ctx context.Context, id, version string) error
+}
@@ -6,11 +6,66 @@ import (
 	"gin-swagger-api/internal/domain"
 )
 
-// Service defines the order service interface
+// CreateOrderInput describes a new order. Only the product and quantity of
+// each item are used. Currency is the base currency when empty and
+// CouponCode is optional.
+type CreateOrderInput struct {
+	UserID     int
+	Items      []domain.OrderItem
+	Currency   string
+	CouponCode string
+}
+
+// Service defines the order service interface.
+// Writes that take a version fail with domain.ErrPreconditionFailed when
+// the order has changed since; an empty version skips the check. Item
+// prices and order totals are priced from the products, never supplied by
+// callers. Status changes follow the lifecycle in
+// domain.CheckOrderTransition and fail with domain.ErrInvalidTransition
+// when it does not allow them; each change is recorded in the order
+// history with the actor in the context.
 type Service interface {
-	GetOrders(ctx context.Context) ([]domain.Order, error)
-	GetOrder(ctx context.Context, id string) (*domain.Order, error)
-	CreateOrder(ctx context.Context, userID, productID, quantity int, totalPrice float64, status string) (*domain.Order, error)
-	UpdateOrder(ctx context.Context, id string, userID, productID, quantity int, totalPrice float64, status string) (*domain.Order, error)
-	DeleteOrder(ctx context.Context, id string) error
+	GetOrders(ctx context.Context, list domain.ListQuery, page domain.PageRequest, expand domain.OrderExpand) (*domain.Page[domain.Order], error)
+	ExportOrders(ctx context.Context, list domain.ListQuery, fn func([]domain.Order) error) error
+	GetOrder(ctx context.Context, id string, expand domain.OrderExpand) (*domain.Order, error)
+	GetOrderHistory(ctx context.Context, id string) ([]domain.OrderEvent, error)
+
+	// CreateOrder places a pending order in its currency, with prices
+	// converted from the product prices at the exchange rate of that
+	// currency. A coupon code takes the discount off the items total and
+	// is redeemed in the transaction that places the order; a coupon that
+	// does not exist or apply fails with a domain.ValidationError on
+	// coupon_code, and one that reached its usage limit with
+	// domain.ErrCouponUsedUp.
+	CreateOrder(ctx context.Context, input CreateOrderInput) (*domain.Order, error)
+
+	// UpdateOrder replaces the items of an order and changes its status.
+	// Added products are priced at the exchange rate the order was placed
+	// at and the coupon discount is kept. Changing the items of an order
+	// that is no longer pending or paid fails with domain.ErrOrderClosed.
+	UpdateOrder(ctx context.Context, id, version string, items []domain.OrderItem, status string) (*domain.Order, error)
+
+	// PatchOrder changes the fields set in patch, like UpdateOrder
+	PatchOrder(ctx context.Context, id, version string, patch domain.OrderPatch) (*domain.Order, error)
+
+	// TransitionOrder moves an order to status, recording reason in its
+	// history
+	TransitionOrder(ctx context.Context, id, version, status, reason string) (*domain.Order, error)
+
+	// DeleteOrder soft-deletes an order and returns its stock to the
+	// products
+	DeleteOrder(ctx context.Context, id, version string) error
+
+	// RestoreOrder brings back a soft-deleted order and reserves its stock
+	// again, failing with domain.ErrInsufficientStock when it is gone
+	RestoreOrder(ctx context.Context, id string) (*domain.Order, error)
+
+	// PurgeOrder removes a soft-deleted order for good and fails with
+	// domain.ErrNotDeleted otherwise
+	PurgeOrder(ctx context.Context, id string) error
+
+	// BatchOrders applies the creates, updates and deletes of a batch in
+	// its mode and reports the outcome of each item; failing items are not
+	// errors. Orders are created one at a time, since each reserves stock.
+	BatchOrders(ctx context.Context, batch domain.Batch[domain.Order]) (*domain.BatchResults[domain.Order], error)
 }
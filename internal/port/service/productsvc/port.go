@@ -6,11 +6,28 @@ import (
 	"gin-swagger-api/internal/domain"
 )
 
-// Service defines the product service interface
+// Service defines the product service interface.
+// Writes that take a version fail with domain.ErrPreconditionFailed when
+// the product has changed since; an empty version skips the check.
+// ImportProducts applies the rows in one transaction. Rows that cannot be
+// applied are reported as failed and skipped; any other error rolls back
+// the whole import. A dry run reports the same results and rolls back.
+// BatchProducts applies the creates, updates and deletes of a batch in its
+// mode and reports the outcome of each item; failing items are not errors.
+// DeleteProduct only soft-deletes; the product delete policy applies when
+// PurgeProduct removes the product for good, which it only does to a
+// soft-deleted product and otherwise fails with domain.ErrNotDeleted.
 type Service interface {
-	GetProducts(ctx context.Context) ([]domain.Product, error)
+	GetProducts(ctx context.Context, list domain.ListQuery, page domain.PageRequest) (*domain.Page[domain.Product], error)
+	ExportProducts(ctx context.Context, list domain.ListQuery, fn func([]domain.Product) error) error
 	GetProduct(ctx context.Context, id string) (*domain.Product, error)
-	CreateProduct(ctx context.Context, name, description string, price float64, stock int) (*domain.Product, error)
-	UpdateProduct(ctx context.Context, id, name, description string, price float64, stock int) (*domain.Product, error)
-	DeleteProduct(ctx context.Context, id string) error
+	GetProductOrders(ctx context.Context, id string, page domain.PageRequest) (*domain.Page[domain.Order], error)
+	CreateProduct(ctx context.Context, name, description string, price domain.Money, stock int) (*domain.Product, error)
+	ImportProducts(ctx context.Context, rows []domain.ProductImportRow, dryRun bool) ([]domain.ImportResult, error)
+	UpdateProduct(ctx context.Context, id, version, name, description string, price domain.Money, stock int) (*domain.Product, error)
+	PatchProduct(ctx context.Context, id, version string, patch domain.ProductPatch) (*domain.Product, error)
+	DeleteProduct(ctx context.Context, id, version string) error
+	RestoreProduct(ctx context.Context, id string) (*domain.Product, error)
+	PurgeProduct(ctx context.Context, id string) error
+	BatchProducts(ctx context.Context, batch domain.Batch[domain.Product]) (*domain.BatchResults[domain.Product], error)
 }
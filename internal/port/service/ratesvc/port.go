@@ -0,0 +1,19 @@
+package ratesvc
+
+import (
+	"context"
+
+	"gin-swagger-api/internal/domain"
+)
+
+// Service defines the exchange rate service interface. Rates convert
+// domain.BaseCurrency prices to the other supported currencies. The base
+// currency always has rate one and cannot be set or deleted; currencies
+// are ISO 4217 codes in any case, and unsupported ones fail with
+// domain.ErrInvalidArgument.
+type Service interface {
+	GetRates(ctx context.Context) ([]domain.ExchangeRate, error)
+	GetRate(ctx context.Context, currency string) (*domain.ExchangeRate, error)
+	SetRate(ctx context.Context, currency, rate string) (*domain.ExchangeRate, error)
+	DeleteRate(ctx context.Context, currency string) error
+}
@@ -0,0 +1,12 @@
+package reportsvc
+
+import (
+	"context"
+
+	"gin-swagger-api/internal/domain"
+)
+
+// Service defines the report service interface
+type Service interface {
+	GetSalesReport(ctx context.Context, query domain.SalesQuery) (*domain.SalesReport, error)
+}
@@ -6,11 +6,24 @@ import (
 	"gin-swagger-api/internal/domain"
 )
 
-// Service defines the interface for user business logic
+// Service defines the interface for user business logic.
+// Writes that take a version fail with domain.ErrPreconditionFailed when
+// the user has changed since; an empty version skips the check.
+// BatchUsers applies the creates, updates and deletes of a batch in its
+// mode and reports the outcome of each item; failing items are not errors.
+// DeleteUser soft-deletes the user and RestoreUser brings it back.
+// PurgeUser removes a soft-deleted user for good, applying the user delete
+// policy to its orders, and fails with domain.ErrNotDeleted for a user
+// that is not soft-deleted.
 type Service interface {
-	GetUsers(ctx context.Context) ([]domain.User, error)
+	GetUsers(ctx context.Context, list domain.ListQuery, page domain.PageRequest) (*domain.Page[domain.User], error)
+	ExportUsers(ctx context.Context, list domain.ListQuery, fn func([]domain.User) error) error
 	GetUser(ctx context.Context, id string) (*domain.User, error)
+	GetUserOrders(ctx context.Context, id string, page domain.PageRequest) (*domain.Page[domain.Order], error)
 	CreateUser(ctx context.Context, name, email string) (*domain.User, error)
-	UpdateUser(ctx context.Context, id, name, email string) (*domain.User, error)
-	DeleteUser(ctx context.Context, id string) error
+	UpdateUser(ctx context.Context, id, version, name, email string) (*domain.User, error)
+	DeleteUser(ctx context.Context, id, version string) error
+	RestoreUser(ctx context.Context, id string) (*domain.User, error)
+	PurgeUser(ctx context.Context, id string) error
+	BatchUsers(ctx context.Context, batch domain.Batch[domain.User]) (*domain.BatchResults[domain.User], error)
 }
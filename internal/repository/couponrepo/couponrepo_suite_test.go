@@ -0,0 +1,13 @@
+package couponrepo_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestCouponRepo(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "CouponRepo Suite")
+}
@@ -0,0 +1,292 @@
+package couponrepo
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"entgo.io/ent/dialect/sql"
+
+	"gin-swagger-api/internal/domain"
+	portcouponrepo "gin-swagger-api/internal/port/repository/couponrepo"
+	porttxmanager "gin-swagger-api/internal/port/repository/txmanager"
+	"gin-swagger-api/internal/repository/paging"
+	"gin-swagger-api/internal/repository/repoerr"
+	"gin-swagger-api/internal/repository/txmanager"
+
+	"github.com/snilli/ormprovider"
+	"github.com/snilli/ormprovider/ent"
+	"github.com/snilli/ormprovider/ent/coupon"
+	"github.com/snilli/ormprovider/ent/couponredemption"
+	"github.com/snilli/ormprovider/ent/predicate"
+)
+
+// Repository implements the coupon repository interface
+type Repository struct {
+	db        *ormprovider.Client
+	txManager porttxmanager.Manager
+}
+
+// New creates a new coupon repository. Its multi-statement writes run in
+// a transaction of txManager.
+func New(db *ormprovider.Client, txManager porttxmanager.Manager) portcouponrepo.Repository {
+	return &Repository{db: db, txManager: txManager}
+}
+
+// GetAll retrieves a page of coupons in ID order
+func (r *Repository) GetAll(ctx context.Context, page domain.PageRequest) (*domain.Page[domain.Coupon], error) {
+	window, err := paging.NewWindow(page, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	query := r.client(ctx).Coupon.Query()
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, repoerr.Translate(err, "coupon")
+	}
+
+	switch {
+	case window.Descending():
+		query = query.Where(coupon.IDLT(window.Cursor.ID)).Order(ent.Desc(coupon.FieldID))
+	case window.HasCursor:
+		query = query.Where(coupon.IDGT(window.Cursor.ID)).Order(ent.Asc(coupon.FieldID))
+	default:
+		query = query.Order(ent.Asc(coupon.FieldID)).Offset(window.Offset)
+	}
+
+	entCoupons, err := query.Limit(window.Fetch()).All(ctx)
+	if err != nil {
+		return nil, repoerr.Translate(err, "coupon")
+	}
+
+	return paging.NewPage(window, entCoupons, total, couponID, toCoupon), nil
+}
+
+// GetByID retrieves a coupon by ID
+func (r *Repository) GetByID(ctx context.Context, id int) (*domain.Coupon, error) {
+	entCoupon, err := r.client(ctx).Coupon.Get(ctx, id)
+	if err != nil {
+		return nil, repoerr.Translate(err, "coupon")
+	}
+
+	c := toCoupon(entCoupon)
+	return &c, nil
+}
+
+// GetByCode retrieves a coupon by its normalized code
+func (r *Repository) GetByCode(ctx context.Context, code string) (*domain.Coupon, error) {
+	entCoupon, err := r.client(ctx).Coupon.Query().
+		Where(coupon.Code(code)).
+		Only(ctx)
+	if err != nil {
+		return nil, repoerr.Translate(err, "coupon")
+	}
+
+	c := toCoupon(entCoupon)
+	return &c, nil
+}
+
+// Create creates a new coupon. A code that is already taken is a conflict.
+func (r *Repository) Create(ctx context.Context, c domain.Coupon) (*domain.Coupon, error) {
+	entCoupon, err := r.client(ctx).Coupon.Create().
+		SetCode(c.Code).
+		SetType(c.Type).
+		SetPercent(c.Percent).
+		SetAmount(c.Amount.Amount).
+		SetMinOrderValue(c.MinOrderValue.Amount).
+		SetUsageLimit(c.UsageLimit).
+		SetPerUserLimit(c.PerUserLimit).
+		SetNillableStartsAt(c.StartsAt).
+		SetNillableEndsAt(c.EndsAt).
+		Save(ctx)
+	if err != nil {
+		return nil, repoerr.Translate(err, "coupon")
+	}
+
+	created := toCoupon(entCoupon)
+	return &created, nil
+}
+
+// Update updates a coupon, keeping its redemptions. A non-empty version
+// must match the current version of the coupon.
+func (r *Repository) Update(ctx context.Context, id int, version string, c domain.Coupon) (*domain.Coupon, error) {
+	guard, err := r.guard(ctx, id, version)
+	if err != nil {
+		return nil, err
+	}
+
+	update := r.client(ctx).Coupon.UpdateOneID(id).
+		Where(guard...).
+		SetCode(c.Code).
+		SetType(c.Type).
+		SetPercent(c.Percent).
+		SetAmount(c.Amount.Amount).
+		SetMinOrderValue(c.MinOrderValue.Amount).
+		SetUsageLimit(c.UsageLimit).
+		SetPerUserLimit(c.PerUserLimit).
+		SetNillableStartsAt(c.StartsAt).
+		SetNillableEndsAt(c.EndsAt)
+	if c.StartsAt == nil {
+		update.ClearStartsAt()
+	}
+	if c.EndsAt == nil {
+		update.ClearEndsAt()
+	}
+
+	entCoupon, err := update.Save(ctx)
+	if err != nil {
+		return nil, repoerr.TranslateGuarded(err, "coupon", guard != nil)
+	}
+
+	updated := toCoupon(entCoupon)
+	return &updated, nil
+}
+
+// Delete deletes a coupon. A non-empty version must match the current
+// version of the coupon. The redemptions of a coupon reference it, so a
+// coupon that has been redeemed cannot be deleted.
+func (r *Repository) Delete(ctx context.Context, id int, version string) error {
+	guard, err := r.guard(ctx, id, version)
+	if err != nil {
+		return err
+	}
+
+	err = r.client(ctx).Coupon.DeleteOneID(id).
+		Where(guard...).
+		Exec(ctx)
+	return repoerr.TranslateGuarded(err, "coupon", guard != nil)
+}
+
+// Redeem counts a use of a coupon and records the redemption, in the
+// transaction carried by ctx or a new one. The usage limit is checked by
+// the update that counts the use, which also locks the coupon row until
+// the transaction ends, so the per-user count that follows cannot race
+// with another redemption of the same coupon.
+func (r *Repository) Redeem(ctx context.Context, redemption domain.CouponRedemption) error {
+	return r.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		client := r.client(ctx)
+
+		n, err := client.Coupon.Update().
+			Where(coupon.ID(redemption.CouponID), underUsageLimit()).
+			AddRedemptions(1).
+			Save(ctx)
+		if err != nil {
+			return repoerr.Translate(err, "coupon")
+		}
+
+		entCoupon, err := client.Coupon.Get(ctx, redemption.CouponID)
+		if err != nil {
+			return repoerr.Translate(err, "coupon")
+		}
+		if n == 0 {
+			return fmt.Errorf("coupon %s %w", entCoupon.Code, domain.ErrCouponUsedUp)
+		}
+
+		if entCoupon.PerUserLimit > 0 {
+			used, err := client.CouponRedemption.Query().
+				Where(
+					couponredemption.CouponID(redemption.CouponID),
+					couponredemption.UserID(redemption.UserID),
+				).
+				Count(ctx)
+			if err != nil {
+				return repoerr.Translate(err, "coupon redemption")
+			}
+			if used >= entCoupon.PerUserLimit {
+				return fmt.Errorf("coupon %s %w for user %d", entCoupon.Code, domain.ErrCouponUsedUp, redemption.UserID)
+			}
+		}
+
+		err = client.CouponRedemption.Create().
+			SetCouponID(redemption.CouponID).
+			SetUserID(redemption.UserID).
+			SetOrderID(redemption.OrderID).
+			SetDiscount(redemption.Discount.Amount).
+			Exec(ctx)
+		return repoerr.Translate(err, "coupon redemption")
+	})
+}
+
+// underUsageLimit matches coupons that can be used at least once more
+func underUsageLimit() predicate.Coupon {
+	return coupon.Or(
+		coupon.UsageLimit(0),
+		predicate.Coupon(func(s *sql.Selector) {
+			s.Where(sql.ColumnsLT(s.C(coupon.FieldRedemptions), s.C(coupon.FieldUsageLimit)))
+		}),
+	)
+}
+
+// guard returns predicates that match the coupon only while it is still
+// at version, so a write using them fails if a concurrent write got in
+// first. An empty version needs no guard.
+func (r *Repository) guard(ctx context.Context, id int, version string) ([]predicate.Coupon, error) {
+	if version == "" {
+		return nil, nil
+	}
+
+	entCoupon, err := r.client(ctx).Coupon.Get(ctx, id)
+	if err != nil {
+		return nil, repoerr.Translate(err, "coupon")
+	}
+	if toCoupon(entCoupon).Version() != version {
+		return nil, repoerr.Stale("coupon")
+	}
+
+	guard := []predicate.Coupon{
+		coupon.Code(entCoupon.Code),
+		coupon.Type(entCoupon.Type),
+		coupon.Percent(entCoupon.Percent),
+		coupon.Amount(entCoupon.Amount),
+		coupon.MinOrderValue(entCoupon.MinOrderValue),
+		coupon.UsageLimit(entCoupon.UsageLimit),
+		coupon.PerUserLimit(entCoupon.PerUserLimit),
+	}
+	if entCoupon.StartsAt == nil {
+		guard = append(guard, coupon.StartsAtIsNil())
+	} else {
+		guard = append(guard, coupon.StartsAt(*entCoupon.StartsAt))
+	}
+	if entCoupon.EndsAt == nil {
+		guard = append(guard, coupon.EndsAtIsNil())
+	} else {
+		guard = append(guard, coupon.EndsAt(*entCoupon.EndsAt))
+	}
+	return guard, nil
+}
+
+// client returns the ent client for ctx, bound to the transaction it
+// carries if any
+func (r *Repository) client(ctx context.Context) *ent.Client {
+	return txmanager.Client(ctx, r.db)
+}
+
+// couponID returns the ID of an ent coupon
+func couponID(entCoupon *ent.Coupon) int {
+	return entCoupon.ID
+}
+
+// toCoupon converts an ent coupon to domain.Coupon. Amounts are in the
+// base currency; an amount that is not set stays the zero Money.
+func toCoupon(entCoupon *ent.Coupon) domain.Coupon {
+	c := domain.Coupon{
+		ID:           strconv.Itoa(entCoupon.ID),
+		Code:         entCoupon.Code,
+		Type:         entCoupon.Type,
+		Percent:      entCoupon.Percent,
+		UsageLimit:   entCoupon.UsageLimit,
+		PerUserLimit: entCoupon.PerUserLimit,
+		Redemptions:  entCoupon.Redemptions,
+		StartsAt:     entCoupon.StartsAt,
+		EndsAt:       entCoupon.EndsAt,
+	}
+	if entCoupon.Amount != 0 {
+		c.Amount = domain.Money{Amount: entCoupon.Amount, Currency: domain.BaseCurrency}
+	}
+	if entCoupon.MinOrderValue != 0 {
+		c.MinOrderValue = domain.Money{Amount: entCoupon.MinOrderValue, Currency: domain.BaseCurrency}
+	}
+	return c
+}
@@ -0,0 +1,295 @@
+package couponrepo_test
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"gin-swagger-api/internal/domain"
+	portcouponrepo "gin-swagger-api/internal/port/repository/couponrepo"
+	"gin-swagger-api/internal/repository/couponrepo"
+	"gin-swagger-api/internal/repository/txmanager"
+	"gin-swagger-api/internal/testutil"
+
+	"github.com/snilli/ormprovider"
+	"github.com/snilli/ormprovider/ent"
+)
+
+var _ = Describe("CouponRepository", func() {
+	var (
+		repo       portcouponrepo.Repository
+		db         *ormprovider.Client
+		ctx        context.Context
+		couponID   int
+		testUserID int
+	)
+
+	save10 := domain.Coupon{Code: "SAVE10", Type: domain.CouponPercentage, Percent: 10}
+
+	// newOrder creates an order of the test user to redeem a coupon on
+	newOrder := func() int {
+		order, err := db.Order.Create().
+			SetUserID(testUserID).
+			SetTotalPrice(10000).
+			SetCurrency(domain.BaseCurrency).
+			SetExchangeRate(string(domain.RateOne)).
+			SetStatus("pending").
+			Save(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		return order.ID
+	}
+
+	// redeem redeems a coupon on a new order of userID
+	redeem := func(couponID, userID int) error {
+		return repo.Redeem(ctx, domain.CouponRedemption{CouponID: couponID, UserID: userID, OrderID: newOrder(), Discount: testutil.THB("10.00")})
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		db = testutil.NewTestDBClient(GinkgoT())
+		repo = couponrepo.New(db, txmanager.New(db))
+
+		// Create test user for foreign keys
+		user, err := db.User.Create().SetName("Test User").SetEmail("test@example.com").Save(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		testUserID = user.ID
+	})
+
+	AfterEach(func() {
+		// Cleanup: close database connection
+		if db != nil {
+			_ = db.Close()
+		}
+	})
+
+	Describe("Create", func() {
+		It("should create a coupon successfully", func() {
+			endsAt := time.Date(2026, 12, 31, 0, 0, 0, 0, time.UTC)
+
+			coupon, err := repo.Create(ctx, domain.Coupon{
+				Code:          "WELCOME",
+				Type:          domain.CouponFixed,
+				Amount:        testutil.THB("100.00"),
+				MinOrderValue: testutil.THB("500.00"),
+				UsageLimit:    100,
+				PerUserLimit:  1,
+				EndsAt:        &endsAt,
+			})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(coupon.ID).ToNot(BeEmpty())
+			Expect(coupon.Amount).To(Equal(testutil.THB("100.00")))
+			Expect(coupon.MinOrderValue).To(Equal(testutil.THB("500.00")))
+			Expect(coupon.StartsAt).To(BeNil())
+			Expect(coupon.EndsAt).To(HaveValue(BeTemporally("==", endsAt)))
+			Expect(coupon.Redemptions).To(BeZero())
+		})
+
+		It("should return ErrConflict for a code that is taken", func() {
+			_, err := repo.Create(ctx, save10)
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = repo.Create(ctx, save10)
+
+			Expect(err).To(MatchError(domain.ErrConflict))
+		})
+	})
+
+	Describe("GetAll", func() {
+		BeforeEach(func() {
+			for _, code := range []string{"ONE", "TWO", "THREE"} {
+				_, err := repo.Create(ctx, domain.Coupon{Code: code, Type: domain.CouponPercentage, Percent: 5})
+				Expect(err).ToNot(HaveOccurred())
+			}
+		})
+
+		It("should return a page of coupons in ID order", func() {
+			page, err := repo.GetAll(ctx, domain.PageRequest{Limit: 2, Offset: 1})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(page.Total).To(Equal(3))
+			Expect(page.Items).To(HaveLen(2))
+			Expect(page.Items[0].Code).To(Equal("TWO"))
+			Expect(page.Items[1].Code).To(Equal("THREE"))
+		})
+	})
+
+	Describe("GetByCode", func() {
+		It("should return the coupon with the code", func() {
+			created, err := repo.Create(ctx, save10)
+			Expect(err).ToNot(HaveOccurred())
+
+			coupon, err := repo.GetByCode(ctx, "SAVE10")
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(coupon).To(Equal(created))
+		})
+
+		It("should return ErrNotFound for an unknown code", func() {
+			coupon, err := repo.GetByCode(ctx, "MISSING")
+
+			Expect(err).To(MatchError(domain.ErrNotFound))
+			Expect(coupon).To(BeNil())
+		})
+	})
+
+	Describe("Update", func() {
+		var current *domain.Coupon
+
+		BeforeEach(func() {
+			var err error
+			current, err = repo.Create(ctx, save10)
+			Expect(err).ToNot(HaveOccurred())
+			couponID, _ = strconv.Atoi(current.ID)
+		})
+
+		It("should update when the version matches and keep the redemptions", func() {
+			Expect(redeem(couponID, testUserID)).To(Succeed())
+			update := save10
+			update.Percent = 15
+
+			coupon, err := repo.Update(ctx, couponID, current.Version(), update)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(coupon.Percent).To(Equal(15))
+			Expect(coupon.Redemptions).To(Equal(1))
+		})
+
+		It("should return ErrPreconditionFailed when the version is stale", func() {
+			coupon, err := repo.Update(ctx, couponID, "stale", save10)
+
+			Expect(err).To(MatchError(domain.ErrPreconditionFailed))
+			Expect(coupon).To(BeNil())
+		})
+
+		It("should return ErrPreconditionFailed when the validity window changed after the version was checked", func() {
+			endsAt := time.Date(2026, 12, 31, 0, 0, 0, 0, time.UTC)
+			changed := false
+			// Change the end date between the version check and the update
+			db.Coupon.Use(func(next ent.Mutator) ent.Mutator {
+				return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+					if !changed && m.Op().Is(ent.OpUpdateOne) {
+						changed = true
+						db.Coupon.UpdateOneID(couponID).SetEndsAt(endsAt).ExecX(ctx)
+					}
+					return next.Mutate(ctx, m)
+				})
+			})
+
+			coupon, err := repo.Update(ctx, couponID, current.Version(), save10)
+
+			Expect(err).To(MatchError(domain.ErrPreconditionFailed))
+			Expect(coupon).To(BeNil())
+		})
+
+		It("should return ErrNotFound when the coupon does not exist", func() {
+			coupon, err := repo.Update(ctx, 99999, "", save10)
+
+			Expect(err).To(MatchError(domain.ErrNotFound))
+			Expect(coupon).To(BeNil())
+		})
+	})
+
+	Describe("Delete", func() {
+		BeforeEach(func() {
+			coupon, err := repo.Create(ctx, save10)
+			Expect(err).ToNot(HaveOccurred())
+			couponID, _ = strconv.Atoi(coupon.ID)
+		})
+
+		It("should delete coupon successfully", func() {
+			Expect(repo.Delete(ctx, couponID, "")).To(Succeed())
+
+			_, err := repo.GetByID(ctx, couponID)
+			Expect(err).To(MatchError(domain.ErrNotFound))
+		})
+
+		It("should keep the coupon when the version is stale", func() {
+			Expect(repo.Delete(ctx, couponID, "stale")).To(MatchError(domain.ErrPreconditionFailed))
+		})
+
+		It("should return ErrConflict once the coupon has been redeemed", func() {
+			Expect(redeem(couponID, testUserID)).To(Succeed())
+
+			Expect(repo.Delete(ctx, couponID, "")).To(MatchError(domain.ErrConflict))
+		})
+	})
+
+	Describe("Redeem", func() {
+		It("should count the use and record the redemption", func() {
+			coupon, err := repo.Create(ctx, save10)
+			Expect(err).ToNot(HaveOccurred())
+			couponID, _ = strconv.Atoi(coupon.ID)
+
+			Expect(redeem(couponID, testUserID)).To(Succeed())
+
+			coupon, err = repo.GetByID(ctx, couponID)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(coupon.Redemptions).To(Equal(1))
+			Expect(db.CouponRedemption.Query().CountX(ctx)).To(Equal(1))
+		})
+
+		It("should return ErrCouponUsedUp once the usage limit is reached", func() {
+			limited := save10
+			limited.UsageLimit = 1
+			coupon, err := repo.Create(ctx, limited)
+			Expect(err).ToNot(HaveOccurred())
+			couponID, _ = strconv.Atoi(coupon.ID)
+			other, err := db.User.Create().SetName("Other User").SetEmail("other@example.com").Save(ctx)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(redeem(couponID, testUserID)).To(Succeed())
+			Expect(redeem(couponID, other.ID)).To(MatchError(domain.ErrCouponUsedUp))
+
+			coupon, err = repo.GetByID(ctx, couponID)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(coupon.Redemptions).To(Equal(1))
+		})
+
+		It("should return ErrCouponUsedUp once the user reached the per-user limit", func() {
+			limited := save10
+			limited.PerUserLimit = 1
+			coupon, err := repo.Create(ctx, limited)
+			Expect(err).ToNot(HaveOccurred())
+			couponID, _ = strconv.Atoi(coupon.ID)
+			other, err := db.User.Create().SetName("Other User").SetEmail("other@example.com").Save(ctx)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(redeem(couponID, testUserID)).To(Succeed())
+			Expect(redeem(couponID, testUserID)).To(MatchError(domain.ErrCouponUsedUp))
+			Expect(redeem(couponID, other.ID)).To(Succeed())
+
+			coupon, err = repo.GetByID(ctx, couponID)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(coupon.Redemptions).To(Equal(2))
+		})
+
+		It("should redeem in the transaction carried by the context", func() {
+			coupon, err := repo.Create(ctx, save10)
+			Expect(err).ToNot(HaveOccurred())
+			couponID, _ = strconv.Atoi(coupon.ID)
+			orderID := newOrder()
+			rollback := errors.New("rollback")
+
+			err = txmanager.New(db).WithinTx(ctx, func(ctx context.Context) error {
+				err := repo.Redeem(ctx, domain.CouponRedemption{CouponID: couponID, UserID: testUserID, OrderID: orderID, Discount: testutil.THB("10.00")})
+				Expect(err).ToNot(HaveOccurred())
+				return rollback
+			})
+
+			Expect(err).To(MatchError(rollback))
+			coupon, err = repo.GetByID(ctx, couponID)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(coupon.Redemptions).To(BeZero())
+			Expect(db.CouponRedemption.Query().CountX(ctx)).To(BeZero())
+		})
+
+		It("should return ErrNotFound when the coupon does not exist", func() {
+			Expect(redeem(99999, testUserID)).To(MatchError(domain.ErrNotFound))
+		})
+	})
+})
@@ -0,0 +1,75 @@
+package filtering
+
+import (
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+
+	"gin-swagger-api/internal/domain"
+)
+
+// Predicates translates resolved filters into predicates of an ent query
+func Predicates[P ~func(*sql.Selector)](filters []domain.Filter) []P {
+	predicates := make([]P, len(filters))
+	for i, f := range filters {
+		predicates[i] = predicate(f)
+	}
+	return predicates
+}
+
+// predicate translates a single filter
+func predicate(f domain.Filter) func(*sql.Selector) {
+	switch f.Op {
+	case domain.OpNe:
+		return sql.FieldNEQ(f.Field, f.Values[0])
+	case domain.OpGt:
+		return sql.FieldGT(f.Field, f.Values[0])
+	case domain.OpLt:
+		return sql.FieldLT(f.Field, f.Values[0])
+	case domain.OpIn:
+		return sql.FieldIn(f.Field, f.Values...)
+	case domain.OpLike:
+		return sql.FieldContainsFold(f.Field, fmt.Sprint(f.Values[0]))
+	default:
+		return sql.FieldEQ(f.Field, f.Values[0])
+	}
+}
+
+// Rename replaces the fields of the filters and sort terms of a list query
+// that are stored in a column of another name with that column
+func Rename(list domain.ListQuery, columns map[string]string) domain.ListQuery {
+	renamed := domain.ListQuery{
+		Filters: make([]domain.Filter, len(list.Filters)),
+		Sort:    make([]domain.Sort, len(list.Sort)),
+	}
+	for i, f := range list.Filters {
+		if column, ok := columns[f.Field]; ok {
+			f.Field = column
+		}
+		renamed.Filters[i] = f
+	}
+	for i, s := range list.Sort {
+		if column, ok := columns[s.Field]; ok {
+			s.Field = column
+		}
+		renamed.Sort[i] = s
+	}
+	return renamed
+}
+
+// Orders returns the order terms of an ent query. The ID column is always
+// the last term so that pages are stable when sort values repeat.
+func Orders[O ~func(*sql.Selector)](sort []domain.Sort, idField string) []O {
+	orders := make([]O, 0, len(sort)+1)
+	for _, s := range sort {
+		if s.Desc {
+			orders = append(orders, sql.OrderByField(s.Field, sql.OrderDesc()).ToFunc())
+		} else {
+			orders = append(orders, sql.OrderByField(s.Field).ToFunc())
+		}
+		if s.Field == idField {
+			return orders
+		}
+	}
+	return append(orders, sql.OrderByField(idField).ToFunc())
+}
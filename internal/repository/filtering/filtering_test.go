@@ -0,0 +1,96 @@
+package filtering_test
+
+import (
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"gin-swagger-api/internal/domain"
+	"gin-swagger-api/internal/repository/filtering"
+)
+
+// selectorFunc mirrors the predicate and order types generated by ent
+type selectorFunc func(*sql.Selector)
+
+// build applies the functions to a query on the orders table
+func build(funcs ...selectorFunc) (string, []any) {
+	s := sql.Dialect(dialect.SQLite).Select("*").From(sql.Table("orders"))
+	for _, f := range funcs {
+		f(s)
+	}
+	return s.Query()
+}
+
+var _ = Describe("Filtering", func() {
+	Describe("Predicates", func() {
+		DescribeTable("should translate each operator",
+			func(filter domain.Filter, expectedSQL string, expectedArgs []any) {
+				query, args := build(filtering.Predicates[selectorFunc]([]domain.Filter{filter})...)
+
+				Expect(query).To(Equal("SELECT * FROM `orders` WHERE " + expectedSQL))
+				Expect(args).To(Equal(expectedArgs))
+			},
+			Entry("eq", domain.Filter{Field: "status", Op: domain.OpEq, Values: []any{"pending"}},
+				"`orders`.`status` = ?", []any{"pending"}),
+			Entry("ne", domain.Filter{Field: "status", Op: domain.OpNe, Values: []any{"pending"}},
+				"`orders`.`status` <> ?", []any{"pending"}),
+			Entry("gt", domain.Filter{Field: "total_price", Op: domain.OpGt, Values: []any{int64(1050)}},
+				"`orders`.`total_price` > ?", []any{int64(1050)}),
+			Entry("lt", domain.Filter{Field: "quantity", Op: domain.OpLt, Values: []any{3}},
+				"`orders`.`quantity` < ?", []any{3}),
+			Entry("in", domain.Filter{Field: "user_id", Op: domain.OpIn, Values: []any{1, 2}},
+				"`orders`.`user_id` IN (?, ?)", []any{1, 2}),
+			Entry("like", domain.Filter{Field: "status", Op: domain.OpLike, Values: []any{"Pend"}},
+				"LOWER(`orders`.`status`) LIKE ?", []any{"%pend%"}),
+		)
+
+		It("should combine filters with AND", func() {
+			query, args := build(filtering.Predicates[selectorFunc]([]domain.Filter{
+				{Field: "price", Op: domain.OpGt, Values: []any{int64(1000)}},
+				{Field: "price", Op: domain.OpLt, Values: []any{int64(2000)}},
+			})...)
+
+			Expect(query).To(Equal("SELECT * FROM `orders` WHERE `orders`.`price` > ? AND `orders`.`price` < ?"))
+			Expect(args).To(Equal([]any{int64(1000), int64(2000)}))
+		})
+	})
+
+	Describe("Rename", func() {
+		It("should use the column of renamed fields in filters and sort terms", func() {
+			list := filtering.Rename(domain.ListQuery{
+				Filters: []domain.Filter{
+					{Field: "total_price", Op: domain.OpGt, Values: []any{int64(1000)}},
+					{Field: "status", Op: domain.OpEq, Values: []any{"pending"}},
+				},
+				Sort: []domain.Sort{{Field: "total_price", Desc: true}},
+			}, map[string]string{"total_price": "base_total"})
+
+			query, _ := build(append(filtering.Predicates[selectorFunc](list.Filters), filtering.Orders[selectorFunc](list.Sort, "id")...)...)
+			Expect(query).To(Equal("SELECT * FROM `orders` WHERE `orders`.`base_total` > ? AND `orders`.`status` = ? ORDER BY `orders`.`base_total` DESC, `orders`.`id`"))
+		})
+	})
+
+	Describe("Orders", func() {
+		It("should order by ID when no sort is given", func() {
+			query, _ := build(filtering.Orders[selectorFunc](nil, "id")...)
+
+			Expect(query).To(Equal("SELECT * FROM `orders` ORDER BY `orders`.`id`"))
+		})
+
+		It("should append the ID as a tiebreaker", func() {
+			query, _ := build(filtering.Orders[selectorFunc]([]domain.Sort{
+				{Field: "total_price", Desc: true},
+				{Field: "status"},
+			}, "id")...)
+
+			Expect(query).To(Equal("SELECT * FROM `orders` ORDER BY `orders`.`total_price` DESC, `orders`.`status`, `orders`.`id`"))
+		})
+
+		It("should not repeat an explicit ID sort", func() {
+			query, _ := build(filtering.Orders[selectorFunc]([]domain.Sort{{Field: "id", Desc: true}}, "id")...)
+
+			Expect(query).To(Equal("SELECT * FROM `orders` ORDER BY `orders`.`id` DESC"))
+		})
+	})
+})
@@ -0,0 +1,13 @@
+package idempotencyredis_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestIdempotencyRedis(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "IdempotencyRedis Suite")
+}
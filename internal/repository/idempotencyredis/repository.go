@@ -0,0 +1,147 @@
+package idempotencyredis
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"gin-swagger-api/internal/domain"
+	portidempotencyrepo "gin-swagger-api/internal/port/repository/idempotencyrepo"
+)
+
+// keyPrefix namespaces idempotency keys in the Redis database
+const keyPrefix = "idempotency:"
+
+// Repository implements the idempotency key store in Redis. Keys expire
+// with the record, so Redis forgets them on its own.
+type Repository struct {
+	client redis.UniversalClient
+}
+
+// New creates a new Redis idempotency key store
+func New(client redis.UniversalClient) portidempotencyrepo.Repository {
+	return &Repository{client: client}
+}
+
+// entry is the JSON value stored under a key
+type entry struct {
+	Fingerprint string            `json:"fingerprint"`
+	Status      int               `json:"status,omitempty"`
+	Header      map[string]string `json:"header,omitempty"`
+	Body        []byte            `json:"body,omitempty"`
+	ExpiresAt   time.Time         `json:"expires_at"`
+}
+
+// Reserve sets the key only if it does not exist
+func (r *Repository) Reserve(ctx context.Context, record domain.IdempotencyRecord) (*domain.IdempotencyRecord, error) {
+	value, err := json.Marshal(toEntry(record))
+	if err != nil {
+		return nil, err
+	}
+
+	reserved, err := r.client.SetNX(ctx, keyPrefix+record.Key, value, time.Until(record.ExpiresAt)).Result()
+	if err != nil {
+		return nil, fmt.Errorf("reserve idempotency key: %w", err)
+	}
+	if reserved {
+		return nil, nil
+	}
+
+	value, err = r.client.Get(ctx, keyPrefix+record.Key).Bytes()
+	if errors.Is(err, redis.Nil) {
+		// Expired or released between the two commands
+		return nil, fmt.Errorf("idempotency key %w: retry the request", domain.ErrConflict)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get idempotency key: %w", err)
+	}
+
+	var e entry
+	if err := json.Unmarshal(value, &e); err != nil {
+		return nil, fmt.Errorf("decode idempotency key: %w", err)
+	}
+	existing := e.toRecord(record.Key)
+	return &existing, nil
+}
+
+// inFlight is the Lua prelude of the scripts that change a key. It stops
+// the script unless the key holds a request in flight with the
+// fingerprint in ARGV[1], so that a request whose key expired and was
+// taken by another request cannot overwrite or free the new one.
+const inFlight = `
+local value = redis.call('GET', KEYS[1])
+if not value then
+	return 0
+end
+local held = cjson.decode(value)
+if held.fingerprint ~= ARGV[1] or (held.status or 0) ~= 0 then
+	return 0
+end
+`
+
+// completeScript stores the response in ARGV[2] and sets the key to
+// expire at the Unix time in milliseconds in ARGV[3]
+var completeScript = redis.NewScript(inFlight + `
+redis.call('SET', KEYS[1], ARGV[2])
+redis.call('PEXPIREAT', KEYS[1], ARGV[3])
+return 1
+`)
+
+// releaseScript deletes the key
+var releaseScript = redis.NewScript(inFlight + `
+return redis.call('DEL', KEYS[1])
+`)
+
+// Complete overwrites the key and moves its expiry to the one of the
+// record. A key that expired in the meantime is not stored again, and one
+// whose lease ran out and was taken by another request is left alone.
+func (r *Repository) Complete(ctx context.Context, record domain.IdempotencyRecord) error {
+	value, err := json.Marshal(toEntry(record))
+	if err != nil {
+		return err
+	}
+
+	keys := []string{keyPrefix + record.Key}
+	err = completeScript.Run(ctx, r.client, keys, record.Fingerprint, value, record.ExpiresAt.UnixMilli()).Err()
+	if err != nil {
+		return fmt.Errorf("complete idempotency key: %w", err)
+	}
+	return nil
+}
+
+// Release deletes the key if it is still held in flight by the record's
+// request
+func (r *Repository) Release(ctx context.Context, record domain.IdempotencyRecord) error {
+	err := releaseScript.Run(ctx, r.client, []string{keyPrefix + record.Key}, record.Fingerprint).Err()
+	if err != nil {
+		return fmt.Errorf("release idempotency key: %w", err)
+	}
+	return nil
+}
+
+// toEntry converts a domain record to the stored value
+func toEntry(record domain.IdempotencyRecord) entry {
+	return entry{
+		Fingerprint: record.Fingerprint,
+		Status:      record.Status,
+		Header:      record.Header,
+		Body:        record.Body,
+		ExpiresAt:   record.ExpiresAt,
+	}
+}
+
+// toRecord converts a stored value to the domain record of key
+func (e entry) toRecord(key string) domain.IdempotencyRecord {
+	return domain.IdempotencyRecord{
+		Key:         key,
+		Fingerprint: e.Fingerprint,
+		Status:      e.Status,
+		Header:      e.Header,
+		Body:        e.Body,
+		ExpiresAt:   e.ExpiresAt,
+	}
+}
@@ -0,0 +1,165 @@
+package idempotencyredis_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/redis/go-redis/v9"
+
+	"gin-swagger-api/internal/domain"
+	portidempotencyrepo "gin-swagger-api/internal/port/repository/idempotencyrepo"
+	"gin-swagger-api/internal/repository/idempotencyredis"
+)
+
+var _ = Describe("IdempotencyRedisRepository", func() {
+	var (
+		repo   portidempotencyrepo.Repository
+		server *miniredis.Miniredis
+		ctx    context.Context
+		record domain.IdempotencyRecord
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		server = miniredis.RunT(GinkgoT())
+		client := redis.NewClient(&redis.Options{Addr: server.Addr()})
+		DeferCleanup(client.Close)
+		repo = idempotencyredis.New(client)
+
+		record = domain.IdempotencyRecord{
+			Key:         "key-1",
+			Fingerprint: "abc",
+			ExpiresAt:   time.Now().Add(time.Hour),
+		}
+	})
+
+	Describe("Reserve", func() {
+		It("should reserve a new key with the record expiry", func() {
+			existing, err := repo.Reserve(ctx, record)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(existing).To(BeNil())
+			Expect(server.TTL("idempotency:key-1")).To(BeNumerically("~", time.Hour, time.Minute))
+		})
+
+		It("should return the record of a key in flight", func() {
+			_, err := repo.Reserve(ctx, record)
+			Expect(err).ToNot(HaveOccurred())
+
+			existing, err := repo.Reserve(ctx, domain.IdempotencyRecord{Key: "key-1", Fingerprint: "def", ExpiresAt: time.Now().Add(time.Hour)})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(existing).ToNot(BeNil())
+			Expect(existing.Fingerprint).To(Equal("abc"))
+			Expect(existing.Completed()).To(BeFalse())
+		})
+
+		It("should reserve a key again once it expired", func() {
+			_, err := repo.Reserve(ctx, record)
+			Expect(err).ToNot(HaveOccurred())
+			server.FastForward(2 * time.Hour)
+
+			existing, err := repo.Reserve(ctx, record)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(existing).To(BeNil())
+		})
+	})
+
+	Describe("Complete", func() {
+		It("should store the response until the record expires", func() {
+			_, err := repo.Reserve(ctx, record)
+			Expect(err).ToNot(HaveOccurred())
+			server.FastForward(30 * time.Minute)
+
+			record.Status = 201
+			record.Header = map[string]string{"Content-Type": "application/json"}
+			record.Body = []byte(`{"id":"1"}`)
+			record.ExpiresAt = time.Now().Add(24 * time.Hour)
+			Expect(repo.Complete(ctx, record)).To(Succeed())
+
+			existing, err := repo.Reserve(ctx, record)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(existing.Completed()).To(BeTrue())
+			Expect(existing.Status).To(Equal(201))
+			Expect(existing.Header).To(Equal(record.Header))
+			Expect(existing.Body).To(MatchJSON(`{"id":"1"}`))
+			Expect(server.TTL("idempotency:key-1")).To(BeNumerically("~", 24*time.Hour, time.Minute))
+		})
+
+		It("should leave a key taken by another request alone", func() {
+			_, err := repo.Reserve(ctx, domain.IdempotencyRecord{Key: "key-1", Fingerprint: "def", ExpiresAt: time.Now().Add(time.Hour)})
+			Expect(err).ToNot(HaveOccurred())
+
+			record.Status = 201
+			Expect(repo.Complete(ctx, record)).To(Succeed())
+
+			existing, err := repo.Reserve(ctx, record)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(existing.Fingerprint).To(Equal("def"))
+			Expect(existing.Completed()).To(BeFalse())
+		})
+
+		It("should not overwrite a completed key", func() {
+			_, err := repo.Reserve(ctx, record)
+			Expect(err).ToNot(HaveOccurred())
+			record.Status = 201
+			Expect(repo.Complete(ctx, record)).To(Succeed())
+
+			record.Status = 409
+			Expect(repo.Complete(ctx, record)).To(Succeed())
+
+			existing, err := repo.Reserve(ctx, record)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(existing.Status).To(Equal(201))
+		})
+
+		It("should not store a key that expired", func() {
+			record.Status = 201
+			Expect(repo.Complete(ctx, record)).To(Succeed())
+
+			Expect(server.Exists("idempotency:key-1")).To(BeFalse())
+		})
+	})
+
+	Describe("Release", func() {
+		It("should free the key", func() {
+			_, err := repo.Reserve(ctx, record)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(repo.Release(ctx, record)).To(Succeed())
+
+			existing, err := repo.Reserve(ctx, record)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(existing).To(BeNil())
+		})
+
+		It("should leave a key taken by another request alone", func() {
+			_, err := repo.Reserve(ctx, domain.IdempotencyRecord{Key: "key-1", Fingerprint: "def", ExpiresAt: time.Now().Add(time.Hour)})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(repo.Release(ctx, record)).To(Succeed())
+
+			existing, err := repo.Reserve(ctx, record)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(existing.Fingerprint).To(Equal("def"))
+		})
+
+		It("should leave a completed key alone", func() {
+			_, err := repo.Reserve(ctx, record)
+			Expect(err).ToNot(HaveOccurred())
+			completed := record
+			completed.Status = 201
+			Expect(repo.Complete(ctx, completed)).To(Succeed())
+
+			Expect(repo.Release(ctx, record)).To(Succeed())
+
+			existing, err := repo.Reserve(ctx, record)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(existing.Completed()).To(BeTrue())
+		})
+	})
+})
@@ -0,0 +1,114 @@
+package idempotencyrepo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gin-swagger-api/internal/domain"
+	portidempotencyrepo "gin-swagger-api/internal/port/repository/idempotencyrepo"
+	"gin-swagger-api/internal/repository/repoerr"
+	"gin-swagger-api/internal/repository/txmanager"
+
+	"github.com/snilli/ormprovider"
+	"github.com/snilli/ormprovider/ent"
+	"github.com/snilli/ormprovider/ent/idempotencykey"
+)
+
+// Repository implements the idempotency key store in the database
+type Repository struct {
+	db *ormprovider.Client
+}
+
+// New creates a new database idempotency key store
+func New(db *ormprovider.Client) portidempotencyrepo.Repository {
+	return &Repository{db: db}
+}
+
+// Reserve inserts the key, relying on its unique index to detect a key
+// already in use. Expired keys are deleted first, so the table only holds
+// live keys and an expired key can be taken again.
+func (r *Repository) Reserve(ctx context.Context, record domain.IdempotencyRecord) (*domain.IdempotencyRecord, error) {
+	client := r.client(ctx)
+
+	_, err := client.IdempotencyKey.Delete().
+		Where(idempotencykey.ExpiresAtLTE(time.Now())).
+		Exec(ctx)
+	if err != nil {
+		return nil, repoerr.Translate(err, "idempotency key")
+	}
+
+	err = client.IdempotencyKey.Create().
+		SetKey(record.Key).
+		SetFingerprint(record.Fingerprint).
+		SetExpiresAt(record.ExpiresAt).
+		Exec(ctx)
+	if err == nil {
+		return nil, nil
+	}
+	if !ent.IsConstraintError(err) {
+		return nil, repoerr.Translate(err, "idempotency key")
+	}
+
+	entKey, err := client.IdempotencyKey.Query().
+		Where(idempotencykey.Key(record.Key)).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		// Released by its request between the insert and the query
+		return nil, fmt.Errorf("idempotency key %w: retry the request", domain.ErrConflict)
+	}
+	if err != nil {
+		return nil, repoerr.Translate(err, "idempotency key")
+	}
+
+	existing := toRecord(entKey)
+	return &existing, nil
+}
+
+// Complete stores the response of the request holding the key and keeps
+// it until the record expires. A key whose lease ran out and was taken by
+// another request is left alone.
+func (r *Repository) Complete(ctx context.Context, record domain.IdempotencyRecord) error {
+	err := r.client(ctx).IdempotencyKey.Update().
+		Where(
+			idempotencykey.Key(record.Key),
+			idempotencykey.Fingerprint(record.Fingerprint),
+			idempotencykey.Status(0),
+		).
+		SetStatus(record.Status).
+		SetHeader(record.Header).
+		SetBody(record.Body).
+		SetExpiresAt(record.ExpiresAt).
+		Exec(ctx)
+	return repoerr.Translate(err, "idempotency key")
+}
+
+// Release deletes the key if it is still held in flight by the record's
+// request
+func (r *Repository) Release(ctx context.Context, record domain.IdempotencyRecord) error {
+	_, err := r.client(ctx).IdempotencyKey.Delete().
+		Where(
+			idempotencykey.Key(record.Key),
+			idempotencykey.Fingerprint(record.Fingerprint),
+			idempotencykey.Status(0),
+		).
+		Exec(ctx)
+	return repoerr.Translate(err, "idempotency key")
+}
+
+// client returns the transactional client carried by ctx, if any
+func (r *Repository) client(ctx context.Context) *ent.Client {
+	return txmanager.Client(ctx, r.db)
+}
+
+// toRecord converts an ent idempotency key to a domain record
+func toRecord(entKey *ent.IdempotencyKey) domain.IdempotencyRecord {
+	return domain.IdempotencyRecord{
+		Key:         entKey.Key,
+		Fingerprint: entKey.Fingerprint,
+		Status:      entKey.Status,
+		Header:      entKey.Header,
+		Body:        entKey.Body,
+		ExpiresAt:   entKey.ExpiresAt,
+	}
+}
@@ -0,0 +1,162 @@
+package idempotencyrepo_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"gin-swagger-api/internal/domain"
+	portidempotencyrepo "gin-swagger-api/internal/port/repository/idempotencyrepo"
+	"gin-swagger-api/internal/repository/idempotencyrepo"
+	"gin-swagger-api/internal/testutil"
+
+	"github.com/snilli/ormprovider"
+)
+
+var _ = Describe("IdempotencyRepository", func() {
+	var (
+		repo   portidempotencyrepo.Repository
+		db     *ormprovider.Client
+		ctx    context.Context
+		record domain.IdempotencyRecord
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		db = testutil.NewTestDBClient(GinkgoT())
+		repo = idempotencyrepo.New(db)
+
+		record = domain.IdempotencyRecord{
+			Key:         "key-1",
+			Fingerprint: "abc",
+			ExpiresAt:   time.Now().Add(time.Hour),
+		}
+	})
+
+	AfterEach(func() {
+		// Cleanup: close database connection
+		if db != nil {
+			_ = db.Close()
+		}
+	})
+
+	Describe("Reserve", func() {
+		It("should reserve a new key", func() {
+			existing, err := repo.Reserve(ctx, record)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(existing).To(BeNil())
+			Expect(db.IdempotencyKey.Query().CountX(ctx)).To(Equal(1))
+		})
+
+		It("should return the record of a key in flight", func() {
+			_, err := repo.Reserve(ctx, record)
+			Expect(err).ToNot(HaveOccurred())
+
+			existing, err := repo.Reserve(ctx, domain.IdempotencyRecord{Key: "key-1", Fingerprint: "def", ExpiresAt: time.Now().Add(time.Hour)})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(existing).ToNot(BeNil())
+			Expect(existing.Fingerprint).To(Equal("abc"))
+			Expect(existing.Completed()).To(BeFalse())
+		})
+
+		It("should delete expired keys and reserve them again", func() {
+			_, err := repo.Reserve(ctx, domain.IdempotencyRecord{Key: "key-1", Fingerprint: "abc", ExpiresAt: time.Now().Add(-time.Minute)})
+			Expect(err).ToNot(HaveOccurred())
+			_, err = repo.Reserve(ctx, domain.IdempotencyRecord{Key: "key-2", Fingerprint: "abc", ExpiresAt: time.Now().Add(-time.Minute)})
+			Expect(err).ToNot(HaveOccurred())
+
+			existing, err := repo.Reserve(ctx, record)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(existing).To(BeNil())
+			Expect(db.IdempotencyKey.Query().CountX(ctx)).To(Equal(1))
+		})
+	})
+
+	Describe("Complete", func() {
+		It("should store the response", func() {
+			_, err := repo.Reserve(ctx, record)
+			Expect(err).ToNot(HaveOccurred())
+
+			record.Status = 201
+			record.Header = map[string]string{"Content-Type": "application/json"}
+			record.Body = []byte(`{"id":"1"}`)
+			Expect(repo.Complete(ctx, record)).To(Succeed())
+
+			existing, err := repo.Reserve(ctx, record)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(existing.Completed()).To(BeTrue())
+			Expect(existing.Status).To(Equal(201))
+			Expect(existing.Header).To(Equal(record.Header))
+			Expect(existing.Body).To(MatchJSON(`{"id":"1"}`))
+		})
+
+		It("should keep the response until the record expires", func() {
+			record.ExpiresAt = time.Now().Add(time.Minute)
+			_, err := repo.Reserve(ctx, record)
+			Expect(err).ToNot(HaveOccurred())
+
+			record.Status = 201
+			record.ExpiresAt = time.Now().Add(24 * time.Hour)
+			Expect(repo.Complete(ctx, record)).To(Succeed())
+
+			entKey := db.IdempotencyKey.Query().OnlyX(ctx)
+			Expect(entKey.ExpiresAt).To(BeTemporally("~", record.ExpiresAt, time.Second))
+		})
+
+		It("should leave a key taken by another request alone", func() {
+			_, err := repo.Reserve(ctx, domain.IdempotencyRecord{Key: "key-1", Fingerprint: "def", ExpiresAt: time.Now().Add(time.Hour)})
+			Expect(err).ToNot(HaveOccurred())
+
+			record.Status = 201
+			Expect(repo.Complete(ctx, record)).To(Succeed())
+
+			existing, err := repo.Reserve(ctx, record)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(existing.Fingerprint).To(Equal("def"))
+			Expect(existing.Completed()).To(BeFalse())
+		})
+	})
+
+	Describe("Release", func() {
+		It("should free the key", func() {
+			_, err := repo.Reserve(ctx, record)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(repo.Release(ctx, record)).To(Succeed())
+
+			existing, err := repo.Reserve(ctx, record)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(existing).To(BeNil())
+		})
+
+		It("should leave a key taken by another request alone", func() {
+			_, err := repo.Reserve(ctx, domain.IdempotencyRecord{Key: "key-1", Fingerprint: "def", ExpiresAt: time.Now().Add(time.Hour)})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(repo.Release(ctx, record)).To(Succeed())
+
+			existing, err := repo.Reserve(ctx, record)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(existing.Fingerprint).To(Equal("def"))
+		})
+
+		It("should leave a completed key alone", func() {
+			_, err := repo.Reserve(ctx, record)
+			Expect(err).ToNot(HaveOccurred())
+			completed := record
+			completed.Status = 201
+			Expect(repo.Complete(ctx, completed)).To(Succeed())
+
+			Expect(repo.Release(ctx, record)).To(Succeed())
+
+			existing, err := repo.Reserve(ctx, record)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(existing.Completed()).To(BeTrue())
+		})
+	})
+})
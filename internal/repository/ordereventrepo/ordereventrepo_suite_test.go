@@ -0,0 +1,13 @@
+package ordereventrepo_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestOrderEventRepo(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "OrderEventRepo Suite")
+}
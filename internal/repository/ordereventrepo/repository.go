@@ -0,0 +1,79 @@
+package ordereventrepo
+
+import (
+	"context"
+	"strconv"
+
+	"gin-swagger-api/internal/domain"
+	portordereventrepo "gin-swagger-api/internal/port/repository/ordereventrepo"
+	"gin-swagger-api/internal/repository/repoerr"
+	"gin-swagger-api/internal/repository/txmanager"
+
+	"github.com/snilli/ormprovider"
+	"github.com/snilli/ormprovider/ent"
+	"github.com/snilli/ormprovider/ent/orderevent"
+)
+
+// Repository implements the order event repository interface
+type Repository struct {
+	db *ormprovider.Client
+}
+
+// New creates a new order event repository
+func New(db *ormprovider.Client) portordereventrepo.Repository {
+	return &Repository{db: db}
+}
+
+// Create records an order event. The creation time is set by the
+// database.
+func (r *Repository) Create(ctx context.Context, event domain.OrderEvent) (*domain.OrderEvent, error) {
+	entEvent, err := r.client(ctx).OrderEvent.Create().
+		SetOrderID(event.OrderID).
+		SetFromStatus(event.FromStatus).
+		SetToStatus(event.ToStatus).
+		SetActor(event.Actor).
+		SetReason(event.Reason).
+		Save(ctx)
+	if err != nil {
+		return nil, repoerr.Translate(err, "order event")
+	}
+
+	e := toOrderEvent(entEvent)
+	return &e, nil
+}
+
+// GetByOrderID retrieves the events of an order, oldest first
+func (r *Repository) GetByOrderID(ctx context.Context, orderID int) ([]domain.OrderEvent, error) {
+	entEvents, err := r.client(ctx).OrderEvent.Query().
+		Where(orderevent.OrderID(orderID)).
+		Order(ent.Asc(orderevent.FieldCreatedAt), ent.Asc(orderevent.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, repoerr.Translate(err, "order event")
+	}
+
+	events := make([]domain.OrderEvent, len(entEvents))
+	for i, entEvent := range entEvents {
+		events[i] = toOrderEvent(entEvent)
+	}
+	return events, nil
+}
+
+// client returns the ent client for ctx, bound to the transaction it
+// carries if any
+func (r *Repository) client(ctx context.Context) *ent.Client {
+	return txmanager.Client(ctx, r.db)
+}
+
+// toOrderEvent converts an ent order event to domain.OrderEvent
+func toOrderEvent(entEvent *ent.OrderEvent) domain.OrderEvent {
+	return domain.OrderEvent{
+		ID:         strconv.Itoa(entEvent.ID),
+		OrderID:    entEvent.OrderID,
+		FromStatus: entEvent.FromStatus,
+		ToStatus:   entEvent.ToStatus,
+		Actor:      entEvent.Actor,
+		Reason:     entEvent.Reason,
+		CreatedAt:  entEvent.CreatedAt,
+	}
+}
@@ -0,0 +1,99 @@
+package ordereventrepo_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"gin-swagger-api/internal/domain"
+	portordereventrepo "gin-swagger-api/internal/port/repository/ordereventrepo"
+	"gin-swagger-api/internal/repository/ordereventrepo"
+	"gin-swagger-api/internal/testutil"
+
+	"github.com/snilli/ormprovider"
+)
+
+var _ = Describe("OrderEventRepository", func() {
+	var (
+		repo    portordereventrepo.Repository
+		db      *ormprovider.Client
+		ctx     context.Context
+		orderID int
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		db = testutil.NewTestDBClient(GinkgoT())
+		repo = ordereventrepo.New(db)
+
+		user, err := db.User.Create().SetName("Test User").SetEmail("test@example.com").Save(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		order, err := db.Order.Create().
+			SetUserID(user.ID).
+			SetTotalPrice(1000).
+			SetStatus("pending").
+			Save(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		orderID = order.ID
+	})
+
+	AfterEach(func() {
+		// Cleanup: close database connection
+		if db != nil {
+			_ = db.Close()
+		}
+	})
+
+	Describe("Create", func() {
+		It("should record an event with its creation time", func() {
+			event, err := repo.Create(ctx, domain.OrderEvent{
+				OrderID:    orderID,
+				FromStatus: "pending",
+				ToStatus:   "cancelled",
+				Actor:      "api-key:1a2b3c4d",
+				Reason:     "customer changed their mind",
+			})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(event.ID).ToNot(BeEmpty())
+			Expect(event.CreatedAt).ToNot(BeZero())
+			Expect(event.OrderID).To(Equal(orderID))
+			Expect(event.FromStatus).To(Equal("pending"))
+			Expect(event.ToStatus).To(Equal("cancelled"))
+			Expect(event.Actor).To(Equal("api-key:1a2b3c4d"))
+			Expect(event.Reason).To(Equal("customer changed their mind"))
+		})
+
+		It("should reject an event for a missing order", func() {
+			event, err := repo.Create(ctx, domain.OrderEvent{OrderID: 99999, ToStatus: "pending"})
+
+			Expect(err).To(HaveOccurred())
+			Expect(event).To(BeNil())
+		})
+	})
+
+	Describe("GetByOrderID", func() {
+		It("should list the events of the order oldest first", func() {
+			for _, to := range []string{"pending", "paid", "shipped"} {
+				_, err := repo.Create(ctx, domain.OrderEvent{OrderID: orderID, ToStatus: to})
+				Expect(err).ToNot(HaveOccurred())
+			}
+
+			events, err := repo.GetByOrderID(ctx, orderID)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(events).To(HaveLen(3))
+			Expect(events[0].ToStatus).To(Equal("pending"))
+			Expect(events[2].ToStatus).To(Equal("shipped"))
+		})
+
+		It("should return an empty list for an order without events", func() {
+			events, err := repo.GetByOrderID(ctx, orderID)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(events).ToNot(BeNil())
+			Expect(events).To(BeEmpty())
+		})
+	})
+})
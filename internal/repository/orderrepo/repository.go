@@ -3,106 +3,583 @@ package orderrepo
 import (
 	"context"
 	"strconv"
+	"time"
 
 	"gin-swagger-api/internal/domain"
 	portorderrepo "gin-swagger-api/internal/port/repository/orderrepo"
+	porttxmanager "gin-swagger-api/internal/port/repository/txmanager"
+	"gin-swagger-api/internal/repository/filtering"
+	"gin-swagger-api/internal/repository/paging"
+	"gin-swagger-api/internal/repository/repoerr"
+	"gin-swagger-api/internal/repository/txmanager"
 
 	"github.com/snilli/ormprovider"
+	"github.com/snilli/ormprovider/ent"
+	"github.com/snilli/ormprovider/ent/couponredemption"
+	"github.com/snilli/ormprovider/ent/order"
+	"github.com/snilli/ormprovider/ent/orderevent"
+	"github.com/snilli/ormprovider/ent/orderitem"
+	"github.com/snilli/ormprovider/ent/predicate"
 )
 
+// listColumns maps the order list fields that are not stored under their
+// own name to their column. Orders in different currencies have totals
+// that do not compare, so total_price filters and sorts on the total in
+// the base currency.
+var listColumns = map[string]string{
+	"total_price": order.FieldBaseTotal,
+}
+
 // Repository implements the order repository interface
 type Repository struct {
-	db *ormprovider.Client
+	db        *ormprovider.Client
+	txManager porttxmanager.Manager
+}
+
+// New creates a new order repository. Its multi-statement writes run in
+// a transaction of txManager.
+func New(db *ormprovider.Client, txManager porttxmanager.Manager) portorderrepo.Repository {
+	return &Repository{db: db, txManager: txManager}
+}
+
+// GetAll retrieves a page of orders matching the list query
+func (r *Repository) GetAll(ctx context.Context, list domain.ListQuery, page domain.PageRequest, expand domain.OrderExpand) (*domain.Page[domain.Order], error) {
+	list = filtering.Rename(list, listColumns)
+	query := r.client(ctx).Order.Query().
+		Where(live(ctx)...).
+		Where(filtering.Predicates[predicate.Order](list.Filters)...)
+	return r.page(ctx, query, list.Sort, page, expand)
+}
+
+// GetByUser retrieves a page of the orders placed by a user
+func (r *Repository) GetByUser(ctx context.Context, userID int, page domain.PageRequest) (*domain.Page[domain.Order], error) {
+	query := r.client(ctx).Order.Query().Where(live(ctx)...).Where(order.UserID(userID))
+	return r.page(ctx, query, nil, page, domain.OrderExpand{})
 }
 
-// New creates a new order repository
-func New(db *ormprovider.Client) portorderrepo.Repository {
-	return &Repository{db: db}
+// GetByProduct retrieves a page of the orders with an item for a product
+func (r *Repository) GetByProduct(ctx context.Context, productID int, page domain.PageRequest) (*domain.Page[domain.Order], error) {
+	query := r.client(ctx).Order.Query().Where(live(ctx)...).Where(order.HasItemsWith(orderitem.ProductID(productID)))
+	return r.page(ctx, query, nil, page, domain.OrderExpand{})
 }
 
-// GetAll retrieves all orders
-func (r *Repository) GetAll(ctx context.Context) ([]domain.Order, error) {
-	entOrders, err := r.db.Order.Query().All(ctx)
+// Stream passes the orders matching the list filters to fn in batches of
+// batchSize, in ID order, with their items
+func (r *Repository) Stream(ctx context.Context, list domain.ListQuery, batchSize int, fn func([]domain.Order) error) error {
+	list = filtering.Rename(list, listColumns)
+	query := r.client(ctx).Order.Query().
+		Where(live(ctx)...).
+		Where(filtering.Predicates[predicate.Order](list.Filters)...)
+
+	fetch := func(afterID, limit int) ([]*ent.Order, error) {
+		rows, err := withRelations(query.Clone(), domain.OrderExpand{}).
+			Where(order.IDGT(afterID)).
+			Order(ent.Asc(order.FieldID)).
+			Limit(limit).
+			All(ctx)
+		return rows, repoerr.Translate(err, "order")
+	}
+	return paging.Batches(batchSize, fetch, orderID, toOrder, fn)
+}
+
+// GetByID retrieves an order by ID
+func (r *Repository) GetByID(ctx context.Context, id int, expand domain.OrderExpand) (*domain.Order, error) {
+	query := r.client(ctx).Order.Query().Where(order.ID(id)).Where(live(ctx)...)
+	entOrder, err := withRelations(query, expand).Only(ctx)
+	if err != nil {
+		return nil, repoerr.Translate(err, "order")
+	}
+
+	o := toOrder(entOrder)
+	return &o, nil
+}
+
+// Create creates a new order with its items, in the currency of its total,
+// and the coupon code and discount it was placed with
+func (r *Repository) Create(ctx context.Context, placed domain.Order) (*domain.Order, error) {
+	baseTotal, itemBaseTotals, err := placed.BaseTotals()
 	if err != nil {
 		return nil, err
 	}
 
-	orders := make([]domain.Order, len(entOrders))
-	for i, entOrder := range entOrders {
-		orders[i] = domain.Order{
-			ID:         strconv.Itoa(entOrder.ID),
-			UserID:     entOrder.UserID,
-			ProductID:  entOrder.ProductID,
-			Quantity:   entOrder.Quantity,
-			TotalPrice: entOrder.TotalPrice,
-			Status:     entOrder.Status,
+	var o *domain.Order
+	err = r.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		entOrder, err := r.client(ctx).Order.Create().
+			SetUserID(placed.UserID).
+			SetTotalPrice(placed.TotalPrice.Amount).
+			SetBaseTotal(baseTotal.Amount).
+			SetCurrency(placed.TotalPrice.Currency).
+			SetExchangeRate(string(placed.ExchangeRate)).
+			SetCouponCode(placed.CouponCode).
+			SetDiscount(placed.Discount.Amount).
+			SetStatus(placed.Status).
+			Save(ctx)
+		if err != nil {
+			return repoerr.Translate(err, "order")
+		}
+
+		if err := r.createItems(ctx, entOrder.ID, placed.Items, itemBaseTotals); err != nil {
+			return err
 		}
+
+		o, err = r.GetByID(ctx, entOrder.ID, domain.OrderExpand{})
+		return err
+	})
+	if err != nil {
+		return nil, err
 	}
-	return orders, nil
+	return o, nil
 }
 
-// GetByID retrieves an order by ID
-func (r *Repository) GetByID(ctx context.Context, id int) (*domain.Order, error) {
-	entOrder, err := r.db.Order.Get(ctx, id)
+// Update updates an order and replaces its items. A non-empty version must
+// match the current version of the order.
+func (r *Repository) Update(ctx context.Context, id int, version string, items []domain.OrderItem, totalPrice domain.Money, status string) (*domain.Order, error) {
+	var o *domain.Order
+	err := r.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		current, err := r.GetByID(ctx, id, domain.OrderExpand{})
+		if err != nil {
+			return err
+		}
+		guard, err := guardAt(*current, version)
+		if err != nil {
+			return err
+		}
+
+		updated := *current
+		updated.Items, updated.TotalPrice = items, totalPrice
+		baseTotal, itemBaseTotals, err := updated.BaseTotals()
+		if err != nil {
+			return err
+		}
+
+		err = r.client(ctx).Order.UpdateOneID(id).
+			Where(order.DeletedAtIsNil()).
+			Where(guard...).
+			SetTotalPrice(totalPrice.Amount).
+			SetBaseTotal(baseTotal.Amount).
+			SetStatus(status).
+			AddVersion(1).
+			Exec(ctx)
+		if err != nil {
+			return repoerr.TranslateGuarded(err, "order", guard != nil)
+		}
+
+		if err := r.replaceItems(ctx, id, items, itemBaseTotals); err != nil {
+			return err
+		}
+
+		o, err = r.GetByID(ctx, id, domain.OrderExpand{})
+		return err
+	})
 	if err != nil {
 		return nil, err
 	}
+	return o, nil
+}
 
-	return &domain.Order{
-		ID:         strconv.Itoa(entOrder.ID),
-		UserID:     entOrder.UserID,
-		ProductID:  entOrder.ProductID,
-		Quantity:   entOrder.Quantity,
-		TotalPrice: entOrder.TotalPrice,
-		Status:     entOrder.Status,
-	}, nil
-}
-
-// Create creates a new order
-func (r *Repository) Create(ctx context.Context, userID, productID, quantity int, totalPrice float64, status string) (*domain.Order, error) {
-	entOrder, err := r.db.Order.Create().
-		SetUserID(userID).
-		SetProductID(productID).
-		SetQuantity(quantity).
-		SetTotalPrice(totalPrice).
-		SetStatus(status).
-		Save(ctx)
+// Patch updates only the order fields set in the patch; non-nil items
+// replace the items of the order. A non-empty version must match the
+// current version of the order.
+func (r *Repository) Patch(ctx context.Context, id int, version string, patch domain.OrderPatch) (*domain.Order, error) {
+	var o *domain.Order
+	err := r.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		current, err := r.GetByID(ctx, id, domain.OrderExpand{})
+		if err != nil {
+			return err
+		}
+		guard, err := guardAt(*current, version)
+		if err != nil {
+			return err
+		}
+
+		update := r.client(ctx).Order.UpdateOneID(id).
+			Where(order.DeletedAtIsNil()).
+			Where(guard...).
+			AddVersion(1)
+		if patch.Status != nil {
+			update.SetStatus(*patch.Status)
+		}
+
+		// A new total or new items change the base currency amounts of
+		// the order and of every item, so the items are rewritten with
+		// their new shares.
+		repriced := patch.TotalPrice != nil || patch.Items != nil
+		var itemBaseTotals []domain.Money
+		updated := *current
+		if repriced {
+			if patch.TotalPrice != nil {
+				updated.TotalPrice = *patch.TotalPrice
+			}
+			if patch.Items != nil {
+				updated.Items = patch.Items
+			}
+			var baseTotal domain.Money
+			baseTotal, itemBaseTotals, err = updated.BaseTotals()
+			if err != nil {
+				return err
+			}
+			update.SetTotalPrice(updated.TotalPrice.Amount).SetBaseTotal(baseTotal.Amount)
+		}
+
+		if err := update.Exec(ctx); err != nil {
+			return repoerr.TranslateGuarded(err, "order", guard != nil)
+		}
+
+		if repriced {
+			if err := r.replaceItems(ctx, id, updated.Items, itemBaseTotals); err != nil {
+				return err
+			}
+		}
+
+		o, err = r.GetByID(ctx, id, domain.OrderExpand{})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return o, nil
+}
+
+// Delete soft-deletes an order by setting its deleted_at; its items are
+// kept. A non-empty version must match the current version of the order.
+func (r *Repository) Delete(ctx context.Context, id int, version string) error {
+	guard, err := r.guard(ctx, id, version)
+	if err != nil {
+		return err
+	}
+
+	err = r.client(ctx).Order.UpdateOneID(id).
+		Where(order.DeletedAtIsNil()).
+		Where(guard...).
+		SetDeletedAt(time.Now()).
+		AddVersion(1).
+		Exec(ctx)
+	return repoerr.TranslateGuarded(err, "order", guard != nil)
+}
+
+// Restore clears the deleted_at of an order. Restoring an order that is
+// not deleted leaves it as it is.
+func (r *Repository) Restore(ctx context.Context, id int) (*domain.Order, error) {
+	var o *domain.Order
+	err := r.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		err := r.client(ctx).Order.UpdateOneID(id).
+			ClearDeletedAt().
+			AddVersion(1).
+			Exec(ctx)
+		if err != nil {
+			return repoerr.Translate(err, "order")
+		}
+
+		o, err = r.GetByID(ctx, id, domain.OrderExpand{})
+		return err
+	})
 	if err != nil {
 		return nil, err
 	}
+	return o, nil
+}
+
+// Purge deletes an order for good, whether or not it is soft-deleted,
+// with its items, status history and coupon redemption
+func (r *Repository) Purge(ctx context.Context, id int) error {
+	return r.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		if err := r.deleteDependents(ctx, id); err != nil {
+			return err
+		}
 
-	return &domain.Order{
-		ID:         strconv.Itoa(entOrder.ID),
-		UserID:     entOrder.UserID,
-		ProductID:  entOrder.ProductID,
-		Quantity:   entOrder.Quantity,
-		TotalPrice: entOrder.TotalPrice,
-		Status:     entOrder.Status,
-	}, nil
+		err := r.client(ctx).Order.DeleteOneID(id).Exec(ctx)
+		return repoerr.Translate(err, "order")
+	})
 }
 
-// Update updates an order
-func (r *Repository) Update(ctx context.Context, id, quantity int, totalPrice float64, status string) (*domain.Order, error) {
-	entOrder, err := r.db.Order.UpdateOneID(id).
-		SetQuantity(quantity).
-		SetTotalPrice(totalPrice).
-		SetStatus(status).
+// CountByUser counts the orders placed by a user
+func (r *Repository) CountByUser(ctx context.Context, userID int) (int, error) {
+	return r.count(ctx, order.UserID(userID))
+}
+
+// CountByProduct counts the orders with an item for a product
+func (r *Repository) CountByProduct(ctx context.Context, productID int) (int, error) {
+	return r.count(ctx, order.HasItemsWith(orderitem.ProductID(productID)))
+}
+
+// DeleteByUser deletes the orders placed by a user and returns them with
+// their items
+func (r *Repository) DeleteByUser(ctx context.Context, userID int) ([]domain.Order, error) {
+	return r.deleteWhere(ctx, order.UserID(userID))
+}
+
+// DeleteByProduct deletes the orders with an item for a product and
+// returns them with their items
+func (r *Repository) DeleteByProduct(ctx context.Context, productID int) ([]domain.Order, error) {
+	return r.deleteWhere(ctx, order.HasItemsWith(orderitem.ProductID(productID)))
+}
+
+// DetachUser clears the user of the orders placed by a user
+func (r *Repository) DetachUser(ctx context.Context, userID int) error {
+	_, err := r.client(ctx).Order.Update().
+		Where(order.UserID(userID)).
+		ClearUserID().
+		AddVersion(1).
 		Save(ctx)
+	return repoerr.Translate(err, "order")
+}
+
+// DetachProduct clears the product of the order items for a product and
+// bumps the version of their orders. The items keep their quantity and
+// prices, so order totals do not change.
+func (r *Repository) DetachProduct(ctx context.Context, productID int) error {
+	return r.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		_, err := r.client(ctx).Order.Update().
+			Where(order.HasItemsWith(orderitem.ProductID(productID))).
+			AddVersion(1).
+			Save(ctx)
+		if err != nil {
+			return repoerr.Translate(err, "order")
+		}
+
+		_, err = r.client(ctx).OrderItem.Update().
+			Where(orderitem.ProductID(productID)).
+			ClearProductID().
+			Save(ctx)
+		return repoerr.Translate(err, "order item")
+	})
+}
+
+// count counts the orders matching a predicate
+func (r *Repository) count(ctx context.Context, where predicate.Order) (int, error) {
+	count, err := r.client(ctx).Order.Query().Where(where).Count(ctx)
+	if err != nil {
+		return 0, repoerr.Translate(err, "order")
+	}
+	return count, nil
+}
+
+// deleteWhere deletes the orders matching a predicate with their items,
+// status history and coupon redemptions, in one statement each, and
+// returns them
+func (r *Repository) deleteWhere(ctx context.Context, where predicate.Order) ([]domain.Order, error) {
+	var orders []domain.Order
+	err := r.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		entOrders, err := withRelations(r.client(ctx).Order.Query().Where(where), domain.OrderExpand{}).All(ctx)
+		if err != nil {
+			return repoerr.Translate(err, "order")
+		}
+
+		ids := make([]int, len(entOrders))
+		orders = make([]domain.Order, len(entOrders))
+		for i, entOrder := range entOrders {
+			ids[i] = entOrder.ID
+			orders[i] = toOrder(entOrder)
+		}
+
+		if err := r.deleteDependents(ctx, ids...); err != nil {
+			return err
+		}
+
+		_, err = r.client(ctx).Order.Delete().Where(order.IDIn(ids...)).Exec(ctx)
+		return repoerr.Translate(err, "order")
+	})
 	if err != nil {
 		return nil, err
 	}
+	return orders, nil
+}
+
+// deleteDependents deletes the rows that reference the orders, so that
+// the orders themselves can be deleted
+func (r *Repository) deleteDependents(ctx context.Context, orderIDs ...int) error {
+	client := r.client(ctx)
+
+	_, err := client.OrderItem.Delete().Where(orderitem.OrderIDIn(orderIDs...)).Exec(ctx)
+	if err != nil {
+		return repoerr.Translate(err, "order item")
+	}
+
+	_, err = client.OrderEvent.Delete().Where(orderevent.OrderIDIn(orderIDs...)).Exec(ctx)
+	if err != nil {
+		return repoerr.Translate(err, "order event")
+	}
 
-	return &domain.Order{
-		ID:         strconv.Itoa(entOrder.ID),
-		UserID:     entOrder.UserID,
-		ProductID:  entOrder.ProductID,
-		Quantity:   entOrder.Quantity,
-		TotalPrice: entOrder.TotalPrice,
-		Status:     entOrder.Status,
-	}, nil
+	_, err = client.CouponRedemption.Delete().Where(couponredemption.OrderIDIn(orderIDs...)).Exec(ctx)
+	return repoerr.Translate(err, "coupon redemption")
 }
 
-// Delete deletes an order
-func (r *Repository) Delete(ctx context.Context, id int) error {
-	return r.db.Order.DeleteOneID(id).Exec(ctx)
+// createItems adds items to an order in one statement, each with the
+// base currency amount at the same index of baseTotals
+func (r *Repository) createItems(ctx context.Context, orderID int, items []domain.OrderItem, baseTotals []domain.Money) error {
+	builders := make([]*ent.OrderItemCreate, len(items))
+	for i, item := range items {
+		builders[i] = r.client(ctx).OrderItem.Create().
+			SetOrderID(orderID).
+			SetProductID(item.ProductID).
+			SetQuantity(item.Quantity).
+			SetUnitPrice(item.UnitPrice.Amount).
+			SetLineTotal(item.LineTotal.Amount).
+			SetBaseTotal(baseTotals[i].Amount)
+	}
+
+	_, err := r.client(ctx).OrderItem.CreateBulk(builders...).Save(ctx)
+	return repoerr.Translate(err, "order item")
+}
+
+// replaceItems replaces all items of an order
+func (r *Repository) replaceItems(ctx context.Context, orderID int, items []domain.OrderItem, baseTotals []domain.Money) error {
+	_, err := r.client(ctx).OrderItem.Delete().Where(orderitem.OrderID(orderID)).Exec(ctx)
+	if err != nil {
+		return repoerr.Translate(err, "order item")
+	}
+	return r.createItems(ctx, orderID, items, baseTotals)
+}
+
+// guard loads the order and returns its guardAt predicates for version.
+// An empty version needs no guard.
+func (r *Repository) guard(ctx context.Context, id int, version string) ([]predicate.Order, error) {
+	if version == "" {
+		return nil, nil
+	}
+
+	current, err := r.GetByID(ctx, id, domain.OrderExpand{})
+	if err != nil {
+		return nil, err
+	}
+	return guardAt(*current, version)
+}
+
+// guardAt returns predicates that match the current order only while it
+// is still at version, so a write using them fails if a concurrent write
+// got in first. An empty version needs no guard.
+func guardAt(current domain.Order, version string) ([]predicate.Order, error) {
+	if version == "" {
+		return nil, nil
+	}
+	if current.Version() != version {
+		return nil, repoerr.Stale("order")
+	}
+
+	// Every write to the order or its items bumps its version column, so
+	// a write guarded on it fails even when a concurrent write left the
+	// other columns as they were.
+	return []predicate.Order{order.Version(current.Revision)}, nil
+}
+
+// client returns the ent client for ctx, bound to the transaction it
+// carries if any
+func (r *Repository) client(ctx context.Context) *ent.Client {
+	return txmanager.Client(ctx, r.db)
+}
+
+// page counts the orders matched by query and loads the requested page
+func (r *Repository) page(ctx context.Context, query *ent.OrderQuery, sort []domain.Sort, page domain.PageRequest, expand domain.OrderExpand) (*domain.Page[domain.Order], error) {
+	window, err := paging.NewWindow(page, sort)
+	if err != nil {
+		return nil, err
+	}
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, repoerr.Translate(err, "order")
+	}
+
+	switch {
+	case window.Descending():
+		query = query.Where(order.IDLT(window.Cursor.ID)).Order(ent.Desc(order.FieldID))
+	case window.HasCursor:
+		query = query.Where(order.IDGT(window.Cursor.ID)).Order(ent.Asc(order.FieldID))
+	default:
+		query = query.Order(filtering.Orders[order.OrderOption](sort, order.FieldID)...).Offset(window.Offset)
+	}
+
+	entOrders, err := withRelations(query, expand).Limit(window.Fetch()).All(ctx)
+	if err != nil {
+		return nil, repoerr.Translate(err, "order")
+	}
+
+	return paging.NewPage(window, entOrders, total, orderID, toOrder), nil
+}
+
+// live returns the predicates that leave soft-deleted orders out of a
+// query, unless ctx comes from domain.WithDeleted
+func live(ctx context.Context) []predicate.Order {
+	if domain.IncludesDeleted(ctx) {
+		return nil
+	}
+	return []predicate.Order{order.DeletedAtIsNil()}
+}
+
+// orderID returns the ID of an ent order
+func orderID(entOrder *ent.Order) int {
+	return entOrder.ID
+}
+
+// withRelations eager loads the items of the orders a query returns, in
+// the order they were added, and the relations selected by expand. Ent
+// loads each relation with one query for all orders.
+func withRelations(query *ent.OrderQuery, expand domain.OrderExpand) *ent.OrderQuery {
+	if expand.User {
+		query = query.WithUser()
+	}
+	return query.WithItems(func(q *ent.OrderItemQuery) {
+		q.Order(ent.Asc(orderitem.FieldID))
+		if expand.Product {
+			q.WithProduct()
+		}
+	})
+}
+
+// toOrder converts an ent order with its loaded items to domain.Order
+func toOrder(entOrder *ent.Order) domain.Order {
+	items := make([]domain.OrderItem, len(entOrder.Edges.Items))
+	for i, entItem := range entOrder.Edges.Items {
+		items[i] = toOrderItem(entItem, entOrder.Currency)
+	}
+
+	o := domain.Order{
+		ID:           strconv.Itoa(entOrder.ID),
+		UserID:       entOrder.UserID,
+		Items:        items,
+		TotalPrice:   domain.Money{Amount: entOrder.TotalPrice, Currency: entOrder.Currency},
+		BaseTotal:    domain.Money{Amount: entOrder.BaseTotal, Currency: domain.BaseCurrency},
+		Currency:     entOrder.Currency,
+		ExchangeRate: domain.Rate(entOrder.ExchangeRate),
+		CouponCode:   entOrder.CouponCode,
+		Status:       entOrder.Status,
+		CreatedAt:    entOrder.CreatedAt,
+		DeletedAt:    entOrder.DeletedAt,
+		Revision:     entOrder.Version,
+	}
+	if entOrder.CouponCode != "" {
+		o.Discount = domain.Money{Amount: entOrder.Discount, Currency: entOrder.Currency}
+	}
+	if entOrder.Edges.User != nil {
+		o.User = &domain.User{
+			ID:        strconv.Itoa(entOrder.Edges.User.ID),
+			Name:      entOrder.Edges.User.Name,
+			Email:     entOrder.Edges.User.Email,
+			DeletedAt: entOrder.Edges.User.DeletedAt,
+		}
+	}
+	return o
+}
+
+// toOrderItem converts an ent order item, priced in currency, to
+// domain.OrderItem. An expanded product keeps its base currency price.
+func toOrderItem(entItem *ent.OrderItem, currency string) domain.OrderItem {
+	item := domain.OrderItem{
+		ProductID: entItem.ProductID,
+		Quantity:  entItem.Quantity,
+		UnitPrice: domain.Money{Amount: entItem.UnitPrice, Currency: currency},
+		LineTotal: domain.Money{Amount: entItem.LineTotal, Currency: currency},
+		BaseTotal: domain.Money{Amount: entItem.BaseTotal, Currency: domain.BaseCurrency},
+	}
+	if entItem.Edges.Product != nil {
+		item.Product = &domain.Product{
+			ID:          strconv.Itoa(entItem.Edges.Product.ID),
+			Name:        entItem.Edges.Product.Name,
+			Description: entItem.Edges.Product.Description,
+			Price:       domain.Money{Amount: entItem.Edges.Product.Price, Currency: domain.BaseCurrency},
+			Stock:       entItem.Edges.Product.Stock,
+			DeletedAt:   entItem.Edges.Product.DeletedAt,
+		}
+	}
+	return item
 }
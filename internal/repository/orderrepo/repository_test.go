@@ -2,7 +2,11 @@ package orderrepo_test
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"strconv"
+	"strings"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -10,9 +14,11 @@ import (
 	"gin-swagger-api/internal/domain"
 	portorderrepo "gin-swagger-api/internal/port/repository/orderrepo"
 	"gin-swagger-api/internal/repository/orderrepo"
+	"gin-swagger-api/internal/repository/txmanager"
 	"gin-swagger-api/internal/testutil"
 
 	"github.com/snilli/ormprovider"
+	"github.com/snilli/ormprovider/ent"
 )
 
 var _ = Describe("OrderRepository", func() {
@@ -25,10 +31,27 @@ var _ = Describe("OrderRepository", func() {
 		testProductID int
 	)
 
+	// line returns the single item of an order for the test product, in
+	// the base currency and without a discount
+	line := func(quantity int, total domain.Money) []domain.OrderItem {
+		return []domain.OrderItem{{
+			ProductID: testProductID,
+			Quantity:  quantity,
+			UnitPrice: domain.Money{Amount: total.Amount / int64(quantity), Currency: total.Currency},
+			LineTotal: total,
+			BaseTotal: total,
+		}}
+	}
+
+	// placed returns an order to create for userID
+	placed := func(userID int, items []domain.OrderItem, total domain.Money, rate domain.Rate, status string) domain.Order {
+		return domain.Order{UserID: userID, Items: items, TotalPrice: total, Currency: total.Currency, ExchangeRate: rate, Status: status}
+	}
+
 	BeforeEach(func() {
 		ctx = context.Background()
 		db = testutil.NewTestDBClient(GinkgoT())
-		repo = orderrepo.New(db)
+		repo = orderrepo.New(db, txmanager.New(db))
 
 		// Create test user for foreign key
 		user, err := db.User.Create().SetName("Test User").SetEmail("test@example.com").Save(ctx)
@@ -36,7 +59,7 @@ var _ = Describe("OrderRepository", func() {
 		testUserID = user.ID
 
 		// Create test product for foreign key
-		product, err := db.Product.Create().SetName("Test Product").SetDescription("Test").SetPrice(100.0).SetStock(10).Save(ctx)
+		product, err := db.Product.Create().SetName("Test Product").SetDescription("Test").SetPrice(10000).SetStock(100).Save(ctx)
 		Expect(err).ToNot(HaveOccurred())
 		testProductID = product.ID
 	})
@@ -50,37 +73,104 @@ var _ = Describe("OrderRepository", func() {
 
 	Describe("Create", func() {
 		It("should create an order successfully", func() {
-			order, err := repo.Create(ctx, testUserID, testProductID, 2, 100.00, "pending")
+			order, err := repo.Create(ctx, placed(testUserID, line(2, testutil.THB("100.00")), testutil.THB("100.00"), domain.RateOne, "pending"))
 
 			Expect(err).ToNot(HaveOccurred())
 			Expect(*order).To(Equal(domain.Order{
-				ID:         order.ID,
-				UserID:     testUserID,
-				ProductID:  testProductID,
-				Quantity:   2,
-				TotalPrice: 100.00,
-				Status:     "pending",
+				ID:           order.ID,
+				UserID:       testUserID,
+				Items:        line(2, testutil.THB("100.00")),
+				TotalPrice:   testutil.THB("100.00"),
+				BaseTotal:    testutil.THB("100.00"),
+				Currency:     "THB",
+				ExchangeRate: "1",
+				Status:       "pending",
+				CreatedAt:    order.CreatedAt,
 			}))
+			Expect(order.CreatedAt).ToNot(BeZero())
 		})
 
 		It("should create order with different status", func() {
-			order, err := repo.Create(ctx, testUserID, testProductID, 1, 50.00, "completed")
+			order, err := repo.Create(ctx, placed(testUserID, line(1, testutil.THB("50.00")), testutil.THB("50.00"), domain.RateOne, "completed"))
 
 			Expect(err).ToNot(HaveOccurred())
 			Expect(*order).To(Equal(domain.Order{
-				ID:         order.ID,
-				UserID:     testUserID,
-				ProductID:  testProductID,
-				Quantity:   1,
-				TotalPrice: 50.00,
-				Status:     "completed",
+				ID:           order.ID,
+				UserID:       testUserID,
+				Items:        line(1, testutil.THB("50.00")),
+				TotalPrice:   testutil.THB("50.00"),
+				BaseTotal:    testutil.THB("50.00"),
+				Currency:     "THB",
+				ExchangeRate: "1",
+				Status:       "completed",
+				CreatedAt:    order.CreatedAt,
 			}))
 		})
 
+		It("should record the currency and exchange rate of the order", func() {
+			usd := domain.MustParseMoney("2.75", "USD")
+			items := []domain.OrderItem{domain.NewOrderItem(testProductID, 2, usd)}
+
+			order, err := repo.Create(ctx, placed(testUserID, items, usd.Mul(2), "0.0275", "pending"))
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(order.Currency).To(Equal("USD"))
+			Expect(order.ExchangeRate).To(Equal(domain.Rate("0.0275")))
+			Expect(order.TotalPrice).To(Equal(domain.MustParseMoney("5.50", "USD")))
+			Expect(order.BaseTotal).To(Equal(testutil.THB("200.00")))
+			items[0].BaseTotal = testutil.THB("200.00")
+			Expect(order.Items).To(Equal(items))
+		})
+
+		It("should record the coupon code and discount of the order", func() {
+			discounted := placed(testUserID, line(2, testutil.THB("100.00")), testutil.THB("90.00"), domain.RateOne, "pending")
+			discounted.CouponCode = "SAVE10"
+			discounted.Discount = testutil.THB("10.00")
+
+			order, err := repo.Create(ctx, discounted)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(order.CouponCode).To(Equal("SAVE10"))
+			Expect(order.Discount).To(Equal(testutil.THB("10.00")))
+			Expect(order.TotalPrice).To(Equal(testutil.THB("90.00")))
+			Expect(order.BaseTotal).To(Equal(testutil.THB("90.00")))
+			Expect(order.Items[0].BaseTotal).To(Equal(testutil.THB("90.00")))
+		})
+
+		It("should create an order with several items in the order given", func() {
+			other, err := db.Product.Create().SetName("Other Product").SetDescription("Test").SetPrice(500).SetStock(10).Save(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			items := []domain.OrderItem{
+				domain.NewOrderItem(other.ID, 3, testutil.THB("5.00")),
+				domain.NewOrderItem(testProductID, 1, testutil.THB("100.00")),
+			}
+
+			order, err := repo.Create(ctx, placed(testUserID, items, testutil.THB("115.00"), domain.RateOne, "pending"))
+
+			Expect(err).ToNot(HaveOccurred())
+			items[0].BaseTotal, items[1].BaseTotal = testutil.THB("15.00"), testutil.THB("100.00")
+			Expect(order.Items).To(Equal(items))
+
+			id, _ := strconv.Atoi(order.ID)
+			loaded, err := repo.GetByID(ctx, id, domain.OrderExpand{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(*loaded).To(Equal(*order))
+		})
+
+		It("should not leave an order behind when an item cannot be written", func() {
+			items := []domain.OrderItem{domain.NewOrderItem(99999, 1, testutil.THB("10.00"))}
+
+			order, err := repo.Create(ctx, placed(testUserID, items, testutil.THB("10.00"), domain.RateOne, "pending"))
+
+			Expect(err).To(HaveOccurred())
+			Expect(order).To(BeNil())
+			Expect(db.Order.Query().CountX(ctx)).To(BeZero())
+		})
+
 		It("should return error when database connection fails", func() {
 			_ = db.Close()
 
-			order, err := repo.Create(ctx, testUserID, testProductID, 1, 50.00, "pending")
+			order, err := repo.Create(ctx, placed(testUserID, line(1, testutil.THB("50.00")), testutil.THB("50.00"), domain.RateOne, "pending"))
 
 			Expect(err).To(HaveOccurred())
 			Expect(order).To(BeNil())
@@ -92,50 +182,69 @@ var _ = Describe("OrderRepository", func() {
 
 		BeforeEach(func() {
 			var err error
-			createdOrder, err = repo.Create(ctx, testUserID, testProductID, 3, 150.00, "pending")
+			createdOrder, err = repo.Create(ctx, placed(testUserID, line(3, testutil.THB("150.00")), testutil.THB("150.00"), domain.RateOne, "pending"))
 			Expect(err).ToNot(HaveOccurred())
 			orderID, _ = strconv.Atoi(createdOrder.ID)
 		})
 
 		It("should retrieve order by ID successfully", func() {
-			order, err := repo.GetByID(ctx, orderID)
+			order, err := repo.GetByID(ctx, orderID, domain.OrderExpand{})
 
 			Expect(err).ToNot(HaveOccurred())
 			Expect(*order).To(Equal(*createdOrder))
 		})
 
 		It("should return error when order not found", func() {
-			order, err := repo.GetByID(ctx, 99999)
+			order, err := repo.GetByID(ctx, 99999, domain.OrderExpand{})
 
-			Expect(err).To(HaveOccurred())
+			Expect(err).To(MatchError(domain.ErrNotFound))
 			Expect(order).To(BeNil())
 		})
+
+		It("should load the user and the item products when expanded", func() {
+			order, err := repo.GetByID(ctx, orderID, domain.OrderExpand{User: true, Product: true})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(order.User).To(Equal(&domain.User{ID: strconv.Itoa(testUserID), Name: "Test User", Email: "test@example.com"}))
+			Expect(order.Items).To(HaveLen(1))
+			Expect(order.Items[0].Product).ToNot(BeNil())
+			Expect(order.Items[0].Product.Name).To(Equal("Test Product"))
+			Expect(order.Version()).To(Equal(createdOrder.Version()))
+		})
+
+		It("should leave relations unloaded when not expanded", func() {
+			order, err := repo.GetByID(ctx, orderID, domain.OrderExpand{})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(order.User).To(BeNil())
+			Expect(order.Items[0].Product).To(BeNil())
+		})
 	})
 
 	Describe("GetAll", func() {
 		It("should return empty list when no orders exist", func() {
-			orders, err := repo.GetAll(ctx)
+			page, err := repo.GetAll(ctx, domain.ListQuery{}, domain.PageRequest{Limit: 10}, domain.OrderExpand{})
 
 			Expect(err).ToNot(HaveOccurred())
-			Expect(orders).ToNot(BeNil())
-			Expect(len(orders)).To(Equal(0))
+			Expect(page.Items).ToNot(BeNil())
+			Expect(len(page.Items)).To(Equal(0))
 		})
 
 		It("should return all orders with correct data", func() {
-			order1, err := repo.Create(ctx, testUserID, testProductID, 1, 50.00, "pending")
+			order1, err := repo.Create(ctx, placed(testUserID, line(1, testutil.THB("50.00")), testutil.THB("50.00"), domain.RateOne, "pending"))
 			Expect(err).ToNot(HaveOccurred())
-			order2, err := repo.Create(ctx, testUserID, testProductID, 2, 100.00, "completed")
+			order2, err := repo.Create(ctx, placed(testUserID, line(2, testutil.THB("100.00")), testutil.THB("100.00"), domain.RateOne, "completed"))
 			Expect(err).ToNot(HaveOccurred())
 
-			orders, err := repo.GetAll(ctx)
+			page, err := repo.GetAll(ctx, domain.ListQuery{}, domain.PageRequest{Limit: 10}, domain.OrderExpand{})
 
 			Expect(err).ToNot(HaveOccurred())
-			Expect(len(orders)).To(Equal(2))
+			Expect(len(page.Items)).To(Equal(2))
 
 			// Verify all fields are correctly mapped
 			foundOrder1 := false
 			foundOrder2 := false
-			for _, o := range orders {
+			for _, o := range page.Items {
 				if o.ID == order1.ID && o.Status == "pending" {
 					foundOrder1 = true
 				}
@@ -147,81 +256,710 @@ var _ = Describe("OrderRepository", func() {
 			Expect(foundOrder2).To(BeTrue())
 		})
 
+		Context("with filters and sort", func() {
+			BeforeEach(func() {
+				_, err := repo.Create(ctx, placed(testUserID, line(1, testutil.THB("100.00")), testutil.THB("100.00"), domain.RateOne, "pending"))
+				Expect(err).ToNot(HaveOccurred())
+				_, err = repo.Create(ctx, placed(testUserID, line(3, testutil.THB("300.00")), testutil.THB("300.00"), domain.RateOne, "pending"))
+				Expect(err).ToNot(HaveOccurred())
+				_, err = repo.Create(ctx, placed(testUserID, line(2, testutil.THB("200.00")), testutil.THB("200.00"), domain.RateOne, "completed"))
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("should return matching orders in the requested order", func() {
+				page, err := repo.GetAll(ctx, domain.ListQuery{
+					Filters: []domain.Filter{
+						{Field: "user_id", Op: domain.OpEq, Values: []any{testUserID}},
+						{Field: "status", Op: domain.OpEq, Values: []any{"pending"}},
+					},
+					Sort: []domain.Sort{{Field: "total_price", Desc: true}},
+				}, domain.PageRequest{Limit: 10}, domain.OrderExpand{})
+
+				Expect(err).ToNot(HaveOccurred())
+				Expect(page.Total).To(Equal(2))
+				Expect(page.Items).To(HaveLen(2))
+				Expect(page.Items[0].TotalPrice).To(Equal(testutil.THB("300.00")))
+				Expect(page.Items[1].TotalPrice).To(Equal(testutil.THB("100.00")))
+			})
+
+			It("should match any of the in values", func() {
+				page, err := repo.GetAll(ctx, domain.ListQuery{
+					Filters: []domain.Filter{{Field: "total_price", Op: domain.OpIn, Values: []any{int64(10000), int64(20000)}}},
+				}, domain.PageRequest{Limit: 10}, domain.OrderExpand{})
+
+				Expect(err).ToNot(HaveOccurred())
+				Expect(page.Total).To(Equal(2))
+			})
+
+			It("should compare totals in other currencies in the base currency", func() {
+				// 5.50 USD at 0.0275 is 200.00 THB
+				usd := domain.MustParseMoney("5.50", "USD")
+				_, err := repo.Create(ctx, placed(testUserID, []domain.OrderItem{domain.NewOrderItem(testProductID, 1, usd)}, usd, "0.0275", "pending"))
+				Expect(err).ToNot(HaveOccurred())
+
+				page, err := repo.GetAll(ctx, domain.ListQuery{
+					Filters: []domain.Filter{{Field: "total_price", Op: domain.OpGt, Values: []any{int64(15000)}}},
+					Sort:    []domain.Sort{{Field: "total_price"}},
+				}, domain.PageRequest{Limit: 10}, domain.OrderExpand{})
+
+				Expect(err).ToNot(HaveOccurred())
+				Expect(page.Items).To(HaveLen(3))
+				Expect(page.Items[0].BaseTotal).To(Equal(testutil.THB("200.00")))
+				Expect(page.Items[1].BaseTotal).To(Equal(testutil.THB("200.00")))
+				Expect(page.Items[2].TotalPrice).To(Equal(testutil.THB("300.00")))
+			})
+
+			It("should reject a cursor combined with a sort", func() {
+				page, err := repo.GetAll(ctx, domain.ListQuery{
+					Sort: []domain.Sort{{Field: "total_price"}},
+				}, domain.PageRequest{Limit: 10, Cursor: domain.Cursor{ID: 1}.Encode()}, domain.OrderExpand{})
+
+				Expect(err).To(MatchError(domain.ErrInvalidArgument))
+				Expect(page).To(BeNil())
+			})
+		})
+
+		Context("with more rows than the page size", func() {
+			BeforeEach(func() {
+				for i := 1; i <= 5; i++ {
+					_, err := repo.Create(ctx, placed(testUserID, line(i, testutil.THB("10.00").Mul(i)), testutil.THB("10.00").Mul(i), domain.RateOne, "pending"))
+					Expect(err).ToNot(HaveOccurred())
+				}
+			})
+
+			It("should return the requested offset window with the total", func() {
+				page, err := repo.GetAll(ctx, domain.ListQuery{}, domain.PageRequest{Limit: 2, Offset: 2}, domain.OrderExpand{})
+
+				Expect(err).ToNot(HaveOccurred())
+				Expect(page.Items).To(HaveLen(2))
+				Expect(page.Total).To(Equal(5))
+				Expect(page.Offset).To(Equal(2))
+				Expect(page.NextCursor).ToNot(BeEmpty())
+				Expect(page.PrevCursor).ToNot(BeEmpty())
+			})
+
+			It("should walk forwards and backwards with cursors", func() {
+				first, err := repo.GetAll(ctx, domain.ListQuery{}, domain.PageRequest{Limit: 2}, domain.OrderExpand{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(first.PrevCursor).To(BeEmpty())
+
+				second, err := repo.GetAll(ctx, domain.ListQuery{}, domain.PageRequest{Limit: 2, Cursor: first.NextCursor}, domain.OrderExpand{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(second.Items).To(HaveLen(2))
+				Expect(second.Items[0].ID).ToNot(Equal(first.Items[1].ID))
+
+				back, err := repo.GetAll(ctx, domain.ListQuery{}, domain.PageRequest{Limit: 2, Cursor: second.PrevCursor}, domain.OrderExpand{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(back.Items).To(Equal(first.Items))
+				Expect(back.PrevCursor).To(BeEmpty())
+			})
+
+			It("should reject a malformed cursor", func() {
+				page, err := repo.GetAll(ctx, domain.ListQuery{}, domain.PageRequest{Limit: 2, Cursor: "!!!"}, domain.OrderExpand{})
+
+				Expect(err).To(MatchError(domain.ErrInvalidArgument))
+				Expect(page).To(BeNil())
+			})
+		})
+
+		Context("when relations are expanded", func() {
+			var (
+				queries   int
+				debugDB   *ormprovider.Client
+				debugRepo portorderrepo.Repository
+			)
+
+			BeforeEach(func() {
+				other, err := db.Product.Create().SetName("Other Product").SetDescription("Test").SetPrice(5000).SetStock(100).Save(ctx)
+				Expect(err).ToNot(HaveOccurred())
+
+				for range 5 {
+					items := []domain.OrderItem{
+						domain.NewOrderItem(testProductID, 1, testutil.THB("100.00")),
+						domain.NewOrderItem(other.ID, 2, testutil.THB("50.00")),
+					}
+					_, err := repo.Create(ctx, placed(testUserID, items, testutil.THB("200.00"), domain.RateOne, "pending"))
+					Expect(err).ToNot(HaveOccurred())
+				}
+
+				// A second client over the same database logs every statement
+				// it sends, so the test can count them.
+				debugDB = testutil.NewTestDBClient(GinkgoT(), ent.Debug(), ent.Log(func(args ...any) {
+					if strings.Contains(fmt.Sprint(args...), "driver.Query") {
+						queries++
+					}
+				}))
+				debugRepo = orderrepo.New(debugDB, txmanager.New(debugDB))
+				queries = 0
+			})
+
+			AfterEach(func() {
+				_ = debugDB.Close()
+			})
+
+			It("should embed the relations of every order", func() {
+				page, err := repo.GetAll(ctx, domain.ListQuery{}, domain.PageRequest{Limit: 10}, domain.OrderExpand{User: true, Product: true})
+
+				Expect(err).ToNot(HaveOccurred())
+				Expect(page.Items).To(HaveLen(5))
+				for _, order := range page.Items {
+					Expect(order.User).ToNot(BeNil())
+					Expect(order.Items).To(HaveLen(2))
+					Expect(order.Items[0].Product).ToNot(BeNil())
+					Expect(order.Items[1].Product).ToNot(BeNil())
+				}
+			})
+
+			It("should run one query per relation however many orders are returned", func() {
+				expand := domain.OrderExpand{User: true, Product: true}
+
+				_, err := debugRepo.GetAll(ctx, domain.ListQuery{}, domain.PageRequest{Limit: 1}, expand)
+				Expect(err).ToNot(HaveOccurred())
+				single := queries
+
+				queries = 0
+				page, err := debugRepo.GetAll(ctx, domain.ListQuery{}, domain.PageRequest{Limit: 10}, expand)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(page.Items).To(HaveLen(5))
+
+				// count, orders, items, users and products
+				Expect(single).To(Equal(5))
+				Expect(queries).To(Equal(single))
+			})
+		})
+
 		It("should return error when database connection fails", func() {
 			_ = db.Close()
 
-			orders, err := repo.GetAll(ctx)
+			page, err := repo.GetAll(ctx, domain.ListQuery{}, domain.PageRequest{Limit: 10}, domain.OrderExpand{})
 
 			Expect(err).To(HaveOccurred())
-			Expect(orders).To(BeNil())
+			Expect(page).To(BeNil())
+		})
+	})
+
+	Describe("GetByUser", func() {
+		var otherUserID int
+
+		BeforeEach(func() {
+			other, err := db.User.Create().SetName("Other User").SetEmail("other@example.com").Save(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			otherUserID = other.ID
+
+			for _, total := range []domain.Money{testutil.THB("100.00"), testutil.THB("200.00"), testutil.THB("300.00")} {
+				_, err := repo.Create(ctx, placed(testUserID, line(1, total), total, domain.RateOne, "pending"))
+				Expect(err).ToNot(HaveOccurred())
+			}
+			_, err = repo.Create(ctx, placed(otherUserID, line(1, testutil.THB("50.00")), testutil.THB("50.00"), domain.RateOne, "pending"))
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should return only the orders of the user", func() {
+			page, err := repo.GetByUser(ctx, testUserID, domain.PageRequest{Limit: 10})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(page.Total).To(Equal(3))
+			Expect(page.Items).To(HaveLen(3))
+			for _, order := range page.Items {
+				Expect(order.UserID).To(Equal(testUserID))
+				Expect(order.Items).To(HaveLen(1))
+			}
+		})
+
+		It("should page by limit and offset", func() {
+			page, err := repo.GetByUser(ctx, testUserID, domain.PageRequest{Limit: 2, Offset: 2})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(page.Total).To(Equal(3))
+			Expect(page.Items).To(HaveLen(1))
+			Expect(page.Items[0].TotalPrice).To(Equal(testutil.THB("300.00")))
+		})
+
+		It("should return an empty page for a user without orders", func() {
+			user, err := db.User.Create().SetName("New User").SetEmail("new@example.com").Save(ctx)
+			Expect(err).ToNot(HaveOccurred())
+
+			page, err := repo.GetByUser(ctx, user.ID, domain.PageRequest{Limit: 10})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(page.Total).To(BeZero())
+			Expect(page.Items).To(BeEmpty())
+		})
+	})
+
+	Describe("GetByProduct", func() {
+		var otherProductID int
+
+		BeforeEach(func() {
+			other, err := db.Product.Create().SetName("Other Product").SetDescription("Test").SetPrice(5000).SetStock(100).Save(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			otherProductID = other.ID
+
+			_, err = repo.Create(ctx, placed(testUserID, line(1, testutil.THB("100.00")), testutil.THB("100.00"), domain.RateOne, "pending"))
+			Expect(err).ToNot(HaveOccurred())
+			_, err = repo.Create(ctx, placed(testUserID, []domain.OrderItem{
+				domain.NewOrderItem(otherProductID, 2, testutil.THB("50.00")),
+				domain.NewOrderItem(testProductID, 1, testutil.THB("100.00")),
+			}, testutil.THB("200.00"), domain.RateOne, "pending"))
+			Expect(err).ToNot(HaveOccurred())
+			_, err = repo.Create(ctx, placed(testUserID, []domain.OrderItem{domain.NewOrderItem(otherProductID, 1, testutil.THB("50.00"))}, testutil.THB("50.00"), domain.RateOne, "pending"))
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should return every order with an item for the product, with all its items", func() {
+			page, err := repo.GetByProduct(ctx, testProductID, domain.PageRequest{Limit: 10})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(page.Total).To(Equal(2))
+			Expect(page.Items).To(HaveLen(2))
+			Expect(page.Items[0].Items).To(HaveLen(1))
+			Expect(page.Items[1].Items).To(HaveLen(2))
+			for _, order := range page.Items {
+				_, ok := order.Item(testProductID)
+				Expect(ok).To(BeTrue())
+			}
+		})
+
+		It("should page by limit and offset", func() {
+			page, err := repo.GetByProduct(ctx, otherProductID, domain.PageRequest{Limit: 1, Offset: 1})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(page.Total).To(Equal(2))
+			Expect(page.Items).To(HaveLen(1))
+			Expect(page.Items[0].TotalPrice).To(Equal(testutil.THB("50.00")))
+		})
+	})
+
+	Describe("Stream", func() {
+		BeforeEach(func() {
+			for i, status := range []string{"pending", "paid", "paid", "cancelled", "paid"} {
+				total := testutil.THB("100.00").Mul(i + 1)
+				_, err := repo.Create(ctx, placed(testUserID, line(1, total), total, domain.RateOne, status))
+				Expect(err).ToNot(HaveOccurred())
+			}
+		})
+
+		It("should pass every order with its items in id order in batches", func() {
+			var batches [][]domain.Order
+			err := repo.Stream(ctx, domain.ListQuery{}, 2, func(orders []domain.Order) error {
+				batches = append(batches, orders)
+				return nil
+			})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(batches).To(HaveLen(3))
+			Expect(batches[0][0].TotalPrice).To(Equal(testutil.THB("100.00")))
+			Expect(batches[2][0].TotalPrice).To(Equal(testutil.THB("500.00")))
+			for _, batch := range batches {
+				for _, order := range batch {
+					Expect(order.Items).To(HaveLen(1))
+					Expect(order.CreatedAt).ToNot(BeZero())
+				}
+			}
+		})
+
+		It("should filter by status and creation time", func() {
+			var totals []domain.Money
+			err := repo.Stream(ctx, domain.ListQuery{
+				Filters: []domain.Filter{
+					{Field: "status", Op: domain.OpEq, Values: []any{"paid"}},
+					{Field: "created_at", Op: domain.OpGt, Values: []any{time.Now().Add(-time.Hour)}},
+					{Field: "created_at", Op: domain.OpLt, Values: []any{time.Now().Add(time.Hour)}},
+				},
+			}, 2, func(orders []domain.Order) error {
+				for _, order := range orders {
+					totals = append(totals, order.TotalPrice)
+				}
+				return nil
+			})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(totals).To(Equal([]domain.Money{testutil.THB("200.00"), testutil.THB("300.00"), testutil.THB("500.00")}))
+		})
+
+		It("should pass nothing outside the date range", func() {
+			calls := 0
+			err := repo.Stream(ctx, domain.ListQuery{
+				Filters: []domain.Filter{{Field: "created_at", Op: domain.OpLt, Values: []any{time.Now().Add(-time.Hour)}}},
+			}, 2, func([]domain.Order) error {
+				calls++
+				return nil
+			})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(calls).To(Equal(0))
 		})
 	})
 
 	Describe("Update", func() {
 		BeforeEach(func() {
-			order, err := repo.Create(ctx, testUserID, testProductID, 2, 100.00, "pending")
+			order, err := repo.Create(ctx, placed(testUserID, line(2, testutil.THB("100.00")), testutil.THB("100.00"), domain.RateOne, "pending"))
 			Expect(err).ToNot(HaveOccurred())
 			orderID, _ = strconv.Atoi(order.ID)
 		})
 
 		It("should update order successfully", func() {
-			order, err := repo.Update(ctx, orderID, 5, 250.00, "shipped")
+			order, err := repo.Update(ctx, orderID, "", line(5, testutil.THB("250.00")), testutil.THB("250.00"), "shipped")
 
 			Expect(err).ToNot(HaveOccurred())
 			Expect(*order).To(Equal(domain.Order{
-				ID:         order.ID,
-				UserID:     testUserID,
-				ProductID:  testProductID,
-				Quantity:   5,
-				TotalPrice: 250.00,
-				Status:     "shipped",
+				ID:           order.ID,
+				UserID:       testUserID,
+				Items:        line(5, testutil.THB("250.00")),
+				TotalPrice:   testutil.THB("250.00"),
+				BaseTotal:    testutil.THB("250.00"),
+				Currency:     "THB",
+				ExchangeRate: "1",
+				Status:       "shipped",
+				CreatedAt:    order.CreatedAt,
+				Revision:     1,
 			}))
 		})
 
 		It("should update order status only", func() {
-			order, err := repo.Update(ctx, orderID, 2, 100.00, "completed")
+			order, err := repo.Update(ctx, orderID, "", line(2, testutil.THB("100.00")), testutil.THB("100.00"), "completed")
 
 			Expect(err).ToNot(HaveOccurred())
 			Expect(*order).To(Equal(domain.Order{
-				ID:         order.ID,
-				UserID:     testUserID,
-				ProductID:  testProductID,
-				Quantity:   2,
-				TotalPrice: 100.00,
-				Status:     "completed",
+				ID:           order.ID,
+				UserID:       testUserID,
+				Items:        line(2, testutil.THB("100.00")),
+				TotalPrice:   testutil.THB("100.00"),
+				BaseTotal:    testutil.THB("100.00"),
+				Currency:     "THB",
+				ExchangeRate: "1",
+				Status:       "completed",
+				CreatedAt:    order.CreatedAt,
+				Revision:     1,
 			}))
 		})
 
+		It("should replace the items of the order", func() {
+			other, err := db.Product.Create().SetName("Other Product").SetDescription("Test").SetPrice(500).SetStock(10).Save(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			items := []domain.OrderItem{domain.NewOrderItem(other.ID, 2, testutil.THB("5.00"))}
+
+			order, err := repo.Update(ctx, orderID, "", items, testutil.THB("10.00"), "pending")
+
+			Expect(err).ToNot(HaveOccurred())
+			items[0].BaseTotal = testutil.THB("10.00")
+			Expect(order.Items).To(Equal(items))
+			Expect(db.OrderItem.Query().CountX(ctx)).To(Equal(1))
+		})
+
+		It("should reject a second write based on the same version", func() {
+			current, err := repo.GetByID(ctx, orderID, domain.OrderExpand{})
+			Expect(err).ToNot(HaveOccurred())
+			_, err = repo.Update(ctx, orderID, current.Version(), line(2, testutil.THB("100.00")), testutil.THB("100.00"), "paid")
+			Expect(err).ToNot(HaveOccurred())
+
+			order, err := repo.Update(ctx, orderID, current.Version(), line(2, testutil.THB("100.00")), testutil.THB("100.00"), "cancelled")
+
+			Expect(err).To(MatchError(domain.ErrPreconditionFailed))
+			Expect(order).To(BeNil())
+		})
+
+		It("should reject a write when the order was written after it was read", func() {
+			current, err := repo.GetByID(ctx, orderID, domain.OrderExpand{})
+			Expect(err).ToNot(HaveOccurred())
+			// Another write that leaves every column but the version as it
+			// was, as rewriting the same items does
+			db.Order.UpdateOneID(orderID).AddVersion(1).ExecX(ctx)
+
+			order, err := repo.Update(ctx, orderID, current.Version(), line(1, testutil.THB("50.00")), testutil.THB("50.00"), "pending")
+
+			Expect(err).To(MatchError(domain.ErrPreconditionFailed))
+			Expect(order).To(BeNil())
+			Expect(db.OrderItem.Query().OnlyX(ctx).Quantity).To(Equal(2))
+		})
+
 		It("should return error when order not found", func() {
-			order, err := repo.Update(ctx, 99999, 1, 50.00, "pending")
+			order, err := repo.Update(ctx, 99999, "", line(1, testutil.THB("50.00")), testutil.THB("50.00"), "pending")
 
-			Expect(err).To(HaveOccurred())
+			Expect(err).To(MatchError(domain.ErrNotFound))
+			Expect(order).To(BeNil())
+		})
+	})
+
+	Describe("Patch", func() {
+		BeforeEach(func() {
+			order, err := repo.Create(ctx, placed(testUserID, line(2, testutil.THB("100.00")), testutil.THB("100.00"), domain.RateOne, "pending"))
+			Expect(err).ToNot(HaveOccurred())
+			orderID, _ = strconv.Atoi(order.ID)
+		})
+
+		It("should update only the fields set in the patch", func() {
+			status := "shipped"
+			order, err := repo.Patch(ctx, orderID, "", domain.OrderPatch{Status: &status})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(*order).To(Equal(domain.Order{
+				ID:           order.ID,
+				UserID:       testUserID,
+				Items:        line(2, testutil.THB("100.00")),
+				TotalPrice:   testutil.THB("100.00"),
+				BaseTotal:    testutil.THB("100.00"),
+				Currency:     "THB",
+				ExchangeRate: "1",
+				Status:       "shipped",
+				CreatedAt:    order.CreatedAt,
+				Revision:     1,
+			}))
+		})
+
+		It("should replace the items when the patch sets them", func() {
+			items := line(4, testutil.THB("200.00"))
+			totalPrice := testutil.THB("200.00")
+
+			order, err := repo.Patch(ctx, orderID, "", domain.OrderPatch{Items: items, TotalPrice: &totalPrice})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(order.Items).To(Equal(items))
+			Expect(order.TotalPrice).To(Equal(testutil.THB("200.00")))
+			Expect(order.BaseTotal).To(Equal(testutil.THB("200.00")))
+		})
+
+		It("should apply the patch when the version matches", func() {
+			current, err := repo.GetByID(ctx, orderID, domain.OrderExpand{})
+			Expect(err).ToNot(HaveOccurred())
+			status := "shipped"
+
+			order, err := repo.Patch(ctx, orderID, current.Version(), domain.OrderPatch{Status: &status})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(order.Status).To(Equal("shipped"))
+		})
+
+		It("should return error when order not found", func() {
+			status := "shipped"
+			order, err := repo.Patch(ctx, 99999, "", domain.OrderPatch{Status: &status})
+
+			Expect(err).To(MatchError(domain.ErrNotFound))
 			Expect(order).To(BeNil())
 		})
 	})
 
 	Describe("Delete", func() {
 		BeforeEach(func() {
-			order, err := repo.Create(ctx, testUserID, testProductID, 1, 50.00, "pending")
+			order, err := repo.Create(ctx, placed(testUserID, line(1, testutil.THB("50.00")), testutil.THB("50.00"), domain.RateOne, "pending"))
 			Expect(err).ToNot(HaveOccurred())
 			orderID, _ = strconv.Atoi(order.ID)
 		})
 
 		It("should delete order successfully", func() {
-			err := repo.Delete(ctx, orderID)
+			err := repo.Delete(ctx, orderID, "")
 
 			Expect(err).ToNot(HaveOccurred())
 
 			// Verify order is deleted
-			order, err := repo.GetByID(ctx, orderID)
+			order, err := repo.GetByID(ctx, orderID, domain.OrderExpand{})
 			Expect(err).To(HaveOccurred())
 			Expect(order).To(BeNil())
 		})
 
+		It("should keep a soft-deleted order with its items for reads that include deleted orders", func() {
+			Expect(repo.Delete(ctx, orderID, "")).To(Succeed())
+
+			order, err := repo.GetByID(domain.WithDeleted(ctx), orderID, domain.OrderExpand{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(order.DeletedAt).ToNot(BeNil())
+			Expect(order.Items).To(HaveLen(1))
+
+			page, err := repo.GetByUser(ctx, testUserID, domain.PageRequest{Limit: 10})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(page.Items).To(BeEmpty())
+		})
+
+		It("should keep the order when the version is stale", func() {
+			err := repo.Delete(ctx, orderID, "stale")
+
+			Expect(err).To(MatchError(domain.ErrPreconditionFailed))
+
+			order, err := repo.GetByID(ctx, orderID, domain.OrderExpand{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(order.Items).To(HaveLen(1))
+		})
+
 		It("should return error when order not found", func() {
-			err := repo.Delete(ctx, 99999)
+			err := repo.Delete(ctx, 99999, "")
 
-			Expect(err).To(HaveOccurred())
+			Expect(err).To(MatchError(domain.ErrNotFound))
+		})
+	})
+
+	Describe("Restore", func() {
+		BeforeEach(func() {
+			order, err := repo.Create(ctx, placed(testUserID, line(1, testutil.THB("50.00")), testutil.THB("50.00"), domain.RateOne, "pending"))
+			Expect(err).ToNot(HaveOccurred())
+			orderID, _ = strconv.Atoi(order.ID)
+		})
+
+		It("should bring back a soft-deleted order with its items", func() {
+			Expect(repo.Delete(ctx, orderID, "")).To(Succeed())
+
+			order, err := repo.Restore(ctx, orderID)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(order.DeletedAt).To(BeNil())
+			Expect(order.Items).To(HaveLen(1))
+		})
+
+		It("should return error when order not found", func() {
+			_, err := repo.Restore(ctx, 99999)
+
+			Expect(err).To(MatchError(domain.ErrNotFound))
+		})
+	})
+
+	Describe("Purge", func() {
+		BeforeEach(func() {
+			order, err := repo.Create(ctx, placed(testUserID, line(1, testutil.THB("50.00")), testutil.THB("50.00"), domain.RateOne, "pending"))
+			Expect(err).ToNot(HaveOccurred())
+			orderID, _ = strconv.Atoi(order.ID)
+		})
+
+		It("should remove a soft-deleted order with its items for good", func() {
+			Expect(repo.Delete(ctx, orderID, "")).To(Succeed())
+
+			Expect(repo.Purge(ctx, orderID)).To(Succeed())
+
+			_, err := repo.GetByID(domain.WithDeleted(ctx), orderID, domain.OrderExpand{})
+			Expect(err).To(MatchError(domain.ErrNotFound))
+			Expect(db.OrderItem.Query().CountX(ctx)).To(BeZero())
+		})
+
+		It("should remove the status history and coupon redemption of the order", func() {
+			db.OrderEvent.Create().SetOrderID(orderID).SetToStatus("pending").SetActor("user:1").ExecX(ctx)
+			coupon := db.Coupon.Create().SetCode("SAVE10").SetType(domain.CouponPercentage).SetPercent(10).SaveX(ctx)
+			db.CouponRedemption.Create().SetCouponID(coupon.ID).SetUserID(testUserID).SetOrderID(orderID).SetDiscount(500).ExecX(ctx)
+
+			Expect(repo.Purge(ctx, orderID)).To(Succeed())
+
+			Expect(db.Order.Query().CountX(ctx)).To(BeZero())
+			Expect(db.OrderEvent.Query().CountX(ctx)).To(BeZero())
+			Expect(db.CouponRedemption.Query().CountX(ctx)).To(BeZero())
+			Expect(db.Coupon.Query().CountX(ctx)).To(Equal(1))
+		})
+
+		It("should return error when order not found", func() {
+			err := repo.Purge(ctx, 99999)
+
+			Expect(err).To(MatchError(domain.ErrNotFound))
+		})
+	})
+
+	Describe("Delete policies", func() {
+		var (
+			otherUserID    int
+			otherProductID int
+			mixedOrderID   int
+		)
+
+		BeforeEach(func() {
+			other, err := db.User.Create().SetName("Other User").SetEmail("other@example.com").Save(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			otherUserID = other.ID
+			otherProduct, err := db.Product.Create().SetName("Other Product").SetDescription("Test").SetPrice(5000).SetStock(100).Save(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			otherProductID = otherProduct.ID
+
+			_, err = repo.Create(ctx, placed(testUserID, line(1, testutil.THB("100.00")), testutil.THB("100.00"), domain.RateOne, "pending"))
+			Expect(err).ToNot(HaveOccurred())
+			mixed, err := repo.Create(ctx, placed(otherUserID, []domain.OrderItem{
+				domain.NewOrderItem(otherProductID, 2, testutil.THB("50.00")),
+				domain.NewOrderItem(testProductID, 1, testutil.THB("100.00")),
+			}, testutil.THB("200.00"), domain.RateOne, "paid"))
+			Expect(err).ToNot(HaveOccurred())
+			mixedOrderID, _ = strconv.Atoi(mixed.ID)
+			_, err = repo.Create(ctx, placed(otherUserID, []domain.OrderItem{domain.NewOrderItem(otherProductID, 1, testutil.THB("50.00"))}, testutil.THB("50.00"), domain.RateOne, "pending"))
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should count the orders of a user and of a product", func() {
+			Expect(repo.CountByUser(ctx, otherUserID)).To(Equal(2))
+			Expect(repo.CountByProduct(ctx, testProductID)).To(Equal(2))
+			Expect(repo.CountByProduct(ctx, 99999)).To(BeZero())
+		})
+
+		It("should delete the orders of a user and return them with their items", func() {
+			orders, err := repo.DeleteByUser(ctx, otherUserID)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(orders).To(HaveLen(2))
+			Expect(orders[0].Items).ToNot(BeEmpty())
+			Expect(db.Order.Query().CountX(ctx)).To(Equal(1))
+			Expect(db.OrderItem.Query().CountX(ctx)).To(Equal(1))
+		})
+
+		It("should delete the status history and coupon redemptions of the orders", func() {
+			db.OrderEvent.Create().SetOrderID(mixedOrderID).SetFromStatus("pending").SetToStatus("paid").ExecX(ctx)
+			coupon := db.Coupon.Create().SetCode("SAVE10").SetType(domain.CouponPercentage).SetPercent(10).SaveX(ctx)
+			db.CouponRedemption.Create().SetCouponID(coupon.ID).SetUserID(otherUserID).SetOrderID(mixedOrderID).SetDiscount(2000).ExecX(ctx)
+
+			_, err := repo.DeleteByUser(ctx, otherUserID)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(db.OrderEvent.Query().CountX(ctx)).To(BeZero())
+			Expect(db.CouponRedemption.Query().CountX(ctx)).To(BeZero())
+		})
+
+		It("should delete the orders with an item for a product", func() {
+			orders, err := repo.DeleteByProduct(ctx, otherProductID)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(orders).To(HaveLen(2))
+			Expect(repo.CountByProduct(ctx, testProductID)).To(Equal(1))
+		})
+
+		It("should keep the orders of a detached user without their user", func() {
+			Expect(repo.DetachUser(ctx, otherUserID)).To(Succeed())
+
+			Expect(repo.CountByUser(ctx, otherUserID)).To(BeZero())
+			order, err := repo.GetByID(ctx, mixedOrderID, domain.OrderExpand{User: true})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(order.UserID).To(BeZero())
+			Expect(order.User).To(BeNil())
+			Expect(order.TotalPrice).To(Equal(testutil.THB("200.00")))
+		})
+
+		It("should keep writing orders of a detached user", func() {
+			Expect(repo.DetachUser(ctx, otherUserID)).To(Succeed())
+			current, err := repo.GetByID(ctx, mixedOrderID, domain.OrderExpand{})
+			Expect(err).ToNot(HaveOccurred())
+
+			status := "shipped"
+			_, err = repo.Patch(ctx, mixedOrderID, current.Version(), domain.OrderPatch{Status: &status})
+
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should keep the items of a detached product with their prices", func() {
+			Expect(repo.DetachProduct(ctx, testProductID)).To(Succeed())
+
+			Expect(repo.CountByProduct(ctx, testProductID)).To(BeZero())
+			order, err := repo.GetByID(ctx, mixedOrderID, domain.OrderExpand{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(order.Items).To(ContainElement(domain.OrderItem{ProductID: 0, Quantity: 1, UnitPrice: testutil.THB("100.00"), LineTotal: testutil.THB("100.00"), BaseTotal: testutil.THB("100.00")}))
+			Expect(order.TotalPrice).To(Equal(testutil.THB("200.00")))
+		})
+	})
+
+	Describe("Transactions", func() {
+		It("should write in the transaction carried by the context", func() {
+			rollback := errors.New("rollback")
+
+			err := txmanager.New(db).WithinTx(ctx, func(ctx context.Context) error {
+				order, err := repo.Create(ctx, placed(testUserID, line(1, testutil.THB("50.00")), testutil.THB("50.00"), domain.RateOne, "pending"))
+				Expect(err).ToNot(HaveOccurred())
+				id, _ := strconv.Atoi(order.ID)
+
+				_, err = repo.GetByID(ctx, id, domain.OrderExpand{})
+				Expect(err).ToNot(HaveOccurred())
+				return rollback
+			})
+
+			Expect(err).To(MatchError(rollback))
+			Expect(db.Order.Query().CountX(ctx)).To(BeZero())
 		})
 	})
 })
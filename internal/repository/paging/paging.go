@@ -0,0 +1,127 @@
+package paging
+
+import (
+	"fmt"
+	"slices"
+
+	"gin-swagger-api/internal/domain"
+)
+
+// Window describes how to query one page of rows ordered by ID
+type Window struct {
+	Cursor    domain.Cursor
+	HasCursor bool
+	Limit     int
+	Offset    int
+}
+
+// NewWindow decodes the cursor of a page request. Cursors walk the ID
+// order, so they cannot be combined with a custom sort.
+func NewWindow(page domain.PageRequest, sort []domain.Sort) (Window, error) {
+	w := Window{Limit: page.Limit, Offset: page.Offset}
+	if page.Cursor == "" {
+		return w, nil
+	}
+	if len(sort) > 0 {
+		return Window{}, fmt.Errorf("%w: cursor paging does not support sort", domain.ErrInvalidArgument)
+	}
+
+	cursor, err := domain.DecodeCursor(page.Cursor)
+	if err != nil {
+		return Window{}, err
+	}
+
+	w.Cursor = cursor
+	w.HasCursor = true
+	w.Offset = 0
+	return w, nil
+}
+
+// Descending reports whether rows must be fetched in descending ID order
+func (w Window) Descending() bool {
+	return w.HasCursor && w.Cursor.Before
+}
+
+// Fetch returns how many rows to fetch; one extra row detects further pages
+func (w Window) Fetch() int {
+	return w.Limit + 1
+}
+
+// NewPage builds a page of domain items from rows fetched for the window.
+// Rows must be in the order implied by Descending and may contain the
+// extra lookahead row.
+func NewPage[E any, T any](w Window, rows []E, total int, id func(E) int, convert func(E) T) *domain.Page[T] {
+	hasMore := len(rows) > w.Limit
+	if hasMore {
+		rows = rows[:w.Limit]
+	}
+	if w.Descending() {
+		slices.Reverse(rows)
+	}
+
+	items := make([]T, len(rows))
+	for i, row := range rows {
+		items[i] = convert(row)
+	}
+
+	page := &domain.Page[T]{
+		Items:  items,
+		Total:  total,
+		Limit:  w.Limit,
+		Offset: w.Offset,
+	}
+	if len(rows) == 0 {
+		return page
+	}
+
+	first := domain.Cursor{ID: id(rows[0]), Before: true}
+	last := domain.Cursor{ID: id(rows[len(rows)-1])}
+
+	if w.Descending() {
+		// Walking backwards from a cursor always leaves the cursor row ahead
+		page.NextCursor = last.Encode()
+		if hasMore {
+			page.PrevCursor = first.Encode()
+		}
+		return page
+	}
+
+	if hasMore {
+		page.NextCursor = last.Encode()
+	}
+	if w.HasCursor || w.Offset > 0 {
+		page.PrevCursor = first.Encode()
+	}
+	return page
+}
+
+// Batches walks rows in ascending ID order, batchSize rows at a time, and
+// passes each batch to fn converted to domain items. fetch returns up to
+// limit rows with an ID greater than afterID, in ID order. Only one batch
+// is held at a time, however many rows there are. Batches stops at the
+// first error, including one returned by fn.
+func Batches[E any, T any](batchSize int, fetch func(afterID, limit int) ([]E, error), id func(E) int, convert func(E) T, fn func([]T) error) error {
+	afterID := 0
+	for {
+		rows, err := fetch(afterID, batchSize)
+		if err != nil {
+			return err
+		}
+		if len(rows) == 0 {
+			return nil
+		}
+
+		items := make([]T, len(rows))
+		for i, row := range rows {
+			items[i] = convert(row)
+		}
+		if err := fn(items); err != nil {
+			return err
+		}
+
+		if len(rows) < batchSize {
+			return nil
+		}
+		afterID = id(rows[len(rows)-1])
+	}
+}
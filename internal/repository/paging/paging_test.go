@@ -0,0 +1,176 @@
+package paging_test
+
+import (
+	"errors"
+	"strconv"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"gin-swagger-api/internal/domain"
+	"gin-swagger-api/internal/repository/paging"
+)
+
+func identity(id int) int { return id }
+
+func itoa(id int) string { return strconv.Itoa(id) }
+
+var _ = Describe("Paging", func() {
+	Describe("NewWindow", func() {
+		It("should keep the offset without a cursor", func() {
+			window, err := paging.NewWindow(domain.PageRequest{Limit: 10, Offset: 20}, nil)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(window.HasCursor).To(BeFalse())
+			Expect(window.Offset).To(Equal(20))
+			Expect(window.Fetch()).To(Equal(11))
+		})
+
+		It("should decode the cursor and ignore the offset", func() {
+			cursor := domain.Cursor{ID: 5, Before: true}.Encode()
+
+			window, err := paging.NewWindow(domain.PageRequest{Limit: 10, Offset: 20, Cursor: cursor}, nil)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(window.HasCursor).To(BeTrue())
+			Expect(window.Descending()).To(BeTrue())
+			Expect(window.Cursor.ID).To(Equal(5))
+			Expect(window.Offset).To(BeZero())
+		})
+
+		It("should return invalid argument for a malformed cursor", func() {
+			_, err := paging.NewWindow(domain.PageRequest{Limit: 10, Cursor: "!!!"}, nil)
+
+			Expect(err).To(MatchError(domain.ErrInvalidArgument))
+		})
+
+		It("should reject a cursor combined with a sort", func() {
+			cursor := domain.Cursor{ID: 5}.Encode()
+
+			_, err := paging.NewWindow(domain.PageRequest{Limit: 10, Cursor: cursor}, []domain.Sort{{Field: "name"}})
+
+			Expect(err).To(MatchError(domain.ErrInvalidArgument))
+		})
+
+		It("should allow a sort with offset paging", func() {
+			window, err := paging.NewWindow(domain.PageRequest{Limit: 10, Offset: 10}, []domain.Sort{{Field: "name"}})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(window.Offset).To(Equal(10))
+		})
+	})
+
+	Describe("NewPage", func() {
+		It("should trim the lookahead row and set the next cursor", func() {
+			window := paging.Window{Limit: 2}
+
+			page := paging.NewPage(window, []int{1, 2, 3}, 5, identity, itoa)
+
+			Expect(page.Items).To(Equal([]string{"1", "2"}))
+			Expect(page.Total).To(Equal(5))
+			Expect(page.NextCursor).To(Equal(domain.Cursor{ID: 2}.Encode()))
+			Expect(page.PrevCursor).To(BeEmpty())
+		})
+
+		It("should set no cursors on the only page", func() {
+			page := paging.NewPage(paging.Window{Limit: 5}, []int{1, 2}, 2, identity, itoa)
+
+			Expect(page.Items).To(HaveLen(2))
+			Expect(page.NextCursor).To(BeEmpty())
+			Expect(page.PrevCursor).To(BeEmpty())
+		})
+
+		It("should set a previous cursor after an offset", func() {
+			page := paging.NewPage(paging.Window{Limit: 2, Offset: 2}, []int{3, 4}, 4, identity, itoa)
+
+			Expect(page.NextCursor).To(BeEmpty())
+			Expect(page.PrevCursor).To(Equal(domain.Cursor{ID: 3, Before: true}.Encode()))
+		})
+
+		It("should restore ascending order when walking backwards", func() {
+			window := paging.Window{Limit: 2, HasCursor: true, Cursor: domain.Cursor{ID: 5, Before: true}}
+
+			page := paging.NewPage(window, []int{4, 3, 2}, 10, identity, itoa)
+
+			Expect(page.Items).To(Equal([]string{"3", "4"}))
+			Expect(page.NextCursor).To(Equal(domain.Cursor{ID: 4}.Encode()))
+			Expect(page.PrevCursor).To(Equal(domain.Cursor{ID: 3, Before: true}.Encode()))
+		})
+
+		It("should return an empty page without cursors", func() {
+			window := paging.Window{Limit: 2, HasCursor: true, Cursor: domain.Cursor{ID: 9}}
+
+			page := paging.NewPage(window, []int{}, 9, identity, itoa)
+
+			Expect(page.Items).To(BeEmpty())
+			Expect(page.NextCursor).To(BeEmpty())
+			Expect(page.PrevCursor).To(BeEmpty())
+		})
+	})
+
+	Describe("Batches", func() {
+		// rows fetches from the IDs 1 to n and records each call
+		rows := func(n int, calls *[]int) func(afterID, limit int) ([]int, error) {
+			return func(afterID, limit int) ([]int, error) {
+				*calls = append(*calls, afterID)
+				var batch []int
+				for id := afterID + 1; id <= n && len(batch) < limit; id++ {
+					batch = append(batch, id)
+				}
+				return batch, nil
+			}
+		}
+
+		It("should pass every row in batches, resuming after the last ID", func() {
+			var calls []int
+			var batches [][]string
+
+			err := paging.Batches(2, rows(5, &calls), identity, itoa, func(batch []string) error {
+				batches = append(batches, batch)
+				return nil
+			})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(batches).To(Equal([][]string{{"1", "2"}, {"3", "4"}, {"5"}}))
+			Expect(calls).To(Equal([]int{0, 2, 4}))
+		})
+
+		It("should stop with an empty fetch after a full batch", func() {
+			var calls []int
+			var count int
+
+			err := paging.Batches(2, rows(4, &calls), identity, itoa, func(batch []string) error {
+				count++
+				return nil
+			})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(count).To(Equal(2))
+			Expect(calls).To(Equal([]int{0, 2, 4}))
+		})
+
+		It("should stop at an error from fn", func() {
+			var calls []int
+			stop := errors.New("client went away")
+
+			err := paging.Batches(2, rows(10, &calls), identity, itoa, func(batch []string) error {
+				return stop
+			})
+
+			Expect(err).To(MatchError(stop))
+			Expect(calls).To(HaveLen(1))
+		})
+
+		It("should return fetch errors", func() {
+			failure := errors.New("database error")
+
+			err := paging.Batches(2, func(afterID, limit int) ([]int, error) {
+				return nil, failure
+			}, identity, itoa, func(batch []string) error {
+				return nil
+			})
+
+			Expect(err).To(MatchError(failure))
+		})
+	})
+})
@@ -2,12 +2,21 @@ package productrepo
 
 import (
 	"context"
+	"fmt"
 	"strconv"
+	"time"
 
 	"gin-swagger-api/internal/domain"
 	portproductrepo "gin-swagger-api/internal/port/repository/productrepo"
+	"gin-swagger-api/internal/repository/filtering"
+	"gin-swagger-api/internal/repository/paging"
+	"gin-swagger-api/internal/repository/repoerr"
+	"gin-swagger-api/internal/repository/txmanager"
 
 	"github.com/snilli/ormprovider"
+	"github.com/snilli/ormprovider/ent"
+	"github.com/snilli/ormprovider/ent/predicate"
+	"github.com/snilli/ormprovider/ent/product"
 )
 
 // Repository implements the product repository interface
@@ -20,85 +29,300 @@ func New(db *ormprovider.Client) portproductrepo.Repository {
 	return &Repository{db: db}
 }
 
-// GetAll retrieves all products
-func (r *Repository) GetAll(ctx context.Context) ([]domain.Product, error) {
-	entProducts, err := r.db.Product.Query().All(ctx)
+// GetAll retrieves a page of products matching the list query
+func (r *Repository) GetAll(ctx context.Context, list domain.ListQuery, page domain.PageRequest) (*domain.Page[domain.Product], error) {
+	window, err := paging.NewWindow(page, list.Sort)
 	if err != nil {
 		return nil, err
 	}
 
-	products := make([]domain.Product, len(entProducts))
-	for i, entProduct := range entProducts {
-		products[i] = domain.Product{
-			ID:          strconv.Itoa(entProduct.ID),
-			Name:        entProduct.Name,
-			Description: entProduct.Description,
-			Price:       entProduct.Price,
-			Stock:       entProduct.Stock,
-		}
+	query := r.client(ctx).Product.Query().
+		Where(live(ctx)...).
+		Where(filtering.Predicates[predicate.Product](list.Filters)...)
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, repoerr.Translate(err, "product")
+	}
+
+	switch {
+	case window.Descending():
+		query = query.Where(product.IDLT(window.Cursor.ID)).Order(ent.Desc(product.FieldID))
+	case window.HasCursor:
+		query = query.Where(product.IDGT(window.Cursor.ID)).Order(ent.Asc(product.FieldID))
+	default:
+		query = query.Order(filtering.Orders[product.OrderOption](list.Sort, product.FieldID)...).Offset(window.Offset)
+	}
+
+	entProducts, err := query.Limit(window.Fetch()).All(ctx)
+	if err != nil {
+		return nil, repoerr.Translate(err, "product")
+	}
+
+	return paging.NewPage(window, entProducts, total, productID, toProduct), nil
+}
+
+// Stream passes the products matching the list filters to fn in batches of
+// batchSize, in ID order
+func (r *Repository) Stream(ctx context.Context, list domain.ListQuery, batchSize int, fn func([]domain.Product) error) error {
+	query := r.client(ctx).Product.Query().
+		Where(live(ctx)...).
+		Where(filtering.Predicates[predicate.Product](list.Filters)...)
+
+	fetch := func(afterID, limit int) ([]*ent.Product, error) {
+		rows, err := query.Clone().
+			Where(product.IDGT(afterID)).
+			Order(ent.Asc(product.FieldID)).
+			Limit(limit).
+			All(ctx)
+		return rows, repoerr.Translate(err, "product")
 	}
-	return products, nil
+	return paging.Batches(batchSize, fetch, productID, toProduct, fn)
 }
 
 // GetByID retrieves a product by ID
 func (r *Repository) GetByID(ctx context.Context, id int) (*domain.Product, error) {
-	entProduct, err := r.db.Product.Get(ctx, id)
+	entProduct, err := r.get(ctx, id)
 	if err != nil {
 		return nil, err
 	}
 
-	return &domain.Product{
-		ID:          strconv.Itoa(entProduct.ID),
-		Name:        entProduct.Name,
-		Description: entProduct.Description,
-		Price:       entProduct.Price,
-		Stock:       entProduct.Stock,
-	}, nil
+	p := toProduct(entProduct)
+	return &p, nil
 }
 
 // Create creates a new product
-func (r *Repository) Create(ctx context.Context, name, description string, price float64, stock int) (*domain.Product, error) {
-	entProduct, err := r.db.Product.Create().
+func (r *Repository) Create(ctx context.Context, name, description string, price domain.Money, stock int) (*domain.Product, error) {
+	entProduct, err := r.client(ctx).Product.Create().
 		SetName(name).
 		SetDescription(description).
-		SetPrice(price).
+		SetPrice(price.Amount).
 		SetStock(stock).
 		Save(ctx)
 	if err != nil {
-		return nil, err
+		return nil, repoerr.Translate(err, "product")
 	}
 
-	return &domain.Product{
-		ID:          strconv.Itoa(entProduct.ID),
-		Name:        entProduct.Name,
-		Description: entProduct.Description,
-		Price:       entProduct.Price,
-		Stock:       entProduct.Stock,
-	}, nil
+	p := toProduct(entProduct)
+	return &p, nil
 }
 
-// Update updates a product
-func (r *Repository) Update(ctx context.Context, id int, name, description string, price float64, stock int) (*domain.Product, error) {
-	entProduct, err := r.db.Product.UpdateOneID(id).
+// CreateBulk creates products in one statement
+func (r *Repository) CreateBulk(ctx context.Context, products []domain.Product) ([]domain.Product, error) {
+	client := r.client(ctx)
+	builders := make([]*ent.ProductCreate, len(products))
+	for i, p := range products {
+		builders[i] = client.Product.Create().
+			SetName(p.Name).
+			SetDescription(p.Description).
+			SetPrice(p.Price.Amount).
+			SetStock(p.Stock)
+	}
+
+	entProducts, err := client.Product.CreateBulk(builders...).Save(ctx)
+	if err != nil {
+		return nil, repoerr.Translate(err, "product")
+	}
+
+	created := make([]domain.Product, len(entProducts))
+	for i, entProduct := range entProducts {
+		created[i] = toProduct(entProduct)
+	}
+	return created, nil
+}
+
+// Update updates a product. A non-empty version must match the current
+// version of the product.
+func (r *Repository) Update(ctx context.Context, id int, version, name, description string, price domain.Money, stock int) (*domain.Product, error) {
+	guard, err := r.guard(ctx, id, version)
+	if err != nil {
+		return nil, err
+	}
+
+	entProduct, err := r.client(ctx).Product.UpdateOneID(id).
+		Where(product.DeletedAtIsNil()).
+		Where(guard...).
 		SetName(name).
 		SetDescription(description).
-		SetPrice(price).
+		SetPrice(price.Amount).
 		SetStock(stock).
 		Save(ctx)
+	if err != nil {
+		return nil, repoerr.TranslateGuarded(err, "product", guard != nil)
+	}
+
+	p := toProduct(entProduct)
+	return &p, nil
+}
+
+// Patch updates only the product fields set in the patch. A non-empty
+// version must match the current version of the product.
+func (r *Repository) Patch(ctx context.Context, id int, version string, patch domain.ProductPatch) (*domain.Product, error) {
+	guard, err := r.guard(ctx, id, version)
+	if err != nil {
+		return nil, err
+	}
+
+	update := r.client(ctx).Product.UpdateOneID(id).
+		Where(product.DeletedAtIsNil()).
+		Where(guard...)
+	if patch.Name != nil {
+		update.SetName(*patch.Name)
+	}
+	if patch.Description != nil {
+		update.SetDescription(*patch.Description)
+	}
+	if patch.Price != nil {
+		update.SetPrice(patch.Price.Amount)
+	}
+	if patch.Stock != nil {
+		update.SetStock(*patch.Stock)
+	}
+
+	entProduct, err := update.Save(ctx)
+	if err != nil {
+		return nil, repoerr.TranslateGuarded(err, "product", guard != nil)
+	}
+
+	p := toProduct(entProduct)
+	return &p, nil
+}
+
+// Delete soft-deletes a product by setting its deleted_at. A non-empty
+// version must match the current version of the product.
+func (r *Repository) Delete(ctx context.Context, id int, version string) error {
+	guard, err := r.guard(ctx, id, version)
+	if err != nil {
+		return err
+	}
+
+	err = r.client(ctx).Product.UpdateOneID(id).
+		Where(product.DeletedAtIsNil()).
+		Where(guard...).
+		SetDeletedAt(time.Now()).
+		Exec(ctx)
+	return repoerr.TranslateGuarded(err, "product", guard != nil)
+}
+
+// Restore clears the deleted_at of a product. Restoring a product that is
+// not deleted leaves it as it is.
+func (r *Repository) Restore(ctx context.Context, id int) (*domain.Product, error) {
+	entProduct, err := r.client(ctx).Product.UpdateOneID(id).
+		ClearDeletedAt().
+		Save(ctx)
+	if err != nil {
+		return nil, repoerr.Translate(err, "product")
+	}
+
+	p := toProduct(entProduct)
+	return &p, nil
+}
+
+// Purge deletes a product for good, whether or not it is soft-deleted
+func (r *Repository) Purge(ctx context.Context, id int) error {
+	err := r.client(ctx).Product.DeleteOneID(id).Exec(ctx)
+	return repoerr.Translate(err, "product")
+}
+
+// ReserveStock takes quantity units of a product out of stock. It fails
+// with domain.ErrInsufficientStock when fewer units are in stock; the check
+// and the decrement are a single conditional update so concurrent
+// reservations cannot oversell. A soft-deleted product is not found.
+func (r *Repository) ReserveStock(ctx context.Context, id, quantity int) error {
+	n, err := r.client(ctx).Product.Update().
+		Where(product.ID(id), product.DeletedAtIsNil(), product.StockGTE(quantity)).
+		AddStock(-quantity).
+		Save(ctx)
+	if err != nil {
+		return repoerr.Translate(err, "product")
+	}
+	if n > 0 {
+		return nil
+	}
+
+	exists, err := r.client(ctx).Product.Query().Where(product.ID(id), product.DeletedAtIsNil()).Exist(ctx)
+	if err != nil {
+		return repoerr.Translate(err, "product")
+	}
+	if !exists {
+		return fmt.Errorf("product %w", domain.ErrNotFound)
+	}
+	return fmt.Errorf("product %d has %w for %d more units", id, domain.ErrInsufficientStock, quantity)
+}
+
+// ReleaseStock returns quantity units of a product to stock
+func (r *Repository) ReleaseStock(ctx context.Context, id, quantity int) error {
+	err := r.client(ctx).Product.UpdateOneID(id).
+		AddStock(quantity).
+		Exec(ctx)
+	return repoerr.Translate(err, "product")
+}
+
+// guard returns predicates that match the product only while it is still
+// at version, so a write using them fails if a concurrent write got in
+// first. An empty version needs no guard.
+func (r *Repository) guard(ctx context.Context, id int, version string) ([]predicate.Product, error) {
+	if version == "" {
+		return nil, nil
+	}
+
+	entProduct, err := r.get(ctx, id)
 	if err != nil {
 		return nil, err
 	}
+	if toProduct(entProduct).Version() != version {
+		return nil, repoerr.Stale("product")
+	}
 
-	return &domain.Product{
+	return []predicate.Product{
+		product.Name(entProduct.Name),
+		product.Description(entProduct.Description),
+		product.Price(entProduct.Price),
+		product.Stock(entProduct.Stock),
+	}, nil
+}
+
+// get loads a product, unless it is soft-deleted and ctx does not include
+// deleted products
+func (r *Repository) get(ctx context.Context, id int) (*ent.Product, error) {
+	entProduct, err := r.client(ctx).Product.Query().
+		Where(product.ID(id)).
+		Where(live(ctx)...).
+		Only(ctx)
+	if err != nil {
+		return nil, repoerr.Translate(err, "product")
+	}
+	return entProduct, nil
+}
+
+// client returns the ent client for ctx, bound to the transaction it
+// carries if any
+func (r *Repository) client(ctx context.Context) *ent.Client {
+	return txmanager.Client(ctx, r.db)
+}
+
+// live returns the predicates that leave soft-deleted products out of a
+// query, unless ctx comes from domain.WithDeleted
+func live(ctx context.Context) []predicate.Product {
+	if domain.IncludesDeleted(ctx) {
+		return nil
+	}
+	return []predicate.Product{product.DeletedAtIsNil()}
+}
+
+// productID returns the ID of an ent product
+func productID(entProduct *ent.Product) int {
+	return entProduct.ID
+}
+
+// toProduct converts an ent product to domain.Product
+func toProduct(entProduct *ent.Product) domain.Product {
+	return domain.Product{
 		ID:          strconv.Itoa(entProduct.ID),
 		Name:        entProduct.Name,
 		Description: entProduct.Description,
-		Price:       entProduct.Price,
+		Price:       domain.Money{Amount: entProduct.Price, Currency: domain.BaseCurrency},
 		Stock:       entProduct.Stock,
-	}, nil
-}
-
-// Delete deletes a product
-func (r *Repository) Delete(ctx context.Context, id int) error {
-	return r.db.Product.DeleteOneID(id).Exec(ctx)
+		DeletedAt:   entProduct.DeletedAt,
+	}
 }
@@ -2,6 +2,8 @@ package productrepo_test
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"strconv"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -10,6 +12,7 @@ import (
 	"gin-swagger-api/internal/domain"
 	portproductrepo "gin-swagger-api/internal/port/repository/productrepo"
 	"gin-swagger-api/internal/repository/productrepo"
+	"gin-swagger-api/internal/repository/txmanager"
 	"gin-swagger-api/internal/testutil"
 
 	"github.com/snilli/ormprovider"
@@ -38,27 +41,27 @@ var _ = Describe("ProductRepository", func() {
 
 	Describe("Create", func() {
 		It("should create a product successfully", func() {
-			product, err := repo.Create(ctx, "Laptop", "High performance laptop", 1500.00, 10)
+			product, err := repo.Create(ctx, "Laptop", "High performance laptop", testutil.THB("1500.00"), 10)
 
 			Expect(err).ToNot(HaveOccurred())
 			Expect(*product).To(Equal(domain.Product{
 				ID:          product.ID,
 				Name:        "Laptop",
 				Description: "High performance laptop",
-				Price:       1500.00,
+				Price:       testutil.THB("1500.00"),
 				Stock:       10,
 			}))
 		})
 
 		It("should create product with zero stock", func() {
-			product, err := repo.Create(ctx, "Out of Stock Item", "Currently unavailable", 99.99, 0)
+			product, err := repo.Create(ctx, "Out of Stock Item", "Currently unavailable", testutil.THB("99.99"), 0)
 
 			Expect(err).ToNot(HaveOccurred())
 			Expect(*product).To(Equal(domain.Product{
 				ID:          product.ID,
 				Name:        "Out of Stock Item",
 				Description: "Currently unavailable",
-				Price:       99.99,
+				Price:       testutil.THB("99.99"),
 				Stock:       0,
 			}))
 		})
@@ -66,19 +69,47 @@ var _ = Describe("ProductRepository", func() {
 		It("should return error when database connection fails", func() {
 			_ = db.Close()
 
-			product, err := repo.Create(ctx, "Product", "Description", 100.00, 10)
+			product, err := repo.Create(ctx, "Product", "Description", testutil.THB("100.00"), 10)
 
 			Expect(err).To(HaveOccurred())
 			Expect(product).To(BeNil())
 		})
 	})
 
+	Describe("CreateBulk", func() {
+		It("should create the products in order with their ids", func() {
+			products, err := repo.CreateBulk(ctx, []domain.Product{
+				{Name: "Mouse", Description: "Wireless", Price: testutil.THB("20.00"), Stock: 5},
+				{Name: "Keyboard", Price: testutil.THB("50.00")},
+			})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(products).To(HaveLen(2))
+			Expect(products[0]).To(Equal(domain.Product{ID: products[0].ID, Name: "Mouse", Description: "Wireless", Price: testutil.THB("20.00"), Stock: 5}))
+			Expect(products[1].Name).To(Equal("Keyboard"))
+			Expect(products[1].ID).ToNot(Equal(products[0].ID))
+		})
+
+		It("should write in the transaction carried by the context", func() {
+			rollback := errors.New("rollback")
+
+			err := txmanager.New(db).WithinTx(ctx, func(ctx context.Context) error {
+				_, err := repo.CreateBulk(ctx, []domain.Product{{Name: "Mouse", Price: testutil.THB("20.00")}})
+				Expect(err).ToNot(HaveOccurred())
+				return rollback
+			})
+
+			Expect(err).To(MatchError(rollback))
+			Expect(db.Product.Query().CountX(ctx)).To(BeZero())
+		})
+	})
+
 	Describe("GetByID", func() {
 		var createdProduct *domain.Product
 
 		BeforeEach(func() {
 			var err error
-			createdProduct, err = repo.Create(ctx, "Test Product", "Test Description", 100.00, 5)
+			createdProduct, err = repo.Create(ctx, "Test Product", "Test Description", testutil.THB("100.00"), 5)
 			Expect(err).ToNot(HaveOccurred())
 			productID, _ = strconv.Atoi(createdProduct.ID)
 		})
@@ -93,35 +124,35 @@ var _ = Describe("ProductRepository", func() {
 		It("should return error when product not found", func() {
 			product, err := repo.GetByID(ctx, 99999)
 
-			Expect(err).To(HaveOccurred())
+			Expect(err).To(MatchError(domain.ErrNotFound))
 			Expect(product).To(BeNil())
 		})
 	})
 
 	Describe("GetAll", func() {
 		It("should return empty list when no products exist", func() {
-			products, err := repo.GetAll(ctx)
+			page, err := repo.GetAll(ctx, domain.ListQuery{}, domain.PageRequest{Limit: 10})
 
 			Expect(err).ToNot(HaveOccurred())
-			Expect(products).ToNot(BeNil())
-			Expect(len(products)).To(Equal(0))
+			Expect(page.Items).ToNot(BeNil())
+			Expect(len(page.Items)).To(Equal(0))
 		})
 
 		It("should return all products with correct data", func() {
-			prod1, err := repo.Create(ctx, "Product 1", "Description 1", 50.00, 10)
+			prod1, err := repo.Create(ctx, "Product 1", "Description 1", testutil.THB("50.00"), 10)
 			Expect(err).ToNot(HaveOccurred())
-			prod2, err := repo.Create(ctx, "Product 2", "Description 2", 75.00, 20)
+			prod2, err := repo.Create(ctx, "Product 2", "Description 2", testutil.THB("75.00"), 20)
 			Expect(err).ToNot(HaveOccurred())
 
-			products, err := repo.GetAll(ctx)
+			page, err := repo.GetAll(ctx, domain.ListQuery{}, domain.PageRequest{Limit: 10})
 
 			Expect(err).ToNot(HaveOccurred())
-			Expect(len(products)).To(Equal(2))
+			Expect(len(page.Items)).To(Equal(2))
 
 			// Verify all fields are correctly mapped
 			foundProd1 := false
 			foundProd2 := false
-			for _, p := range products {
+			for _, p := range page.Items {
 				if p.ID == prod1.ID && p.Name == "Product 1" {
 					foundProd1 = true
 				}
@@ -133,53 +164,260 @@ var _ = Describe("ProductRepository", func() {
 			Expect(foundProd2).To(BeTrue())
 		})
 
+		Context("with filters and sort", func() {
+			BeforeEach(func() {
+				_, err := repo.Create(ctx, "Cable", "USB cable", testutil.THB("5.00"), 100)
+				Expect(err).ToNot(HaveOccurred())
+				_, err = repo.Create(ctx, "Mouse", "Wireless mouse", testutil.THB("25.00"), 0)
+				Expect(err).ToNot(HaveOccurred())
+				_, err = repo.Create(ctx, "Keyboard", "Mechanical keyboard", testutil.THB("45.00"), 3)
+				Expect(err).ToNot(HaveOccurred())
+				_, err = repo.Create(ctx, "Headset", "Wireless headset", testutil.THB("30.00"), 8)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("should return products in a price range that are in stock", func() {
+				page, err := repo.GetAll(ctx, domain.ListQuery{
+					Filters: []domain.Filter{
+						{Field: "price", Op: domain.OpGt, Values: []any{int64(1000)}},
+						{Field: "price", Op: domain.OpLt, Values: []any{int64(5000)}},
+						{Field: "stock", Op: domain.OpGt, Values: []any{0}},
+					},
+					Sort: []domain.Sort{{Field: "name"}},
+				}, domain.PageRequest{Limit: 10})
+
+				Expect(err).ToNot(HaveOccurred())
+				Expect(page.Total).To(Equal(2))
+				Expect(page.Items).To(HaveLen(2))
+				Expect(page.Items[0].Name).To(Equal("Headset"))
+				Expect(page.Items[1].Name).To(Equal("Keyboard"))
+			})
+
+			It("should match a case-insensitive substring", func() {
+				page, err := repo.GetAll(ctx, domain.ListQuery{
+					Filters: []domain.Filter{{Field: "description", Op: domain.OpLike, Values: []any{"WIRELESS"}}},
+				}, domain.PageRequest{Limit: 10})
+
+				Expect(err).ToNot(HaveOccurred())
+				Expect(page.Total).To(Equal(2))
+			})
+		})
+
+		Context("with more rows than the page size", func() {
+			BeforeEach(func() {
+				for i := 1; i <= 5; i++ {
+					_, err := repo.Create(ctx, fmt.Sprintf("Product %d", i), "Paged", testutil.THB("10.00"), 1)
+					Expect(err).ToNot(HaveOccurred())
+				}
+			})
+
+			It("should return the requested offset window with the total", func() {
+				page, err := repo.GetAll(ctx, domain.ListQuery{}, domain.PageRequest{Limit: 2, Offset: 2})
+
+				Expect(err).ToNot(HaveOccurred())
+				Expect(page.Items).To(HaveLen(2))
+				Expect(page.Total).To(Equal(5))
+				Expect(page.Offset).To(Equal(2))
+				Expect(page.NextCursor).ToNot(BeEmpty())
+				Expect(page.PrevCursor).ToNot(BeEmpty())
+			})
+
+			It("should walk forwards and backwards with cursors", func() {
+				first, err := repo.GetAll(ctx, domain.ListQuery{}, domain.PageRequest{Limit: 2})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(first.PrevCursor).To(BeEmpty())
+
+				second, err := repo.GetAll(ctx, domain.ListQuery{}, domain.PageRequest{Limit: 2, Cursor: first.NextCursor})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(second.Items).To(HaveLen(2))
+				Expect(second.Items[0].ID).ToNot(Equal(first.Items[1].ID))
+
+				back, err := repo.GetAll(ctx, domain.ListQuery{}, domain.PageRequest{Limit: 2, Cursor: second.PrevCursor})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(back.Items).To(Equal(first.Items))
+				Expect(back.PrevCursor).To(BeEmpty())
+			})
+
+			It("should reject a malformed cursor", func() {
+				page, err := repo.GetAll(ctx, domain.ListQuery{}, domain.PageRequest{Limit: 2, Cursor: "!!!"})
+
+				Expect(err).To(MatchError(domain.ErrInvalidArgument))
+				Expect(page).To(BeNil())
+			})
+		})
+
 		It("should return error when database connection fails", func() {
 			_ = db.Close()
 
-			products, err := repo.GetAll(ctx)
+			page, err := repo.GetAll(ctx, domain.ListQuery{}, domain.PageRequest{Limit: 10})
 
 			Expect(err).To(HaveOccurred())
-			Expect(products).To(BeNil())
+			Expect(page).To(BeNil())
+		})
+	})
+
+	Describe("Stream", func() {
+		BeforeEach(func() {
+			for i := 1; i <= 5; i++ {
+				_, err := repo.Create(ctx, fmt.Sprintf("Product %d", i), "Description", testutil.THB("10.00").Mul(i), i-1)
+				Expect(err).ToNot(HaveOccurred())
+			}
+		})
+
+		It("should pass every product in id order in batches", func() {
+			var batches [][]domain.Product
+			err := repo.Stream(ctx, domain.ListQuery{}, 2, func(products []domain.Product) error {
+				batches = append(batches, products)
+				return nil
+			})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(batches).To(HaveLen(3))
+			Expect(batches[0][0].Name).To(Equal("Product 1"))
+			Expect(batches[2]).To(HaveLen(1))
+		})
+
+		It("should only pass matching products", func() {
+			var names []string
+			err := repo.Stream(ctx, domain.ListQuery{
+				Filters: []domain.Filter{{Field: "stock", Op: domain.OpGt, Values: []any{2}}},
+			}, 2, func(products []domain.Product) error {
+				for _, p := range products {
+					names = append(names, p.Name)
+				}
+				return nil
+			})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(names).To(Equal([]string{"Product 4", "Product 5"}))
 		})
 	})
 
 	Describe("Update", func() {
 		BeforeEach(func() {
-			product, err := repo.Create(ctx, "Original Product", "Original Description", 100.00, 5)
+			product, err := repo.Create(ctx, "Original Product", "Original Description", testutil.THB("100.00"), 5)
 			Expect(err).ToNot(HaveOccurred())
 			productID, _ = strconv.Atoi(product.ID)
 		})
 
 		It("should update product successfully", func() {
-			product, err := repo.Update(ctx, productID, "Updated Product", "Updated Description", 150.00, 15)
+			product, err := repo.Update(ctx, productID, "", "Updated Product", "Updated Description", testutil.THB("150.00"), 15)
 
 			Expect(err).ToNot(HaveOccurred())
 			Expect(*product).To(Equal(domain.Product{
 				ID:          product.ID,
 				Name:        "Updated Product",
 				Description: "Updated Description",
-				Price:       150.00,
+				Price:       testutil.THB("150.00"),
 				Stock:       15,
 			}))
 		})
 
 		It("should return error when product not found", func() {
-			product, err := repo.Update(ctx, 99999, "Name", "Description", 100.00, 10)
+			product, err := repo.Update(ctx, 99999, "", "Name", "Description", testutil.THB("100.00"), 10)
 
-			Expect(err).To(HaveOccurred())
+			Expect(err).To(MatchError(domain.ErrNotFound))
+			Expect(product).To(BeNil())
+		})
+	})
+
+	Describe("Update with a version", func() {
+		var current *domain.Product
+
+		BeforeEach(func() {
+			var err error
+			current, err = repo.Create(ctx, "Original Product", "Original Description", testutil.THB("100.00"), 5)
+			Expect(err).ToNot(HaveOccurred())
+			productID, _ = strconv.Atoi(current.ID)
+		})
+
+		It("should update when the version matches", func() {
+			product, err := repo.Update(ctx, productID, current.Version(), "Updated Product", "Updated Description", testutil.THB("150.00"), 15)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(product.Version()).ToNot(Equal(current.Version()))
+		})
+
+		It("should reject a second write based on the same version", func() {
+			_, err := repo.Update(ctx, productID, current.Version(), "First Writer", "Original Description", testutil.THB("100.00"), 5)
+			Expect(err).ToNot(HaveOccurred())
+
+			product, err := repo.Update(ctx, productID, current.Version(), "Second Writer", "Original Description", testutil.THB("100.00"), 5)
+
+			Expect(err).To(MatchError(domain.ErrPreconditionFailed))
+			Expect(product).To(BeNil())
+
+			stored, err := repo.GetByID(ctx, productID)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(stored.Name).To(Equal("First Writer"))
+		})
+
+		It("should return error when product not found", func() {
+			product, err := repo.Update(ctx, 99999, current.Version(), "Name", "Description", testutil.THB("100.00"), 10)
+
+			Expect(err).To(MatchError(domain.ErrNotFound))
+			Expect(product).To(BeNil())
+		})
+	})
+
+	Describe("Patch", func() {
+		BeforeEach(func() {
+			product, err := repo.Create(ctx, "Original Product", "Original Description", testutil.THB("100.00"), 5)
+			Expect(err).ToNot(HaveOccurred())
+			productID, _ = strconv.Atoi(product.ID)
+		})
+
+		It("should update only the fields set in the patch", func() {
+			price := testutil.THB("10.00")
+			product, err := repo.Patch(ctx, productID, "", domain.ProductPatch{Price: &price})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(*product).To(Equal(domain.Product{
+				ID:          product.ID,
+				Name:        "Original Product",
+				Description: "Original Description",
+				Price:       testutil.THB("10.00"),
+				Stock:       5,
+			}))
+		})
+
+		It("should allow setting a field to its zero value", func() {
+			stock := 0
+			description := ""
+			product, err := repo.Patch(ctx, productID, "", domain.ProductPatch{Stock: &stock, Description: &description})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(product.Stock).To(Equal(0))
+			Expect(product.Description).To(BeEmpty())
+			Expect(product.Name).To(Equal("Original Product"))
+		})
+
+		It("should reject a stale version", func() {
+			price := testutil.THB("10.00")
+			product, err := repo.Patch(ctx, productID, "stale", domain.ProductPatch{Price: &price})
+
+			Expect(err).To(MatchError(domain.ErrPreconditionFailed))
+			Expect(product).To(BeNil())
+		})
+
+		It("should return error when product not found", func() {
+			price := testutil.THB("10.00")
+			product, err := repo.Patch(ctx, 99999, "", domain.ProductPatch{Price: &price})
+
+			Expect(err).To(MatchError(domain.ErrNotFound))
 			Expect(product).To(BeNil())
 		})
 	})
 
 	Describe("Delete", func() {
 		BeforeEach(func() {
-			product, err := repo.Create(ctx, "To Delete", "Will be deleted", 50.00, 5)
+			product, err := repo.Create(ctx, "To Delete", "Will be deleted", testutil.THB("50.00"), 5)
 			Expect(err).ToNot(HaveOccurred())
 			productID, _ = strconv.Atoi(product.ID)
 		})
 
 		It("should delete product successfully", func() {
-			err := repo.Delete(ctx, productID)
+			err := repo.Delete(ctx, productID, "")
 
 			Expect(err).ToNot(HaveOccurred())
 
@@ -189,10 +427,165 @@ var _ = Describe("ProductRepository", func() {
 			Expect(product).To(BeNil())
 		})
 
+		It("should delete when the version matches", func() {
+			current, err := repo.GetByID(ctx, productID)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(repo.Delete(ctx, productID, current.Version())).To(Succeed())
+		})
+
+		It("should keep the product when the version is stale", func() {
+			err := repo.Delete(ctx, productID, "stale")
+
+			Expect(err).To(MatchError(domain.ErrPreconditionFailed))
+
+			_, err = repo.GetByID(ctx, productID)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
 		It("should return error when product not found", func() {
-			err := repo.Delete(ctx, 99999)
+			err := repo.Delete(ctx, 99999, "")
 
-			Expect(err).To(HaveOccurred())
+			Expect(err).To(MatchError(domain.ErrNotFound))
+		})
+
+		It("should keep a soft-deleted product for reads that include deleted products", func() {
+			Expect(repo.Delete(ctx, productID, "")).To(Succeed())
+
+			product, err := repo.GetByID(domain.WithDeleted(ctx), productID)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(product.DeletedAt).ToNot(BeNil())
+
+			page, err := repo.GetAll(ctx, domain.ListQuery{}, domain.PageRequest{Limit: 10})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(page.Items).To(BeEmpty())
+		})
+
+		It("should not patch a soft-deleted product", func() {
+			Expect(repo.Delete(ctx, productID, "")).To(Succeed())
+			stock := 1
+
+			_, err := repo.Patch(ctx, productID, "", domain.ProductPatch{Stock: &stock})
+
+			Expect(err).To(MatchError(domain.ErrNotFound))
+		})
+	})
+
+	Describe("Restore", func() {
+		BeforeEach(func() {
+			product, err := repo.Create(ctx, "To Restore", "Will be restored", testutil.THB("50.00"), 5)
+			Expect(err).ToNot(HaveOccurred())
+			productID, _ = strconv.Atoi(product.ID)
+		})
+
+		It("should bring back a soft-deleted product", func() {
+			Expect(repo.Delete(ctx, productID, "")).To(Succeed())
+
+			product, err := repo.Restore(ctx, productID)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(product.DeletedAt).To(BeNil())
+			_, err = repo.GetByID(ctx, productID)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should return error when product not found", func() {
+			_, err := repo.Restore(ctx, 99999)
+
+			Expect(err).To(MatchError(domain.ErrNotFound))
+		})
+	})
+
+	Describe("Purge", func() {
+		BeforeEach(func() {
+			product, err := repo.Create(ctx, "To Purge", "Will be purged", testutil.THB("50.00"), 5)
+			Expect(err).ToNot(HaveOccurred())
+			productID, _ = strconv.Atoi(product.ID)
+		})
+
+		It("should remove a soft-deleted product for good", func() {
+			Expect(repo.Delete(ctx, productID, "")).To(Succeed())
+
+			Expect(repo.Purge(ctx, productID)).To(Succeed())
+
+			_, err := repo.GetByID(domain.WithDeleted(ctx), productID)
+			Expect(err).To(MatchError(domain.ErrNotFound))
+		})
+
+		It("should return error when product not found", func() {
+			err := repo.Purge(ctx, 99999)
+
+			Expect(err).To(MatchError(domain.ErrNotFound))
+		})
+	})
+
+	Describe("ReserveStock", func() {
+		BeforeEach(func() {
+			product, err := repo.Create(ctx, "Limited", "Few left", testutil.THB("10.00"), 5)
+			Expect(err).ToNot(HaveOccurred())
+			productID, _ = strconv.Atoi(product.ID)
+		})
+
+		It("should take the quantity out of stock", func() {
+			Expect(repo.ReserveStock(ctx, productID, 3)).To(Succeed())
+
+			product, err := repo.GetByID(ctx, productID)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(product.Stock).To(Equal(2))
+		})
+
+		It("should take the last units in stock", func() {
+			Expect(repo.ReserveStock(ctx, productID, 5)).To(Succeed())
+
+			product, err := repo.GetByID(ctx, productID)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(product.Stock).To(BeZero())
+		})
+
+		It("should leave stock alone when it is short", func() {
+			err := repo.ReserveStock(ctx, productID, 6)
+
+			Expect(err).To(MatchError(domain.ErrInsufficientStock))
+
+			product, err := repo.GetByID(ctx, productID)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(product.Stock).To(Equal(5))
+		})
+
+		It("should return error when product not found", func() {
+			err := repo.ReserveStock(ctx, 99999, 1)
+
+			Expect(err).To(MatchError(domain.ErrNotFound))
+		})
+
+		It("should not reserve stock of a soft-deleted product", func() {
+			Expect(repo.Delete(ctx, productID, "")).To(Succeed())
+
+			err := repo.ReserveStock(ctx, productID, 1)
+
+			Expect(err).To(MatchError(domain.ErrNotFound))
+		})
+	})
+
+	Describe("ReleaseStock", func() {
+		BeforeEach(func() {
+			product, err := repo.Create(ctx, "Limited", "Few left", testutil.THB("10.00"), 5)
+			Expect(err).ToNot(HaveOccurred())
+			productID, _ = strconv.Atoi(product.ID)
+		})
+
+		It("should return the quantity to stock", func() {
+			Expect(repo.ReleaseStock(ctx, productID, 2)).To(Succeed())
+
+			product, err := repo.GetByID(ctx, productID)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(product.Stock).To(Equal(7))
+		})
+
+		It("should return error when product not found", func() {
+			err := repo.ReleaseStock(ctx, 99999, 1)
+
+			Expect(err).To(MatchError(domain.ErrNotFound))
 		})
 	})
 })
@@ -0,0 +1,13 @@
+package raterepo_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestRateRepo(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "RateRepo Suite")
+}
@@ -0,0 +1,123 @@
+package raterepo
+
+import (
+	"context"
+	"fmt"
+
+	"gin-swagger-api/internal/domain"
+	portraterepo "gin-swagger-api/internal/port/repository/raterepo"
+	"gin-swagger-api/internal/repository/repoerr"
+	"gin-swagger-api/internal/repository/txmanager"
+
+	"github.com/snilli/ormprovider"
+	"github.com/snilli/ormprovider/ent"
+	"github.com/snilli/ormprovider/ent/exchangerate"
+)
+
+// Repository implements the exchange rate table in the database
+type Repository struct {
+	db *ormprovider.Client
+}
+
+// New creates a new exchange rate repository
+func New(db *ormprovider.Client) portraterepo.Repository {
+	return &Repository{db: db}
+}
+
+// GetAll returns the rates ordered by currency
+func (r *Repository) GetAll(ctx context.Context) ([]domain.ExchangeRate, error) {
+	entRates, err := r.client(ctx).ExchangeRate.Query().
+		Order(ent.Asc(exchangerate.FieldCurrency)).
+		All(ctx)
+	if err != nil {
+		return nil, repoerr.Translate(err, "exchange rate")
+	}
+
+	rates := make([]domain.ExchangeRate, len(entRates))
+	for i, entRate := range entRates {
+		rates[i] = toExchangeRate(entRate)
+	}
+	return rates, nil
+}
+
+// Get looks up the rate of a currency
+func (r *Repository) Get(ctx context.Context, currency string) (*domain.ExchangeRate, error) {
+	entRate, err := r.client(ctx).ExchangeRate.Query().
+		Where(exchangerate.Currency(currency)).
+		Only(ctx)
+	if err != nil {
+		return nil, repoerr.Translate(err, "exchange rate")
+	}
+
+	rate := toExchangeRate(entRate)
+	return &rate, nil
+}
+
+// Set replaces the rate of its currency, or adds it when the currency has
+// none. A rate added concurrently for the same currency is replaced.
+func (r *Repository) Set(ctx context.Context, rate domain.ExchangeRate) (*domain.ExchangeRate, error) {
+	replaced, err := r.replace(ctx, rate)
+	if err != nil {
+		return nil, err
+	}
+	if replaced {
+		return &rate, nil
+	}
+
+	err = r.client(ctx).ExchangeRate.Create().
+		SetCurrency(rate.Currency).
+		SetRate(string(rate.Rate)).
+		SetUpdatedAt(rate.UpdatedAt).
+		Exec(ctx)
+	if ent.IsConstraintError(err) {
+		if replaced, err = r.replace(ctx, rate); err == nil && !replaced {
+			err = fmt.Errorf("exchange rate %w: retry the request", domain.ErrConflict)
+		}
+	}
+	if err != nil {
+		return nil, repoerr.Translate(err, "exchange rate")
+	}
+	return &rate, nil
+}
+
+// replace updates the rate of its currency, reporting whether it had one
+func (r *Repository) replace(ctx context.Context, rate domain.ExchangeRate) (bool, error) {
+	updated, err := r.client(ctx).ExchangeRate.Update().
+		Where(exchangerate.Currency(rate.Currency)).
+		SetRate(string(rate.Rate)).
+		SetUpdatedAt(rate.UpdatedAt).
+		Save(ctx)
+	if err != nil {
+		return false, repoerr.Translate(err, "exchange rate")
+	}
+	return updated > 0, nil
+}
+
+// Delete removes the rate of a currency
+func (r *Repository) Delete(ctx context.Context, currency string) error {
+	deleted, err := r.client(ctx).ExchangeRate.Delete().
+		Where(exchangerate.Currency(currency)).
+		Exec(ctx)
+	if err != nil {
+		return repoerr.Translate(err, "exchange rate")
+	}
+	if deleted == 0 {
+		return fmt.Errorf("exchange rate %w", domain.ErrNotFound)
+	}
+	return nil
+}
+
+// client returns the ent client for ctx, bound to the transaction it
+// carries if any
+func (r *Repository) client(ctx context.Context) *ent.Client {
+	return txmanager.Client(ctx, r.db)
+}
+
+// toExchangeRate converts an ent exchange rate to domain.ExchangeRate
+func toExchangeRate(entRate *ent.ExchangeRate) domain.ExchangeRate {
+	return domain.ExchangeRate{
+		Currency:  entRate.Currency,
+		Rate:      domain.Rate(entRate.Rate),
+		UpdatedAt: entRate.UpdatedAt,
+	}
+}
@@ -0,0 +1,155 @@
+package raterepo_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"gin-swagger-api/internal/domain"
+	portraterepo "gin-swagger-api/internal/port/repository/raterepo"
+	"gin-swagger-api/internal/repository/raterepo"
+	"gin-swagger-api/internal/testutil"
+
+	"github.com/snilli/ormprovider"
+)
+
+var _ = Describe("RateRepository", func() {
+	var (
+		repo portraterepo.Repository
+		db   *ormprovider.Client
+		ctx  context.Context
+	)
+
+	updatedAt := time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		db = testutil.NewTestDBClient(GinkgoT())
+		repo = raterepo.New(db)
+
+		Expect(raterepo.Seed(ctx, db, "testdata/exchange_rates.json")).To(Succeed())
+	})
+
+	AfterEach(func() {
+		// Cleanup: close database connection
+		if db != nil {
+			_ = db.Close()
+		}
+	})
+
+	Describe("Seed", func() {
+		It("should leave a table that already has rates alone", func() {
+			_, err := repo.Set(ctx, domain.ExchangeRate{Currency: "USD", Rate: "0.028", UpdatedAt: updatedAt})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(raterepo.Seed(ctx, db, "testdata/exchange_rates.json")).To(Succeed())
+
+			Expect(repo.Get(ctx, "USD")).To(HaveField("Rate", domain.Rate("0.028")))
+			Expect(repo.GetAll(ctx)).To(HaveLen(2))
+		})
+
+		It("should seed nothing when the file does not exist", func() {
+			Expect(repo.Delete(ctx, "USD")).To(Succeed())
+			Expect(repo.Delete(ctx, "EUR")).To(Succeed())
+
+			Expect(raterepo.Seed(ctx, db, filepath.Join(GinkgoT().TempDir(), "missing.json"))).To(Succeed())
+
+			Expect(repo.GetAll(ctx)).To(BeEmpty())
+		})
+
+		DescribeTable("should reject a malformed file",
+			func(content, message string) {
+				path := filepath.Join(GinkgoT().TempDir(), "exchange_rates.json")
+				Expect(os.WriteFile(path, []byte(content), 0o644)).To(Succeed())
+
+				err := raterepo.Seed(ctx, db, path)
+
+				Expect(err).To(MatchError(ContainSubstring(message)))
+			},
+			Entry("invalid JSON", `{"rates": [`, "parse exchange rates"),
+			Entry("unsupported currency", `{"rates": [{"currency": "XYZ", "rate": "1"}]}`, `unsupported currency "XYZ"`),
+			Entry("base currency", `{"rates": [{"currency": "THB", "rate": "1"}]}`, `unsupported currency "THB"`),
+			Entry("duplicate currency", `{"rates": [{"currency": "USD", "rate": "1"}, {"currency": "usd", "rate": "2"}]}`, "USD is listed twice"),
+			Entry("malformed rate", `{"rates": [{"currency": "USD", "rate": "-1"}]}`, "rates[0]"),
+		)
+	})
+
+	Describe("GetAll", func() {
+		It("should return the rates ordered by currency", func() {
+			rates, err := repo.GetAll(ctx)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(rates).To(HaveLen(2))
+			Expect(rates[0].Currency).To(Equal("EUR"))
+			Expect(rates[0].Rate).To(Equal(domain.Rate("0.0255")))
+			Expect(rates[0].UpdatedAt).To(BeTemporally("==", updatedAt))
+			Expect(rates[1].Currency).To(Equal("USD"))
+		})
+	})
+
+	Describe("Get", func() {
+		It("should return the rate of a currency", func() {
+			rate, err := repo.Get(ctx, "USD")
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(rate.Currency).To(Equal("USD"))
+			Expect(rate.Rate).To(Equal(domain.Rate("0.0275")))
+			Expect(rate.UpdatedAt).To(BeTemporally("==", updatedAt))
+		})
+
+		It("should return ErrNotFound for a currency without a rate", func() {
+			rate, err := repo.Get(ctx, "JPY")
+
+			Expect(err).To(MatchError(domain.ErrNotFound))
+			Expect(rate).To(BeNil())
+		})
+	})
+
+	Describe("Set", func() {
+		It("should replace the rate of a currency", func() {
+			now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+			rate, err := repo.Set(ctx, domain.ExchangeRate{Currency: "USD", Rate: "0.028", UpdatedAt: now})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(rate.Rate).To(Equal(domain.Rate("0.028")))
+			stored, err := repo.Get(ctx, "USD")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(stored.Rate).To(Equal(domain.Rate("0.028")))
+			Expect(stored.UpdatedAt).To(BeTemporally("==", now))
+			Expect(repo.GetAll(ctx)).To(HaveLen(2))
+		})
+
+		It("should add the rate of a currency without one", func() {
+			Expect(repo.Delete(ctx, "EUR")).To(Succeed())
+
+			_, err := repo.Set(ctx, domain.ExchangeRate{Currency: "EUR", Rate: "0.025", UpdatedAt: updatedAt})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(repo.Get(ctx, "EUR")).To(HaveField("Rate", domain.Rate("0.025")))
+		})
+	})
+
+	Describe("Delete", func() {
+		It("should remove the rate of a currency", func() {
+			Expect(repo.Delete(ctx, "EUR")).To(Succeed())
+
+			_, err := repo.Get(ctx, "EUR")
+			Expect(err).To(MatchError(domain.ErrNotFound))
+		})
+
+		It("should return ErrNotFound for a currency without a rate", func() {
+			Expect(repo.Delete(ctx, "JPY")).To(MatchError(domain.ErrNotFound))
+		})
+
+		It("should return error when database connection fails", func() {
+			_ = db.Close()
+
+			Expect(repo.Delete(ctx, "EUR")).To(HaveOccurred())
+		})
+	})
+})
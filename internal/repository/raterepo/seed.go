@@ -0,0 +1,95 @@
+package raterepo
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"strings"
+	"time"
+
+	"gin-swagger-api/internal/domain"
+	"gin-swagger-api/internal/repository/repoerr"
+
+	"github.com/snilli/ormprovider"
+	"github.com/snilli/ormprovider/ent"
+)
+
+// document is the JSON layout of a seed file
+type document struct {
+	Rates []entry `json:"rates"`
+}
+
+// entry is a rate in a seed file
+type entry struct {
+	Currency  string    `json:"currency"`
+	Rate      string    `json:"rate"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// Seed fills an empty exchange rate table with the rates of the JSON file
+// at path. A table that already has rates is left alone, so rates changed
+// through the API survive restarts; a missing file seeds nothing.
+func Seed(ctx context.Context, db *ormprovider.Client, path string) error {
+	rates, err := load(path)
+	if err != nil || len(rates) == 0 {
+		return err
+	}
+
+	seeded, err := db.ExchangeRate.Query().Exist(ctx)
+	if err != nil {
+		return repoerr.Translate(err, "exchange rate")
+	}
+	if seeded {
+		return nil
+	}
+
+	builders := make([]*ent.ExchangeRateCreate, len(rates))
+	for i, rate := range rates {
+		builders[i] = db.ExchangeRate.Create().
+			SetCurrency(rate.Currency).
+			SetRate(string(rate.Rate)).
+			SetUpdatedAt(rate.UpdatedAt)
+	}
+	if err := db.ExchangeRate.CreateBulk(builders...).Exec(ctx); err != nil {
+		return repoerr.Translate(err, "exchange rate")
+	}
+	return nil
+}
+
+// load reads and checks a seed file
+func load(path string) ([]domain.ExchangeRate, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read exchange rates: %w", err)
+	}
+
+	var doc document
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("parse exchange rates %s: %w", path, err)
+	}
+
+	rates := make([]domain.ExchangeRate, 0, len(doc.Rates))
+	seen := make(map[string]bool, len(doc.Rates))
+	for i, e := range doc.Rates {
+		currency := strings.ToUpper(e.Currency)
+		if !domain.SupportedCurrency(currency) || currency == domain.BaseCurrency {
+			return nil, fmt.Errorf("exchange rates %s: rates[%d]: unsupported currency %q", path, i, e.Currency)
+		}
+		if seen[currency] {
+			return nil, fmt.Errorf("exchange rates %s: rates[%d]: %s is listed twice", path, i, currency)
+		}
+		seen[currency] = true
+		rate, err := domain.ParseRate(e.Rate)
+		if err != nil {
+			return nil, fmt.Errorf("exchange rates %s: rates[%d]: %w", path, i, err)
+		}
+		rates = append(rates, domain.ExchangeRate{Currency: currency, Rate: rate, UpdatedAt: e.UpdatedAt})
+	}
+	return rates, nil
+}
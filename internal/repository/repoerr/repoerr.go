@@ -0,0 +1,41 @@
+package repoerr
+
+import (
+	"fmt"
+
+	"github.com/snilli/ormprovider/ent"
+
+	"gin-swagger-api/internal/domain"
+)
+
+// Translate converts an ent error into a domain error for the given entity.
+// The original ent error is dropped so driver messages never reach callers.
+func Translate(err error, entity string) error {
+	switch {
+	case err == nil:
+		return nil
+	case ent.IsNotFound(err):
+		return fmt.Errorf("%s %w", entity, domain.ErrNotFound)
+	case ent.IsConstraintError(err):
+		return fmt.Errorf("%s %w", entity, domain.ErrConflict)
+	case ent.IsValidationError(err):
+		return fmt.Errorf("%s %w", entity, domain.ErrValidation)
+	default:
+		return err
+	}
+}
+
+// TranslateGuarded translates the error of a write guarded by a version
+// check. A guarded write that finds no row lost a race with a concurrent
+// write, so the version no longer matches.
+func TranslateGuarded(err error, entity string, guarded bool) error {
+	if guarded && ent.IsNotFound(err) {
+		return Stale(entity)
+	}
+	return Translate(err, entity)
+}
+
+// Stale reports that an entity is no longer at the expected version
+func Stale(entity string) error {
+	return fmt.Errorf("%s version %w", entity, domain.ErrPreconditionFailed)
+}
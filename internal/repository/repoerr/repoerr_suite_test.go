@@ -0,0 +1,13 @@
+package repoerr_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestRepoErr(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "RepoErr Suite")
+}
@@ -0,0 +1,62 @@
+package repoerr_test
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/snilli/ormprovider/ent"
+
+	"gin-swagger-api/internal/domain"
+	"gin-swagger-api/internal/repository/repoerr"
+)
+
+var _ = Describe("Translate", func() {
+	It("should return nil for nil error", func() {
+		Expect(repoerr.Translate(nil, "user")).To(Succeed())
+	})
+
+	It("should translate not found error", func() {
+		err := repoerr.Translate(&ent.NotFoundError{}, "user")
+
+		Expect(err).To(MatchError(domain.ErrNotFound))
+		Expect(err.Error()).To(Equal("user not found"))
+	})
+
+	It("should translate constraint error without leaking driver message", func() {
+		err := repoerr.Translate(&ent.ConstraintError{}, "user")
+
+		Expect(err).To(MatchError(domain.ErrConflict))
+		Expect(err.Error()).To(Equal("user conflicts with existing data"))
+	})
+
+	It("should translate validation error", func() {
+		err := repoerr.Translate(&ent.ValidationError{Name: "email"}, "user")
+
+		Expect(err).To(MatchError(domain.ErrValidation))
+	})
+
+	It("should pass through unknown errors", func() {
+		expectedError := errors.New("connection refused")
+
+		Expect(repoerr.Translate(expectedError, "user")).To(MatchError(expectedError))
+	})
+})
+
+var _ = Describe("TranslateGuarded", func() {
+	It("should report a guarded write that found no row as stale", func() {
+		err := repoerr.TranslateGuarded(&ent.NotFoundError{}, "user", true)
+
+		Expect(err).To(MatchError(domain.ErrPreconditionFailed))
+		Expect(err.Error()).To(Equal("user version precondition failed"))
+	})
+
+	It("should translate errors of unguarded writes", func() {
+		Expect(repoerr.TranslateGuarded(&ent.NotFoundError{}, "user", false)).To(MatchError(domain.ErrNotFound))
+	})
+
+	It("should translate other errors of guarded writes", func() {
+		Expect(repoerr.TranslateGuarded(&ent.ConstraintError{}, "user", true)).To(MatchError(domain.ErrConflict))
+	})
+})
@@ -0,0 +1,59 @@
+package reporting
+
+import (
+	"fmt"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+
+	"gin-swagger-api/internal/domain"
+)
+
+// Period returns the SQL expression that buckets a timestamp column into
+// the day, ISO week or month of a sales report dimension, formatted as
+// the first day of the period in UTC. Weeks start on Monday.
+func Period(s *sql.Selector, column, dimension string) (string, error) {
+	switch s.Dialect() {
+	case dialect.Postgres:
+		utc := column + " AT TIME ZONE 'UTC'"
+		switch dimension {
+		case domain.SalesByDay:
+			return fmt.Sprintf("to_char(%s, 'YYYY-MM-DD')", utc), nil
+		case domain.SalesByWeek:
+			return fmt.Sprintf("to_char(date_trunc('week', %s), 'YYYY-MM-DD')", utc), nil
+		case domain.SalesByMonth:
+			return fmt.Sprintf("to_char(%s, 'YYYY-MM')", utc), nil
+		}
+	case dialect.SQLite:
+		switch dimension {
+		case domain.SalesByDay:
+			return fmt.Sprintf("strftime('%%Y-%%m-%%d', %s)", column), nil
+		case domain.SalesByWeek:
+			// Move to the Sunday ending the week, then back to its Monday
+			return fmt.Sprintf("date(%s, 'weekday 0', '-6 days')", column), nil
+		case domain.SalesByMonth:
+			return fmt.Sprintf("strftime('%%Y-%%m', %s)", column), nil
+		}
+	default:
+		return "", fmt.Errorf("sales periods are not supported on %s", s.Dialect())
+	}
+	return "", fmt.Errorf("%w: %q is not a sales period", domain.ErrInvalidArgument, dimension)
+}
+
+// Text casts a column to text so that every dimension scans into a string
+// key
+func Text(column string) string {
+	return "CAST(" + column + " AS TEXT)"
+}
+
+// CountDistinct counts the distinct values of a column
+func CountDistinct(column string) string {
+	return "COUNT(DISTINCT " + column + ")"
+}
+
+// Sum returns the sum of a column, zero rather than NULL when no rows
+// match. Like the other helpers it takes a column already quoted for the
+// selector's dialect, such as the result of Selector.C.
+func Sum(column string) string {
+	return "COALESCE(SUM(" + column + "), 0)"
+}
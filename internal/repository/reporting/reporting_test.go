@@ -0,0 +1,73 @@
+package reporting_test
+
+import (
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"gin-swagger-api/internal/domain"
+	"gin-swagger-api/internal/repository/reporting"
+)
+
+var _ = Describe("Reporting", func() {
+	selector := func(name string) *sql.Selector {
+		return sql.Dialect(name).Select("*").From(sql.Table("orders"))
+	}
+
+	Describe("Period", func() {
+		DescribeTable("should bucket timestamps on PostgreSQL in UTC",
+			func(dimension, expected string) {
+				expr, err := reporting.Period(selector(dialect.Postgres), `"orders"."created_at"`, dimension)
+
+				Expect(err).ToNot(HaveOccurred())
+				Expect(expr).To(Equal(expected))
+			},
+			Entry("by day", domain.SalesByDay, `to_char("orders"."created_at" AT TIME ZONE 'UTC', 'YYYY-MM-DD')`),
+			Entry("by week", domain.SalesByWeek, `to_char(date_trunc('week', "orders"."created_at" AT TIME ZONE 'UTC'), 'YYYY-MM-DD')`),
+			Entry("by month", domain.SalesByMonth, `to_char("orders"."created_at" AT TIME ZONE 'UTC', 'YYYY-MM')`),
+		)
+
+		DescribeTable("should bucket timestamps on SQLite",
+			func(dimension, expected string) {
+				expr, err := reporting.Period(selector(dialect.SQLite), "`orders`.`created_at`", dimension)
+
+				Expect(err).ToNot(HaveOccurred())
+				Expect(expr).To(Equal(expected))
+			},
+			Entry("by day", domain.SalesByDay, "strftime('%Y-%m-%d', `orders`.`created_at`)"),
+			Entry("by week", domain.SalesByWeek, "date(`orders`.`created_at`, 'weekday 0', '-6 days')"),
+			Entry("by month", domain.SalesByMonth, "strftime('%Y-%m', `orders`.`created_at`)"),
+		)
+
+		It("should reject a dimension that is not a period", func() {
+			_, err := reporting.Period(selector(dialect.SQLite), "created_at", domain.SalesByProduct)
+
+			Expect(err).To(MatchError(domain.ErrInvalidArgument))
+		})
+
+		It("should reject a dialect without period support", func() {
+			_, err := reporting.Period(selector(dialect.MySQL), "created_at", domain.SalesByDay)
+
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("Sum", func() {
+		It("should default to zero", func() {
+			Expect(reporting.Sum("quantity")).To(Equal("COALESCE(SUM(quantity), 0)"))
+		})
+	})
+
+	Describe("CountDistinct", func() {
+		It("should count distinct values", func() {
+			Expect(reporting.CountDistinct("order_id")).To(Equal("COUNT(DISTINCT order_id)"))
+		})
+	})
+
+	Describe("Text", func() {
+		It("should cast the column to text", func() {
+			Expect(reporting.Text("product_id")).To(Equal("CAST(product_id AS TEXT)"))
+		})
+	})
+})
@@ -0,0 +1,13 @@
+package reportrepo_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestReportRepo(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "ReportRepo Suite")
+}
@@ -0,0 +1,122 @@
+package reportrepo
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+
+	"gin-swagger-api/internal/domain"
+	portreportrepo "gin-swagger-api/internal/port/repository/reportrepo"
+	"gin-swagger-api/internal/repository/repoerr"
+	"gin-swagger-api/internal/repository/reporting"
+	"gin-swagger-api/internal/repository/txmanager"
+
+	"github.com/snilli/ormprovider"
+	"github.com/snilli/ormprovider/ent"
+	"github.com/snilli/ormprovider/ent/order"
+	"github.com/snilli/ormprovider/ent/orderitem"
+)
+
+// Repository implements the report repository interface
+type Repository struct {
+	db *ormprovider.Client
+}
+
+// New creates a new report repository
+func New(db *ormprovider.Client) portreportrepo.Repository {
+	return &Repository{db: db}
+}
+
+// salesRow is a row of the sales aggregation as scanned from the database
+type salesRow struct {
+	Key     string `json:"key"`
+	Revenue int64  `json:"revenue"`
+	Orders  int    `json:"orders"`
+	Units   int    `json:"units"`
+}
+
+// Sales aggregates the order items of the orders placed in the query's
+// range. The items are joined to their orders so that one statement can
+// group by either side. Revenue sums the base currency amount each item
+// was given when its order was priced, which is already net of the
+// order's discount.
+func (r *Repository) Sales(ctx context.Context, query domain.SalesQuery) ([]domain.SalesRow, error) {
+	var (
+		rows   []salesRow
+		keyErr error
+	)
+	err := r.client(ctx).OrderItem.Query().
+		Modify(func(s *sql.Selector) {
+			orders := sql.Table(order.Table)
+			s.Join(orders).On(s.C(orderitem.FieldOrderID), orders.C(order.FieldID))
+			s.Where(sql.And(
+				sql.GTE(orders.C(order.FieldCreatedAt), query.From),
+				sql.LT(orders.C(order.FieldCreatedAt), query.To),
+				sql.NEQ(orders.C(order.FieldStatus), domain.OrderStatusCancelled),
+				sql.IsNull(orders.C(order.FieldDeletedAt)),
+			))
+
+			aggregates := []string{
+				sql.As(reporting.Sum(s.C(orderitem.FieldBaseTotal)), "revenue"),
+				sql.As(reporting.CountDistinct(s.C(orderitem.FieldOrderID)), "orders"),
+				sql.As(reporting.Sum(s.C(orderitem.FieldQuantity)), "units"),
+			}
+			if query.GroupBy == "" {
+				s.Select(aggregates...)
+				return
+			}
+
+			key, err := groupKey(s, orders, query.GroupBy)
+			if err != nil {
+				keyErr = err
+				return
+			}
+			s.Select(append([]string{sql.As(key, "key")}, aggregates...)...).GroupBy(key)
+			switch query.GroupBy {
+			case domain.SalesByDay, domain.SalesByWeek, domain.SalesByMonth:
+				s.OrderBy(key)
+			default:
+				s.OrderBy(sql.Desc("revenue"), key)
+			}
+		}).
+		Scan(ctx, &rows)
+	if keyErr != nil {
+		// Ent does not check the errors of a modified selector, so the
+		// ungrouped query ran and its result is discarded
+		return nil, keyErr
+	}
+	if err != nil {
+		return nil, repoerr.Translate(err, "sales report")
+	}
+
+	result := make([]domain.SalesRow, len(rows))
+	for i, row := range rows {
+		result[i] = domain.SalesRow{
+			Key:     row.Key,
+			Revenue: domain.Money{Amount: row.Revenue, Currency: domain.BaseCurrency},
+			Orders:  row.Orders,
+			Units:   row.Units,
+		}
+	}
+	return result, nil
+}
+
+// client returns the ent client for ctx, bound to the transaction it
+// carries if any
+func (r *Repository) client(ctx context.Context) *ent.Client {
+	return txmanager.Client(ctx, r.db)
+}
+
+// groupKey returns the SQL expression of the group key of a dimension
+func groupKey(s *sql.Selector, orders *sql.SelectTable, dimension string) (string, error) {
+	switch dimension {
+	case domain.SalesByProduct:
+		return reporting.Text(s.C(orderitem.FieldProductID)), nil
+	case domain.SalesByUser:
+		return reporting.Text(orders.C(order.FieldUserID)), nil
+	case domain.SalesByStatus:
+		return orders.C(order.FieldStatus), nil
+	default:
+		return reporting.Period(s, orders.C(order.FieldCreatedAt), dimension)
+	}
+}
@@ -0,0 +1,294 @@
+package reportrepo_test
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"gin-swagger-api/internal/domain"
+	portreportrepo "gin-swagger-api/internal/port/repository/reportrepo"
+	"gin-swagger-api/internal/repository/reportrepo"
+	"gin-swagger-api/internal/testutil"
+
+	"github.com/snilli/ormprovider"
+)
+
+var _ = Describe("ReportRepository", func() {
+	var (
+		repo         portreportrepo.Repository
+		db           *ormprovider.Client
+		ctx          context.Context
+		alice        int
+		bob          int
+		laptop       int
+		mouse        int
+		placeOrderAt func(rate domain.ExchangeRate, discount int64, userID int, status string, createdAt time.Time, items ...domain.OrderItem)
+		placeOrderIn func(rate domain.ExchangeRate, userID int, status string, createdAt time.Time, items ...domain.OrderItem)
+		placeOrder   func(userID int, status string, createdAt time.Time, items ...domain.OrderItem)
+	)
+
+	// Noon UTC on a day of January 2026; the 1st is a Thursday
+	day := func(d int) time.Time {
+		return time.Date(2026, time.January, d, 12, 0, 0, 0, time.UTC)
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		db = testutil.NewTestDBClient(GinkgoT())
+		repo = reportrepo.New(db)
+
+		user, err := db.User.Create().SetName("Alice").SetEmail("alice@example.com").Save(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		alice = user.ID
+		user, err = db.User.Create().SetName("Bob").SetEmail("bob@example.com").Save(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		bob = user.ID
+
+		product, err := db.Product.Create().SetName("Laptop").SetDescription("Test").SetPrice(100000).SetStock(100).Save(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		laptop = product.ID
+		product, err = db.Product.Create().SetName("Mouse").SetDescription("Test").SetPrice(2500).SetStock(100).Save(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		mouse = product.ID
+
+		// placeOrderAt stores an order priced like the order repository
+		// prices it, with its base currency amounts
+		placeOrderAt = func(rate domain.ExchangeRate, discount int64, userID int, status string, createdAt time.Time, items ...domain.OrderItem) {
+			placed := domain.Order{
+				Items:        items,
+				Currency:     rate.Currency,
+				ExchangeRate: rate.Rate,
+				Discount:     domain.Money{Amount: discount, Currency: rate.Currency},
+			}
+			var err error
+			placed.TotalPrice, err = placed.Total(items)
+			Expect(err).ToNot(HaveOccurred())
+			baseTotal, itemBaseTotals, err := placed.BaseTotals()
+			Expect(err).ToNot(HaveOccurred())
+
+			order, err := db.Order.Create().
+				SetUserID(userID).
+				SetTotalPrice(placed.TotalPrice.Amount).
+				SetBaseTotal(baseTotal.Amount).
+				SetCurrency(rate.Currency).
+				SetExchangeRate(string(rate.Rate)).
+				SetDiscount(discount).
+				SetStatus(status).
+				SetCreatedAt(createdAt).
+				Save(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			for i, item := range items {
+				_, err := db.OrderItem.Create().
+					SetOrderID(order.ID).
+					SetProductID(item.ProductID).
+					SetQuantity(item.Quantity).
+					SetUnitPrice(item.UnitPrice.Amount).
+					SetLineTotal(item.LineTotal.Amount).
+					SetBaseTotal(itemBaseTotals[i].Amount).
+					Save(ctx)
+				Expect(err).ToNot(HaveOccurred())
+			}
+		}
+		placeOrderIn = func(rate domain.ExchangeRate, userID int, status string, createdAt time.Time, items ...domain.OrderItem) {
+			placeOrderAt(rate, 0, userID, status, createdAt, items...)
+		}
+		placeOrder = func(userID int, status string, createdAt time.Time, items ...domain.OrderItem) {
+			placeOrderIn(domain.BaseRate(), userID, status, createdAt, items...)
+		}
+
+		placeOrder(alice, domain.OrderStatusPaid, day(1),
+			domain.NewOrderItem(laptop, 1, testutil.THB("1000.00")),
+			domain.NewOrderItem(mouse, 2, testutil.THB("25.00")),
+		)
+		placeOrder(alice, domain.OrderStatusPending, day(2), domain.NewOrderItem(mouse, 1, testutil.THB("25.00")))
+		placeOrder(bob, domain.OrderStatusShipped, day(6), domain.NewOrderItem(laptop, 2, testutil.THB("950.00")))
+		placeOrder(bob, domain.OrderStatusCancelled, day(6), domain.NewOrderItem(laptop, 5, testutil.THB("1000.00")))
+		placeOrder(bob, domain.OrderStatusPaid, time.Date(2026, time.February, 1, 12, 0, 0, 0, time.UTC), domain.NewOrderItem(mouse, 4, testutil.THB("25.00")))
+	})
+
+	AfterEach(func() {
+		// Cleanup: close database connection
+		if db != nil {
+			_ = db.Close()
+		}
+	})
+
+	january := func(groupBy string) domain.SalesQuery {
+		return domain.SalesQuery{From: time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC), To: time.Date(2026, time.February, 1, 0, 0, 0, 0, time.UTC), GroupBy: groupBy}
+	}
+
+	Describe("Sales", func() {
+		It("should total the orders in the range, leaving cancelled orders out", func() {
+			rows, err := repo.Sales(ctx, january(""))
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(rows).To(Equal([]domain.SalesRow{{Revenue: testutil.THB("2975.00"), Orders: 3, Units: 6}}))
+		})
+
+		It("should leave deleted orders out", func() {
+			order, err := db.Order.Create().
+				SetUserID(bob).
+				SetTotalPrice(100000).
+				SetBaseTotal(100000).
+				SetStatus(domain.OrderStatusPaid).
+				SetCreatedAt(day(7)).
+				SetDeletedAt(day(8)).
+				Save(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			_, err = db.OrderItem.Create().
+				SetOrderID(order.ID).
+				SetProductID(laptop).
+				SetQuantity(1).
+				SetUnitPrice(100000).
+				SetLineTotal(100000).
+				SetBaseTotal(100000).
+				Save(ctx)
+			Expect(err).ToNot(HaveOccurred())
+
+			rows, err := repo.Sales(ctx, january(""))
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(rows).To(Equal([]domain.SalesRow{{Revenue: testutil.THB("2975.00"), Orders: 3, Units: 6}}))
+		})
+
+		It("should convert revenue in other currencies back to the base currency", func() {
+			usd := domain.ExchangeRate{Currency: "USD", Rate: "0.0275"}
+			placeOrderIn(usd, alice, domain.OrderStatusPaid, day(9), domain.NewOrderItem(mouse, 1, domain.MustParseMoney("0.69", "USD")))
+
+			rows, err := repo.Sales(ctx, january(""))
+
+			Expect(err).ToNot(HaveOccurred())
+			// 0.69 USD at 0.0275 is 25.0909 THB, rounded to 25.09
+			Expect(rows).To(Equal([]domain.SalesRow{{Revenue: testutil.THB("3000.09"), Orders: 4, Units: 7}}))
+		})
+
+		Describe("with a discounted order", func() {
+			BeforeEach(func() {
+				// 10% off 1025.00
+				placeOrderAt(domain.BaseRate(), 10250, alice, domain.OrderStatusPaid, day(3),
+					domain.NewOrderItem(laptop, 1, testutil.THB("1000.00")),
+					domain.NewOrderItem(mouse, 1, testutil.THB("25.00")),
+				)
+			})
+
+			It("should total the revenue net of the discount", func() {
+				rows, err := repo.Sales(ctx, january(""))
+
+				Expect(err).ToNot(HaveOccurred())
+				Expect(rows).To(Equal([]domain.SalesRow{{Revenue: testutil.THB("3897.50"), Orders: 4, Units: 8}}))
+			})
+
+			It("should share the discount among the products in proportion to their line totals", func() {
+				rows, err := repo.Sales(ctx, january(domain.SalesByProduct))
+
+				Expect(err).ToNot(HaveOccurred())
+				Expect(rows).To(Equal([]domain.SalesRow{
+					{Key: strconv.Itoa(laptop), Revenue: testutil.THB("3800.00"), Orders: 3, Units: 4},
+					{Key: strconv.Itoa(mouse), Revenue: testutil.THB("97.50"), Orders: 3, Units: 4},
+				}))
+			})
+
+			It("should convert the discount of an order in another currency at its rate", func() {
+				usd := domain.ExchangeRate{Currency: "USD", Rate: "0.0275"}
+				placeOrderAt(usd, 38, bob, domain.OrderStatusPaid, day(9), domain.NewOrderItem(mouse, 2, domain.MustParseMoney("0.69", "USD")))
+
+				rows, err := repo.Sales(ctx, january(domain.SalesByUser))
+
+				Expect(err).ToNot(HaveOccurred())
+				// 1.00 USD at 0.0275 is 36.3636 THB, rounded to 36.36
+				Expect(rows).To(Equal([]domain.SalesRow{
+					{Key: strconv.Itoa(alice), Revenue: testutil.THB("1997.50"), Orders: 3, Units: 6},
+					{Key: strconv.Itoa(bob), Revenue: testutil.THB("1936.36"), Orders: 2, Units: 4},
+				}))
+			})
+		})
+
+		It("should report zeros when no orders are in the range", func() {
+			rows, err := repo.Sales(ctx, domain.SalesQuery{From: day(20), To: day(21)})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(rows).To(Equal([]domain.SalesRow{{Revenue: testutil.THB("0")}}))
+		})
+
+		It("should group by product, highest revenue first", func() {
+			rows, err := repo.Sales(ctx, january(domain.SalesByProduct))
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(rows).To(Equal([]domain.SalesRow{
+				{Key: strconv.Itoa(laptop), Revenue: testutil.THB("2900.00"), Orders: 2, Units: 3},
+				{Key: strconv.Itoa(mouse), Revenue: testutil.THB("75.00"), Orders: 2, Units: 3},
+			}))
+		})
+
+		It("should group by user", func() {
+			rows, err := repo.Sales(ctx, january(domain.SalesByUser))
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(rows).To(Equal([]domain.SalesRow{
+				{Key: strconv.Itoa(bob), Revenue: testutil.THB("1900.00"), Orders: 1, Units: 2},
+				{Key: strconv.Itoa(alice), Revenue: testutil.THB("1075.00"), Orders: 2, Units: 4},
+			}))
+		})
+
+		It("should group by status", func() {
+			rows, err := repo.Sales(ctx, january(domain.SalesByStatus))
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(rows).To(Equal([]domain.SalesRow{
+				{Key: domain.OrderStatusShipped, Revenue: testutil.THB("1900.00"), Orders: 1, Units: 2},
+				{Key: domain.OrderStatusPaid, Revenue: testutil.THB("1050.00"), Orders: 1, Units: 3},
+				{Key: domain.OrderStatusPending, Revenue: testutil.THB("25.00"), Orders: 1, Units: 1},
+			}))
+		})
+
+		It("should group by day in date order", func() {
+			rows, err := repo.Sales(ctx, january(domain.SalesByDay))
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(rows).To(Equal([]domain.SalesRow{
+				{Key: "2026-01-01", Revenue: testutil.THB("1050.00"), Orders: 1, Units: 3},
+				{Key: "2026-01-02", Revenue: testutil.THB("25.00"), Orders: 1, Units: 1},
+				{Key: "2026-01-06", Revenue: testutil.THB("1900.00"), Orders: 1, Units: 2},
+			}))
+		})
+
+		It("should group by week starting on Monday", func() {
+			rows, err := repo.Sales(ctx, january(domain.SalesByWeek))
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(rows).To(Equal([]domain.SalesRow{
+				{Key: "2025-12-29", Revenue: testutil.THB("1075.00"), Orders: 2, Units: 4},
+				{Key: "2026-01-05", Revenue: testutil.THB("1900.00"), Orders: 1, Units: 2},
+			}))
+		})
+
+		It("should group by month", func() {
+			rows, err := repo.Sales(ctx, domain.SalesQuery{From: day(1), To: day(1).AddDate(0, 2, 0), GroupBy: domain.SalesByMonth})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(rows).To(Equal([]domain.SalesRow{
+				{Key: "2026-01", Revenue: testutil.THB("2975.00"), Orders: 3, Units: 6},
+				{Key: "2026-02", Revenue: testutil.THB("100.00"), Orders: 1, Units: 4},
+			}))
+		})
+
+		It("should reject an unknown dimension", func() {
+			rows, err := repo.Sales(ctx, january("country"))
+
+			Expect(err).To(MatchError(domain.ErrInvalidArgument))
+			Expect(rows).To(BeNil())
+		})
+
+		It("should return error when database connection fails", func() {
+			_ = db.Close()
+
+			rows, err := repo.Sales(ctx, january(""))
+
+			Expect(err).To(HaveOccurred())
+			Expect(rows).To(BeNil())
+		})
+	})
+})
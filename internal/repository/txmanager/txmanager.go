@@ -0,0 +1,58 @@
+package txmanager
+
+import (
+	"context"
+	"errors"
+
+	porttxmanager "gin-swagger-api/internal/port/repository/txmanager"
+
+	"github.com/snilli/ormprovider"
+	"github.com/snilli/ormprovider/ent"
+)
+
+// Manager implements the transaction manager interface with ent
+// transactions carried in the context
+type Manager struct {
+	db *ormprovider.Client
+}
+
+// New creates a new transaction manager
+func New(db *ormprovider.Client) porttxmanager.Manager {
+	return &Manager{db: db}
+}
+
+// WithinTx runs fn in a transaction that is committed when fn returns nil
+// and rolled back when it returns an error or panics
+func (m *Manager) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if ent.TxFromContext(ctx) != nil {
+		return fn(ctx)
+	}
+
+	tx, err := m.db.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			_ = tx.Rollback()
+			panic(v)
+		}
+	}()
+
+	if err := fn(ent.NewTxContext(ctx, tx)); err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			return errors.Join(err, rerr)
+		}
+		return err
+	}
+	return tx.Commit()
+}
+
+// Client returns the ent client repositories use for ctx: the client of
+// the transaction started by WithinTx, or db outside one
+func Client(ctx context.Context, db *ormprovider.Client) *ent.Client {
+	if tx := ent.TxFromContext(ctx); tx != nil {
+		return tx.Client()
+	}
+	return db.Client
+}
@@ -0,0 +1,95 @@
+package txmanager_test
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	porttxmanager "gin-swagger-api/internal/port/repository/txmanager"
+	"gin-swagger-api/internal/repository/txmanager"
+	"gin-swagger-api/internal/testutil"
+
+	"github.com/snilli/ormprovider"
+)
+
+var _ = Describe("TxManager", func() {
+	var (
+		txManager porttxmanager.Manager
+		db        *ormprovider.Client
+		ctx       context.Context
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		db = testutil.NewTestDBClient(GinkgoT())
+		txManager = txmanager.New(db)
+	})
+
+	AfterEach(func() {
+		// Cleanup: close database connection
+		if db != nil {
+			_ = db.Close()
+		}
+	})
+
+	createUser := func(ctx context.Context, email string) error {
+		_, err := txmanager.Client(ctx, db).User.Create().SetName("Test User").SetEmail(email).Save(ctx)
+		return err
+	}
+
+	Describe("WithinTx", func() {
+		It("should commit the writes when fn succeeds", func() {
+			err := txManager.WithinTx(ctx, func(ctx context.Context) error {
+				return createUser(ctx, "a@example.com")
+			})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(db.User.Query().CountX(ctx)).To(Equal(1))
+		})
+
+		It("should roll the writes back when fn fails", func() {
+			expectedError := errors.New("failed")
+
+			err := txManager.WithinTx(ctx, func(ctx context.Context) error {
+				Expect(createUser(ctx, "a@example.com")).To(Succeed())
+				return expectedError
+			})
+
+			Expect(err).To(MatchError(expectedError))
+			Expect(db.User.Query().CountX(ctx)).To(BeZero())
+		})
+
+		It("should roll the writes back when fn panics", func() {
+			Expect(func() {
+				_ = txManager.WithinTx(ctx, func(ctx context.Context) error {
+					Expect(createUser(ctx, "a@example.com")).To(Succeed())
+					panic("boom")
+				})
+			}).To(PanicWith("boom"))
+
+			Expect(db.User.Query().CountX(ctx)).To(BeZero())
+		})
+
+		It("should join an outer transaction", func() {
+			expectedError := errors.New("failed")
+
+			err := txManager.WithinTx(ctx, func(ctx context.Context) error {
+				Expect(txManager.WithinTx(ctx, func(ctx context.Context) error {
+					return createUser(ctx, "a@example.com")
+				})).To(Succeed())
+				return expectedError
+			})
+
+			Expect(err).To(MatchError(expectedError))
+			Expect(db.User.Query().CountX(ctx)).To(BeZero())
+		})
+	})
+
+	Describe("Client", func() {
+		It("should return the database client outside a transaction", func() {
+			Expect(txmanager.Client(ctx, db)).To(BeIdenticalTo(db.Client))
+		})
+	})
+})
@@ -3,11 +3,19 @@ package userrepo
 import (
 	"context"
 	"strconv"
+	"time"
 
 	"gin-swagger-api/internal/domain"
 	portuserrepo "gin-swagger-api/internal/port/repository/userrepo"
+	"gin-swagger-api/internal/repository/filtering"
+	"gin-swagger-api/internal/repository/paging"
+	"gin-swagger-api/internal/repository/repoerr"
+	"gin-swagger-api/internal/repository/txmanager"
 
 	"github.com/snilli/ormprovider"
+	"github.com/snilli/ormprovider/ent"
+	"github.com/snilli/ormprovider/ent/predicate"
+	"github.com/snilli/ormprovider/ent/user"
 )
 
 // Repository implements the user repository interface
@@ -20,73 +28,223 @@ func New(db *ormprovider.Client) portuserrepo.Repository {
 	return &Repository{db: db}
 }
 
-// GetAll retrieves all users
-func (r *Repository) GetAll(ctx context.Context) ([]domain.User, error) {
-	entUsers, err := r.db.User.Query().All(ctx)
+// GetAll retrieves a page of users matching the list query
+func (r *Repository) GetAll(ctx context.Context, list domain.ListQuery, page domain.PageRequest) (*domain.Page[domain.User], error) {
+	window, err := paging.NewWindow(page, list.Sort)
 	if err != nil {
 		return nil, err
 	}
 
-	users := make([]domain.User, len(entUsers))
-	for i, entUser := range entUsers {
-		users[i] = domain.User{
-			ID:    strconv.Itoa(entUser.ID),
-			Name:  entUser.Name,
-			Email: entUser.Email,
-		}
+	query := r.client(ctx).User.Query().
+		Where(live(ctx)...).
+		Where(filtering.Predicates[predicate.User](list.Filters)...)
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, repoerr.Translate(err, "user")
+	}
+
+	switch {
+	case window.Descending():
+		query = query.Where(user.IDLT(window.Cursor.ID)).Order(ent.Desc(user.FieldID))
+	case window.HasCursor:
+		query = query.Where(user.IDGT(window.Cursor.ID)).Order(ent.Asc(user.FieldID))
+	default:
+		query = query.Order(filtering.Orders[user.OrderOption](list.Sort, user.FieldID)...).Offset(window.Offset)
+	}
+
+	entUsers, err := query.Limit(window.Fetch()).All(ctx)
+	if err != nil {
+		return nil, repoerr.Translate(err, "user")
 	}
-	return users, nil
+
+	return paging.NewPage(window, entUsers, total, userID, toUser), nil
+}
+
+// Stream passes the users matching the list filters to fn in batches of
+// batchSize, in ID order
+func (r *Repository) Stream(ctx context.Context, list domain.ListQuery, batchSize int, fn func([]domain.User) error) error {
+	query := r.client(ctx).User.Query().
+		Where(live(ctx)...).
+		Where(filtering.Predicates[predicate.User](list.Filters)...)
+
+	fetch := func(afterID, limit int) ([]*ent.User, error) {
+		rows, err := query.Clone().
+			Where(user.IDGT(afterID)).
+			Order(ent.Asc(user.FieldID)).
+			Limit(limit).
+			All(ctx)
+		return rows, repoerr.Translate(err, "user")
+	}
+	return paging.Batches(batchSize, fetch, userID, toUser, fn)
 }
 
 // GetByID retrieves a user by ID
 func (r *Repository) GetByID(ctx context.Context, id int) (*domain.User, error) {
-	entUser, err := r.db.User.Get(ctx, id)
+	entUser, err := r.get(ctx, id)
 	if err != nil {
 		return nil, err
 	}
 
-	return &domain.User{
-		ID:    strconv.Itoa(entUser.ID),
-		Name:  entUser.Name,
-		Email: entUser.Email,
-	}, nil
+	u := toUser(entUser)
+	return &u, nil
 }
 
 // Create creates a new user
 func (r *Repository) Create(ctx context.Context, name, email string) (*domain.User, error) {
-	entUser, err := r.db.User.Create().
+	entUser, err := r.client(ctx).User.Create().
 		SetName(name).
 		SetEmail(email).
 		Save(ctx)
 	if err != nil {
-		return nil, err
+		return nil, repoerr.Translate(err, "user")
 	}
 
-	return &domain.User{
-		ID:    strconv.Itoa(entUser.ID),
-		Name:  entUser.Name,
-		Email: entUser.Email,
-	}, nil
+	u := toUser(entUser)
+	return &u, nil
 }
 
-// Update updates a user
-func (r *Repository) Update(ctx context.Context, id int, name, email string) (*domain.User, error) {
-	entUser, err := r.db.User.UpdateOneID(id).
+// CreateBulk creates users in one statement
+func (r *Repository) CreateBulk(ctx context.Context, users []domain.User) ([]domain.User, error) {
+	client := r.client(ctx)
+	builders := make([]*ent.UserCreate, len(users))
+	for i, u := range users {
+		builders[i] = client.User.Create().
+			SetName(u.Name).
+			SetEmail(u.Email)
+	}
+
+	entUsers, err := client.User.CreateBulk(builders...).Save(ctx)
+	if err != nil {
+		return nil, repoerr.Translate(err, "user")
+	}
+
+	created := make([]domain.User, len(entUsers))
+	for i, entUser := range entUsers {
+		created[i] = toUser(entUser)
+	}
+	return created, nil
+}
+
+// Update updates a user. A non-empty version must match the current
+// version of the user.
+func (r *Repository) Update(ctx context.Context, id int, version, name, email string) (*domain.User, error) {
+	guard, err := r.guard(ctx, id, version)
+	if err != nil {
+		return nil, err
+	}
+
+	entUser, err := r.client(ctx).User.UpdateOneID(id).
+		Where(user.DeletedAtIsNil()).
+		Where(guard...).
 		SetName(name).
 		SetEmail(email).
 		Save(ctx)
+	if err != nil {
+		return nil, repoerr.TranslateGuarded(err, "user", guard != nil)
+	}
+
+	u := toUser(entUser)
+	return &u, nil
+}
+
+// Delete soft-deletes a user by setting its deleted_at. A non-empty
+// version must match the current version of the user.
+func (r *Repository) Delete(ctx context.Context, id int, version string) error {
+	guard, err := r.guard(ctx, id, version)
+	if err != nil {
+		return err
+	}
+
+	err = r.client(ctx).User.UpdateOneID(id).
+		Where(user.DeletedAtIsNil()).
+		Where(guard...).
+		SetDeletedAt(time.Now()).
+		Exec(ctx)
+	return repoerr.TranslateGuarded(err, "user", guard != nil)
+}
+
+// Restore clears the deleted_at of a user. Restoring a user that is not
+// deleted leaves it as it is.
+func (r *Repository) Restore(ctx context.Context, id int) (*domain.User, error) {
+	entUser, err := r.client(ctx).User.UpdateOneID(id).
+		ClearDeletedAt().
+		Save(ctx)
+	if err != nil {
+		return nil, repoerr.Translate(err, "user")
+	}
+
+	u := toUser(entUser)
+	return &u, nil
+}
+
+// Purge deletes a user for good, whether or not it is soft-deleted
+func (r *Repository) Purge(ctx context.Context, id int) error {
+	err := r.client(ctx).User.DeleteOneID(id).Exec(ctx)
+	return repoerr.Translate(err, "user")
+}
+
+// guard returns predicates that match the user only while it is still at
+// version, so a write using them fails if a concurrent write got in first.
+// An empty version needs no guard.
+func (r *Repository) guard(ctx context.Context, id int, version string) ([]predicate.User, error) {
+	if version == "" {
+		return nil, nil
+	}
+
+	entUser, err := r.get(ctx, id)
 	if err != nil {
 		return nil, err
 	}
+	if toUser(entUser).Version() != version {
+		return nil, repoerr.Stale("user")
+	}
 
-	return &domain.User{
-		ID:    strconv.Itoa(entUser.ID),
-		Name:  entUser.Name,
-		Email: entUser.Email,
+	return []predicate.User{
+		user.Name(entUser.Name),
+		user.Email(entUser.Email),
 	}, nil
 }
 
-// Delete deletes a user
-func (r *Repository) Delete(ctx context.Context, id int) error {
-	return r.db.User.DeleteOneID(id).Exec(ctx)
+// get loads a user, unless it is soft-deleted and ctx does not include
+// deleted users
+func (r *Repository) get(ctx context.Context, id int) (*ent.User, error) {
+	entUser, err := r.client(ctx).User.Query().
+		Where(user.ID(id)).
+		Where(live(ctx)...).
+		Only(ctx)
+	if err != nil {
+		return nil, repoerr.Translate(err, "user")
+	}
+	return entUser, nil
+}
+
+// client returns the ent client for ctx, bound to the transaction it
+// carries if any
+func (r *Repository) client(ctx context.Context) *ent.Client {
+	return txmanager.Client(ctx, r.db)
+}
+
+// live returns the predicates that leave soft-deleted users out of a
+// query, unless ctx comes from domain.WithDeleted
+func live(ctx context.Context) []predicate.User {
+	if domain.IncludesDeleted(ctx) {
+		return nil
+	}
+	return []predicate.User{user.DeletedAtIsNil()}
+}
+
+// userID returns the ID of an ent user
+func userID(entUser *ent.User) int {
+	return entUser.ID
+}
+
+// toUser converts an ent user to domain.User
+func toUser(entUser *ent.User) domain.User {
+	return domain.User{
+		ID:        strconv.Itoa(entUser.ID),
+		Name:      entUser.Name,
+		Email:     entUser.Email,
+		DeletedAt: entUser.DeletedAt,
+	}
 }
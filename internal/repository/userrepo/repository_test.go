@@ -2,6 +2,8 @@ package userrepo_test
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"strconv"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -9,6 +11,7 @@ import (
 
 	"gin-swagger-api/internal/domain"
 	portuserrepo "gin-swagger-api/internal/port/repository/userrepo"
+	"gin-swagger-api/internal/repository/txmanager"
 	"gin-swagger-api/internal/repository/userrepo"
 	"gin-swagger-api/internal/testutil"
 
@@ -57,6 +60,47 @@ var _ = Describe("UserRepository", func() {
 		})
 	})
 
+	Describe("CreateBulk", func() {
+		It("should create the users in order with their ids", func() {
+			users, err := repo.CreateBulk(ctx, []domain.User{
+				{Name: "John Doe", Email: "john@example.com"},
+				{Name: "Jane Doe", Email: "jane@example.com"},
+			})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(users).To(HaveLen(2))
+			Expect(users[0]).To(Equal(domain.User{ID: users[0].ID, Name: "John Doe", Email: "john@example.com"}))
+			Expect(users[1].Email).To(Equal("jane@example.com"))
+			Expect(users[1].ID).ToNot(Equal(users[0].ID))
+		})
+
+		It("should create none of the users when one email already exists", func() {
+			_, err := repo.Create(ctx, "John Doe", "john@example.com")
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = repo.CreateBulk(ctx, []domain.User{
+				{Name: "Jane Doe", Email: "jane@example.com"},
+				{Name: "Johnny Doe", Email: "john@example.com"},
+			})
+
+			Expect(err).To(HaveOccurred())
+			Expect(db.User.Query().CountX(ctx)).To(Equal(1))
+		})
+
+		It("should write in the transaction carried by the context", func() {
+			rollback := errors.New("rollback")
+
+			err := txmanager.New(db).WithinTx(ctx, func(ctx context.Context) error {
+				_, err := repo.CreateBulk(ctx, []domain.User{{Name: "John Doe", Email: "john@example.com"}})
+				Expect(err).ToNot(HaveOccurred())
+				return rollback
+			})
+
+			Expect(err).To(MatchError(rollback))
+			Expect(db.User.Query().CountX(ctx)).To(BeZero())
+		})
+	})
+
 	Describe("GetByID", func() {
 		var createdUser *domain.User
 
@@ -77,18 +121,18 @@ var _ = Describe("UserRepository", func() {
 		It("should return error when user not found", func() {
 			user, err := repo.GetByID(ctx, 99999)
 
-			Expect(err).To(HaveOccurred())
+			Expect(err).To(MatchError(domain.ErrNotFound))
 			Expect(user).To(BeNil())
 		})
 	})
 
 	Describe("GetAll", func() {
 		It("should return empty list when no users exist", func() {
-			users, err := repo.GetAll(ctx)
+			page, err := repo.GetAll(ctx, domain.ListQuery{}, domain.PageRequest{Limit: 10})
 
 			Expect(err).ToNot(HaveOccurred())
-			Expect(users).ToNot(BeNil())
-			Expect(len(users)).To(Equal(0))
+			Expect(page.Items).ToNot(BeNil())
+			Expect(len(page.Items)).To(Equal(0))
 		})
 
 		It("should return all users with correct data", func() {
@@ -97,15 +141,15 @@ var _ = Describe("UserRepository", func() {
 			user2, err := repo.Create(ctx, "User 2", "user2@example.com")
 			Expect(err).ToNot(HaveOccurred())
 
-			users, err := repo.GetAll(ctx)
+			page, err := repo.GetAll(ctx, domain.ListQuery{}, domain.PageRequest{Limit: 10})
 
 			Expect(err).ToNot(HaveOccurred())
-			Expect(len(users)).To(Equal(2))
+			Expect(len(page.Items)).To(Equal(2))
 
 			// Verify all fields are correctly mapped in loop
 			foundUser1 := false
 			foundUser2 := false
-			for _, u := range users {
+			for _, u := range page.Items {
 				if u.ID == user1.ID && u.Name == "User 1" && u.Email == "user1@example.com" {
 					foundUser1 = true
 				}
@@ -121,21 +165,147 @@ var _ = Describe("UserRepository", func() {
 			user, err := repo.Create(ctx, "Single User", "single@example.com")
 			Expect(err).ToNot(HaveOccurred())
 
-			users, err := repo.GetAll(ctx)
+			page, err := repo.GetAll(ctx, domain.ListQuery{}, domain.PageRequest{Limit: 10})
 
 			Expect(err).ToNot(HaveOccurred())
-			Expect(len(users)).To(Equal(1))
-			Expect(users[0]).To(Equal(*user))
+			Expect(len(page.Items)).To(Equal(1))
+			Expect(page.Items[0]).To(Equal(*user))
+		})
+
+		Context("with filters and sort", func() {
+			BeforeEach(func() {
+				_, err := repo.Create(ctx, "Alice", "alice@example.com")
+				Expect(err).ToNot(HaveOccurred())
+				_, err = repo.Create(ctx, "Bob", "bob@example.org")
+				Expect(err).ToNot(HaveOccurred())
+				_, err = repo.Create(ctx, "Carol", "carol@example.com")
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("should return matching users in the requested order", func() {
+				page, err := repo.GetAll(ctx, domain.ListQuery{
+					Filters: []domain.Filter{{Field: "email", Op: domain.OpLike, Values: []any{"example.com"}}},
+					Sort:    []domain.Sort{{Field: "name", Desc: true}},
+				}, domain.PageRequest{Limit: 10})
+
+				Expect(err).ToNot(HaveOccurred())
+				Expect(page.Total).To(Equal(2))
+				Expect(page.Items).To(HaveLen(2))
+				Expect(page.Items[0].Name).To(Equal("Carol"))
+				Expect(page.Items[1].Name).To(Equal("Alice"))
+			})
+
+			It("should exclude users with ne", func() {
+				page, err := repo.GetAll(ctx, domain.ListQuery{
+					Filters: []domain.Filter{{Field: "name", Op: domain.OpNe, Values: []any{"Bob"}}},
+				}, domain.PageRequest{Limit: 10})
+
+				Expect(err).ToNot(HaveOccurred())
+				Expect(page.Total).To(Equal(2))
+			})
+		})
+
+		Context("with more rows than the page size", func() {
+			BeforeEach(func() {
+				for i := 1; i <= 5; i++ {
+					_, err := repo.Create(ctx, fmt.Sprintf("User %d", i), fmt.Sprintf("user%d@example.com", i))
+					Expect(err).ToNot(HaveOccurred())
+				}
+			})
+
+			It("should return the requested offset window with the total", func() {
+				page, err := repo.GetAll(ctx, domain.ListQuery{}, domain.PageRequest{Limit: 2, Offset: 2})
+
+				Expect(err).ToNot(HaveOccurred())
+				Expect(page.Items).To(HaveLen(2))
+				Expect(page.Total).To(Equal(5))
+				Expect(page.Offset).To(Equal(2))
+				Expect(page.NextCursor).ToNot(BeEmpty())
+				Expect(page.PrevCursor).ToNot(BeEmpty())
+			})
+
+			It("should walk forwards and backwards with cursors", func() {
+				first, err := repo.GetAll(ctx, domain.ListQuery{}, domain.PageRequest{Limit: 2})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(first.PrevCursor).To(BeEmpty())
+
+				second, err := repo.GetAll(ctx, domain.ListQuery{}, domain.PageRequest{Limit: 2, Cursor: first.NextCursor})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(second.Items).To(HaveLen(2))
+				Expect(second.Items[0].ID).ToNot(Equal(first.Items[1].ID))
+
+				back, err := repo.GetAll(ctx, domain.ListQuery{}, domain.PageRequest{Limit: 2, Cursor: second.PrevCursor})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(back.Items).To(Equal(first.Items))
+				Expect(back.PrevCursor).To(BeEmpty())
+			})
+
+			It("should reject a malformed cursor", func() {
+				page, err := repo.GetAll(ctx, domain.ListQuery{}, domain.PageRequest{Limit: 2, Cursor: "!!!"})
+
+				Expect(err).To(MatchError(domain.ErrInvalidArgument))
+				Expect(page).To(BeNil())
+			})
 		})
 
 		It("should return error when database connection fails", func() {
 			// Close database to trigger error
 			_ = db.Close()
 
-			users, err := repo.GetAll(ctx)
+			page, err := repo.GetAll(ctx, domain.ListQuery{}, domain.PageRequest{Limit: 10})
 
 			Expect(err).To(HaveOccurred())
-			Expect(users).To(BeNil())
+			Expect(page).To(BeNil())
+		})
+	})
+
+	Describe("Stream", func() {
+		BeforeEach(func() {
+			for i := 1; i <= 5; i++ {
+				_, err := repo.Create(ctx, fmt.Sprintf("User %d", i), fmt.Sprintf("user%d@example.com", i))
+				Expect(err).ToNot(HaveOccurred())
+			}
+		})
+
+		It("should pass every user in id order in batches", func() {
+			var batches [][]domain.User
+			err := repo.Stream(ctx, domain.ListQuery{}, 2, func(users []domain.User) error {
+				batches = append(batches, users)
+				return nil
+			})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(batches).To(HaveLen(3))
+			Expect(batches[0]).To(HaveLen(2))
+			Expect(batches[2]).To(HaveLen(1))
+			Expect(batches[0][0].Name).To(Equal("User 1"))
+			Expect(batches[2][0].Name).To(Equal("User 5"))
+		})
+
+		It("should only pass matching users", func() {
+			var names []string
+			err := repo.Stream(ctx, domain.ListQuery{
+				Filters: []domain.Filter{{Field: "name", Op: domain.OpNe, Values: []any{"User 3"}}},
+			}, 2, func(users []domain.User) error {
+				for _, u := range users {
+					names = append(names, u.Name)
+				}
+				return nil
+			})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(names).To(Equal([]string{"User 1", "User 2", "User 4", "User 5"}))
+		})
+
+		It("should stop at the first error of fn", func() {
+			calls := 0
+			err := repo.Stream(ctx, domain.ListQuery{}, 2, func([]domain.User) error {
+				calls++
+				return errors.New("write failed")
+			})
+
+			Expect(err).To(MatchError("write failed"))
+			Expect(calls).To(Equal(1))
 		})
 	})
 
@@ -147,7 +317,7 @@ var _ = Describe("UserRepository", func() {
 		})
 
 		It("should update user successfully", func() {
-			user, err := repo.Update(ctx, userID, "Updated Name", "updated@example.com")
+			user, err := repo.Update(ctx, userID, "", "Updated Name", "updated@example.com")
 
 			Expect(err).ToNot(HaveOccurred())
 			Expect(*user).To(Equal(domain.User{
@@ -157,10 +327,22 @@ var _ = Describe("UserRepository", func() {
 			}))
 		})
 
+		It("should reject a second write based on the same version", func() {
+			current, err := repo.GetByID(ctx, userID)
+			Expect(err).ToNot(HaveOccurred())
+			_, err = repo.Update(ctx, userID, current.Version(), "First Writer", "original@example.com")
+			Expect(err).ToNot(HaveOccurred())
+
+			user, err := repo.Update(ctx, userID, current.Version(), "Second Writer", "original@example.com")
+
+			Expect(err).To(MatchError(domain.ErrPreconditionFailed))
+			Expect(user).To(BeNil())
+		})
+
 		It("should return error when user not found", func() {
-			user, err := repo.Update(ctx, 99999, "Name", "email@example.com")
+			user, err := repo.Update(ctx, 99999, "", "Name", "email@example.com")
 
-			Expect(err).To(HaveOccurred())
+			Expect(err).To(MatchError(domain.ErrNotFound))
 			Expect(user).To(BeNil())
 		})
 	})
@@ -173,7 +355,7 @@ var _ = Describe("UserRepository", func() {
 		})
 
 		It("should delete user successfully", func() {
-			err := repo.Delete(ctx, userID)
+			err := repo.Delete(ctx, userID, "")
 
 			Expect(err).ToNot(HaveOccurred())
 
@@ -183,10 +365,107 @@ var _ = Describe("UserRepository", func() {
 			Expect(user).To(BeNil())
 		})
 
+		It("should keep the user when the version is stale", func() {
+			err := repo.Delete(ctx, userID, "stale")
+
+			Expect(err).To(MatchError(domain.ErrPreconditionFailed))
+
+			_, err = repo.GetByID(ctx, userID)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
 		It("should return error when user not found", func() {
-			err := repo.Delete(ctx, 99999)
+			err := repo.Delete(ctx, 99999, "")
 
-			Expect(err).To(HaveOccurred())
+			Expect(err).To(MatchError(domain.ErrNotFound))
+		})
+
+		It("should keep a soft-deleted user for reads that include deleted users", func() {
+			Expect(repo.Delete(ctx, userID, "")).To(Succeed())
+
+			user, err := repo.GetByID(domain.WithDeleted(ctx), userID)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(user.DeletedAt).ToNot(BeNil())
+
+			page, err := repo.GetAll(ctx, domain.ListQuery{}, domain.PageRequest{Limit: 10})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(page.Items).To(BeEmpty())
+
+			page, err = repo.GetAll(domain.WithDeleted(ctx), domain.ListQuery{}, domain.PageRequest{Limit: 10})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(page.Items).To(HaveLen(1))
+		})
+
+		It("should not update a soft-deleted user", func() {
+			Expect(repo.Delete(ctx, userID, "")).To(Succeed())
+
+			_, err := repo.Update(ctx, userID, "", "Updated", "delete@example.com")
+
+			Expect(err).To(MatchError(domain.ErrNotFound))
+		})
+
+		It("should not delete a user twice", func() {
+			Expect(repo.Delete(ctx, userID, "")).To(Succeed())
+
+			err := repo.Delete(ctx, userID, "")
+
+			Expect(err).To(MatchError(domain.ErrNotFound))
+		})
+	})
+
+	Describe("Restore", func() {
+		BeforeEach(func() {
+			user, err := repo.Create(ctx, "To Restore", "restore@example.com")
+			Expect(err).ToNot(HaveOccurred())
+			userID, _ = strconv.Atoi(user.ID)
+		})
+
+		It("should bring back a soft-deleted user", func() {
+			Expect(repo.Delete(ctx, userID, "")).To(Succeed())
+
+			user, err := repo.Restore(ctx, userID)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(user.DeletedAt).To(BeNil())
+			_, err = repo.GetByID(ctx, userID)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should leave a user that is not deleted as it is", func() {
+			user, err := repo.Restore(ctx, userID)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(user.Name).To(Equal("To Restore"))
+		})
+
+		It("should return error when user not found", func() {
+			_, err := repo.Restore(ctx, 99999)
+
+			Expect(err).To(MatchError(domain.ErrNotFound))
+		})
+	})
+
+	Describe("Purge", func() {
+		BeforeEach(func() {
+			user, err := repo.Create(ctx, "To Purge", "purge@example.com")
+			Expect(err).ToNot(HaveOccurred())
+			userID, _ = strconv.Atoi(user.ID)
+		})
+
+		It("should remove a soft-deleted user for good", func() {
+			Expect(repo.Delete(ctx, userID, "")).To(Succeed())
+
+			err := repo.Purge(ctx, userID)
+
+			Expect(err).ToNot(HaveOccurred())
+			_, err = repo.GetByID(domain.WithDeleted(ctx), userID)
+			Expect(err).To(MatchError(domain.ErrNotFound))
+		})
+
+		It("should return error when user not found", func() {
+			err := repo.Purge(ctx, 99999)
+
+			Expect(err).To(MatchError(domain.ErrNotFound))
 		})
 	})
 })
@@ -0,0 +1,144 @@
+package batch
+
+import (
+	"context"
+	"errors"
+
+	"gin-swagger-api/internal/domain"
+	"gin-swagger-api/internal/port/repository/txmanager"
+)
+
+// errRollback rolls back an all-or-nothing batch once an item has failed
+var errRollback = errors.New("batch item failed")
+
+// errBulkFailed reports that the bulk create of an all-or-nothing batch
+// failed, so the batch is retried one item at a time to find the cause
+var errBulkFailed = errors.New("bulk create failed")
+
+// Writes are the writes a batch makes on one resource
+type Writes[T any] struct {
+	// CreateBulk creates several resources in one statement. When it is
+	// nil, or fails, resources are created one at a time.
+	CreateBulk func(ctx context.Context, values []T) ([]T, error)
+	Create     func(ctx context.Context, value T) (*T, error)
+	Update     func(ctx context.Context, version string, value T) (*T, error)
+	Delete     func(ctx context.Context, ref domain.BatchRef) error
+}
+
+// Run applies the items of b with writes and reports the outcome of each.
+//
+// An all-or-nothing batch runs in one transaction and stops at the first
+// failing item. The transaction is then rolled back, and every other item
+// is reported as domain.ErrBatchAborted. A best-effort batch applies each
+// item on its own and reports the items that failed.
+//
+// Items failing is not an error of Run. It only returns errors outside of
+// any item, such as a failed commit.
+func Run[T any](ctx context.Context, tx txmanager.Manager, b domain.Batch[T], writes Writes[T]) (*domain.BatchResults[T], error) {
+	if b.Mode == domain.BatchBestEffort {
+		return bestEffort(ctx, b, writes), nil
+	}
+
+	results, err := allOrNothing(ctx, tx, b, writes, writes.CreateBulk != nil)
+	if errors.Is(err, errBulkFailed) {
+		results, err = allOrNothing(ctx, tx, b, writes, false)
+	}
+	return results, err
+}
+
+// step writes one item into its result
+type step[T any] struct {
+	result *domain.BatchResult[T]
+	write  func(ctx context.Context) (*T, error)
+}
+
+// steps returns the writes of the items of b in order, leaving out the
+// creates when they are made in bulk
+func steps[T any](b domain.Batch[T], writes Writes[T], results *domain.BatchResults[T], bulk bool) []step[T] {
+	var steps []step[T]
+	if !bulk {
+		for i, value := range b.Create {
+			steps = append(steps, step[T]{&results.Create[i], func(ctx context.Context) (*T, error) {
+				return writes.Create(ctx, value)
+			}})
+		}
+	}
+	for i, update := range b.Update {
+		steps = append(steps, step[T]{&results.Update[i], func(ctx context.Context) (*T, error) {
+			return writes.Update(ctx, update.Version, update.Value)
+		}})
+	}
+	for i, ref := range b.Delete {
+		steps = append(steps, step[T]{&results.Delete[i], func(ctx context.Context) (*T, error) {
+			return nil, writes.Delete(ctx, ref)
+		}})
+	}
+	return steps
+}
+
+func allOrNothing[T any](ctx context.Context, tx txmanager.Manager, b domain.Batch[T], writes Writes[T], bulk bool) (*domain.BatchResults[T], error) {
+	results := domain.NewBatchResults(b)
+
+	err := tx.WithinTx(ctx, func(ctx context.Context) error {
+		if bulk && len(b.Create) > 0 {
+			created, err := writes.CreateBulk(ctx, b.Create)
+			if err != nil {
+				return errBulkFailed
+			}
+			for i := range created {
+				results.Create[i].Value = &created[i]
+			}
+		}
+
+		for _, s := range steps(b, writes, results, bulk) {
+			value, err := s.write(ctx)
+			if err != nil {
+				s.result.Err = err
+				return errRollback
+			}
+			s.result.Value = value
+		}
+		return nil
+	})
+	if errors.Is(err, errRollback) {
+		abort(results.Create)
+		abort(results.Update)
+		abort(results.Delete)
+		return results, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// abort reports the items of a rolled back batch that did not fail as
+// not applied
+func abort[T any](results []domain.BatchResult[T]) {
+	for i := range results {
+		if results[i].Err == nil {
+			results[i] = domain.BatchResult[T]{Err: domain.ErrBatchAborted}
+		}
+	}
+}
+
+func bestEffort[T any](ctx context.Context, b domain.Batch[T], writes Writes[T]) *domain.BatchResults[T] {
+	results := domain.NewBatchResults(b)
+
+	bulk := false
+	if writes.CreateBulk != nil && len(b.Create) > 0 {
+		// A failed bulk insert writes nothing, so its items can be retried
+		// one at a time to apply the others
+		if created, err := writes.CreateBulk(ctx, b.Create); err == nil {
+			for i := range created {
+				results.Create[i].Value = &created[i]
+			}
+			bulk = true
+		}
+	}
+
+	for _, s := range steps(b, writes, results, bulk) {
+		s.result.Value, s.result.Err = s.write(ctx)
+	}
+	return results
+}
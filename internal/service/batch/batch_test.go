@@ -0,0 +1,180 @@
+package batch_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"gin-swagger-api/internal/domain"
+	"gin-swagger-api/internal/service/batch"
+	mocktxmanager "gin-swagger-api/mock/repository/txmanager"
+)
+
+var _ = Describe("Run", func() {
+	var (
+		ctx       context.Context
+		txManager *mocktxmanager.MockManager
+		txErr     error
+		calls     []string
+		writes    batch.Writes[string]
+		b         domain.Batch[string]
+		conflict  error
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		txErr = nil
+		calls = nil
+		conflict = fmt.Errorf("name %w", domain.ErrConflict)
+		txManager = mocktxmanager.NewMockManager(GinkgoT())
+		txManager.EXPECT().
+			WithinTx(mock.Anything, mock.Anything).
+			RunAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+				txErr = fn(ctx)
+				return txErr
+			}).
+			Maybe()
+
+		// Writes fail for values starting with "bad"
+		fail := func(value string) bool { return len(value) >= 3 && value[:3] == "bad" }
+		writes = batch.Writes[string]{
+			CreateBulk: func(_ context.Context, values []string) ([]string, error) {
+				calls = append(calls, fmt.Sprintf("bulk %v", values))
+				for _, v := range values {
+					if fail(v) {
+						return nil, conflict
+					}
+				}
+				return values, nil
+			},
+			Create: func(_ context.Context, value string) (*string, error) {
+				calls = append(calls, "create "+value)
+				if fail(value) {
+					return nil, conflict
+				}
+				return &value, nil
+			},
+			Update: func(_ context.Context, version, value string) (*string, error) {
+				calls = append(calls, "update "+value+"@"+version)
+				if fail(value) {
+					return nil, conflict
+				}
+				return &value, nil
+			},
+			Delete: func(_ context.Context, ref domain.BatchRef) error {
+				calls = append(calls, "delete "+ref.ID)
+				if ref.ID == "404" {
+					return fmt.Errorf("item %w", domain.ErrNotFound)
+				}
+				return nil
+			},
+		}
+		b = domain.Batch[string]{
+			Create: []string{"a", "b"},
+			Update: []domain.BatchUpdate[string]{{Version: "v1", Value: "c"}},
+			Delete: []domain.BatchRef{{ID: "1"}},
+		}
+	})
+
+	value := func(v string) *string { return &v }
+
+	Context("in all-or-nothing mode", func() {
+		BeforeEach(func() {
+			b.Mode = domain.BatchAllOrNothing
+		})
+
+		It("should create in bulk, then update and delete in one transaction", func() {
+			results, err := batch.Run(ctx, txManager, b, writes)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(calls).To(Equal([]string{"bulk [a b]", "update c@v1", "delete 1"}))
+			Expect(results).To(Equal(&domain.BatchResults[string]{
+				Create: []domain.BatchResult[string]{{Value: value("a")}, {Value: value("b")}},
+				Update: []domain.BatchResult[string]{{Value: value("c")}},
+				Delete: []domain.BatchResult[string]{{}},
+			}))
+			txManager.AssertNumberOfCalls(GinkgoT(), "WithinTx", 1)
+		})
+
+		It("should roll back at the first failure and abort the other items", func() {
+			b.Delete = []domain.BatchRef{{ID: "404"}, {ID: "2"}}
+
+			results, err := batch.Run(ctx, txManager, b, writes)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(txErr).To(HaveOccurred())
+			Expect(calls).To(Equal([]string{"bulk [a b]", "update c@v1", "delete 404"}))
+			Expect(results.Create[0].Err).To(MatchError(domain.ErrBatchAborted))
+			Expect(results.Create[0].Value).To(BeNil())
+			Expect(results.Update[0].Err).To(MatchError(domain.ErrBatchAborted))
+			Expect(results.Delete[0].Err).To(MatchError(domain.ErrNotFound))
+			Expect(results.Delete[1].Err).To(MatchError(domain.ErrBatchAborted))
+		})
+
+		It("should retry a failed bulk create one item at a time to find the failure", func() {
+			b.Create = []string{"a", "bad", "b"}
+
+			results, err := batch.Run(ctx, txManager, b, writes)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(calls).To(Equal([]string{"bulk [a bad b]", "create a", "create bad"}))
+			Expect(results.Create[0].Err).To(MatchError(domain.ErrBatchAborted))
+			Expect(results.Create[1].Err).To(MatchError(domain.ErrConflict))
+			Expect(results.Create[2].Err).To(MatchError(domain.ErrBatchAborted))
+		})
+
+		It("should create one at a time without a bulk create", func() {
+			writes.CreateBulk = nil
+
+			_, err := batch.Run(ctx, txManager, b, writes)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(calls).To(Equal([]string{"create a", "create b", "update c@v1", "delete 1"}))
+		})
+
+		It("should return errors outside of the items", func() {
+			txManager = mocktxmanager.NewMockManager(GinkgoT())
+			expectedError := errors.New("commit failed")
+			txManager.EXPECT().WithinTx(ctx, mock.Anything).Return(expectedError).Once()
+
+			results, err := batch.Run(ctx, txManager, b, writes)
+
+			Expect(err).To(MatchError(expectedError))
+			Expect(results).To(BeNil())
+		})
+	})
+
+	Context("in best-effort mode", func() {
+		BeforeEach(func() {
+			b.Mode = domain.BatchBestEffort
+		})
+
+		It("should apply every item outside of a transaction", func() {
+			results, err := batch.Run(ctx, txManager, b, writes)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(calls).To(Equal([]string{"bulk [a b]", "update c@v1", "delete 1"}))
+			Expect(results.Create[1].Value).To(Equal(value("b")))
+			txManager.AssertNotCalled(GinkgoT(), "WithinTx", mock.Anything, mock.Anything)
+		})
+
+		It("should apply the items that do not fail", func() {
+			b.Create = []string{"a", "bad"}
+			b.Update = []domain.BatchUpdate[string]{{Value: "bad"}, {Value: "d"}}
+
+			results, err := batch.Run(ctx, txManager, b, writes)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(calls).To(Equal([]string{"bulk [a bad]", "create a", "create bad", "update bad@", "update d@", "delete 1"}))
+			Expect(results).To(Equal(&domain.BatchResults[string]{
+				Create: []domain.BatchResult[string]{{Value: value("a")}, {Err: conflict}},
+				Update: []domain.BatchResult[string]{{Err: conflict}, {Value: value("d")}},
+				Delete: []domain.BatchResult[string]{{}},
+			}))
+		})
+	})
+})
@@ -0,0 +1,13 @@
+package couponsvc_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestCouponSvc(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "CouponSvc Suite")
+}
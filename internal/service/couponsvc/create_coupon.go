@@ -0,0 +1,18 @@
+package couponsvc
+
+import (
+	"context"
+
+	"gin-swagger-api/internal/domain"
+)
+
+// CreateCoupon creates a coupon with a normalized code. A code that is
+// already taken fails with domain.ErrConflict.
+func (s *Service) CreateCoupon(ctx context.Context, coupon domain.Coupon) (*domain.Coupon, error) {
+	coupon.Code = domain.NormalizeCouponCode(coupon.Code)
+	if fields := coupon.Validate(); len(fields) > 0 {
+		return nil, fields
+	}
+
+	return s.couponRepo.Create(ctx, coupon)
+}
@@ -0,0 +1,60 @@
+package couponsvc_test
+
+import (
+	"context"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"gin-swagger-api/internal/domain"
+	portcouponsvc "gin-swagger-api/internal/port/service/couponsvc"
+	"gin-swagger-api/internal/service/couponsvc"
+	"gin-swagger-api/internal/testutil"
+	mockcouponrepo "gin-swagger-api/mock/repository/couponrepo"
+)
+
+var _ = Describe("CouponService CreateCoupon", func() {
+	var (
+		mockRepo *mockcouponrepo.MockRepository
+		service  portcouponsvc.Service
+		ctx      context.Context
+	)
+
+	BeforeEach(func() {
+		mockRepo = mockcouponrepo.NewMockRepository(GinkgoT())
+		service = couponsvc.New(mockRepo)
+		ctx = context.Background()
+	})
+
+	Describe("CreateCoupon", func() {
+		It("should create the coupon with an upper-cased code", func() {
+			stored := domain.Coupon{Code: "WELCOME", Type: domain.CouponFixed, Amount: testutil.THB("100.00"), PerUserLimit: 1}
+			created := stored
+			created.ID = "1"
+			mockRepo.EXPECT().Create(ctx, stored).Return(&created, nil).Once()
+
+			result, err := service.CreateCoupon(ctx, domain.Coupon{Code: " welcome", Type: domain.CouponFixed, Amount: testutil.THB("100.00"), PerUserLimit: 1})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(Equal(&created))
+		})
+
+		It("should reject an invalid coupon naming the fields", func() {
+			result, err := service.CreateCoupon(ctx, domain.Coupon{Code: "SAVE10", Type: domain.CouponPercentage, Percent: 0, UsageLimit: -1})
+
+			Expect(err).To(MatchError(domain.ErrValidation))
+			Expect(err).To(ConsistOf(HaveField("Field", "percent"), HaveField("Field", "usage_limit")))
+			Expect(result).To(BeNil())
+		})
+
+		It("should return conflict for a code that is taken", func() {
+			mockRepo.EXPECT().Create(ctx, domain.Coupon{Code: "SAVE10", Type: domain.CouponPercentage, Percent: 10}).
+				Return(nil, fmt.Errorf("coupon %w", domain.ErrConflict)).Once()
+
+			_, err := service.CreateCoupon(ctx, domain.Coupon{Code: "save10", Type: domain.CouponPercentage, Percent: 10})
+
+			Expect(err).To(MatchError(domain.ErrConflict))
+		})
+	})
+})
@@ -0,0 +1,31 @@
+package couponsvc
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"gin-swagger-api/internal/domain"
+)
+
+// DeleteCoupon deletes a coupon that was never redeemed. A redeemed
+// coupon fails with a domain.InUseError counting its orders.
+func (s *Service) DeleteCoupon(ctx context.Context, id, version string) error {
+	intID, err := strconv.Atoi(id)
+	if err != nil {
+		return fmt.Errorf("%w: %q", domain.ErrInvalidID, id)
+	}
+
+	coupon, err := s.couponRepo.GetByID(ctx, intID)
+	if err != nil {
+		return err
+	}
+	if version != "" && coupon.Version() != version {
+		return fmt.Errorf("coupon version %w", domain.ErrPreconditionFailed)
+	}
+	if coupon.Redemptions > 0 {
+		return &domain.InUseError{Entity: "coupon", Orders: coupon.Redemptions}
+	}
+
+	return s.couponRepo.Delete(ctx, intID, version)
+}
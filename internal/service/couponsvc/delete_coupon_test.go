@@ -0,0 +1,65 @@
+package couponsvc_test
+
+import (
+	"context"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"gin-swagger-api/internal/domain"
+	portcouponsvc "gin-swagger-api/internal/port/service/couponsvc"
+	"gin-swagger-api/internal/service/couponsvc"
+	mockcouponrepo "gin-swagger-api/mock/repository/couponrepo"
+)
+
+var _ = Describe("CouponService DeleteCoupon", func() {
+	var (
+		mockRepo *mockcouponrepo.MockRepository
+		service  portcouponsvc.Service
+		ctx      context.Context
+		coupon   *domain.Coupon
+	)
+
+	BeforeEach(func() {
+		mockRepo = mockcouponrepo.NewMockRepository(GinkgoT())
+		service = couponsvc.New(mockRepo)
+		ctx = context.Background()
+		coupon = &domain.Coupon{ID: "1", Code: "SAVE10", Type: domain.CouponPercentage, Percent: 10}
+	})
+
+	Describe("DeleteCoupon", func() {
+		It("should delete a coupon that was never redeemed", func() {
+			mockRepo.EXPECT().GetByID(ctx, 1).Return(coupon, nil).Once()
+			mockRepo.EXPECT().Delete(ctx, 1, coupon.Version()).Return(nil).Once()
+
+			Expect(service.DeleteCoupon(ctx, "1", coupon.Version())).To(Succeed())
+		})
+
+		It("should refuse to delete a redeemed coupon", func() {
+			coupon.Redemptions = 3
+			mockRepo.EXPECT().GetByID(ctx, 1).Return(coupon, nil).Once()
+
+			err := service.DeleteCoupon(ctx, "1", "")
+
+			Expect(err).To(MatchError(domain.ErrInUse))
+			Expect(err).To(Equal(&domain.InUseError{Entity: "coupon", Orders: 3}))
+		})
+
+		It("should return precondition failed for a stale version", func() {
+			mockRepo.EXPECT().GetByID(ctx, 1).Return(coupon, nil).Once()
+
+			Expect(service.DeleteCoupon(ctx, "1", "stale")).To(MatchError(domain.ErrPreconditionFailed))
+		})
+
+		It("should return not found for an unknown coupon", func() {
+			mockRepo.EXPECT().GetByID(ctx, 9).Return(nil, fmt.Errorf("coupon %w", domain.ErrNotFound)).Once()
+
+			Expect(service.DeleteCoupon(ctx, "9", "")).To(MatchError(domain.ErrNotFound))
+		})
+
+		It("should reject an invalid ID", func() {
+			Expect(service.DeleteCoupon(ctx, "abc", "")).To(MatchError(domain.ErrInvalidID))
+		})
+	})
+})
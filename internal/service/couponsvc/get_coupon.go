@@ -0,0 +1,18 @@
+package couponsvc
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"gin-swagger-api/internal/domain"
+)
+
+func (s *Service) GetCoupon(ctx context.Context, id string) (*domain.Coupon, error) {
+	intID, err := strconv.Atoi(id)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %q", domain.ErrInvalidID, id)
+	}
+
+	return s.couponRepo.GetByID(ctx, intID)
+}
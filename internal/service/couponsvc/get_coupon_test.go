@@ -0,0 +1,55 @@
+package couponsvc_test
+
+import (
+	"context"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"gin-swagger-api/internal/domain"
+	portcouponsvc "gin-swagger-api/internal/port/service/couponsvc"
+	"gin-swagger-api/internal/service/couponsvc"
+	mockcouponrepo "gin-swagger-api/mock/repository/couponrepo"
+)
+
+var _ = Describe("CouponService GetCoupon", func() {
+	var (
+		mockRepo *mockcouponrepo.MockRepository
+		service  portcouponsvc.Service
+		ctx      context.Context
+	)
+
+	BeforeEach(func() {
+		mockRepo = mockcouponrepo.NewMockRepository(GinkgoT())
+		service = couponsvc.New(mockRepo)
+		ctx = context.Background()
+	})
+
+	Describe("GetCoupon", func() {
+		It("should return the coupon", func() {
+			coupon := &domain.Coupon{ID: "1", Code: "SAVE10", Type: domain.CouponPercentage, Percent: 10}
+			mockRepo.EXPECT().GetByID(ctx, 1).Return(coupon, nil).Once()
+
+			result, err := service.GetCoupon(ctx, "1")
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(Equal(coupon))
+		})
+
+		It("should reject an invalid ID", func() {
+			result, err := service.GetCoupon(ctx, "abc")
+
+			Expect(err).To(MatchError(domain.ErrInvalidID))
+			Expect(result).To(BeNil())
+		})
+
+		It("should return not found for an unknown coupon", func() {
+			mockRepo.EXPECT().GetByID(ctx, 9).Return(nil, fmt.Errorf("coupon %w", domain.ErrNotFound)).Once()
+
+			_, err := service.GetCoupon(ctx, "9")
+
+			Expect(err).To(MatchError(domain.ErrNotFound))
+		})
+	})
+})
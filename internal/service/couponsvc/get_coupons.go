@@ -0,0 +1,12 @@
+package couponsvc
+
+import (
+	"context"
+
+	"gin-swagger-api/internal/domain"
+)
+
+// GetCoupons returns a page of coupons in ID order
+func (s *Service) GetCoupons(ctx context.Context, page domain.PageRequest) (*domain.Page[domain.Coupon], error) {
+	return s.couponRepo.GetAll(ctx, page.Normalize())
+}
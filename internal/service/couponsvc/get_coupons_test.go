@@ -0,0 +1,53 @@
+package couponsvc_test
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"gin-swagger-api/internal/domain"
+	portcouponsvc "gin-swagger-api/internal/port/service/couponsvc"
+	"gin-swagger-api/internal/service/couponsvc"
+	mockcouponrepo "gin-swagger-api/mock/repository/couponrepo"
+)
+
+var _ = Describe("CouponService GetCoupons", func() {
+	var (
+		mockRepo *mockcouponrepo.MockRepository
+		service  portcouponsvc.Service
+		ctx      context.Context
+	)
+
+	BeforeEach(func() {
+		mockRepo = mockcouponrepo.NewMockRepository(GinkgoT())
+		service = couponsvc.New(mockRepo)
+		ctx = context.Background()
+	})
+
+	Describe("GetCoupons", func() {
+		It("should return a page of coupons with the default page size", func() {
+			page := &domain.Page[domain.Coupon]{
+				Items: []domain.Coupon{{ID: "1", Code: "SAVE10", Type: domain.CouponPercentage, Percent: 10}},
+				Total: 1,
+				Limit: domain.DefaultPageSize,
+			}
+			mockRepo.EXPECT().GetAll(ctx, domain.PageRequest{Limit: domain.DefaultPageSize}).Return(page, nil).Once()
+
+			result, err := service.GetCoupons(ctx, domain.PageRequest{})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(Equal(page))
+		})
+
+		It("should return repository errors", func() {
+			mockRepo.EXPECT().GetAll(ctx, domain.PageRequest{Limit: 5}).Return(nil, errors.New("database error")).Once()
+
+			result, err := service.GetCoupons(ctx, domain.PageRequest{Limit: 5})
+
+			Expect(err).To(MatchError("database error"))
+			Expect(result).To(BeNil())
+		})
+	})
+})
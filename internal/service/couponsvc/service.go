@@ -0,0 +1,18 @@
+package couponsvc
+
+import (
+	couponrepo "gin-swagger-api/internal/port/repository/couponrepo"
+	port "gin-swagger-api/internal/port/service/couponsvc"
+)
+
+// Service implements port.Service interface
+type Service struct {
+	couponRepo couponrepo.Repository
+}
+
+// New creates a new coupon service with coupon repository
+func New(couponRepo couponrepo.Repository) port.Service {
+	return &Service{
+		couponRepo: couponRepo,
+	}
+}
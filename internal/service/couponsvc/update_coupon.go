@@ -0,0 +1,26 @@
+package couponsvc
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"gin-swagger-api/internal/domain"
+)
+
+// UpdateCoupon replaces the terms of a coupon. Orders already placed keep
+// the discount they got, and redemptions made so far count towards the
+// new limits.
+func (s *Service) UpdateCoupon(ctx context.Context, id, version string, coupon domain.Coupon) (*domain.Coupon, error) {
+	intID, err := strconv.Atoi(id)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %q", domain.ErrInvalidID, id)
+	}
+
+	coupon.Code = domain.NormalizeCouponCode(coupon.Code)
+	if fields := coupon.Validate(); len(fields) > 0 {
+		return nil, fields
+	}
+
+	return s.couponRepo.Update(ctx, intID, version, coupon)
+}
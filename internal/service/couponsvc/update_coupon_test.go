@@ -0,0 +1,66 @@
+package couponsvc_test
+
+import (
+	"context"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"gin-swagger-api/internal/domain"
+	portcouponsvc "gin-swagger-api/internal/port/service/couponsvc"
+	"gin-swagger-api/internal/service/couponsvc"
+	mockcouponrepo "gin-swagger-api/mock/repository/couponrepo"
+)
+
+var _ = Describe("CouponService UpdateCoupon", func() {
+	var (
+		mockRepo *mockcouponrepo.MockRepository
+		service  portcouponsvc.Service
+		ctx      context.Context
+	)
+
+	BeforeEach(func() {
+		mockRepo = mockcouponrepo.NewMockRepository(GinkgoT())
+		service = couponsvc.New(mockRepo)
+		ctx = context.Background()
+	})
+
+	Describe("UpdateCoupon", func() {
+		coupon := domain.Coupon{Code: "SAVE20", Type: domain.CouponPercentage, Percent: 20, UsageLimit: 100}
+
+		It("should update the coupon at the version", func() {
+			updated := coupon
+			updated.ID = "1"
+			mockRepo.EXPECT().Update(ctx, 1, "abc123", coupon).Return(&updated, nil).Once()
+
+			result, err := service.UpdateCoupon(ctx, "1", "abc123", domain.Coupon{Code: "save20", Type: domain.CouponPercentage, Percent: 20, UsageLimit: 100})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(Equal(&updated))
+		})
+
+		It("should reject an invalid ID", func() {
+			_, err := service.UpdateCoupon(ctx, "abc", "", coupon)
+
+			Expect(err).To(MatchError(domain.ErrInvalidID))
+		})
+
+		It("should reject an invalid coupon", func() {
+			invalid := coupon
+			invalid.Type = "bogo"
+
+			_, err := service.UpdateCoupon(ctx, "1", "", invalid)
+
+			Expect(err).To(MatchError(domain.ErrValidation))
+		})
+
+		It("should return precondition failed for a stale version", func() {
+			mockRepo.EXPECT().Update(ctx, 1, "stale", coupon).Return(nil, fmt.Errorf("coupon version %w", domain.ErrPreconditionFailed)).Once()
+
+			_, err := service.UpdateCoupon(ctx, "1", "stale", coupon)
+
+			Expect(err).To(MatchError(domain.ErrPreconditionFailed))
+		})
+	})
+})
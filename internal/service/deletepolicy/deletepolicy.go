@@ -0,0 +1,51 @@
+package deletepolicy
+
+import (
+	"context"
+	"maps"
+	"slices"
+
+	"gin-swagger-api/internal/domain"
+)
+
+// Orders are the reads and writes a delete policy makes on the orders that
+// reference the entity being purged
+type Orders struct {
+	// Entity names the entity in the error of the restrict policy
+	Entity  string
+	Count   func(ctx context.Context) (int, error)
+	Delete  func(ctx context.Context) ([]domain.Order, error)
+	Detach  func(ctx context.Context) error
+	Release func(ctx context.Context, productID, quantity int) error
+}
+
+// Apply restricts, cascades to or detaches the orders of an entity about
+// to be purged, following policy. Cascaded orders that have not shipped
+// return their stock, one product at a time in ID order.
+func Apply(ctx context.Context, policy domain.DeletePolicy, orders Orders) error {
+	switch policy {
+	case domain.DeleteCascade:
+		deleted, err := orders.Delete(ctx)
+		if err != nil {
+			return err
+		}
+		released := domain.TotalReleasable(deleted)
+		for _, productID := range slices.Sorted(maps.Keys(released)) {
+			if err := orders.Release(ctx, productID, released[productID]); err != nil {
+				return err
+			}
+		}
+		return nil
+	case domain.DeleteNullify:
+		return orders.Detach(ctx)
+	default:
+		count, err := orders.Count(ctx)
+		if err != nil {
+			return err
+		}
+		if count > 0 {
+			return &domain.InUseError{Entity: orders.Entity, Orders: count}
+		}
+		return nil
+	}
+}
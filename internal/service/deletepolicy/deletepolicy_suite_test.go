@@ -0,0 +1,13 @@
+package deletepolicy_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestDeletePolicy(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "DeletePolicy Suite")
+}
@@ -0,0 +1,95 @@
+package deletepolicy_test
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"gin-swagger-api/internal/domain"
+	"gin-swagger-api/internal/service/deletepolicy"
+)
+
+var _ = Describe("Apply", func() {
+	var (
+		ctx      context.Context
+		orders   deletepolicy.Orders
+		count    int
+		deleted  []domain.Order
+		detached bool
+		released [][2]int
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		count, deleted, detached, released = 0, nil, false, nil
+		orders = deletepolicy.Orders{
+			Entity: "user",
+			Count: func(context.Context) (int, error) {
+				return count, nil
+			},
+			Delete: func(context.Context) ([]domain.Order, error) {
+				return deleted, nil
+			},
+			Detach: func(context.Context) error {
+				detached = true
+				return nil
+			},
+			Release: func(_ context.Context, productID, quantity int) error {
+				released = append(released, [2]int{productID, quantity})
+				return nil
+			},
+		}
+	})
+
+	Describe("restrict", func() {
+		It("should allow the purge without orders", func() {
+			Expect(deletepolicy.Apply(ctx, domain.DeleteRestrict, orders)).To(Succeed())
+		})
+
+		It("should refuse the purge while orders reference the entity", func() {
+			count = 2
+
+			err := deletepolicy.Apply(ctx, domain.DeleteRestrict, orders)
+
+			var inUse *domain.InUseError
+			Expect(errors.As(err, &inUse)).To(BeTrue())
+			Expect(*inUse).To(Equal(domain.InUseError{Entity: "user", Orders: 2}))
+		})
+	})
+
+	Describe("cascade", func() {
+		It("should return the stock of the orders that have not shipped in product order", func() {
+			deleted = []domain.Order{
+				{Status: domain.OrderStatusPaid, Items: []domain.OrderItem{{ProductID: 3, Quantity: 1}, {ProductID: 1, Quantity: 2}}},
+				{Status: domain.OrderStatusPending, Items: []domain.OrderItem{{ProductID: 3, Quantity: 4}}},
+				{Status: domain.OrderStatusDelivered, Items: []domain.OrderItem{{ProductID: 1, Quantity: 5}}},
+			}
+
+			Expect(deletepolicy.Apply(ctx, domain.DeleteCascade, orders)).To(Succeed())
+
+			Expect(released).To(Equal([][2]int{{1, 2}, {3, 5}}))
+		})
+
+		It("should stop at the first stock that cannot be returned", func() {
+			deleted = []domain.Order{{Status: domain.OrderStatusPaid, Items: []domain.OrderItem{{ProductID: 1, Quantity: 1}, {ProductID: 2, Quantity: 1}}}}
+			failure := errors.New("database error")
+			orders.Release = func(context.Context, int, int) error {
+				released = append(released, [2]int{})
+				return failure
+			}
+
+			Expect(deletepolicy.Apply(ctx, domain.DeleteCascade, orders)).To(MatchError(failure))
+			Expect(released).To(HaveLen(1))
+		})
+	})
+
+	Describe("nullify", func() {
+		It("should detach the orders", func() {
+			Expect(deletepolicy.Apply(ctx, domain.DeleteNullify, orders)).To(Succeed())
+
+			Expect(detached).To(BeTrue())
+		})
+	})
+})
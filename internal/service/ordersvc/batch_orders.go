@@ -0,0 +1,31 @@
+package ordersvc
+
+import (
+	"context"
+
+	"gin-swagger-api/internal/domain"
+	port "gin-swagger-api/internal/port/service/ordersvc"
+	"gin-swagger-api/internal/service/batch"
+)
+
+// BatchOrders has no bulk create: every order is validated, priced and
+// reserves its stock like a single creation, inside the batch transaction
+// in all-or-nothing mode
+func (s *Service) BatchOrders(ctx context.Context, b domain.Batch[domain.Order]) (*domain.BatchResults[domain.Order], error) {
+	return batch.Run(ctx, s.txManager, b, batch.Writes[domain.Order]{
+		Create: func(ctx context.Context, order domain.Order) (*domain.Order, error) {
+			return s.CreateOrder(ctx, port.CreateOrderInput{
+				UserID:     order.UserID,
+				Items:      order.Items,
+				Currency:   order.Currency,
+				CouponCode: order.CouponCode,
+			})
+		},
+		Update: func(ctx context.Context, version string, order domain.Order) (*domain.Order, error) {
+			return s.UpdateOrder(ctx, order.ID, version, order.Items, order.Status)
+		},
+		Delete: func(ctx context.Context, ref domain.BatchRef) error {
+			return s.DeleteOrder(ctx, ref.ID, ref.Version)
+		},
+	})
+}
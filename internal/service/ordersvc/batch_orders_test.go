@@ -0,0 +1,69 @@
+package ordersvc_test
+
+import (
+	"context"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"gin-swagger-api/internal/domain"
+	portordersvc "gin-swagger-api/internal/port/service/ordersvc"
+	"gin-swagger-api/internal/service/ordersvc"
+	"gin-swagger-api/internal/testutil"
+	mockcouponrepo "gin-swagger-api/mock/repository/couponrepo"
+	mockordereventrepo "gin-swagger-api/mock/repository/ordereventrepo"
+	mockorderrepo "gin-swagger-api/mock/repository/orderrepo"
+	mockproductrepo "gin-swagger-api/mock/repository/productrepo"
+	mockraterepo "gin-swagger-api/mock/repository/raterepo"
+	mockuserrepo "gin-swagger-api/mock/repository/userrepo"
+)
+
+var _ = Describe("OrderService BatchOrders", func() {
+	var (
+		mockRepo        *mockorderrepo.MockRepository
+		mockProductRepo *mockproductrepo.MockRepository
+		service         portordersvc.Service
+		ctx             context.Context
+		first, second   *domain.Order
+	)
+
+	BeforeEach(func() {
+		mockRepo = mockorderrepo.NewMockRepository(GinkgoT())
+		mockProductRepo = mockproductrepo.NewMockRepository(GinkgoT())
+		service = ordersvc.New(mockRepo, mockuserrepo.NewMockRepository(GinkgoT()), mockProductRepo, mockordereventrepo.NewMockRepository(GinkgoT()), mockraterepo.NewMockRepository(GinkgoT()), mockcouponrepo.NewMockRepository(GinkgoT()), testutil.NewTxManager(GinkgoT()))
+		ctx = context.Background()
+		first = &domain.Order{ID: "1", UserID: 1, Items: []domain.OrderItem{domain.NewOrderItem(100, 2, testutil.THB("10.00"))}, TotalPrice: testutil.THB("20.0"), Status: "pending"}
+		second = &domain.Order{ID: "2", UserID: 1, Items: []domain.OrderItem{domain.NewOrderItem(100, 1, testutil.THB("10.00"))}, TotalPrice: testutil.THB("10.0"), Status: "cancelled"}
+	})
+
+	Describe("BatchOrders", func() {
+		It("should delete each order like a single delete", func() {
+			mockRepo.EXPECT().GetByID(ctx, 1, domain.OrderExpand{}).Return(first, nil).Once()
+			mockProductRepo.EXPECT().ReleaseStock(ctx, 100, 2).Return(nil).Once()
+			mockRepo.EXPECT().Delete(ctx, 1, first.Version()).Return(nil).Once()
+			mockRepo.EXPECT().GetByID(ctx, 2, domain.OrderExpand{}).Return(second, nil).Once()
+			mockRepo.EXPECT().Delete(ctx, 2, second.Version()).Return(nil).Once()
+
+			results, err := service.BatchOrders(ctx, domain.Batch[domain.Order]{
+				Delete: []domain.BatchRef{{ID: "1"}, {ID: "2"}},
+			})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(results.Delete).To(Equal([]domain.BatchResult[domain.Order]{{}, {}}))
+		})
+
+		It("should abort the batch when an order is missing", func() {
+			mockRepo.EXPECT().GetByID(ctx, 1, domain.OrderExpand{}).Return(nil, fmt.Errorf("order %w", domain.ErrNotFound)).Once()
+
+			results, err := service.BatchOrders(ctx, domain.Batch[domain.Order]{
+				Mode:   domain.BatchAllOrNothing,
+				Delete: []domain.BatchRef{{ID: "1"}, {ID: "2"}},
+			})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(results.Delete[0].Err).To(MatchError(domain.ErrNotFound))
+			Expect(results.Delete[1].Err).To(MatchError(domain.ErrBatchAborted))
+		})
+	})
+})
@@ -2,14 +2,181 @@ package ordersvc
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
 
 	"gin-swagger-api/internal/domain"
+	port "gin-swagger-api/internal/port/service/ordersvc"
 )
 
-func (s *Service) CreateOrder(ctx context.Context, userID, productID, quantity int, totalPrice float64, status string) (*domain.Order, error) {
-	if status == "" {
-		status = "pending"
+// CreateOrder places a pending order with items priced at the current
+// product prices, converted to currency at its current exchange rate,
+// reserves their stock and records the order in its history in the same
+// transaction. A coupon code takes the coupon's discount off the items
+// total and is redeemed in that transaction too, so a failed order does
+// not use up the coupon. Only the product and quantity of each item are
+// used. A missing user or product, a product out of stock, a malformed
+// item, a currency without a rate or a coupon that does not exist or
+// apply fails with a domain.ValidationError naming the field.
+func (s *Service) CreateOrder(ctx context.Context, input port.CreateOrderInput) (*domain.Order, error) {
+	var order *domain.Order
+	err := s.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		items, rate, coupon, err := s.checkReferences(ctx, input.UserID, input.Items, input.Currency, input.CouponCode)
+		if err != nil {
+			return err
+		}
+
+		placed := domain.Order{
+			UserID:       input.UserID,
+			Items:        items,
+			Currency:     rate.Currency,
+			ExchangeRate: rate.Rate,
+			Status:       domain.OrderStatusPending,
+		}
+		if coupon != nil {
+			itemsTotal, err := placed.Total(items)
+			if err != nil {
+				return err
+			}
+			placed.Discount, err = coupon.Discount(itemsTotal, rate, time.Now())
+			if err != nil {
+				return err
+			}
+			placed.CouponCode = coupon.Code
+		}
+		placed.TotalPrice, err = placed.Total(items)
+		if err != nil {
+			return err
+		}
+
+		if err := s.moveStocks(ctx, nil, placed.Reserved()); err != nil {
+			return err
+		}
+
+		order, err = s.orderRepo.Create(ctx, placed)
+		if err != nil {
+			return err
+		}
+
+		orderID, err := strconv.Atoi(order.ID)
+		if err != nil {
+			return fmt.Errorf("%w: %q", domain.ErrInvalidID, order.ID)
+		}
+		if coupon != nil {
+			if err := s.redeem(ctx, *coupon, *order, orderID); err != nil {
+				return err
+			}
+		}
+		return s.recordStatus(ctx, orderID, "", domain.OrderStatusPending, "")
+	})
+	if err != nil {
+		return nil, err
+	}
+	return order, nil
+}
+
+// checkReferences checks that the user of a new order exists, looks up
+// the exchange rate of its currency and its coupon, if any, and prices its
+// items at the rate. Every failed check is reported in one
+// domain.ValidationError.
+func (s *Service) checkReferences(ctx context.Context, userID int, lines []domain.OrderItem, currency, couponCode string) ([]domain.OrderItem, domain.ExchangeRate, *domain.Coupon, error) {
+	var fields domain.ValidationError
+
+	_, err := s.userRepo.GetByID(ctx, userID)
+	switch {
+	case errors.Is(err, domain.ErrNotFound):
+		fields = append(fields, domain.FieldError{Field: "user_id", Message: fmt.Sprintf("user %d does not exist", userID)})
+	case err != nil:
+		return nil, domain.ExchangeRate{}, nil, err
+	}
+
+	rate, rateField, err := s.checkoutRate(ctx, currency)
+	if err != nil {
+		return nil, domain.ExchangeRate{}, nil, err
+	}
+	if rateField != nil {
+		// Without a rate the items cannot be priced, only checked
+		fields = append(fields, *rateField)
+		rate = domain.BaseRate()
+	}
+
+	coupon, couponField, err := s.checkoutCoupon(ctx, couponCode)
+	if err != nil {
+		return nil, domain.ExchangeRate{}, nil, err
+	}
+	if couponField != nil {
+		fields = append(fields, *couponField)
+	}
+
+	placed := domain.Order{Currency: rate.Currency, ExchangeRate: rate.Rate}
+	items, itemFields, err := s.priceItems(ctx, lines, placed)
+	if err != nil {
+		return nil, domain.ExchangeRate{}, nil, err
+	}
+
+	fields = append(fields, itemFields...)
+	if len(fields) > 0 {
+		return nil, domain.ExchangeRate{}, nil, fields
+	}
+	return items, rate, coupon, nil
+}
+
+// checkoutRate returns the current exchange rate of the currency a new
+// order is placed in, the base currency when it is empty. A currency that
+// is not supported or has no rate is returned as a field error.
+func (s *Service) checkoutRate(ctx context.Context, currency string) (domain.ExchangeRate, *domain.FieldError, error) {
+	code := strings.ToUpper(currency)
+	switch {
+	case code == "" || code == domain.BaseCurrency:
+		return domain.BaseRate(), nil, nil
+	case !domain.SupportedCurrency(code):
+		return domain.ExchangeRate{}, &domain.FieldError{Field: "currency", Message: fmt.Sprintf("currency %q is not supported", currency)}, nil
+	}
+
+	rate, err := s.rateRepo.Get(ctx, code)
+	switch {
+	case errors.Is(err, domain.ErrNotFound):
+		return domain.ExchangeRate{}, &domain.FieldError{Field: "currency", Message: fmt.Sprintf("there is no exchange rate for %s", code)}, nil
+	case err != nil:
+		return domain.ExchangeRate{}, nil, err
+	}
+	return *rate, nil, nil
+}
+
+// checkoutCoupon looks up the coupon a new order is placed with, none when
+// code is empty. A coupon that does not exist is returned as a field
+// error.
+func (s *Service) checkoutCoupon(ctx context.Context, code string) (*domain.Coupon, *domain.FieldError, error) {
+	code = domain.NormalizeCouponCode(code)
+	if code == "" {
+		return nil, nil, nil
+	}
+
+	coupon, err := s.couponRepo.GetByCode(ctx, code)
+	switch {
+	case errors.Is(err, domain.ErrNotFound):
+		return nil, &domain.FieldError{Field: "coupon_code", Message: fmt.Sprintf("coupon %s does not exist", code)}, nil
+	case err != nil:
+		return nil, nil, err
+	}
+	return coupon, nil, nil
+}
+
+// redeem records the use of a coupon on a new order, which fails with
+// domain.ErrCouponUsedUp when the coupon or the user has no uses left
+func (s *Service) redeem(ctx context.Context, coupon domain.Coupon, order domain.Order, orderID int) error {
+	couponID, err := strconv.Atoi(coupon.ID)
+	if err != nil {
+		return fmt.Errorf("%w: %q", domain.ErrInvalidID, coupon.ID)
 	}
 
-	return s.orderRepo.Create(ctx, userID, productID, quantity, totalPrice, status)
+	return s.couponRepo.Redeem(ctx, domain.CouponRedemption{
+		CouponID: couponID,
+		UserID:   order.UserID,
+		OrderID:  orderID,
+		Discount: order.Discount,
+	})
 }
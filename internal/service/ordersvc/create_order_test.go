@@ -3,84 +3,349 @@ package ordersvc_test
 import (
 	"context"
 	"errors"
+	"fmt"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
 
 	"gin-swagger-api/internal/domain"
 	portordersvc "gin-swagger-api/internal/port/service/ordersvc"
 	"gin-swagger-api/internal/service/ordersvc"
+	"gin-swagger-api/internal/testutil"
+	mockcouponrepo "gin-swagger-api/mock/repository/couponrepo"
+	mockordereventrepo "gin-swagger-api/mock/repository/ordereventrepo"
 	mockorderrepo "gin-swagger-api/mock/repository/orderrepo"
+	mockproductrepo "gin-swagger-api/mock/repository/productrepo"
+	mockraterepo "gin-swagger-api/mock/repository/raterepo"
+	mocktxmanager "gin-swagger-api/mock/repository/txmanager"
+	mockuserrepo "gin-swagger-api/mock/repository/userrepo"
 )
 
 var _ = Describe("OrderService CreateOrder", func() {
 	var (
-		mockRepo *mockorderrepo.MockRepository
-		service  portordersvc.Service
-		ctx      context.Context
+		mockRepo        *mockorderrepo.MockRepository
+		mockUserRepo    *mockuserrepo.MockRepository
+		mockProductRepo *mockproductrepo.MockRepository
+		mockEventRepo   *mockordereventrepo.MockRepository
+		mockRateRepo    *mockraterepo.MockRepository
+		mockCouponRepo  *mockcouponrepo.MockRepository
+		service         portordersvc.Service
+		ctx             context.Context
+		user            *domain.User
+		product         *domain.Product
 	)
 
 	BeforeEach(func() {
 		mockRepo = mockorderrepo.NewMockRepository(GinkgoT())
-		service = ordersvc.New(mockRepo)
+		mockUserRepo = mockuserrepo.NewMockRepository(GinkgoT())
+		mockProductRepo = mockproductrepo.NewMockRepository(GinkgoT())
+		mockEventRepo = mockordereventrepo.NewMockRepository(GinkgoT())
+		mockRateRepo = mockraterepo.NewMockRepository(GinkgoT())
+		mockCouponRepo = mockcouponrepo.NewMockRepository(GinkgoT())
+		service = ordersvc.New(mockRepo, mockUserRepo, mockProductRepo, mockEventRepo, mockRateRepo, mockCouponRepo, testutil.NewTxManager(GinkgoT()))
 		ctx = context.Background()
+		user = &domain.User{ID: "1", Name: "John Doe", Email: "john@example.com"}
+		product = &domain.Product{ID: "100", Name: "Keyboard", Price: testutil.THB("99.99"), Stock: 10}
 	})
 
 	Describe("CreateOrder", func() {
-		It("should create order successfully when repository succeeds", func() {
+		var priced []domain.OrderItem
+
+		BeforeEach(func() {
+			priced = []domain.OrderItem{domain.NewOrderItem(100, 5, testutil.THB("99.99"))}
+		})
+
+		It("should price the order from the product and reserve its stock", func() {
 			expectedOrder := &domain.Order{
 				ID:         "1",
 				UserID:     1,
-				ProductID:  100,
-				Quantity:   5,
-				TotalPrice: 499.99,
+				Items:      priced,
+				TotalPrice: testutil.THB("499.95"),
 				Status:     "pending",
 			}
 
+			mockUserRepo.EXPECT().GetByID(ctx, 1).Return(user, nil).Once()
+			mockProductRepo.EXPECT().GetByID(ctx, 100).Return(product, nil).Once()
+			mockProductRepo.EXPECT().ReserveStock(ctx, 100, 5).Return(nil).Once()
 			mockRepo.EXPECT().
-				Create(ctx, 1, 100, 5, 499.99, "pending").
+				Create(ctx, domain.Order{UserID: 1, Items: priced, TotalPrice: testutil.THB("499.95"), Currency: "THB", ExchangeRate: domain.RateOne, Status: "pending"}).
 				Return(expectedOrder, nil).
 				Once()
+			mockEventRepo.EXPECT().Create(ctx, domain.OrderEvent{OrderID: 1, ToStatus: "pending"}).Return(&domain.OrderEvent{ID: "1"}, nil).Once()
 
-			order, err := service.CreateOrder(ctx, 1, 100, 5, 499.99, "pending")
+			order, err := service.CreateOrder(ctx, portordersvc.CreateOrderInput{UserID: 1, Items: lines(100, 5)})
 
 			Expect(err).ToNot(HaveOccurred())
 			Expect(*order).To(Equal(*expectedOrder))
 		})
 
+		It("should price and reserve every item", func() {
+			mouse := &domain.Product{ID: "200", Name: "Mouse", Price: testutil.THB("19.99"), Stock: 10}
+			items := []domain.OrderItem{
+				domain.NewOrderItem(200, 2, testutil.THB("19.99")),
+				domain.NewOrderItem(100, 1, testutil.THB("99.99")),
+			}
+
+			mockUserRepo.EXPECT().GetByID(ctx, 1).Return(user, nil).Once()
+			mockProductRepo.EXPECT().GetByID(ctx, 200).Return(mouse, nil).Once()
+			mockProductRepo.EXPECT().GetByID(ctx, 100).Return(product, nil).Once()
+			mockProductRepo.EXPECT().ReserveStock(ctx, 100, 1).Return(nil).Once()
+			mockProductRepo.EXPECT().ReserveStock(ctx, 200, 2).Return(nil).Once()
+			mockRepo.EXPECT().
+				Create(ctx, domain.Order{UserID: 1, Items: items, TotalPrice: testutil.THB("139.97"), Currency: "THB", ExchangeRate: domain.RateOne, Status: "pending"}).
+				Return(&domain.Order{ID: "1", UserID: 1, Items: items, TotalPrice: testutil.THB("139.97"), Status: "pending"}, nil).
+				Once()
+			mockEventRepo.EXPECT().Create(ctx, domain.OrderEvent{OrderID: 1, ToStatus: "pending"}).Return(&domain.OrderEvent{ID: "1"}, nil).Once()
+
+			order, err := service.CreateOrder(ctx, portordersvc.CreateOrderInput{UserID: 1, Items: []domain.OrderItem{
+				{ProductID: 200, Quantity: 2, UnitPrice: testutil.THB("0.01")},
+				{ProductID: 100, Quantity: 1},
+			}})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(order.Items).To(Equal(items))
+		})
+
+		It("should convert the prices to the order currency at its current rate", func() {
+			usd := func(amount string) domain.Money { return domain.MustParseMoney(amount, "USD") }
+			items := []domain.OrderItem{domain.NewOrderItem(100, 5, usd("2.75"))}
+
+			mockUserRepo.EXPECT().GetByID(ctx, 1).Return(user, nil).Once()
+			mockRateRepo.EXPECT().Get(ctx, "USD").Return(&domain.ExchangeRate{Currency: "USD", Rate: "0.0275"}, nil).Once()
+			mockProductRepo.EXPECT().GetByID(ctx, 100).Return(product, nil).Once()
+			mockProductRepo.EXPECT().ReserveStock(ctx, 100, 5).Return(nil).Once()
+			mockRepo.EXPECT().
+				Create(ctx, domain.Order{UserID: 1, Items: items, TotalPrice: usd("13.75"), Currency: "USD", ExchangeRate: domain.Rate("0.0275"), Status: "pending"}).
+				Return(&domain.Order{ID: "1", UserID: 1, Items: items, TotalPrice: usd("13.75"), Currency: "USD", ExchangeRate: "0.0275", Status: "pending"}, nil).
+				Once()
+			mockEventRepo.EXPECT().Create(ctx, domain.OrderEvent{OrderID: 1, ToStatus: "pending"}).Return(&domain.OrderEvent{ID: "1"}, nil).Once()
+
+			order, err := service.CreateOrder(ctx, portordersvc.CreateOrderInput{UserID: 1, Items: lines(100, 5), Currency: "usd"})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(order.Currency).To(Equal("USD"))
+			Expect(order.TotalPrice).To(Equal(usd("13.75")))
+		})
+
+		It("should name the currency when it has no rate", func() {
+			mockUserRepo.EXPECT().GetByID(ctx, 1).Return(user, nil).Once()
+			mockRateRepo.EXPECT().Get(ctx, "EUR").Return(nil, fmt.Errorf("exchange rate %w", domain.ErrNotFound)).Once()
+			mockProductRepo.EXPECT().GetByID(ctx, 100).Return(nil, fmt.Errorf("product %w", domain.ErrNotFound)).Once()
+
+			order, err := service.CreateOrder(ctx, portordersvc.CreateOrderInput{UserID: 1, Items: lines(100, 5), Currency: "EUR"})
+
+			Expect(err).To(Equal(domain.ValidationError{
+				{Field: "currency", Message: "there is no exchange rate for EUR"},
+				{Field: "items[0].product_id", Message: "product 100 does not exist"},
+			}))
+			Expect(order).To(BeNil())
+		})
+
+		It("should name the currency when it is not supported", func() {
+			mockUserRepo.EXPECT().GetByID(ctx, 1).Return(user, nil).Once()
+			mockProductRepo.EXPECT().GetByID(ctx, 100).Return(product, nil).Once()
+
+			_, err := service.CreateOrder(ctx, portordersvc.CreateOrderInput{UserID: 1, Items: lines(100, 5), Currency: "JPY"})
+
+			Expect(err).To(Equal(domain.ValidationError{
+				{Field: "currency", Message: `currency "JPY" is not supported`},
+			}))
+		})
+
+		It("should take the coupon discount off the total and redeem it", func() {
+			coupon := &domain.Coupon{ID: "5", Code: "SAVE10", Type: domain.CouponPercentage, Percent: 10}
+			placed := domain.Order{
+				UserID:       1,
+				Items:        priced,
+				TotalPrice:   testutil.THB("449.95"),
+				Currency:     "THB",
+				ExchangeRate: domain.RateOne,
+				CouponCode:   "SAVE10",
+				Discount:     testutil.THB("50.00"),
+				Status:       "pending",
+			}
+			created := placed
+			created.ID = "1"
+
+			mockUserRepo.EXPECT().GetByID(ctx, 1).Return(user, nil).Once()
+			mockCouponRepo.EXPECT().GetByCode(ctx, "SAVE10").Return(coupon, nil).Once()
+			mockProductRepo.EXPECT().GetByID(ctx, 100).Return(product, nil).Once()
+			mockProductRepo.EXPECT().ReserveStock(ctx, 100, 5).Return(nil).Once()
+			mockRepo.EXPECT().Create(ctx, placed).Return(&created, nil).Once()
+			mockCouponRepo.EXPECT().
+				Redeem(ctx, domain.CouponRedemption{CouponID: 5, UserID: 1, OrderID: 1, Discount: testutil.THB("50.00")}).
+				Return(nil).
+				Once()
+			mockEventRepo.EXPECT().Create(ctx, domain.OrderEvent{OrderID: 1, ToStatus: "pending"}).Return(&domain.OrderEvent{ID: "1"}, nil).Once()
+
+			order, err := service.CreateOrder(ctx, portordersvc.CreateOrderInput{UserID: 1, Items: lines(100, 5), CouponCode: " save10"})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(order.TotalPrice).To(Equal(testutil.THB("449.95")))
+			Expect(order.Discount).To(Equal(testutil.THB("50.00")))
+		})
+
+		It("should name a coupon that does not exist with the other fields", func() {
+			mockUserRepo.EXPECT().GetByID(ctx, 1).Return(user, nil).Once()
+			mockCouponRepo.EXPECT().GetByCode(ctx, "NOPE").Return(nil, fmt.Errorf("coupon %w", domain.ErrNotFound)).Once()
+			mockProductRepo.EXPECT().GetByID(ctx, 100).Return(nil, fmt.Errorf("product %w", domain.ErrNotFound)).Once()
+
+			_, err := service.CreateOrder(ctx, portordersvc.CreateOrderInput{UserID: 1, Items: lines(100, 5), CouponCode: "NOPE"})
+
+			Expect(err).To(Equal(domain.ValidationError{
+				{Field: "coupon_code", Message: "coupon NOPE does not exist"},
+				{Field: "items[0].product_id", Message: "product 100 does not exist"},
+			}))
+		})
+
+		It("should refuse a coupon the order does not qualify for without reserving stock", func() {
+			coupon := &domain.Coupon{ID: "5", Code: "BIG", Type: domain.CouponFixed, Amount: testutil.THB("100.00"), MinOrderValue: testutil.THB("1000.00")}
+
+			mockUserRepo.EXPECT().GetByID(ctx, 1).Return(user, nil).Once()
+			mockCouponRepo.EXPECT().GetByCode(ctx, "BIG").Return(coupon, nil).Once()
+			mockProductRepo.EXPECT().GetByID(ctx, 100).Return(product, nil).Once()
+
+			order, err := service.CreateOrder(ctx, portordersvc.CreateOrderInput{UserID: 1, Items: lines(100, 5), CouponCode: "BIG"})
+
+			Expect(err).To(Equal(domain.ValidationError{
+				{Field: "coupon_code", Message: "coupon BIG needs an order of at least 1000.00 THB"},
+			}))
+			Expect(order).To(BeNil())
+		})
+
+		It("should fail the order when the coupon is used up", func() {
+			coupon := &domain.Coupon{ID: "5", Code: "ONCE", Type: domain.CouponFixed, Amount: testutil.THB("10.00"), PerUserLimit: 1}
+
+			mockUserRepo.EXPECT().GetByID(ctx, 1).Return(user, nil).Once()
+			mockCouponRepo.EXPECT().GetByCode(ctx, "ONCE").Return(coupon, nil).Once()
+			mockProductRepo.EXPECT().GetByID(ctx, 100).Return(product, nil).Once()
+			mockProductRepo.EXPECT().ReserveStock(ctx, 100, 5).Return(nil).Once()
+			mockRepo.EXPECT().Create(ctx, mock.Anything).Return(&domain.Order{ID: "1", UserID: 1, Discount: testutil.THB("10.00")}, nil).Once()
+			mockCouponRepo.EXPECT().Redeem(ctx, mock.Anything).Return(fmt.Errorf("coupon ONCE %w for user 1", domain.ErrCouponUsedUp)).Once()
+
+			order, err := service.CreateOrder(ctx, portordersvc.CreateOrderInput{UserID: 1, Items: lines(100, 5), CouponCode: "ONCE"})
+
+			Expect(err).To(MatchError(domain.ErrCouponUsedUp))
+			Expect(order).To(BeNil())
+		})
+
 		It("should return error when repository fails", func() {
 			expectedError := errors.New("database error")
 
+			mockUserRepo.EXPECT().GetByID(ctx, 1).Return(user, nil).Once()
+			mockProductRepo.EXPECT().GetByID(ctx, 100).Return(product, nil).Once()
+			mockProductRepo.EXPECT().ReserveStock(ctx, 100, 5).Return(nil).Once()
 			mockRepo.EXPECT().
-				Create(ctx, 1, 100, 5, 499.99, "pending").
+				Create(ctx, domain.Order{UserID: 1, Items: priced, TotalPrice: testutil.THB("499.95"), Currency: "THB", ExchangeRate: domain.RateOne, Status: "pending"}).
 				Return(nil, expectedError).
 				Once()
 
-			order, err := service.CreateOrder(ctx, 1, 100, 5, 499.99, "pending")
+			order, err := service.CreateOrder(ctx, portordersvc.CreateOrderInput{UserID: 1, Items: lines(100, 5)})
 
 			Expect(err).To(MatchError(expectedError))
 			Expect(order).To(BeNil())
 		})
 
-		It("should default status to pending when status is empty", func() {
-			expectedOrder := &domain.Order{
-				ID:         "1",
-				UserID:     1,
-				ProductID:  100,
-				Quantity:   5,
-				TotalPrice: 499.99,
-				Status:     "pending",
-			}
+		It("should name the field of a missing product", func() {
+			mockUserRepo.EXPECT().GetByID(ctx, 1).Return(user, nil).Once()
+			mockProductRepo.EXPECT().GetByID(ctx, 100).Return(nil, fmt.Errorf("product %w", domain.ErrNotFound)).Once()
 
-			mockRepo.EXPECT().
-				Create(ctx, 1, 100, 5, 499.99, "pending").
-				Return(expectedOrder, nil).
-				Once()
+			order, err := service.CreateOrder(ctx, portordersvc.CreateOrderInput{UserID: 1, Items: lines(100, 5)})
 
-			order, err := service.CreateOrder(ctx, 1, 100, 5, 499.99, "")
+			Expect(err).To(MatchError(domain.ErrValidation))
+			Expect(err).To(Equal(domain.ValidationError{
+				{Field: "items[0].product_id", Message: "product 100 does not exist"},
+			}))
+			Expect(order).To(BeNil())
+		})
 
-			Expect(err).ToNot(HaveOccurred())
-			Expect(*order).To(Equal(*expectedOrder))
+		It("should name every missing reference", func() {
+			mockUserRepo.EXPECT().GetByID(ctx, 7).Return(nil, fmt.Errorf("user %w", domain.ErrNotFound)).Once()
+			mockProductRepo.EXPECT().GetByID(ctx, 100).Return(nil, fmt.Errorf("product %w", domain.ErrNotFound)).Once()
+
+			_, err := service.CreateOrder(ctx, portordersvc.CreateOrderInput{UserID: 7, Items: lines(100, 5)})
+
+			Expect(err).To(Equal(domain.ValidationError{
+				{Field: "user_id", Message: "user 7 does not exist"},
+				{Field: "items[0].product_id", Message: "product 100 does not exist"},
+			}))
+		})
+
+		It("should refuse a product that is out of stock", func() {
+			product.Stock = 0
+			mockUserRepo.EXPECT().GetByID(ctx, 1).Return(user, nil).Once()
+			mockProductRepo.EXPECT().GetByID(ctx, 100).Return(product, nil).Once()
+
+			order, err := service.CreateOrder(ctx, portordersvc.CreateOrderInput{UserID: 1, Items: lines(100, 1)})
+
+			Expect(err).To(Equal(domain.ValidationError{
+				{Field: "items[0].product_id", Message: "product 100 is out of stock"},
+			}))
+			Expect(order).To(BeNil())
+		})
+
+		It("should name the fields of malformed items", func() {
+			mockUserRepo.EXPECT().GetByID(ctx, 1).Return(user, nil).Once()
+			mockProductRepo.EXPECT().GetByID(ctx, 100).Return(product, nil).Once()
+
+			order, err := service.CreateOrder(ctx, portordersvc.CreateOrderInput{UserID: 1, Items: []domain.OrderItem{
+				{ProductID: 100, Quantity: 0},
+				{ProductID: 100, Quantity: 1},
+				{Quantity: 1},
+			}})
+
+			Expect(err).To(Equal(domain.ValidationError{
+				{Field: "items[0].quantity", Message: "quantity must be greater than 0"},
+				{Field: "items[1].product_id", Message: "product 100 is already on the order"},
+				{Field: "items[2].product_id", Message: "product_id is required"},
+			}))
+			Expect(order).To(BeNil())
+		})
+
+		It("should require at least one item", func() {
+			mockUserRepo.EXPECT().GetByID(ctx, 1).Return(user, nil).Once()
+
+			order, err := service.CreateOrder(ctx, portordersvc.CreateOrderInput{UserID: 1, Items: nil})
+
+			Expect(err).To(Equal(domain.ValidationError{
+				{Field: "items", Message: "at least one item is required"},
+			}))
+			Expect(order).To(BeNil())
+		})
+
+		It("should return a failed lookup as is", func() {
+			expectedError := errors.New("database error")
+			mockUserRepo.EXPECT().GetByID(ctx, 1).Return(nil, expectedError).Once()
+
+			order, err := service.CreateOrder(ctx, portordersvc.CreateOrderInput{UserID: 1, Items: lines(100, 5)})
+
+			Expect(err).To(MatchError(expectedError))
+			Expect(order).To(BeNil())
+		})
+
+		It("should not create an order when stock is short", func() {
+			mockUserRepo.EXPECT().GetByID(ctx, 1).Return(user, nil).Once()
+			mockProductRepo.EXPECT().GetByID(ctx, 100).Return(product, nil).Once()
+			mockProductRepo.EXPECT().ReserveStock(ctx, 100, 11).Return(domain.ErrInsufficientStock).Once()
+
+			order, err := service.CreateOrder(ctx, portordersvc.CreateOrderInput{UserID: 1, Items: lines(100, 11)})
+
+			Expect(err).To(MatchError(domain.ErrInsufficientStock))
+			Expect(order).To(BeNil())
+		})
+
+		It("should return the error that rolled the transaction back", func() {
+			txManager := mocktxmanager.NewMockManager(GinkgoT())
+			service = ordersvc.New(mockRepo, mockUserRepo, mockProductRepo, mockEventRepo, mockRateRepo, mockCouponRepo, txManager)
+			expectedError := errors.New("commit failed")
+
+			txManager.EXPECT().WithinTx(ctx, mock.Anything).Return(expectedError).Once()
+
+			order, err := service.CreateOrder(ctx, portordersvc.CreateOrderInput{UserID: 1, Items: lines(100, 5)})
+
+			Expect(err).To(MatchError(expectedError))
+			Expect(order).To(BeNil())
 		})
 	})
 })
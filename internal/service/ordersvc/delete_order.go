@@ -2,14 +2,30 @@ package ordersvc
 
 import (
 	"context"
+	"fmt"
 	"strconv"
+
+	"gin-swagger-api/internal/domain"
 )
 
-func (s *Service) DeleteOrder(ctx context.Context, id string) error {
+// DeleteOrder soft-deletes an order and, unless it has shipped, returns
+// its reserved quantities to product stock in the same transaction.
+func (s *Service) DeleteOrder(ctx context.Context, id, version string) error {
 	intID, err := strconv.Atoi(id)
 	if err != nil {
-		return err
+		return fmt.Errorf("%w: %q", domain.ErrInvalidID, id)
 	}
 
-	return s.orderRepo.Delete(ctx, intID)
+	return s.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		current, err := s.loadForWrite(ctx, intID, version)
+		if err != nil {
+			return err
+		}
+
+		if err := s.moveStocks(ctx, current.Releasable(), nil); err != nil {
+			return err
+		}
+
+		return writeError(s.orderRepo.Delete(ctx, intID, current.Version()), version)
+	})
 }
@@ -3,48 +3,79 @@ package ordersvc_test
 import (
 	"context"
 	"errors"
+	"fmt"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	"gin-swagger-api/internal/domain"
 	portordersvc "gin-swagger-api/internal/port/service/ordersvc"
 	"gin-swagger-api/internal/service/ordersvc"
+	"gin-swagger-api/internal/testutil"
+	mockcouponrepo "gin-swagger-api/mock/repository/couponrepo"
+	mockordereventrepo "gin-swagger-api/mock/repository/ordereventrepo"
 	mockorderrepo "gin-swagger-api/mock/repository/orderrepo"
+	mockproductrepo "gin-swagger-api/mock/repository/productrepo"
+	mockraterepo "gin-swagger-api/mock/repository/raterepo"
+	mockuserrepo "gin-swagger-api/mock/repository/userrepo"
 )
 
 var _ = Describe("OrderService DeleteOrder", func() {
 	var (
-		mockRepo *mockorderrepo.MockRepository
-		service  portordersvc.Service
-		ctx      context.Context
+		mockRepo        *mockorderrepo.MockRepository
+		mockProductRepo *mockproductrepo.MockRepository
+		service         portordersvc.Service
+		ctx             context.Context
+		current         *domain.Order
 	)
 
 	BeforeEach(func() {
 		mockRepo = mockorderrepo.NewMockRepository(GinkgoT())
-		service = ordersvc.New(mockRepo)
+		mockProductRepo = mockproductrepo.NewMockRepository(GinkgoT())
+		service = ordersvc.New(mockRepo, mockuserrepo.NewMockRepository(GinkgoT()), mockProductRepo, mockordereventrepo.NewMockRepository(GinkgoT()), mockraterepo.NewMockRepository(GinkgoT()), mockcouponrepo.NewMockRepository(GinkgoT()), testutil.NewTxManager(GinkgoT()))
 		ctx = context.Background()
+		current = &domain.Order{ID: "1", UserID: 1, Items: []domain.OrderItem{domain.NewOrderItem(100, 2, testutil.THB("10.00"))}, TotalPrice: testutil.THB("20.0"), Status: "pending"}
 	})
 
 	Describe("DeleteOrder", func() {
 		Context("when deleting an existing order", func() {
-			It("should delete order successfully", func() {
+			It("should delete order and release its stock", func() {
+				mockRepo.EXPECT().GetByID(ctx, 1, domain.OrderExpand{}).Return(current, nil).Once()
+				mockProductRepo.EXPECT().ReleaseStock(ctx, 100, 2).Return(nil).Once()
 				mockRepo.EXPECT().
-					Delete(ctx, 1).
+					Delete(ctx, 1, current.Version()).
 					Return(nil).
 					Once()
 
-				err := service.DeleteOrder(ctx, "1")
+				err := service.DeleteOrder(ctx, "1", "")
 
 				Expect(err).ToNot(HaveOccurred())
 			})
 
-			It("should return no error for valid order ID", func() {
+			It("should not release stock of a cancelled order", func() {
+				current.Status = "cancelled"
+
+				mockRepo.EXPECT().GetByID(ctx, 1, domain.OrderExpand{}).Return(current, nil).Once()
 				mockRepo.EXPECT().
-					Delete(ctx, 5).
+					Delete(ctx, 1, current.Version()).
 					Return(nil).
 					Once()
 
-				err := service.DeleteOrder(ctx, "5")
+				err := service.DeleteOrder(ctx, "1", "")
+
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("should not return the shipped stock of a delivered order", func() {
+				current.Status = domain.OrderStatusDelivered
+
+				mockRepo.EXPECT().GetByID(ctx, 1, domain.OrderExpand{}).Return(current, nil).Once()
+				mockRepo.EXPECT().
+					Delete(ctx, 1, current.Version()).
+					Return(nil).
+					Once()
+
+				err := service.DeleteOrder(ctx, "1", "")
 
 				Expect(err).ToNot(HaveOccurred())
 			})
@@ -52,30 +83,63 @@ var _ = Describe("OrderService DeleteOrder", func() {
 
 		Context("when order does not exist", func() {
 			It("should return error from repository", func() {
-				expectedError := errors.New("order not found")
+				mockRepo.EXPECT().GetByID(ctx, 999, domain.OrderExpand{}).Return(nil, fmt.Errorf("order %w", domain.ErrNotFound)).Once()
+
+				err := service.DeleteOrder(ctx, "999", "")
+
+				Expect(err).To(MatchError(domain.ErrNotFound))
+			})
+		})
+
+		Context("when the order has changed since the expected version", func() {
+			It("should return a precondition failure", func() {
+				mockRepo.EXPECT().GetByID(ctx, 1, domain.OrderExpand{}).Return(current, nil).Once()
 
+				err := service.DeleteOrder(ctx, "1", "abc123")
+
+				Expect(err).To(MatchError(domain.ErrPreconditionFailed))
+			})
+
+			It("should delete the order at the expected version", func() {
+				mockRepo.EXPECT().GetByID(ctx, 1, domain.OrderExpand{}).Return(current, nil).Once()
+				mockProductRepo.EXPECT().ReleaseStock(ctx, 100, 2).Return(nil).Once()
 				mockRepo.EXPECT().
-					Delete(ctx, 999).
-					Return(expectedError).
+					Delete(ctx, 1, current.Version()).
+					Return(nil).
 					Once()
 
-				err := service.DeleteOrder(ctx, "999")
+				err := service.DeleteOrder(ctx, "1", current.Version())
 
-				Expect(err).To(MatchError(expectedError))
+				Expect(err).ToNot(HaveOccurred())
+			})
+		})
+
+		Context("when a concurrent write changes the order", func() {
+			It("should return a conflict to a caller that sent no version", func() {
+				mockRepo.EXPECT().GetByID(ctx, 1, domain.OrderExpand{}).Return(current, nil).Once()
+				mockProductRepo.EXPECT().ReleaseStock(ctx, 100, 2).Return(nil).Once()
+				mockRepo.EXPECT().
+					Delete(ctx, 1, current.Version()).
+					Return(domain.ErrPreconditionFailed).
+					Once()
+
+				err := service.DeleteOrder(ctx, "1", "")
+
+				Expect(err).To(MatchError(domain.ErrConflict))
 			})
 		})
 
 		Context("when invalid ID is provided", func() {
 			It("should return error for non-numeric ID", func() {
-				err := service.DeleteOrder(ctx, "invalid")
+				err := service.DeleteOrder(ctx, "invalid", "")
 
-				Expect(err).To(HaveOccurred())
+				Expect(err).To(MatchError(domain.ErrInvalidID))
 			})
 
 			It("should return error for empty ID", func() {
-				err := service.DeleteOrder(ctx, "")
+				err := service.DeleteOrder(ctx, "", "")
 
-				Expect(err).To(HaveOccurred())
+				Expect(err).To(MatchError(domain.ErrInvalidID))
 			})
 		})
 
@@ -83,12 +147,14 @@ var _ = Describe("OrderService DeleteOrder", func() {
 			It("should return error from repository", func() {
 				expectedError := errors.New("database deletion failed")
 
+				mockRepo.EXPECT().GetByID(ctx, 1, domain.OrderExpand{}).Return(current, nil).Once()
+				mockProductRepo.EXPECT().ReleaseStock(ctx, 100, 2).Return(nil).Once()
 				mockRepo.EXPECT().
-					Delete(ctx, 1).
+					Delete(ctx, 1, current.Version()).
 					Return(expectedError).
 					Once()
 
-				err := service.DeleteOrder(ctx, "1")
+				err := service.DeleteOrder(ctx, "1", "")
 
 				Expect(err).To(MatchError(expectedError))
 			})
@@ -0,0 +1,20 @@
+package ordersvc
+
+import (
+	"context"
+	"fmt"
+
+	"gin-swagger-api/internal/domain"
+)
+
+func (s *Service) ExportOrders(ctx context.Context, list domain.ListQuery, fn func([]domain.Order) error) error {
+	list, err := domain.OrderFields.Resolve(list)
+	if err != nil {
+		return err
+	}
+	if len(list.Sort) > 0 {
+		return fmt.Errorf("%w: exports are ordered by id and cannot be sorted", domain.ErrInvalidArgument)
+	}
+
+	return s.orderRepo.Stream(ctx, list, domain.ExportBatchSize, fn)
+}
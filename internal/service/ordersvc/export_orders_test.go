@@ -0,0 +1,86 @@
+package ordersvc_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"gin-swagger-api/internal/domain"
+	portordersvc "gin-swagger-api/internal/port/service/ordersvc"
+	"gin-swagger-api/internal/service/ordersvc"
+	"gin-swagger-api/internal/testutil"
+	mockcouponrepo "gin-swagger-api/mock/repository/couponrepo"
+	mockordereventrepo "gin-swagger-api/mock/repository/ordereventrepo"
+	mockorderrepo "gin-swagger-api/mock/repository/orderrepo"
+	mockproductrepo "gin-swagger-api/mock/repository/productrepo"
+	mockraterepo "gin-swagger-api/mock/repository/raterepo"
+	mocktxmanager "gin-swagger-api/mock/repository/txmanager"
+	mockuserrepo "gin-swagger-api/mock/repository/userrepo"
+)
+
+var _ = Describe("OrderService ExportOrders", func() {
+	var (
+		mockRepo *mockorderrepo.MockRepository
+		service  portordersvc.Service
+		ctx      context.Context
+	)
+
+	BeforeEach(func() {
+		mockRepo = mockorderrepo.NewMockRepository(GinkgoT())
+		service = ordersvc.New(mockRepo, mockuserrepo.NewMockRepository(GinkgoT()), mockproductrepo.NewMockRepository(GinkgoT()), mockordereventrepo.NewMockRepository(GinkgoT()), mockraterepo.NewMockRepository(GinkgoT()), mockcouponrepo.NewMockRepository(GinkgoT()), mocktxmanager.NewMockManager(GinkgoT()))
+		ctx = context.Background()
+	})
+
+	Describe("ExportOrders", func() {
+		It("should stream the orders in batches with resolved filters", func() {
+			first := []domain.Order{{ID: "1", UserID: 1, Items: []domain.OrderItem{domain.NewOrderItem(1, 2, testutil.THB("50.00"))}, TotalPrice: testutil.THB("100.00"), Status: "paid"}}
+			second := []domain.Order{{ID: "2", UserID: 2, Items: []domain.OrderItem{domain.NewOrderItem(3, 1, testutil.THB("20.00"))}, TotalPrice: testutil.THB("20.00"), Status: "paid"}}
+			mockRepo.EXPECT().
+				Stream(ctx, domain.ListQuery{Filters: []domain.Filter{{Field: "created_at", Op: domain.OpGt, Values: []any{time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC)}}}}, domain.ExportBatchSize, mock.Anything).
+				RunAndReturn(func(_ context.Context, _ domain.ListQuery, _ int, fn func([]domain.Order) error) error {
+					if err := fn(first); err != nil {
+						return err
+					}
+					return fn(second)
+				}).
+				Once()
+
+			var batches [][]domain.Order
+			err := service.ExportOrders(ctx, domain.ListQuery{Filters: []domain.Filter{{Field: "created_at", Op: domain.OpGt, Values: []any{"2026-01-01"}}}}, func(batch []domain.Order) error {
+				batches = append(batches, batch)
+				return nil
+			})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(batches).To(Equal([][]domain.Order{first, second}))
+		})
+
+		It("should reject a sort", func() {
+			err := service.ExportOrders(ctx, domain.ListQuery{Sort: []domain.Sort{{Field: "total_price"}}}, func([]domain.Order) error { return nil })
+
+			Expect(err).To(MatchError(domain.ErrInvalidArgument))
+		})
+
+		It("should reject an unknown filter field", func() {
+			err := service.ExportOrders(ctx, domain.ListQuery{Filters: []domain.Filter{{Field: "secret", Op: domain.OpEq, Values: []any{"x"}}}}, func([]domain.Order) error { return nil })
+
+			Expect(err).To(MatchError(domain.ErrInvalidArgument))
+		})
+
+		It("should return error when repository fails", func() {
+			expectedError := errors.New("database error")
+			mockRepo.EXPECT().
+				Stream(ctx, domain.ListQuery{}, domain.ExportBatchSize, mock.Anything).
+				Return(expectedError).
+				Once()
+
+			err := service.ExportOrders(ctx, domain.ListQuery{}, func([]domain.Order) error { return nil })
+
+			Expect(err).To(MatchError(expectedError))
+		})
+	})
+})
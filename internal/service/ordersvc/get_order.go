@@ -2,16 +2,17 @@ package ordersvc
 
 import (
 	"context"
+	"fmt"
 	"strconv"
 
 	"gin-swagger-api/internal/domain"
 )
 
-func (s *Service) GetOrder(ctx context.Context, id string) (*domain.Order, error) {
+func (s *Service) GetOrder(ctx context.Context, id string, expand domain.OrderExpand) (*domain.Order, error) {
 	intID, err := strconv.Atoi(id)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("%w: %q", domain.ErrInvalidID, id)
 	}
 
-	return s.orderRepo.GetByID(ctx, intID)
+	return s.orderRepo.GetByID(ctx, intID, expand)
 }
@@ -0,0 +1,23 @@
+package ordersvc
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"gin-swagger-api/internal/domain"
+)
+
+// GetOrderHistory retrieves the status changes of an order, oldest first
+func (s *Service) GetOrderHistory(ctx context.Context, id string) ([]domain.OrderEvent, error) {
+	intID, err := strconv.Atoi(id)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %q", domain.ErrInvalidID, id)
+	}
+
+	if _, err := s.orderRepo.GetByID(ctx, intID, domain.OrderExpand{}); err != nil {
+		return nil, err
+	}
+
+	return s.eventRepo.GetByOrderID(ctx, intID)
+}
@@ -0,0 +1,83 @@
+package ordersvc_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"gin-swagger-api/internal/domain"
+	portordersvc "gin-swagger-api/internal/port/service/ordersvc"
+	"gin-swagger-api/internal/service/ordersvc"
+	mockcouponrepo "gin-swagger-api/mock/repository/couponrepo"
+	mockordereventrepo "gin-swagger-api/mock/repository/ordereventrepo"
+	mockorderrepo "gin-swagger-api/mock/repository/orderrepo"
+	mockproductrepo "gin-swagger-api/mock/repository/productrepo"
+	mockraterepo "gin-swagger-api/mock/repository/raterepo"
+	mocktxmanager "gin-swagger-api/mock/repository/txmanager"
+	mockuserrepo "gin-swagger-api/mock/repository/userrepo"
+)
+
+var _ = Describe("OrderService GetOrderHistory", func() {
+	var (
+		mockRepo      *mockorderrepo.MockRepository
+		mockEventRepo *mockordereventrepo.MockRepository
+		service       portordersvc.Service
+		ctx           context.Context
+	)
+
+	BeforeEach(func() {
+		mockRepo = mockorderrepo.NewMockRepository(GinkgoT())
+		mockEventRepo = mockordereventrepo.NewMockRepository(GinkgoT())
+		service = ordersvc.New(mockRepo, mockuserrepo.NewMockRepository(GinkgoT()), mockproductrepo.NewMockRepository(GinkgoT()), mockEventRepo, mockraterepo.NewMockRepository(GinkgoT()), mockcouponrepo.NewMockRepository(GinkgoT()), mocktxmanager.NewMockManager(GinkgoT()))
+		ctx = context.Background()
+	})
+
+	Describe("GetOrderHistory", func() {
+		It("should return the status changes of the order", func() {
+			events := []domain.OrderEvent{
+				{ID: "1", OrderID: 1, ToStatus: "pending", CreatedAt: time.Now()},
+				{ID: "2", OrderID: 1, FromStatus: "pending", ToStatus: "cancelled", Actor: "api-key:1a2b3c4d", CreatedAt: time.Now()},
+			}
+
+			mockRepo.EXPECT().GetByID(ctx, 1, domain.OrderExpand{}).Return(&domain.Order{ID: "1"}, nil).Once()
+			mockEventRepo.EXPECT().GetByOrderID(ctx, 1).Return(events, nil).Once()
+
+			history, err := service.GetOrderHistory(ctx, "1")
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(history).To(Equal(events))
+		})
+
+		It("should return not found for a missing order", func() {
+			mockRepo.EXPECT().GetByID(ctx, 999, domain.OrderExpand{}).Return(nil, fmt.Errorf("order %w", domain.ErrNotFound)).Once()
+
+			history, err := service.GetOrderHistory(ctx, "999")
+
+			Expect(err).To(MatchError(domain.ErrNotFound))
+			Expect(history).To(BeNil())
+		})
+
+		It("should return error from repository", func() {
+			expectedError := errors.New("database error")
+
+			mockRepo.EXPECT().GetByID(ctx, 1, domain.OrderExpand{}).Return(&domain.Order{ID: "1"}, nil).Once()
+			mockEventRepo.EXPECT().GetByOrderID(ctx, 1).Return(nil, expectedError).Once()
+
+			history, err := service.GetOrderHistory(ctx, "1")
+
+			Expect(err).To(MatchError(expectedError))
+			Expect(history).To(BeNil())
+		})
+
+		It("should return error for non-numeric ID", func() {
+			history, err := service.GetOrderHistory(ctx, "abc")
+
+			Expect(err).To(MatchError(domain.ErrInvalidID))
+			Expect(history).To(BeNil())
+		})
+	})
+})
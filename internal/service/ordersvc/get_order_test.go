@@ -10,7 +10,14 @@ import (
 	"gin-swagger-api/internal/domain"
 	portordersvc "gin-swagger-api/internal/port/service/ordersvc"
 	"gin-swagger-api/internal/service/ordersvc"
+	"gin-swagger-api/internal/testutil"
+	mockcouponrepo "gin-swagger-api/mock/repository/couponrepo"
+	mockordereventrepo "gin-swagger-api/mock/repository/ordereventrepo"
 	mockorderrepo "gin-swagger-api/mock/repository/orderrepo"
+	mockproductrepo "gin-swagger-api/mock/repository/productrepo"
+	mockraterepo "gin-swagger-api/mock/repository/raterepo"
+	mocktxmanager "gin-swagger-api/mock/repository/txmanager"
+	mockuserrepo "gin-swagger-api/mock/repository/userrepo"
 )
 
 var _ = Describe("OrderService GetOrder", func() {
@@ -22,7 +29,7 @@ var _ = Describe("OrderService GetOrder", func() {
 
 	BeforeEach(func() {
 		mockRepo = mockorderrepo.NewMockRepository(GinkgoT())
-		service = ordersvc.New(mockRepo)
+		service = ordersvc.New(mockRepo, mockuserrepo.NewMockRepository(GinkgoT()), mockproductrepo.NewMockRepository(GinkgoT()), mockordereventrepo.NewMockRepository(GinkgoT()), mockraterepo.NewMockRepository(GinkgoT()), mockcouponrepo.NewMockRepository(GinkgoT()), mocktxmanager.NewMockManager(GinkgoT()))
 		ctx = context.Background()
 	})
 
@@ -32,45 +39,55 @@ var _ = Describe("OrderService GetOrder", func() {
 				expectedOrder := &domain.Order{
 					ID:         "1",
 					UserID:     1,
-					ProductID:  100,
-					Quantity:   5,
-					TotalPrice: 499.99,
+					Items:      []domain.OrderItem{domain.NewOrderItem(100, 5, testutil.THB("100.00"))},
+					TotalPrice: testutil.THB("499.99"),
 					Status:     "pending",
 				}
 
 				mockRepo.EXPECT().
-					GetByID(ctx, 1).
+					GetByID(ctx, 1, domain.OrderExpand{}).
 					Return(expectedOrder, nil).
 					Once()
 
-				order, err := service.GetOrder(ctx, "1")
+				order, err := service.GetOrder(ctx, "1", domain.OrderExpand{})
 
 				Expect(err).ToNot(HaveOccurred())
 				Expect(order).ToNot(BeNil())
 				Expect(order.ID).To(Equal("1"))
 				Expect(order.UserID).To(Equal(1))
-				Expect(order.ProductID).To(Equal(100))
-				Expect(order.Quantity).To(Equal(5))
-				Expect(order.TotalPrice).To(Equal(499.99))
+				Expect(order.Items[0].ProductID).To(Equal(100))
+				Expect(order.Items[0].Quantity).To(Equal(5))
+				Expect(order.TotalPrice).To(Equal(testutil.THB("499.99")))
 				Expect(order.Status).To(Equal("pending"))
 			})
 
+			It("should load the requested relations", func() {
+				expand := domain.OrderExpand{User: true, Product: true}
+				expectedOrder := &domain.Order{ID: "1", UserID: 1, User: &domain.User{ID: "1", Name: "John Doe"}}
+
+				mockRepo.EXPECT().GetByID(ctx, 1, expand).Return(expectedOrder, nil).Once()
+
+				order, err := service.GetOrder(ctx, "1", expand)
+
+				Expect(err).ToNot(HaveOccurred())
+				Expect(order.User).To(Equal(expectedOrder.User))
+			})
+
 			It("should return correct order data structure", func() {
 				expectedOrder := &domain.Order{
 					ID:         "2",
 					UserID:     2,
-					ProductID:  200,
-					Quantity:   3,
-					TotalPrice: 299.99,
+					Items:      []domain.OrderItem{domain.NewOrderItem(200, 3, testutil.THB("100.00"))},
+					TotalPrice: testutil.THB("299.99"),
 					Status:     "completed",
 				}
 
 				mockRepo.EXPECT().
-					GetByID(ctx, 2).
+					GetByID(ctx, 2, domain.OrderExpand{}).
 					Return(expectedOrder, nil).
 					Once()
 
-				order, err := service.GetOrder(ctx, "2")
+				order, err := service.GetOrder(ctx, "2", domain.OrderExpand{})
 
 				Expect(err).ToNot(HaveOccurred())
 				Expect(order).To(BeAssignableToTypeOf(&domain.Order{}))
@@ -84,11 +101,11 @@ var _ = Describe("OrderService GetOrder", func() {
 				expectedError := errors.New("order not found")
 
 				mockRepo.EXPECT().
-					GetByID(ctx, 999).
+					GetByID(ctx, 999, domain.OrderExpand{}).
 					Return(nil, expectedError).
 					Once()
 
-				order, err := service.GetOrder(ctx, "999")
+				order, err := service.GetOrder(ctx, "999", domain.OrderExpand{})
 
 				Expect(err).To(MatchError(expectedError))
 				Expect(order).To(BeNil())
@@ -97,9 +114,9 @@ var _ = Describe("OrderService GetOrder", func() {
 
 		Context("when invalid ID is provided", func() {
 			It("should return error for non-numeric ID", func() {
-				order, err := service.GetOrder(ctx, "invalid")
+				order, err := service.GetOrder(ctx, "invalid", domain.OrderExpand{})
 
-				Expect(err).To(HaveOccurred())
+				Expect(err).To(MatchError(domain.ErrInvalidID))
 				Expect(order).To(BeNil())
 			})
 		})
@@ -6,6 +6,11 @@ import (
 	"gin-swagger-api/internal/domain"
 )
 
-func (s *Service) GetOrders(ctx context.Context) ([]domain.Order, error) {
-	return s.orderRepo.GetAll(ctx)
+func (s *Service) GetOrders(ctx context.Context, list domain.ListQuery, page domain.PageRequest, expand domain.OrderExpand) (*domain.Page[domain.Order], error) {
+	list, err := domain.OrderFields.Resolve(list)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.orderRepo.GetAll(ctx, list, page.Normalize(), expand)
 }
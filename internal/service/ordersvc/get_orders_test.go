@@ -10,7 +10,14 @@ import (
 	"gin-swagger-api/internal/domain"
 	portordersvc "gin-swagger-api/internal/port/service/ordersvc"
 	"gin-swagger-api/internal/service/ordersvc"
+	"gin-swagger-api/internal/testutil"
+	mockcouponrepo "gin-swagger-api/mock/repository/couponrepo"
+	mockordereventrepo "gin-swagger-api/mock/repository/ordereventrepo"
 	mockorderrepo "gin-swagger-api/mock/repository/orderrepo"
+	mockproductrepo "gin-swagger-api/mock/repository/productrepo"
+	mockraterepo "gin-swagger-api/mock/repository/raterepo"
+	mocktxmanager "gin-swagger-api/mock/repository/txmanager"
+	mockuserrepo "gin-swagger-api/mock/repository/userrepo"
 )
 
 var _ = Describe("OrderService GetOrders", func() {
@@ -22,7 +29,7 @@ var _ = Describe("OrderService GetOrders", func() {
 
 	BeforeEach(func() {
 		mockRepo = mockorderrepo.NewMockRepository(GinkgoT())
-		service = ordersvc.New(mockRepo)
+		service = ordersvc.New(mockRepo, mockuserrepo.NewMockRepository(GinkgoT()), mockproductrepo.NewMockRepository(GinkgoT()), mockordereventrepo.NewMockRepository(GinkgoT()), mockraterepo.NewMockRepository(GinkgoT()), mockcouponrepo.NewMockRepository(GinkgoT()), mocktxmanager.NewMockManager(GinkgoT()))
 		ctx = context.Background()
 	})
 
@@ -33,31 +40,30 @@ var _ = Describe("OrderService GetOrders", func() {
 					{
 						ID:         "1",
 						UserID:     1,
-						ProductID:  100,
-						Quantity:   5,
-						TotalPrice: 499.99,
+						Items:      []domain.OrderItem{domain.NewOrderItem(100, 5, testutil.THB("100.00"))},
+						TotalPrice: testutil.THB("499.99"),
 						Status:     "pending",
 					},
 					{
 						ID:         "2",
 						UserID:     2,
-						ProductID:  200,
-						Quantity:   3,
-						TotalPrice: 299.99,
+						Items:      []domain.OrderItem{domain.NewOrderItem(200, 3, testutil.THB("100.00"))},
+						TotalPrice: testutil.THB("299.99"),
 						Status:     "completed",
 					},
 				}
 
 				mockRepo.EXPECT().
-					GetAll(ctx).
-					Return(expectedOrders, nil).
+					GetAll(ctx, domain.ListQuery{}, domain.PageRequest{Limit: domain.DefaultPageSize}, domain.OrderExpand{}).
+					Return(&domain.Page[domain.Order]{Items: expectedOrders, Total: len(expectedOrders), Limit: domain.DefaultPageSize}, nil).
 					Once()
 
-				orders, err := service.GetOrders(ctx)
+				page, err := service.GetOrders(ctx, domain.ListQuery{}, domain.PageRequest{}, domain.OrderExpand{})
 
 				Expect(err).ToNot(HaveOccurred())
-				Expect(orders).ToNot(BeNil())
-				Expect(orders).To(HaveLen(2))
+				Expect(page).ToNot(BeNil())
+				Expect(page.Total).To(Equal(2))
+				Expect(page.Items).To(HaveLen(2))
 			})
 
 			It("should return orders with correct data", func() {
@@ -65,42 +71,97 @@ var _ = Describe("OrderService GetOrders", func() {
 					{
 						ID:         "1",
 						UserID:     1,
-						ProductID:  100,
-						Quantity:   5,
-						TotalPrice: 499.99,
+						Items:      []domain.OrderItem{domain.NewOrderItem(100, 5, testutil.THB("100.00"))},
+						TotalPrice: testutil.THB("499.99"),
 						Status:     "pending",
 					},
 					{
 						ID:         "2",
 						UserID:     2,
-						ProductID:  200,
-						Quantity:   3,
-						TotalPrice: 299.99,
+						Items:      []domain.OrderItem{domain.NewOrderItem(200, 3, testutil.THB("100.00"))},
+						TotalPrice: testutil.THB("299.99"),
 						Status:     "completed",
 					},
 				}
 
 				mockRepo.EXPECT().
-					GetAll(ctx).
-					Return(expectedOrders, nil).
+					GetAll(ctx, domain.ListQuery{}, domain.PageRequest{Limit: domain.DefaultPageSize}, domain.OrderExpand{}).
+					Return(&domain.Page[domain.Order]{Items: expectedOrders, Total: len(expectedOrders), Limit: domain.DefaultPageSize}, nil).
 					Once()
 
-				orders, err := service.GetOrders(ctx)
+				page, err := service.GetOrders(ctx, domain.ListQuery{}, domain.PageRequest{}, domain.OrderExpand{})
 
 				Expect(err).ToNot(HaveOccurred())
-				Expect(orders[0].ID).To(Equal("1"))
-				Expect(orders[0].UserID).To(Equal(1))
-				Expect(orders[0].ProductID).To(Equal(100))
-				Expect(orders[0].Quantity).To(Equal(5))
-				Expect(orders[0].TotalPrice).To(Equal(499.99))
-				Expect(orders[0].Status).To(Equal("pending"))
-
-				Expect(orders[1].ID).To(Equal("2"))
-				Expect(orders[1].UserID).To(Equal(2))
-				Expect(orders[1].ProductID).To(Equal(200))
-				Expect(orders[1].Quantity).To(Equal(3))
-				Expect(orders[1].TotalPrice).To(Equal(299.99))
-				Expect(orders[1].Status).To(Equal("completed"))
+				Expect(page.Items[0].ID).To(Equal("1"))
+				Expect(page.Items[0].UserID).To(Equal(1))
+				Expect(page.Items[0].Items[0].ProductID).To(Equal(100))
+				Expect(page.Items[0].Items[0].Quantity).To(Equal(5))
+				Expect(page.Items[0].TotalPrice).To(Equal(testutil.THB("499.99")))
+				Expect(page.Items[0].Status).To(Equal("pending"))
+
+				Expect(page.Items[1].ID).To(Equal("2"))
+				Expect(page.Items[1].UserID).To(Equal(2))
+				Expect(page.Items[1].Items[0].ProductID).To(Equal(200))
+				Expect(page.Items[1].Items[0].Quantity).To(Equal(3))
+				Expect(page.Items[1].TotalPrice).To(Equal(testutil.THB("299.99")))
+				Expect(page.Items[1].Status).To(Equal("completed"))
+			})
+		})
+
+		Context("when paging orders", func() {
+			It("should pass the requested window to the repository", func() {
+				mockRepo.EXPECT().
+					GetAll(ctx, domain.ListQuery{}, domain.PageRequest{Limit: 5, Offset: 10}, domain.OrderExpand{}).
+					Return(&domain.Page[domain.Order]{Total: 12, Limit: 5, Offset: 10}, nil).
+					Once()
+
+				page, err := service.GetOrders(ctx, domain.ListQuery{}, domain.PageRequest{Limit: 5, Offset: 10}, domain.OrderExpand{})
+
+				Expect(err).ToNot(HaveOccurred())
+				Expect(page.Offset).To(Equal(10))
+			})
+
+			It("should pass the requested relations to the repository", func() {
+				expand := domain.OrderExpand{User: true}
+				mockRepo.EXPECT().
+					GetAll(ctx, domain.ListQuery{}, domain.PageRequest{Limit: domain.DefaultPageSize}, expand).
+					Return(&domain.Page[domain.Order]{Items: []domain.Order{}}, nil).
+					Once()
+
+				_, err := service.GetOrders(ctx, domain.ListQuery{}, domain.PageRequest{}, expand)
+
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("should cap the page size at the maximum", func() {
+				mockRepo.EXPECT().
+					GetAll(ctx, domain.ListQuery{}, domain.PageRequest{Limit: domain.MaxPageSize}, domain.OrderExpand{}).
+					Return(&domain.Page[domain.Order]{Limit: domain.MaxPageSize}, nil).
+					Once()
+
+				_, err := service.GetOrders(ctx, domain.ListQuery{}, domain.PageRequest{Limit: 250}, domain.OrderExpand{})
+
+				Expect(err).ToNot(HaveOccurred())
+			})
+		})
+
+		Context("when filtering orders", func() {
+			It("should resolve filters before querying the repository", func() {
+				mockRepo.EXPECT().
+					GetAll(ctx, domain.ListQuery{Filters: []domain.Filter{{Field: "user_id", Op: domain.OpEq, Values: []any{5}}}}, domain.PageRequest{Limit: domain.DefaultPageSize}, domain.OrderExpand{}).
+					Return(&domain.Page[domain.Order]{Limit: domain.DefaultPageSize}, nil).
+					Once()
+
+				_, err := service.GetOrders(ctx, domain.ListQuery{Filters: []domain.Filter{{Field: "user_id", Op: domain.OpEq, Values: []any{"5"}}}}, domain.PageRequest{}, domain.OrderExpand{})
+
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("should reject unknown fields without querying the repository", func() {
+				page, err := service.GetOrders(ctx, domain.ListQuery{Sort: []domain.Sort{{Field: "secret"}}}, domain.PageRequest{}, domain.OrderExpand{})
+
+				Expect(err).To(MatchError(domain.ErrInvalidArgument))
+				Expect(page).To(BeNil())
 			})
 		})
 
@@ -109,15 +170,15 @@ var _ = Describe("OrderService GetOrders", func() {
 				expectedOrders := []domain.Order{}
 
 				mockRepo.EXPECT().
-					GetAll(ctx).
-					Return(expectedOrders, nil).
+					GetAll(ctx, domain.ListQuery{}, domain.PageRequest{Limit: domain.DefaultPageSize}, domain.OrderExpand{}).
+					Return(&domain.Page[domain.Order]{Items: expectedOrders, Total: len(expectedOrders), Limit: domain.DefaultPageSize}, nil).
 					Once()
 
-				orders, err := service.GetOrders(ctx)
+				page, err := service.GetOrders(ctx, domain.ListQuery{}, domain.PageRequest{}, domain.OrderExpand{})
 
 				Expect(err).ToNot(HaveOccurred())
-				Expect(orders).ToNot(BeNil())
-				Expect(orders).To(BeEmpty())
+				Expect(page).ToNot(BeNil())
+				Expect(page.Items).To(BeEmpty())
 			})
 		})
 
@@ -126,14 +187,14 @@ var _ = Describe("OrderService GetOrders", func() {
 				expectedError := errors.New("database connection failed")
 
 				mockRepo.EXPECT().
-					GetAll(ctx).
+					GetAll(ctx, domain.ListQuery{}, domain.PageRequest{Limit: domain.DefaultPageSize}, domain.OrderExpand{}).
 					Return(nil, expectedError).
 					Once()
 
-				orders, err := service.GetOrders(ctx)
+				page, err := service.GetOrders(ctx, domain.ListQuery{}, domain.PageRequest{}, domain.OrderExpand{})
 
 				Expect(err).To(MatchError(expectedError))
-				Expect(orders).To(BeNil())
+				Expect(page).To(BeNil())
 			})
 		})
 	})
@@ -0,0 +1,24 @@
+package ordersvc
+
+import (
+	"context"
+
+	"gin-swagger-api/internal/domain"
+)
+
+// recordStatus records a status change of an order, made by the actor in
+// ctx, in its history. Writes that keep the status record nothing.
+func (s *Service) recordStatus(ctx context.Context, orderID int, from, to, reason string) error {
+	if from == to {
+		return nil
+	}
+
+	_, err := s.eventRepo.Create(ctx, domain.OrderEvent{
+		OrderID:    orderID,
+		FromStatus: from,
+		ToStatus:   to,
+		Actor:      domain.ActorFromContext(ctx),
+		Reason:     reason,
+	})
+	return err
+}
@@ -0,0 +1,72 @@
+package ordersvc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"gin-swagger-api/internal/domain"
+)
+
+// priceItems checks the product and quantity of each line and prices it.
+// A product already on current keeps the unit price it was ordered at;
+// any other product must exist and be in stock and is priced at its
+// current price, converted at the exchange rate of current. Failed checks
+// are returned as field errors.
+func (s *Service) priceItems(ctx context.Context, lines []domain.OrderItem, current domain.Order) ([]domain.OrderItem, domain.ValidationError, error) {
+	if len(lines) == 0 {
+		return nil, domain.ValidationError{{Field: "items", Message: "at least one item is required"}}, nil
+	}
+
+	var fields domain.ValidationError
+	items := make([]domain.OrderItem, len(lines))
+	seen := make(map[int]bool, len(lines))
+	for i, line := range lines {
+		field := fmt.Sprintf("items[%d]", i)
+		if line.Quantity <= 0 {
+			fields = append(fields, domain.FieldError{Field: field + ".quantity", Message: "quantity must be greater than 0"})
+		}
+		if line.ProductID <= 0 {
+			fields = append(fields, domain.FieldError{Field: field + ".product_id", Message: "product_id is required"})
+			continue
+		}
+		if seen[line.ProductID] {
+			fields = append(fields, domain.FieldError{Field: field + ".product_id", Message: fmt.Sprintf("product %d is already on the order", line.ProductID)})
+			continue
+		}
+		seen[line.ProductID] = true
+
+		if ordered, ok := current.Item(line.ProductID); ok {
+			items[i] = domain.NewOrderItem(line.ProductID, line.Quantity, ordered.UnitPrice)
+			continue
+		}
+
+		product, err := s.productRepo.GetByID(ctx, line.ProductID)
+		switch {
+		case errors.Is(err, domain.ErrNotFound):
+			fields = append(fields, domain.FieldError{Field: field + ".product_id", Message: fmt.Sprintf("product %d does not exist", line.ProductID)})
+		case err != nil:
+			return nil, nil, err
+		case product.Stock <= 0:
+			fields = append(fields, domain.FieldError{Field: field + ".product_id", Message: fmt.Sprintf("product %d is out of stock", line.ProductID)})
+		default:
+			price, err := current.Pricing().Convert(product.Price)
+			if err != nil {
+				return nil, nil, err
+			}
+			items[i] = domain.NewOrderItem(line.ProductID, line.Quantity, price)
+		}
+	}
+
+	return items, fields, nil
+}
+
+// resolveLines fills in the product of a single line without one from the
+// only item of current, so clients that send just a quantity keep working
+// for single-product orders
+func resolveLines(lines []domain.OrderItem, current domain.Order) []domain.OrderItem {
+	if len(lines) != 1 || lines[0].ProductID != 0 || len(current.Items) != 1 {
+		return lines
+	}
+	return []domain.OrderItem{{ProductID: current.Items[0].ProductID, Quantity: lines[0].Quantity}}
+}
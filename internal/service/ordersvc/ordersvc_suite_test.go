@@ -5,9 +5,16 @@ import (
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+
+	"gin-swagger-api/internal/domain"
 )
 
 func TestOrderSvc(t *testing.T) {
 	RegisterFailHandler(Fail)
 	RunSpecs(t, "OrderSvc Suite")
 }
+
+// lines returns the single unpriced item of an order for a product
+func lines(productID, quantity int) []domain.OrderItem {
+	return []domain.OrderItem{{ProductID: productID, Quantity: quantity}}
+}
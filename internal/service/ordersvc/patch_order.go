@@ -0,0 +1,72 @@
+package ordersvc
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"gin-swagger-api/internal/domain"
+)
+
+// PatchOrder updates the fields set in the patch and moves the change in
+// reserved quantities in or out of product stock in the same transaction.
+// Patched items replace the items of the order and are priced like in
+// UpdateOrder, and can only change while the order is pending or paid. A changed status must follow the order lifecycle and is
+// recorded in the order history.
+func (s *Service) PatchOrder(ctx context.Context, id, version string, patch domain.OrderPatch) (*domain.Order, error) {
+	intID, err := strconv.Atoi(id)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %q", domain.ErrInvalidID, id)
+	}
+
+	patch.TotalPrice = nil
+
+	var order *domain.Order
+	err = s.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		current, err := s.loadForWrite(ctx, intID, version)
+		if err != nil {
+			return err
+		}
+
+		updated := *current
+		if patch.Items != nil {
+			lines := resolveLines(patch.Items, *current)
+			if err := current.CheckItemsChange(lines); err != nil {
+				return err
+			}
+
+			items, fields, err := s.priceItems(ctx, lines, *current)
+			if err != nil {
+				return err
+			}
+			if len(fields) > 0 {
+				return fields
+			}
+			totalPrice, err := current.Total(items)
+			if err != nil {
+				return err
+			}
+			patch.Items, patch.TotalPrice = items, &totalPrice
+			updated.Items = items
+		}
+		if patch.Status != nil {
+			if err := domain.CheckOrderTransition(current.Status, *patch.Status); err != nil {
+				return err
+			}
+			updated.Status = *patch.Status
+		}
+		if err := s.moveStocks(ctx, current.Reserved(), updated.Reserved()); err != nil {
+			return err
+		}
+
+		order, err = s.orderRepo.Patch(ctx, intID, current.Version(), patch)
+		if err != nil {
+			return writeError(err, version)
+		}
+		return s.recordStatus(ctx, intID, current.Status, updated.Status, "")
+	})
+	if err != nil {
+		return nil, err
+	}
+	return order, nil
+}
@@ -0,0 +1,244 @@
+package ordersvc_test
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"gin-swagger-api/internal/domain"
+	portordersvc "gin-swagger-api/internal/port/service/ordersvc"
+	"gin-swagger-api/internal/service/ordersvc"
+	"gin-swagger-api/internal/testutil"
+	mockcouponrepo "gin-swagger-api/mock/repository/couponrepo"
+	mockordereventrepo "gin-swagger-api/mock/repository/ordereventrepo"
+	mockorderrepo "gin-swagger-api/mock/repository/orderrepo"
+	mockproductrepo "gin-swagger-api/mock/repository/productrepo"
+	mockraterepo "gin-swagger-api/mock/repository/raterepo"
+	mockuserrepo "gin-swagger-api/mock/repository/userrepo"
+)
+
+var _ = Describe("OrderService PatchOrder", func() {
+	var (
+		mockRepo        *mockorderrepo.MockRepository
+		mockProductRepo *mockproductrepo.MockRepository
+		mockEventRepo   *mockordereventrepo.MockRepository
+		service         portordersvc.Service
+		ctx             context.Context
+		current         *domain.Order
+	)
+
+	BeforeEach(func() {
+		mockRepo = mockorderrepo.NewMockRepository(GinkgoT())
+		mockProductRepo = mockproductrepo.NewMockRepository(GinkgoT())
+		mockEventRepo = mockordereventrepo.NewMockRepository(GinkgoT())
+		service = ordersvc.New(mockRepo, mockuserrepo.NewMockRepository(GinkgoT()), mockProductRepo, mockEventRepo, mockraterepo.NewMockRepository(GinkgoT()), mockcouponrepo.NewMockRepository(GinkgoT()), testutil.NewTxManager(GinkgoT()))
+		ctx = context.Background()
+		current = &domain.Order{ID: "1", UserID: 1, Items: []domain.OrderItem{domain.NewOrderItem(2, 3, testutil.THB("10.00"))}, TotalPrice: testutil.THB("30.0"), Status: "pending"}
+	})
+
+	Describe("PatchOrder", func() {
+		Context("when patching an order", func() {
+			It("should pass only the supplied fields to the repository", func() {
+				status := "paid"
+				patch := domain.OrderPatch{Status: &status}
+				expectedOrder := &domain.Order{ID: "1", UserID: 1, Items: []domain.OrderItem{domain.NewOrderItem(2, 3, testutil.THB("10.00"))}, TotalPrice: testutil.THB("30.0"), Status: "paid"}
+
+				mockRepo.EXPECT().GetByID(ctx, 1, domain.OrderExpand{}).Return(current, nil).Once()
+				mockRepo.EXPECT().
+					Patch(ctx, 1, current.Version(), patch).
+					Return(expectedOrder, nil).
+					Once()
+				mockEventRepo.EXPECT().Create(ctx, domain.OrderEvent{OrderID: 1, FromStatus: "pending", ToStatus: "paid"}).Return(&domain.OrderEvent{ID: "1"}, nil).Once()
+
+				order, err := service.PatchOrder(ctx, "1", "", patch)
+
+				Expect(err).ToNot(HaveOccurred())
+				Expect(order).To(Equal(expectedOrder))
+			})
+
+			It("should reprice a changed quantity at the unit price and reserve the extra stock", func() {
+				items := []domain.OrderItem{domain.NewOrderItem(2, 5, testutil.THB("10.00"))}
+				totalPrice := testutil.THB("50.0")
+				expectedOrder := &domain.Order{ID: "1", UserID: 1, Items: items, TotalPrice: testutil.THB("50.0"), Status: "pending"}
+
+				mockRepo.EXPECT().GetByID(ctx, 1, domain.OrderExpand{}).Return(current, nil).Once()
+				mockProductRepo.EXPECT().ReserveStock(ctx, 2, 2).Return(nil).Once()
+				mockRepo.EXPECT().
+					Patch(ctx, 1, current.Version(), domain.OrderPatch{Items: items, TotalPrice: &totalPrice}).
+					Return(expectedOrder, nil).
+					Once()
+
+				order, err := service.PatchOrder(ctx, "1", "", domain.OrderPatch{Items: lines(2, 5)})
+
+				Expect(err).ToNot(HaveOccurred())
+				Expect(order).To(Equal(expectedOrder))
+			})
+
+			It("should apply a quantity without a product to the only item", func() {
+				items := []domain.OrderItem{domain.NewOrderItem(2, 1, testutil.THB("10.00"))}
+				totalPrice := testutil.THB("10.0")
+
+				mockRepo.EXPECT().GetByID(ctx, 1, domain.OrderExpand{}).Return(current, nil).Once()
+				mockProductRepo.EXPECT().ReleaseStock(ctx, 2, 2).Return(nil).Once()
+				mockRepo.EXPECT().
+					Patch(ctx, 1, current.Version(), domain.OrderPatch{Items: items, TotalPrice: &totalPrice}).
+					Return(&domain.Order{ID: "1", Items: items}, nil).
+					Once()
+
+				_, err := service.PatchOrder(ctx, "1", "", domain.OrderPatch{Items: lines(0, 1)})
+
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("should price an added product at its current price and reserve its stock", func() {
+				mouse := &domain.Product{ID: "7", Name: "Mouse", Price: testutil.THB("4.50"), Stock: 10}
+				items := []domain.OrderItem{domain.NewOrderItem(2, 3, testutil.THB("10.00")), domain.NewOrderItem(7, 2, testutil.THB("4.50"))}
+				totalPrice := testutil.THB("39.0")
+
+				mockRepo.EXPECT().GetByID(ctx, 1, domain.OrderExpand{}).Return(current, nil).Once()
+				mockProductRepo.EXPECT().GetByID(ctx, 7).Return(mouse, nil).Once()
+				mockProductRepo.EXPECT().ReserveStock(ctx, 7, 2).Return(nil).Once()
+				mockRepo.EXPECT().
+					Patch(ctx, 1, current.Version(), domain.OrderPatch{Items: items, TotalPrice: &totalPrice}).
+					Return(&domain.Order{ID: "1", Items: items}, nil).
+					Once()
+
+				_, err := service.PatchOrder(ctx, "1", "", domain.OrderPatch{Items: []domain.OrderItem{
+					{ProductID: 2, Quantity: 3},
+					{ProductID: 7, Quantity: 2},
+				}})
+
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("should release stock when the order is cancelled", func() {
+				status := "cancelled"
+				patch := domain.OrderPatch{Status: &status}
+
+				mockRepo.EXPECT().GetByID(ctx, 1, domain.OrderExpand{}).Return(current, nil).Once()
+				mockProductRepo.EXPECT().ReleaseStock(ctx, 2, 3).Return(nil).Once()
+				mockRepo.EXPECT().
+					Patch(ctx, 1, current.Version(), patch).
+					Return(&domain.Order{ID: "1", Status: "cancelled"}, nil).
+					Once()
+				mockEventRepo.EXPECT().Create(ctx, domain.OrderEvent{OrderID: 1, FromStatus: "pending", ToStatus: "cancelled"}).Return(&domain.OrderEvent{ID: "1"}, nil).Once()
+
+				_, err := service.PatchOrder(ctx, "1", "", patch)
+
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("should ignore a caller supplied total", func() {
+				totalPrice := testutil.THB("0.01")
+
+				mockRepo.EXPECT().GetByID(ctx, 1, domain.OrderExpand{}).Return(current, nil).Once()
+				mockRepo.EXPECT().
+					Patch(ctx, 1, current.Version(), domain.OrderPatch{}).
+					Return(&domain.Order{ID: "1"}, nil).
+					Once()
+
+				_, err := service.PatchOrder(ctx, "1", "", domain.OrderPatch{TotalPrice: &totalPrice})
+
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("should reject a quantity that is not positive", func() {
+				mockRepo.EXPECT().GetByID(ctx, 1, domain.OrderExpand{}).Return(current, nil).Once()
+
+				order, err := service.PatchOrder(ctx, "1", "", domain.OrderPatch{Items: lines(2, 0)})
+
+				Expect(err).To(MatchError(domain.ErrValidation))
+				Expect(order).To(BeNil())
+			})
+		})
+
+		Context("when the status change is not in the lifecycle", func() {
+			It("should not patch the order", func() {
+				status := "delivered"
+
+				mockRepo.EXPECT().GetByID(ctx, 1, domain.OrderExpand{}).Return(current, nil).Once()
+
+				order, err := service.PatchOrder(ctx, "1", "", domain.OrderPatch{Status: &status})
+
+				Expect(err).To(MatchError(domain.ErrInvalidTransition))
+				Expect(order).To(BeNil())
+			})
+		})
+
+		Context("when the order is no longer pending or paid", func() {
+			It("should not patch its items", func() {
+				current.Status = "delivered"
+				mockRepo.EXPECT().GetByID(ctx, 1, domain.OrderExpand{}).Return(current, nil).Once()
+
+				order, err := service.PatchOrder(ctx, "1", "", domain.OrderPatch{Items: lines(2, 1)})
+
+				Expect(err).To(MatchError(domain.ErrOrderClosed))
+				Expect(order).To(BeNil())
+			})
+		})
+
+		Context("when stock is short", func() {
+			It("should not patch the order", func() {
+				mockRepo.EXPECT().GetByID(ctx, 1, domain.OrderExpand{}).Return(current, nil).Once()
+				mockProductRepo.EXPECT().ReserveStock(ctx, 2, 47).Return(domain.ErrInsufficientStock).Once()
+
+				order, err := service.PatchOrder(ctx, "1", "", domain.OrderPatch{Items: lines(2, 50)})
+
+				Expect(err).To(MatchError(domain.ErrInsufficientStock))
+				Expect(order).To(BeNil())
+			})
+		})
+
+		Context("when repository fails", func() {
+			It("should return error from repository", func() {
+				expectedError := errors.New("database error")
+
+				mockRepo.EXPECT().GetByID(ctx, 1, domain.OrderExpand{}).Return(current, nil).Once()
+				mockRepo.EXPECT().
+					Patch(ctx, 1, current.Version(), domain.OrderPatch{}).
+					Return(nil, expectedError).
+					Once()
+
+				order, err := service.PatchOrder(ctx, "1", "", domain.OrderPatch{})
+
+				Expect(err).To(MatchError(expectedError))
+				Expect(order).To(BeNil())
+			})
+		})
+
+		Context("when the order has changed since the expected version", func() {
+			It("should return a precondition failure", func() {
+				mockRepo.EXPECT().GetByID(ctx, 1, domain.OrderExpand{}).Return(current, nil).Once()
+
+				_, err := service.PatchOrder(ctx, "1", "abc123", domain.OrderPatch{})
+
+				Expect(err).To(MatchError(domain.ErrPreconditionFailed))
+			})
+		})
+
+		Context("when a concurrent write changes the order", func() {
+			It("should return a conflict to a caller that sent no version", func() {
+				mockRepo.EXPECT().GetByID(ctx, 1, domain.OrderExpand{}).Return(current, nil).Once()
+				mockRepo.EXPECT().
+					Patch(ctx, 1, current.Version(), domain.OrderPatch{}).
+					Return(nil, domain.ErrPreconditionFailed).
+					Once()
+
+				_, err := service.PatchOrder(ctx, "1", "", domain.OrderPatch{})
+
+				Expect(err).To(MatchError(domain.ErrConflict))
+			})
+		})
+
+		Context("when order ID is invalid", func() {
+			It("should return invalid ID error", func() {
+				order, err := service.PatchOrder(ctx, "abc", "", domain.OrderPatch{})
+
+				Expect(err).To(MatchError(domain.ErrInvalidID))
+				Expect(order).To(BeNil())
+			})
+		})
+	})
+})
@@ -0,0 +1,30 @@
+package ordersvc
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"gin-swagger-api/internal/domain"
+)
+
+// PurgeOrder removes a soft-deleted order with its items for good. The
+// stock it held was returned when it was deleted, so none moves.
+func (s *Service) PurgeOrder(ctx context.Context, id string) error {
+	intID, err := strconv.Atoi(id)
+	if err != nil {
+		return fmt.Errorf("%w: %q", domain.ErrInvalidID, id)
+	}
+
+	return s.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		current, err := s.orderRepo.GetByID(domain.WithDeleted(ctx), intID, domain.OrderExpand{})
+		if err != nil {
+			return err
+		}
+		if current.DeletedAt == nil {
+			return fmt.Errorf("order %d is %w: delete it before purging it", intID, domain.ErrNotDeleted)
+		}
+
+		return s.orderRepo.Purge(ctx, intID)
+	})
+}
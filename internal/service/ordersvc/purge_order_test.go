@@ -0,0 +1,74 @@
+package ordersvc_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"gin-swagger-api/internal/domain"
+	portordersvc "gin-swagger-api/internal/port/service/ordersvc"
+	"gin-swagger-api/internal/service/ordersvc"
+	"gin-swagger-api/internal/testutil"
+	mockcouponrepo "gin-swagger-api/mock/repository/couponrepo"
+	mockordereventrepo "gin-swagger-api/mock/repository/ordereventrepo"
+	mockorderrepo "gin-swagger-api/mock/repository/orderrepo"
+	mockproductrepo "gin-swagger-api/mock/repository/productrepo"
+	mockraterepo "gin-swagger-api/mock/repository/raterepo"
+	mockuserrepo "gin-swagger-api/mock/repository/userrepo"
+)
+
+var _ = Describe("OrderService PurgeOrder", func() {
+	var (
+		mockRepo *mockorderrepo.MockRepository
+		service  portordersvc.Service
+		ctx      context.Context
+		deleted  *domain.Order
+	)
+
+	BeforeEach(func() {
+		mockRepo = mockorderrepo.NewMockRepository(GinkgoT())
+		service = ordersvc.New(mockRepo, mockuserrepo.NewMockRepository(GinkgoT()), mockproductrepo.NewMockRepository(GinkgoT()), mockordereventrepo.NewMockRepository(GinkgoT()), mockraterepo.NewMockRepository(GinkgoT()), mockcouponrepo.NewMockRepository(GinkgoT()), testutil.NewTxManager(GinkgoT()))
+		ctx = context.Background()
+		deletedAt := time.Date(2026, 1, 15, 10, 0, 0, 0, time.UTC)
+		deleted = &domain.Order{ID: "1", UserID: 1, Items: []domain.OrderItem{domain.NewOrderItem(100, 2, testutil.THB("10.00"))}, TotalPrice: testutil.THB("20.0"), Status: "pending", DeletedAt: &deletedAt}
+	})
+
+	Describe("PurgeOrder", func() {
+		It("should purge a soft-deleted order without moving stock", func() {
+			mockRepo.EXPECT().GetByID(mock.MatchedBy(domain.IncludesDeleted), 1, domain.OrderExpand{}).Return(deleted, nil).Once()
+			mockRepo.EXPECT().Purge(ctx, 1).Return(nil).Once()
+
+			err := service.PurgeOrder(ctx, "1")
+
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should refuse to purge an order that is not soft-deleted", func() {
+			deleted.DeletedAt = nil
+			mockRepo.EXPECT().GetByID(mock.MatchedBy(domain.IncludesDeleted), 1, domain.OrderExpand{}).Return(deleted, nil).Once()
+
+			err := service.PurgeOrder(ctx, "1")
+
+			Expect(err).To(MatchError(domain.ErrNotDeleted))
+		})
+
+		It("should return error from repository", func() {
+			mockRepo.EXPECT().GetByID(mock.MatchedBy(domain.IncludesDeleted), 1, domain.OrderExpand{}).Return(deleted, nil).Once()
+			mockRepo.EXPECT().Purge(ctx, 1).Return(errors.New("database error")).Once()
+
+			err := service.PurgeOrder(ctx, "1")
+
+			Expect(err).To(MatchError("database error"))
+		})
+
+		It("should return error for non-numeric ID", func() {
+			err := service.PurgeOrder(ctx, "invalid")
+
+			Expect(err).To(MatchError(domain.ErrInvalidID))
+		})
+	})
+})
@@ -0,0 +1,42 @@
+package ordersvc
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"gin-swagger-api/internal/domain"
+)
+
+// RestoreOrder brings back a soft-deleted order and reserves the stock
+// that deleting it released again in the same transaction, so it fails
+// with domain.ErrInsufficientStock when that stock has been ordered since.
+// Restoring an order that is not deleted returns it unchanged.
+func (s *Service) RestoreOrder(ctx context.Context, id string) (*domain.Order, error) {
+	intID, err := strconv.Atoi(id)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %q", domain.ErrInvalidID, id)
+	}
+
+	var order *domain.Order
+	err = s.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		current, err := s.orderRepo.GetByID(domain.WithDeleted(ctx), intID, domain.OrderExpand{})
+		if err != nil {
+			return err
+		}
+		if current.DeletedAt == nil {
+			order = current
+			return nil
+		}
+
+		order, err = s.orderRepo.Restore(ctx, intID)
+		if err != nil {
+			return err
+		}
+		return s.moveStocks(ctx, nil, order.Releasable())
+	})
+	if err != nil {
+		return nil, err
+	}
+	return order, nil
+}
@@ -0,0 +1,112 @@
+package ordersvc_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"gin-swagger-api/internal/domain"
+	portordersvc "gin-swagger-api/internal/port/service/ordersvc"
+	"gin-swagger-api/internal/service/ordersvc"
+	"gin-swagger-api/internal/testutil"
+	mockcouponrepo "gin-swagger-api/mock/repository/couponrepo"
+	mockordereventrepo "gin-swagger-api/mock/repository/ordereventrepo"
+	mockorderrepo "gin-swagger-api/mock/repository/orderrepo"
+	mockproductrepo "gin-swagger-api/mock/repository/productrepo"
+	mockraterepo "gin-swagger-api/mock/repository/raterepo"
+	mockuserrepo "gin-swagger-api/mock/repository/userrepo"
+)
+
+var _ = Describe("OrderService RestoreOrder", func() {
+	var (
+		mockRepo        *mockorderrepo.MockRepository
+		mockProductRepo *mockproductrepo.MockRepository
+		service         portordersvc.Service
+		ctx             context.Context
+		deleted         *domain.Order
+		restored        *domain.Order
+	)
+
+	BeforeEach(func() {
+		mockRepo = mockorderrepo.NewMockRepository(GinkgoT())
+		mockProductRepo = mockproductrepo.NewMockRepository(GinkgoT())
+		service = ordersvc.New(mockRepo, mockuserrepo.NewMockRepository(GinkgoT()), mockProductRepo, mockordereventrepo.NewMockRepository(GinkgoT()), mockraterepo.NewMockRepository(GinkgoT()), mockcouponrepo.NewMockRepository(GinkgoT()), testutil.NewTxManager(GinkgoT()))
+		ctx = context.Background()
+		deletedAt := time.Date(2026, 1, 15, 10, 0, 0, 0, time.UTC)
+		restored = &domain.Order{ID: "1", UserID: 1, Items: []domain.OrderItem{domain.NewOrderItem(100, 2, testutil.THB("10.00"))}, TotalPrice: testutil.THB("20.0"), Status: "pending"}
+		deleted = &domain.Order{ID: "1", UserID: 1, Items: restored.Items, TotalPrice: testutil.THB("20.0"), Status: "pending", DeletedAt: &deletedAt}
+	})
+
+	Describe("RestoreOrder", func() {
+		It("should restore the order and reserve its stock again", func() {
+			mockRepo.EXPECT().GetByID(mock.MatchedBy(domain.IncludesDeleted), 1, domain.OrderExpand{}).Return(deleted, nil).Once()
+			mockRepo.EXPECT().Restore(ctx, 1).Return(restored, nil).Once()
+			mockProductRepo.EXPECT().ReserveStock(ctx, 100, 2).Return(nil).Once()
+
+			order, err := service.RestoreOrder(ctx, "1")
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(order).To(Equal(restored))
+		})
+
+		It("should not reserve stock for a cancelled order", func() {
+			deleted.Status = "cancelled"
+			restored.Status = "cancelled"
+			mockRepo.EXPECT().GetByID(mock.MatchedBy(domain.IncludesDeleted), 1, domain.OrderExpand{}).Return(deleted, nil).Once()
+			mockRepo.EXPECT().Restore(ctx, 1).Return(restored, nil).Once()
+
+			_, err := service.RestoreOrder(ctx, "1")
+
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should not reserve stock again for a delivered order", func() {
+			deleted.Status = domain.OrderStatusDelivered
+			restored.Status = domain.OrderStatusDelivered
+			mockRepo.EXPECT().GetByID(mock.MatchedBy(domain.IncludesDeleted), 1, domain.OrderExpand{}).Return(deleted, nil).Once()
+			mockRepo.EXPECT().Restore(ctx, 1).Return(restored, nil).Once()
+
+			_, err := service.RestoreOrder(ctx, "1")
+
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should fail when the stock of the order is gone", func() {
+			mockRepo.EXPECT().GetByID(mock.MatchedBy(domain.IncludesDeleted), 1, domain.OrderExpand{}).Return(deleted, nil).Once()
+			mockRepo.EXPECT().Restore(ctx, 1).Return(restored, nil).Once()
+			mockProductRepo.EXPECT().ReserveStock(ctx, 100, 2).Return(fmt.Errorf("product 100 has %w", domain.ErrInsufficientStock)).Once()
+
+			order, err := service.RestoreOrder(ctx, "1")
+
+			Expect(err).To(MatchError(domain.ErrInsufficientStock))
+			Expect(order).To(BeNil())
+		})
+
+		It("should return an order that is not deleted unchanged", func() {
+			mockRepo.EXPECT().GetByID(mock.MatchedBy(domain.IncludesDeleted), 1, domain.OrderExpand{}).Return(restored, nil).Once()
+
+			order, err := service.RestoreOrder(ctx, "1")
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(order).To(Equal(restored))
+		})
+
+		It("should return error when order not found", func() {
+			mockRepo.EXPECT().GetByID(mock.MatchedBy(domain.IncludesDeleted), 999, domain.OrderExpand{}).Return(nil, fmt.Errorf("order %w", domain.ErrNotFound)).Once()
+
+			_, err := service.RestoreOrder(ctx, "999")
+
+			Expect(err).To(MatchError(domain.ErrNotFound))
+		})
+
+		It("should return error for non-numeric ID", func() {
+			_, err := service.RestoreOrder(ctx, "invalid")
+
+			Expect(err).To(MatchError(domain.ErrInvalidID))
+		})
+	})
+})
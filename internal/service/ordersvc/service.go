@@ -2,17 +2,37 @@ package ordersvc
 
 import (
 	port "gin-swagger-api/internal/port/service/ordersvc"
+	couponrepo "gin-swagger-api/internal/port/repository/couponrepo"
+	ordereventrepo "gin-swagger-api/internal/port/repository/ordereventrepo"
 	orderrepo "gin-swagger-api/internal/port/repository/orderrepo"
+	productrepo "gin-swagger-api/internal/port/repository/productrepo"
+	raterepo "gin-swagger-api/internal/port/repository/raterepo"
+	userrepo "gin-swagger-api/internal/port/repository/userrepo"
+	"gin-swagger-api/internal/port/repository/txmanager"
 )
 
 // Service implements port.Service interface
 type Service struct {
-	orderRepo orderrepo.Repository
+	orderRepo   orderrepo.Repository
+	userRepo    userrepo.Repository
+	productRepo productrepo.Repository
+	eventRepo   ordereventrepo.Repository
+	rateRepo    raterepo.Repository
+	couponRepo  couponrepo.Repository
+	txManager   txmanager.Manager
 }
 
-// New creates a new order service with order repository
-func New(orderRepo orderrepo.Repository) port.Service {
+// New creates a new order service with order, user, product, order event,
+// exchange rate and coupon repositories and the transaction manager that
+// makes their writes atomic
+func New(orderRepo orderrepo.Repository, userRepo userrepo.Repository, productRepo productrepo.Repository, eventRepo ordereventrepo.Repository, rateRepo raterepo.Repository, couponRepo couponrepo.Repository, txManager txmanager.Manager) port.Service {
 	return &Service{
-		orderRepo: orderRepo,
+		orderRepo:   orderRepo,
+		userRepo:    userRepo,
+		productRepo: productRepo,
+		eventRepo:   eventRepo,
+		rateRepo:    rateRepo,
+		couponRepo:  couponRepo,
+		txManager:   txManager,
 	}
 }
@@ -0,0 +1,65 @@
+package ordersvc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+
+	"gin-swagger-api/internal/domain"
+)
+
+// moveStock takes delta units of a product out of stock, or returns them
+// when delta is negative
+func (s *Service) moveStock(ctx context.Context, productID, delta int) error {
+	switch {
+	case delta > 0:
+		return s.productRepo.ReserveStock(ctx, productID, delta)
+	case delta < 0:
+		return s.productRepo.ReleaseStock(ctx, productID, -delta)
+	default:
+		return nil
+	}
+}
+
+// moveStocks moves the difference between two reservations of an order,
+// as returned by domain.Order.Reserved, in or out of product stock.
+// Products are moved in ID order so concurrent orders lock them in the
+// same order.
+func (s *Service) moveStocks(ctx context.Context, from, to map[int]int) error {
+	productIDs := slices.Concat(slices.Collect(maps.Keys(from)), slices.Collect(maps.Keys(to)))
+	slices.Sort(productIDs)
+	productIDs = slices.Compact(productIDs)
+
+	for _, productID := range productIDs {
+		if err := s.moveStock(ctx, productID, to[productID]-from[productID]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// loadForWrite loads the order a write applies to. A non-empty version
+// must match its current version.
+func (s *Service) loadForWrite(ctx context.Context, id int, version string) (*domain.Order, error) {
+	current, err := s.orderRepo.GetByID(ctx, id, domain.OrderExpand{})
+	if err != nil {
+		return nil, err
+	}
+	if version != "" && current.Version() != version {
+		return nil, fmt.Errorf("order version %w", domain.ErrPreconditionFailed)
+	}
+	return current, nil
+}
+
+// writeError translates the error of a write made at the version
+// loadForWrite returned. The write is always guarded by that version so
+// the stock moved for it stays right; a caller who sent no version did not
+// ask for the check, so losing the race is a conflict for them.
+func writeError(err error, version string) error {
+	if version == "" && errors.Is(err, domain.ErrPreconditionFailed) {
+		return fmt.Errorf("order %w: it was changed by a concurrent request", domain.ErrConflict)
+	}
+	return err
+}
@@ -0,0 +1,187 @@
+package ordersvc_test
+
+import (
+	"context"
+	"sync"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"gin-swagger-api/internal/domain"
+	portordersvc "gin-swagger-api/internal/port/service/ordersvc"
+	"gin-swagger-api/internal/repository/couponrepo"
+	"gin-swagger-api/internal/repository/ordereventrepo"
+	"gin-swagger-api/internal/repository/orderrepo"
+	"gin-swagger-api/internal/repository/productrepo"
+	"gin-swagger-api/internal/repository/raterepo"
+	"gin-swagger-api/internal/repository/txmanager"
+	"gin-swagger-api/internal/repository/userrepo"
+	"gin-swagger-api/internal/service/ordersvc"
+	"gin-swagger-api/internal/testutil"
+
+	"github.com/snilli/ormprovider"
+)
+
+var _ = Describe("OrderService stock", func() {
+	var (
+		db        *ormprovider.Client
+		service   portordersvc.Service
+		ctx       context.Context
+		userID    int
+		productID int
+	)
+
+	stockOf := func(id int) int {
+		p, err := db.Product.Get(ctx, id)
+		Expect(err).ToNot(HaveOccurred())
+		return p.Stock
+	}
+	stock := func() int {
+		return stockOf(productID)
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		db = testutil.NewTestFileDBClient(GinkgoT(), GinkgoT().TempDir())
+		txManager := txmanager.New(db)
+		service = ordersvc.New(orderrepo.New(db, txManager), userrepo.New(db), productrepo.New(db), ordereventrepo.New(db), raterepo.New(db), couponrepo.New(db, txManager), txManager)
+
+		user, err := db.User.Create().SetName("Test User").SetEmail("test@example.com").Save(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		userID = user.ID
+
+		p, err := db.Product.Create().SetName("Limited").SetDescription("Few left").SetPrice(1000).SetStock(5).Save(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		productID = p.ID
+	})
+
+	AfterEach(func() {
+		// Cleanup: close database connection
+		if db != nil {
+			_ = db.Close()
+		}
+	})
+
+	It("should take the ordered quantity out of stock", func() {
+		_, err := service.CreateOrder(ctx, portordersvc.CreateOrderInput{UserID: userID, Items: lines(productID, 3)})
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(stock()).To(Equal(2))
+	})
+
+	It("should reserve every item or none of them", func() {
+		p, err := db.Product.Create().SetName("Plenty").SetDescription("Many left").SetPrice(250).SetStock(50).Save(ctx)
+		Expect(err).ToNot(HaveOccurred())
+
+		order, err := service.CreateOrder(ctx, portordersvc.CreateOrderInput{UserID: userID, Items: []domain.OrderItem{
+			{ProductID: p.ID, Quantity: 10},
+			{ProductID: productID, Quantity: 6},
+		}})
+
+		Expect(err).To(MatchError(domain.ErrInsufficientStock))
+		Expect(order).To(BeNil())
+		Expect(stockOf(p.ID)).To(Equal(50))
+		Expect(stock()).To(Equal(5))
+
+		order, err = service.CreateOrder(ctx, portordersvc.CreateOrderInput{UserID: userID, Items: []domain.OrderItem{
+			{ProductID: p.ID, Quantity: 10},
+			{ProductID: productID, Quantity: 5},
+		}})
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(order.Items).To(HaveLen(2))
+		Expect(order.TotalPrice).To(Equal(testutil.THB("75.00")))
+		Expect(stockOf(p.ID)).To(Equal(40))
+		Expect(stock()).To(BeZero())
+	})
+
+	It("should refuse an order for a missing user without touching stock", func() {
+		order, err := service.CreateOrder(ctx, portordersvc.CreateOrderInput{UserID: 99999, Items: lines(productID, 3)})
+
+		Expect(err).To(MatchError(domain.ErrValidation))
+		Expect(order).To(BeNil())
+		Expect(stock()).To(Equal(5))
+	})
+
+	It("should keep the order when the extra quantity is not in stock", func() {
+		order, err := service.CreateOrder(ctx, portordersvc.CreateOrderInput{UserID: userID, Items: lines(productID, 2)})
+		Expect(err).ToNot(HaveOccurred())
+
+		_, err = service.UpdateOrder(ctx, order.ID, "", lines(productID, 6), domain.OrderStatusPending)
+
+		Expect(err).To(MatchError(domain.ErrInsufficientStock))
+		Expect(stock()).To(Equal(3))
+		Expect(db.OrderItem.Query().OnlyX(ctx).Quantity).To(Equal(2))
+	})
+
+	It("should record the status changes in the order history", func() {
+		order, err := service.CreateOrder(domain.WithActor(ctx, "api-key:1a2b3c4d"), portordersvc.CreateOrderInput{UserID: userID, Items: lines(productID, 1)})
+		Expect(err).ToNot(HaveOccurred())
+		_, err = service.TransitionOrder(domain.WithActor(ctx, "api-key:5e6f7a8b"), order.ID, "", domain.OrderStatusCancelled, "duplicate")
+		Expect(err).ToNot(HaveOccurred())
+
+		history, err := service.GetOrderHistory(ctx, order.ID)
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(history).To(HaveLen(2))
+		Expect(history[0].ToStatus).To(Equal(domain.OrderStatusPending))
+		Expect(history[0].Actor).To(Equal("api-key:1a2b3c4d"))
+		Expect(history[1].FromStatus).To(Equal(domain.OrderStatusPending))
+		Expect(history[1].ToStatus).To(Equal(domain.OrderStatusCancelled))
+		Expect(history[1].Actor).To(Equal("api-key:5e6f7a8b"))
+		Expect(history[1].Reason).To(Equal("duplicate"))
+	})
+
+	It("should return stock when an order is cancelled or deleted", func() {
+		order, err := service.CreateOrder(ctx, portordersvc.CreateOrderInput{UserID: userID, Items: lines(productID, 2)})
+		Expect(err).ToNot(HaveOccurred())
+
+		cancelled := domain.OrderStatusCancelled
+		_, err = service.PatchOrder(ctx, order.ID, "", domain.OrderPatch{Status: &cancelled})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(stock()).To(Equal(5))
+
+		other, err := service.CreateOrder(ctx, portordersvc.CreateOrderInput{UserID: userID, Items: lines(productID, 4)})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(service.DeleteOrder(ctx, other.ID, "")).To(Succeed())
+		Expect(stock()).To(Equal(5))
+	})
+
+	It("should not oversell under parallel orders", func() {
+		const buyers = 20
+		var (
+			wg        sync.WaitGroup
+			mu        sync.Mutex
+			succeeded int
+			failures  []error
+		)
+
+		for range buyers {
+			wg.Add(1)
+			go func() {
+				defer GinkgoRecover()
+				defer wg.Done()
+
+				_, err := service.CreateOrder(ctx, portordersvc.CreateOrderInput{UserID: userID, Items: lines(productID, 1)})
+				mu.Lock()
+				defer mu.Unlock()
+				if err != nil {
+					failures = append(failures, err)
+					return
+				}
+				succeeded++
+			}()
+		}
+		wg.Wait()
+
+		Expect(succeeded).To(Equal(5))
+		Expect(failures).To(HaveLen(buyers - 5))
+		for _, err := range failures {
+			// A buyer that finds the product sold out before reserving fails
+			// validation instead
+			Expect(err).To(Or(MatchError(domain.ErrInsufficientStock), MatchError(domain.ErrValidation)))
+		}
+		Expect(stock()).To(BeZero())
+		Expect(db.Order.Query().CountX(ctx)).To(Equal(5))
+	})
+})
@@ -0,0 +1,51 @@
+package ordersvc
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"gin-swagger-api/internal/domain"
+)
+
+// TransitionOrder moves an order to the next status of its lifecycle.
+// Unlike a status update it must change the status, so repeating a
+// transition fails with domain.ErrInvalidTransition. The transition is
+// recorded in the order history with the optional reason, and cancelling
+// returns the reserved quantities to product stock, in the same transaction.
+func (s *Service) TransitionOrder(ctx context.Context, id, version, status, reason string) (*domain.Order, error) {
+	intID, err := strconv.Atoi(id)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %q", domain.ErrInvalidID, id)
+	}
+
+	var order *domain.Order
+	err = s.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		current, err := s.loadForWrite(ctx, intID, version)
+		if err != nil {
+			return err
+		}
+		if current.Status == status {
+			return fmt.Errorf("%w: order is already %s", domain.ErrInvalidTransition, status)
+		}
+		if err := domain.CheckOrderTransition(current.Status, status); err != nil {
+			return err
+		}
+
+		updated := *current
+		updated.Status = status
+		if err := s.moveStocks(ctx, current.Reserved(), updated.Reserved()); err != nil {
+			return err
+		}
+
+		order, err = s.orderRepo.Patch(ctx, intID, current.Version(), domain.OrderPatch{Status: &status})
+		if err != nil {
+			return writeError(err, version)
+		}
+		return s.recordStatus(ctx, intID, current.Status, status, reason)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return order, nil
+}
@@ -0,0 +1,175 @@
+package ordersvc_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"gin-swagger-api/internal/domain"
+	portordersvc "gin-swagger-api/internal/port/service/ordersvc"
+	"gin-swagger-api/internal/service/ordersvc"
+	"gin-swagger-api/internal/testutil"
+	mockcouponrepo "gin-swagger-api/mock/repository/couponrepo"
+	mockordereventrepo "gin-swagger-api/mock/repository/ordereventrepo"
+	mockorderrepo "gin-swagger-api/mock/repository/orderrepo"
+	mockproductrepo "gin-swagger-api/mock/repository/productrepo"
+	mockraterepo "gin-swagger-api/mock/repository/raterepo"
+	mockuserrepo "gin-swagger-api/mock/repository/userrepo"
+)
+
+var _ = Describe("OrderService TransitionOrder", func() {
+	var (
+		mockRepo        *mockorderrepo.MockRepository
+		mockProductRepo *mockproductrepo.MockRepository
+		mockEventRepo   *mockordereventrepo.MockRepository
+		service         portordersvc.Service
+		ctx             context.Context
+		current         *domain.Order
+	)
+
+	BeforeEach(func() {
+		mockRepo = mockorderrepo.NewMockRepository(GinkgoT())
+		mockProductRepo = mockproductrepo.NewMockRepository(GinkgoT())
+		mockEventRepo = mockordereventrepo.NewMockRepository(GinkgoT())
+		service = ordersvc.New(mockRepo, mockuserrepo.NewMockRepository(GinkgoT()), mockProductRepo, mockEventRepo, mockraterepo.NewMockRepository(GinkgoT()), mockcouponrepo.NewMockRepository(GinkgoT()), testutil.NewTxManager(GinkgoT()))
+		ctx = context.Background()
+		current = &domain.Order{ID: "1", UserID: 1, Items: []domain.OrderItem{domain.NewOrderItem(100, 2, testutil.THB("10.00"))}, TotalPrice: testutil.THB("20.0"), Status: "pending"}
+	})
+
+	Describe("TransitionOrder", func() {
+		Context("when the lifecycle allows the transition", func() {
+			It("should move the order to the new status", func() {
+				status := domain.OrderStatusPaid
+				paid := *current
+				paid.Status = status
+
+				mockRepo.EXPECT().GetByID(ctx, 1, domain.OrderExpand{}).Return(current, nil).Once()
+				mockRepo.EXPECT().
+					Patch(ctx, 1, current.Version(), domain.OrderPatch{Status: &status}).
+					Return(&paid, nil).
+					Once()
+				mockEventRepo.EXPECT().Create(ctx, domain.OrderEvent{OrderID: 1, FromStatus: "pending", ToStatus: "paid"}).Return(&domain.OrderEvent{ID: "1"}, nil).Once()
+
+				order, err := service.TransitionOrder(ctx, "1", "", status, "")
+
+				Expect(err).ToNot(HaveOccurred())
+				Expect(order.Status).To(Equal("paid"))
+			})
+
+			It("should return stock and record who cancelled the order and why", func() {
+				ctx = domain.WithActor(ctx, "api-key:1a2b3c4d")
+				current.Status = domain.OrderStatusPaid
+				status := domain.OrderStatusCancelled
+
+				mockRepo.EXPECT().GetByID(ctx, 1, domain.OrderExpand{}).Return(current, nil).Once()
+				mockProductRepo.EXPECT().ReleaseStock(ctx, 100, 2).Return(nil).Once()
+				mockRepo.EXPECT().
+					Patch(ctx, 1, current.Version(), domain.OrderPatch{Status: &status}).
+					Return(&domain.Order{ID: "1", Status: status}, nil).
+					Once()
+				mockEventRepo.EXPECT().
+					Create(ctx, domain.OrderEvent{
+						OrderID:    1,
+						FromStatus: "paid",
+						ToStatus:   "cancelled",
+						Actor:      "api-key:1a2b3c4d",
+						Reason:     "customer changed their mind",
+					}).
+					Return(&domain.OrderEvent{ID: "1"}, nil).
+					Once()
+
+				_, err := service.TransitionOrder(ctx, "1", "", status, "customer changed their mind")
+
+				Expect(err).ToNot(HaveOccurred())
+			})
+		})
+
+		Context("when the lifecycle does not allow the transition", func() {
+			It("should reject shipping an unpaid order", func() {
+				mockRepo.EXPECT().GetByID(ctx, 1, domain.OrderExpand{}).Return(current, nil).Once()
+
+				order, err := service.TransitionOrder(ctx, "1", "", domain.OrderStatusShipped, "")
+
+				Expect(err).To(MatchError(domain.ErrInvalidTransition))
+				Expect(order).To(BeNil())
+			})
+
+			It("should reject repeating a transition", func() {
+				current.Status = domain.OrderStatusCancelled
+				mockRepo.EXPECT().GetByID(ctx, 1, domain.OrderExpand{}).Return(current, nil).Once()
+
+				_, err := service.TransitionOrder(ctx, "1", "", domain.OrderStatusCancelled, "")
+
+				Expect(err).To(MatchError(domain.ErrInvalidTransition))
+			})
+		})
+
+		Context("when the order has changed since the expected version", func() {
+			It("should return a precondition failure", func() {
+				mockRepo.EXPECT().GetByID(ctx, 1, domain.OrderExpand{}).Return(current, nil).Once()
+
+				_, err := service.TransitionOrder(ctx, "1", "abc123", domain.OrderStatusPaid, "")
+
+				Expect(err).To(MatchError(domain.ErrPreconditionFailed))
+			})
+		})
+
+		Context("when order does not exist", func() {
+			It("should return error from repository", func() {
+				mockRepo.EXPECT().GetByID(ctx, 999, domain.OrderExpand{}).Return(nil, fmt.Errorf("order %w", domain.ErrNotFound)).Once()
+
+				_, err := service.TransitionOrder(ctx, "999", "", domain.OrderStatusPaid, "")
+
+				Expect(err).To(MatchError(domain.ErrNotFound))
+			})
+		})
+
+		Context("when repository fails", func() {
+			It("should return error from repository", func() {
+				expectedError := errors.New("database error")
+				status := domain.OrderStatusPaid
+
+				mockRepo.EXPECT().GetByID(ctx, 1, domain.OrderExpand{}).Return(current, nil).Once()
+				mockRepo.EXPECT().
+					Patch(ctx, 1, current.Version(), domain.OrderPatch{Status: &status}).
+					Return(nil, expectedError).
+					Once()
+
+				order, err := service.TransitionOrder(ctx, "1", "", status, "")
+
+				Expect(err).To(MatchError(expectedError))
+				Expect(order).To(BeNil())
+			})
+		})
+
+		Context("when the history cannot be recorded", func() {
+			It("should fail the transition", func() {
+				expectedError := errors.New("database error")
+				status := domain.OrderStatusPaid
+
+				mockRepo.EXPECT().GetByID(ctx, 1, domain.OrderExpand{}).Return(current, nil).Once()
+				mockRepo.EXPECT().
+					Patch(ctx, 1, current.Version(), domain.OrderPatch{Status: &status}).
+					Return(&domain.Order{ID: "1", Status: status}, nil).
+					Once()
+				mockEventRepo.EXPECT().Create(ctx, domain.OrderEvent{OrderID: 1, FromStatus: "pending", ToStatus: "paid"}).Return(nil, expectedError).Once()
+
+				order, err := service.TransitionOrder(ctx, "1", "", status, "")
+
+				Expect(err).To(MatchError(expectedError))
+				Expect(order).To(BeNil())
+			})
+		})
+
+		Context("when invalid ID is provided", func() {
+			It("should return error for non-numeric ID", func() {
+				_, err := service.TransitionOrder(ctx, "invalid", "", domain.OrderStatusPaid, "")
+
+				Expect(err).To(MatchError(domain.ErrInvalidID))
+			})
+		})
+	})
+})
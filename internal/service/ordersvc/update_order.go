@@ -2,18 +2,72 @@ package ordersvc
 
 import (
 	"context"
+	"fmt"
 	"strconv"
 
 	"gin-swagger-api/internal/domain"
 )
 
-func (s *Service) UpdateOrder(ctx context.Context, id string, userID, productID, quantity int, totalPrice float64, status string) (*domain.Order, error) {
+// UpdateOrder replaces the items and status of an order and moves the
+// change in reserved quantities in or out of product stock in the same
+// transaction. A status change is recorded in the order history. Products
+// already on the order keep the unit price they were placed at; added
+// products are priced at their current price, converted at the rate the
+// order was placed at. A single item without a
+// product refers to the only item of the order. The items can only change
+// while the order is pending or paid. The status must follow the order
+// lifecycle; an empty status keeps the current one.
+func (s *Service) UpdateOrder(ctx context.Context, id, version string, items []domain.OrderItem, status string) (*domain.Order, error) {
 	intID, err := strconv.Atoi(id)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("%w: %q", domain.ErrInvalidID, id)
 	}
 
-	// Repository Update only takes quantity, totalPrice, status
-	// userID and productID are not updatable in repository
-	return s.orderRepo.Update(ctx, intID, quantity, totalPrice, status)
+	var order *domain.Order
+	err = s.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		current, err := s.loadForWrite(ctx, intID, version)
+		if err != nil {
+			return err
+		}
+
+		if status == "" {
+			status = current.Status
+		}
+		if err := domain.CheckOrderTransition(current.Status, status); err != nil {
+			return err
+		}
+
+		lines := resolveLines(items, *current)
+		if err := current.CheckItemsChange(lines); err != nil {
+			return err
+		}
+
+		items, fields, err := s.priceItems(ctx, lines, *current)
+		if err != nil {
+			return err
+		}
+		if len(fields) > 0 {
+			return fields
+		}
+
+		updated := domain.Order{Items: items, Status: status}
+		if err := s.moveStocks(ctx, current.Reserved(), updated.Reserved()); err != nil {
+			return err
+		}
+
+		totalPrice, err := current.Total(items)
+		if err != nil {
+			return err
+		}
+
+		order, err = s.orderRepo.Update(ctx, intID, current.Version(), items, totalPrice, status)
+		if err != nil {
+			return writeError(err, version)
+		}
+		return s.recordStatus(ctx, intID, current.Status, status, "")
+	})
+	if err != nil {
+		return nil, err
+	}
+	return order, nil
 }
@@ -3,6 +3,7 @@ package ordersvc_test
 import (
 	"context"
 	"errors"
+	"fmt"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -10,93 +11,328 @@ import (
 	"gin-swagger-api/internal/domain"
 	portordersvc "gin-swagger-api/internal/port/service/ordersvc"
 	"gin-swagger-api/internal/service/ordersvc"
+	"gin-swagger-api/internal/testutil"
+	mockcouponrepo "gin-swagger-api/mock/repository/couponrepo"
+	mockordereventrepo "gin-swagger-api/mock/repository/ordereventrepo"
 	mockorderrepo "gin-swagger-api/mock/repository/orderrepo"
+	mockproductrepo "gin-swagger-api/mock/repository/productrepo"
+	mockraterepo "gin-swagger-api/mock/repository/raterepo"
+	mockuserrepo "gin-swagger-api/mock/repository/userrepo"
 )
 
 var _ = Describe("OrderService UpdateOrder", func() {
 	var (
-		mockRepo *mockorderrepo.MockRepository
-		service  portordersvc.Service
-		ctx      context.Context
+		mockRepo        *mockorderrepo.MockRepository
+		mockProductRepo *mockproductrepo.MockRepository
+		mockEventRepo   *mockordereventrepo.MockRepository
+		service         portordersvc.Service
+		ctx             context.Context
+		current         *domain.Order
 	)
 
+	// priced returns the single item of the order at the price it was
+	// placed at
+	priced := func(quantity int) []domain.OrderItem {
+		return []domain.OrderItem{domain.NewOrderItem(100, quantity, testutil.THB("99.99"))}
+	}
+
 	BeforeEach(func() {
 		mockRepo = mockorderrepo.NewMockRepository(GinkgoT())
-		service = ordersvc.New(mockRepo)
+		mockProductRepo = mockproductrepo.NewMockRepository(GinkgoT())
+		mockEventRepo = mockordereventrepo.NewMockRepository(GinkgoT())
+		service = ordersvc.New(mockRepo, mockuserrepo.NewMockRepository(GinkgoT()), mockProductRepo, mockEventRepo, mockraterepo.NewMockRepository(GinkgoT()), mockcouponrepo.NewMockRepository(GinkgoT()), testutil.NewTxManager(GinkgoT()))
 		ctx = context.Background()
+		current = &domain.Order{
+			ID:         "1",
+			UserID:     1,
+			Items:      []domain.OrderItem{domain.NewOrderItem(100, 2, testutil.THB("99.99"))},
+			TotalPrice: testutil.THB("199.98"),
+			Status:     "pending",
+		}
 	})
 
 	Describe("UpdateOrder", func() {
 		Context("when updating an existing order", func() {
-			It("should update order successfully", func() {
+			It("should reprice a new quantity at the order's unit price", func() {
 				expectedOrder := &domain.Order{
 					ID:         "1",
 					UserID:     1,
-					ProductID:  100,
-					Quantity:   10,
-					TotalPrice: 999.99,
-					Status:     "completed",
+					Items:      []domain.OrderItem{domain.NewOrderItem(100, 10, testutil.THB("99.99"))},
+					TotalPrice: testutil.THB("999.90"),
+					Status:     "paid",
 				}
 
+				mockRepo.EXPECT().GetByID(ctx, 1, domain.OrderExpand{}).Return(current, nil).Once()
+				mockProductRepo.EXPECT().ReserveStock(ctx, 100, 8).Return(nil).Once()
 				mockRepo.EXPECT().
-					Update(ctx, 1, 10, 999.99, "completed").
+					Update(ctx, 1, current.Version(), priced(10), testutil.THB("999.90"), "paid").
 					Return(expectedOrder, nil).
 					Once()
+				mockEventRepo.EXPECT().Create(ctx, domain.OrderEvent{OrderID: 1, FromStatus: "pending", ToStatus: "paid"}).Return(&domain.OrderEvent{ID: "1"}, nil).Once()
 
-				order, err := service.UpdateOrder(ctx, "1", 1, 100, 10, 999.99, "completed")
+				order, err := service.UpdateOrder(ctx, "1", "", lines(100, 10), "paid")
 
 				Expect(err).ToNot(HaveOccurred())
 				Expect(order).ToNot(BeNil())
 				Expect(order.ID).To(Equal("1"))
-				Expect(order.Quantity).To(Equal(10))
-				Expect(order.TotalPrice).To(Equal(999.99))
-				Expect(order.Status).To(Equal("completed"))
+				Expect(order.Items[0].Quantity).To(Equal(10))
+				Expect(order.TotalPrice).To(Equal(testutil.THB("999.90")))
+				Expect(order.Status).To(Equal("paid"))
 			})
 
-			It("should return updated order with correct structure", func() {
-				expectedOrder := &domain.Order{
-					ID:         "2",
-					UserID:     2,
-					ProductID:  200,
-					Quantity:   5,
-					TotalPrice: 599.99,
-					Status:     "pending",
+			It("should keep the total and stock when the quantity is unchanged", func() {
+				mockRepo.EXPECT().GetByID(ctx, 1, domain.OrderExpand{}).Return(current, nil).Once()
+				mockRepo.EXPECT().
+					Update(ctx, 1, current.Version(), priced(2), testutil.THB("199.98"), "paid").
+					Return(current, nil).
+					Once()
+				mockEventRepo.EXPECT().Create(ctx, domain.OrderEvent{OrderID: 1, FromStatus: "pending", ToStatus: "paid"}).Return(&domain.OrderEvent{ID: "1"}, nil).Once()
+
+				order, err := service.UpdateOrder(ctx, "1", "", lines(100, 2), "paid")
+
+				Expect(err).ToNot(HaveOccurred())
+				Expect(order).To(BeAssignableToTypeOf(&domain.Order{}))
+			})
+
+			It("should release stock when the quantity goes down", func() {
+				mockRepo.EXPECT().GetByID(ctx, 1, domain.OrderExpand{}).Return(current, nil).Once()
+				mockProductRepo.EXPECT().ReleaseStock(ctx, 100, 1).Return(nil).Once()
+				mockRepo.EXPECT().
+					Update(ctx, 1, current.Version(), priced(1), testutil.THB("99.99"), "pending").
+					Return(current, nil).
+					Once()
+
+				_, err := service.UpdateOrder(ctx, "1", "", lines(100, 1), "pending")
+
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("should apply a quantity without a product to the only item", func() {
+				mockRepo.EXPECT().GetByID(ctx, 1, domain.OrderExpand{}).Return(current, nil).Once()
+				mockProductRepo.EXPECT().ReserveStock(ctx, 100, 1).Return(nil).Once()
+				mockRepo.EXPECT().
+					Update(ctx, 1, current.Version(), priced(3), testutil.THB("299.97"), "pending").
+					Return(current, nil).
+					Once()
+
+				_, err := service.UpdateOrder(ctx, "1", "", lines(0, 3), "")
+
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("should replace the items and move the stock of each product", func() {
+				mouse := &domain.Product{ID: "200", Name: "Mouse", Price: testutil.THB("19.99"), Stock: 10}
+				items := []domain.OrderItem{domain.NewOrderItem(200, 3, testutil.THB("19.99"))}
+
+				mockRepo.EXPECT().GetByID(ctx, 1, domain.OrderExpand{}).Return(current, nil).Once()
+				mockProductRepo.EXPECT().GetByID(ctx, 200).Return(mouse, nil).Once()
+				mockProductRepo.EXPECT().ReleaseStock(ctx, 100, 2).Return(nil).Once()
+				mockProductRepo.EXPECT().ReserveStock(ctx, 200, 3).Return(nil).Once()
+				mockRepo.EXPECT().
+					Update(ctx, 1, current.Version(), items, testutil.THB("59.97"), "pending").
+					Return(&domain.Order{ID: "1", Items: items}, nil).
+					Once()
+
+				order, err := service.UpdateOrder(ctx, "1", "", lines(200, 3), "")
+
+				Expect(err).ToNot(HaveOccurred())
+				Expect(order.Items).To(Equal(items))
+			})
+
+			It("should price added products at the rate the order was placed at", func() {
+				eur := func(amount string) domain.Money { return domain.MustParseMoney(amount, "EUR") }
+				current.Items = []domain.OrderItem{domain.NewOrderItem(100, 2, eur("2.55"))}
+				current.TotalPrice = eur("5.10")
+				current.Currency, current.ExchangeRate = "EUR", "0.0255"
+				mouse := &domain.Product{ID: "200", Name: "Mouse", Price: testutil.THB("19.99"), Stock: 10}
+				items := []domain.OrderItem{
+					domain.NewOrderItem(100, 2, eur("2.55")),
+					domain.NewOrderItem(200, 1, eur("0.51")),
 				}
 
+				mockRepo.EXPECT().GetByID(ctx, 1, domain.OrderExpand{}).Return(current, nil).Once()
+				mockProductRepo.EXPECT().GetByID(ctx, 200).Return(mouse, nil).Once()
+				mockProductRepo.EXPECT().ReserveStock(ctx, 200, 1).Return(nil).Once()
 				mockRepo.EXPECT().
-					Update(ctx, 2, 5, 599.99, "pending").
-					Return(expectedOrder, nil).
+					Update(ctx, 1, current.Version(), items, eur("5.61"), "pending").
+					Return(&domain.Order{ID: "1", Items: items}, nil).
 					Once()
 
-				order, err := service.UpdateOrder(ctx, "2", 2, 200, 5, 599.99, "pending")
+				_, err := service.UpdateOrder(ctx, "1", "", []domain.OrderItem{
+					{ProductID: 100, Quantity: 2},
+					{ProductID: 200, Quantity: 1},
+				}, "")
+
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("should keep the coupon discount of the order", func() {
+				current.CouponCode, current.Discount = "LESS50", testutil.THB("50.00")
+				current.TotalPrice = testutil.THB("149.98")
+
+				mockRepo.EXPECT().GetByID(ctx, 1, domain.OrderExpand{}).Return(current, nil).Once()
+				mockProductRepo.EXPECT().ReserveStock(ctx, 100, 1).Return(nil).Once()
+				mockRepo.EXPECT().
+					Update(ctx, 1, current.Version(), priced(3), testutil.THB("249.97"), "pending").
+					Return(&domain.Order{ID: "1"}, nil).
+					Once()
+
+				_, err := service.UpdateOrder(ctx, "1", "", lines(100, 3), "")
+
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("should release all stock when the order is cancelled", func() {
+				mockRepo.EXPECT().GetByID(ctx, 1, domain.OrderExpand{}).Return(current, nil).Once()
+				mockProductRepo.EXPECT().ReleaseStock(ctx, 100, 2).Return(nil).Once()
+				mockRepo.EXPECT().
+					Update(ctx, 1, current.Version(), priced(2), testutil.THB("199.98"), "cancelled").
+					Return(current, nil).
+					Once()
+				mockEventRepo.EXPECT().Create(ctx, domain.OrderEvent{OrderID: 1, FromStatus: "pending", ToStatus: "cancelled"}).Return(&domain.OrderEvent{ID: "1"}, nil).Once()
+
+				_, err := service.UpdateOrder(ctx, "1", "", lines(100, 2), "cancelled")
 
 				Expect(err).ToNot(HaveOccurred())
-				Expect(order).To(BeAssignableToTypeOf(&domain.Order{}))
-				Expect(order.ID).To(Equal("2"))
+			})
+		})
+
+		Context("when the status change is not in the lifecycle", func() {
+			It("should not move stock or update the order", func() {
+				current.Status = "shipped"
+				mockRepo.EXPECT().GetByID(ctx, 1, domain.OrderExpand{}).Return(current, nil).Once()
+
+				order, err := service.UpdateOrder(ctx, "1", "", lines(100, 2), "cancelled")
+
+				Expect(err).To(MatchError(domain.ErrInvalidTransition))
+				Expect(order).To(BeNil())
+			})
+
+			It("should reject an unknown status", func() {
+				mockRepo.EXPECT().GetByID(ctx, 1, domain.OrderExpand{}).Return(current, nil).Once()
+
+				order, err := service.UpdateOrder(ctx, "1", "", lines(100, 2), "completed")
+
+				Expect(err).To(MatchError(domain.ErrValidation))
+				Expect(order).To(BeNil())
+			})
+
+			It("should keep the current status when none is given", func() {
+				mockRepo.EXPECT().GetByID(ctx, 1, domain.OrderExpand{}).Return(current, nil).Once()
+				mockRepo.EXPECT().
+					Update(ctx, 1, current.Version(), priced(2), testutil.THB("199.98"), "pending").
+					Return(current, nil).
+					Once()
+
+				_, err := service.UpdateOrder(ctx, "1", "", lines(100, 2), "")
+
+				Expect(err).ToNot(HaveOccurred())
+			})
+		})
+
+		Context("when the order is no longer pending or paid", func() {
+			It("should not change its items", func() {
+				current.Status = "shipped"
+				mockRepo.EXPECT().GetByID(ctx, 1, domain.OrderExpand{}).Return(current, nil).Once()
+
+				order, err := service.UpdateOrder(ctx, "1", "", lines(100, 5), "shipped")
+
+				Expect(err).To(MatchError(domain.ErrOrderClosed))
+				Expect(order).To(BeNil())
+			})
+
+			It("should still change its status when the items are unchanged", func() {
+				current.Status = "shipped"
+				mockRepo.EXPECT().GetByID(ctx, 1, domain.OrderExpand{}).Return(current, nil).Once()
+				mockRepo.EXPECT().
+					Update(ctx, 1, current.Version(), priced(2), testutil.THB("199.98"), "delivered").
+					Return(current, nil).
+					Once()
+				mockEventRepo.EXPECT().Create(ctx, domain.OrderEvent{OrderID: 1, FromStatus: "shipped", ToStatus: "delivered"}).Return(&domain.OrderEvent{ID: "1"}, nil).Once()
+
+				_, err := service.UpdateOrder(ctx, "1", "", lines(100, 2), "delivered")
+
+				Expect(err).ToNot(HaveOccurred())
+			})
+		})
+
+		Context("when stock is short", func() {
+			It("should not update the order", func() {
+				mockRepo.EXPECT().GetByID(ctx, 1, domain.OrderExpand{}).Return(current, nil).Once()
+				mockProductRepo.EXPECT().ReserveStock(ctx, 100, 8).Return(domain.ErrInsufficientStock).Once()
+
+				order, err := service.UpdateOrder(ctx, "1", "", lines(100, 10), "pending")
+
+				Expect(err).To(MatchError(domain.ErrInsufficientStock))
+				Expect(order).To(BeNil())
 			})
 		})
 
 		Context("when order does not exist", func() {
 			It("should return error from repository", func() {
-				expectedError := errors.New("order not found")
+				mockRepo.EXPECT().GetByID(ctx, 999, domain.OrderExpand{}).Return(nil, fmt.Errorf("order %w", domain.ErrNotFound)).Once()
+
+				order, err := service.UpdateOrder(ctx, "999", "", lines(100, 10), "paid")
+
+				Expect(err).To(MatchError(domain.ErrNotFound))
+				Expect(order).To(BeNil())
+			})
+		})
 
+		Context("when the order has changed since the expected version", func() {
+			It("should fail the precondition before moving stock", func() {
+				mockRepo.EXPECT().GetByID(ctx, 1, domain.OrderExpand{}).Return(current, nil).Once()
+
+				_, err := service.UpdateOrder(ctx, "1", "abc123", lines(100, 10), "paid")
+
+				Expect(err).To(MatchError(domain.ErrPreconditionFailed))
+			})
+
+			It("should return the precondition failure from repository", func() {
+				mockRepo.EXPECT().GetByID(ctx, 1, domain.OrderExpand{}).Return(current, nil).Once()
+				mockProductRepo.EXPECT().ReserveStock(ctx, 100, 8).Return(nil).Once()
 				mockRepo.EXPECT().
-					Update(ctx, 999, 10, 999.99, "completed").
-					Return(nil, expectedError).
+					Update(ctx, 1, current.Version(), priced(10), testutil.THB("999.90"), "paid").
+					Return(nil, domain.ErrPreconditionFailed).
 					Once()
 
-				order, err := service.UpdateOrder(ctx, "999", 1, 100, 10, 999.99, "completed")
+				_, err := service.UpdateOrder(ctx, "1", current.Version(), lines(100, 10), "paid")
 
-				Expect(err).To(MatchError(expectedError))
-				Expect(order).To(BeNil())
+				Expect(err).To(MatchError(domain.ErrPreconditionFailed))
+			})
+		})
+
+		Context("when a concurrent write changes the order", func() {
+			It("should return a conflict to a caller that sent no version", func() {
+				mockRepo.EXPECT().GetByID(ctx, 1, domain.OrderExpand{}).Return(current, nil).Once()
+				mockProductRepo.EXPECT().ReserveStock(ctx, 100, 8).Return(nil).Once()
+				mockRepo.EXPECT().
+					Update(ctx, 1, current.Version(), priced(10), testutil.THB("999.90"), "paid").
+					Return(nil, domain.ErrPreconditionFailed).
+					Once()
+
+				_, err := service.UpdateOrder(ctx, "1", "", lines(100, 10), "paid")
+
+				Expect(err).To(MatchError(domain.ErrConflict))
 			})
 		})
 
 		Context("when invalid ID is provided", func() {
 			It("should return error for non-numeric ID", func() {
-				order, err := service.UpdateOrder(ctx, "invalid", 1, 100, 10, 999.99, "completed")
+				order, err := service.UpdateOrder(ctx, "invalid", "", lines(100, 10), "paid")
+
+				Expect(err).To(MatchError(domain.ErrInvalidID))
+				Expect(order).To(BeNil())
+			})
+		})
+
+		Context("when quantity is not positive", func() {
+			It("should return a validation error", func() {
+				mockRepo.EXPECT().GetByID(ctx, 1, domain.OrderExpand{}).Return(current, nil).Once()
+
+				order, err := service.UpdateOrder(ctx, "1", "", lines(100, 0), "paid")
 
-				Expect(err).To(HaveOccurred())
+				Expect(err).To(MatchError(domain.ErrValidation))
 				Expect(order).To(BeNil())
 			})
 		})
@@ -105,12 +341,14 @@ var _ = Describe("OrderService UpdateOrder", func() {
 			It("should return error from repository", func() {
 				expectedError := errors.New("database update failed")
 
+				mockRepo.EXPECT().GetByID(ctx, 1, domain.OrderExpand{}).Return(current, nil).Once()
+				mockProductRepo.EXPECT().ReserveStock(ctx, 100, 8).Return(nil).Once()
 				mockRepo.EXPECT().
-					Update(ctx, 1, 10, 999.99, "completed").
+					Update(ctx, 1, current.Version(), priced(10), testutil.THB("999.90"), "paid").
 					Return(nil, expectedError).
 					Once()
 
-				order, err := service.UpdateOrder(ctx, "1", 1, 100, 10, 999.99, "completed")
+				order, err := service.UpdateOrder(ctx, "1", "", lines(100, 10), "paid")
 
 				Expect(err).To(MatchError(expectedError))
 				Expect(order).To(BeNil())
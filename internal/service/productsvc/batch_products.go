@@ -0,0 +1,23 @@
+package productsvc
+
+import (
+	"context"
+
+	"gin-swagger-api/internal/domain"
+	"gin-swagger-api/internal/service/batch"
+)
+
+func (s *Service) BatchProducts(ctx context.Context, b domain.Batch[domain.Product]) (*domain.BatchResults[domain.Product], error) {
+	return batch.Run(ctx, s.txManager, b, batch.Writes[domain.Product]{
+		CreateBulk: s.productRepo.CreateBulk,
+		Create: func(ctx context.Context, product domain.Product) (*domain.Product, error) {
+			return s.CreateProduct(ctx, product.Name, product.Description, product.Price, product.Stock)
+		},
+		Update: func(ctx context.Context, version string, product domain.Product) (*domain.Product, error) {
+			return s.UpdateProduct(ctx, product.ID, version, product.Name, product.Description, product.Price, product.Stock)
+		},
+		Delete: func(ctx context.Context, ref domain.BatchRef) error {
+			return s.DeleteProduct(ctx, ref.ID, ref.Version)
+		},
+	})
+}
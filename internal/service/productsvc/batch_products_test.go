@@ -0,0 +1,69 @@
+package productsvc_test
+
+import (
+	"context"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"gin-swagger-api/internal/domain"
+	portproductsvc "gin-swagger-api/internal/port/service/productsvc"
+	"gin-swagger-api/internal/service/productsvc"
+	"gin-swagger-api/internal/testutil"
+	mockorderrepo "gin-swagger-api/mock/repository/orderrepo"
+	mockproductrepo "gin-swagger-api/mock/repository/productrepo"
+)
+
+var _ = Describe("ProductService BatchProducts", func() {
+	var (
+		mockRepo *mockproductrepo.MockRepository
+		service  portproductsvc.Service
+		ctx      context.Context
+		batch    domain.Batch[domain.Product]
+	)
+
+	BeforeEach(func() {
+		mockRepo = mockproductrepo.NewMockRepository(GinkgoT())
+		service = productsvc.New(mockRepo, mockorderrepo.NewMockRepository(GinkgoT()), testutil.NewTxManager(GinkgoT()), domain.DeleteRestrict)
+		ctx = context.Background()
+		batch = domain.Batch[domain.Product]{
+			Mode:   domain.BatchAllOrNothing,
+			Create: []domain.Product{{Name: "Mouse", Price: testutil.THB("20"), Stock: 5}},
+			Update: []domain.BatchUpdate[domain.Product]{{Value: domain.Product{ID: "3", Name: "Keyboard", Price: testutil.THB("50"), Stock: 1}}},
+			Delete: []domain.BatchRef{{ID: "4", Version: "abc"}},
+		}
+	})
+
+	Describe("BatchProducts", func() {
+		Context("when every item succeeds", func() {
+			It("should create in bulk, then update and delete", func() {
+				mockRepo.EXPECT().CreateBulk(ctx, batch.Create).Return([]domain.Product{{ID: "1", Name: "Mouse"}}, nil).Once()
+				mockRepo.EXPECT().Update(ctx, 3, "", "Keyboard", "", testutil.THB("50.0"), 1).Return(&domain.Product{ID: "3"}, nil).Once()
+				mockRepo.EXPECT().Delete(ctx, 4, "abc").Return(nil).Once()
+
+				results, err := service.BatchProducts(ctx, batch)
+
+				Expect(err).ToNot(HaveOccurred())
+				Expect(results.Create[0].Value.ID).To(Equal("1"))
+				Expect(results.Update[0].Value.ID).To(Equal("3"))
+				Expect(results.Delete[0].Err).ToNot(HaveOccurred())
+			})
+		})
+
+		Context("when a product has changed", func() {
+			It("should report the stale product and abort the others", func() {
+				mockRepo.EXPECT().CreateBulk(ctx, batch.Create).Return([]domain.Product{{ID: "1"}}, nil).Once()
+				mockRepo.EXPECT().Update(ctx, 3, "", "Keyboard", "", testutil.THB("50.0"), 1).Return(&domain.Product{ID: "3"}, nil).Once()
+				mockRepo.EXPECT().Delete(ctx, 4, "abc").Return(fmt.Errorf("product %w", domain.ErrPreconditionFailed)).Once()
+
+				results, err := service.BatchProducts(ctx, batch)
+
+				Expect(err).ToNot(HaveOccurred())
+				Expect(results.Create[0].Err).To(MatchError(domain.ErrBatchAborted))
+				Expect(results.Update[0].Err).To(MatchError(domain.ErrBatchAborted))
+				Expect(results.Delete[0].Err).To(MatchError(domain.ErrPreconditionFailed))
+			})
+		})
+	})
+})
@@ -6,6 +6,6 @@ import (
 	"gin-swagger-api/internal/domain"
 )
 
-func (s *Service) CreateProduct(ctx context.Context, name, description string, price float64, stock int) (*domain.Product, error) {
+func (s *Service) CreateProduct(ctx context.Context, name, description string, price domain.Money, stock int) (*domain.Product, error) {
 	return s.productRepo.Create(ctx, name, description, price, stock)
 }
@@ -10,6 +10,8 @@ import (
 	"gin-swagger-api/internal/domain"
 	portproductsvc "gin-swagger-api/internal/port/service/productsvc"
 	"gin-swagger-api/internal/service/productsvc"
+	"gin-swagger-api/internal/testutil"
+	mockorderrepo "gin-swagger-api/mock/repository/orderrepo"
 	mockproductrepo "gin-swagger-api/mock/repository/productrepo"
 )
 
@@ -22,7 +24,7 @@ var _ = Describe("ProductService CreateProduct", func() {
 
 	BeforeEach(func() {
 		mockRepo = mockproductrepo.NewMockRepository(GinkgoT())
-		service = productsvc.New(mockRepo)
+		service = productsvc.New(mockRepo, mockorderrepo.NewMockRepository(GinkgoT()), testutil.NewTxManager(GinkgoT()), domain.DeleteRestrict)
 		ctx = context.Background()
 	})
 
@@ -32,16 +34,16 @@ var _ = Describe("ProductService CreateProduct", func() {
 				ID:          "1",
 				Name:        "Laptop",
 				Description: "High-performance laptop",
-				Price:       999.99,
+				Price:       testutil.THB("999.99"),
 				Stock:       10,
 			}
 
 			mockRepo.EXPECT().
-				Create(ctx, "Laptop", "High-performance laptop", 999.99, 10).
+				Create(ctx, "Laptop", "High-performance laptop", testutil.THB("999.99"), 10).
 				Return(expectedProduct, nil).
 				Once()
 
-			product, err := service.CreateProduct(ctx, "Laptop", "High-performance laptop", 999.99, 10)
+			product, err := service.CreateProduct(ctx, "Laptop", "High-performance laptop", testutil.THB("999.99"), 10)
 
 			Expect(err).ToNot(HaveOccurred())
 			Expect(*product).To(Equal(*expectedProduct))
@@ -51,11 +53,11 @@ var _ = Describe("ProductService CreateProduct", func() {
 			expectedError := errors.New("database error")
 
 			mockRepo.EXPECT().
-				Create(ctx, "Laptop", "High-performance laptop", 999.99, 10).
+				Create(ctx, "Laptop", "High-performance laptop", testutil.THB("999.99"), 10).
 				Return(nil, expectedError).
 				Once()
 
-			product, err := service.CreateProduct(ctx, "Laptop", "High-performance laptop", 999.99, 10)
+			product, err := service.CreateProduct(ctx, "Laptop", "High-performance laptop", testutil.THB("999.99"), 10)
 
 			Expect(err).To(MatchError(expectedError))
 			Expect(product).To(BeNil())
@@ -2,15 +2,20 @@ package productsvc
 
 import (
 	"context"
+	"fmt"
 	"strconv"
+
+	"gin-swagger-api/internal/domain"
 )
 
-func (s *Service) DeleteProduct(ctx context.Context, id string) error {
+// DeleteProduct soft-deletes a product. Orders with an item for it are
+// left as they are until the product is purged.
+func (s *Service) DeleteProduct(ctx context.Context, id, version string) error {
 	// Convert string ID to int
 	intID, err := strconv.Atoi(id)
 	if err != nil {
-		return err
+		return fmt.Errorf("%w: %q", domain.ErrInvalidID, id)
 	}
 
-	return s.productRepo.Delete(ctx, intID)
+	return s.productRepo.Delete(ctx, intID, version)
 }
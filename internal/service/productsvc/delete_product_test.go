@@ -8,35 +8,46 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	"gin-swagger-api/internal/domain"
 	portproductsvc "gin-swagger-api/internal/port/service/productsvc"
 	"gin-swagger-api/internal/service/productsvc"
+	"gin-swagger-api/internal/testutil"
+	mockorderrepo "gin-swagger-api/mock/repository/orderrepo"
 	mockproductrepo "gin-swagger-api/mock/repository/productrepo"
 )
 
 var _ = Describe("ProductService DeleteProduct", func() {
 	var (
-		mockRepo *mockproductrepo.MockRepository
-		service  portproductsvc.Service
-		ctx      context.Context
+		mockRepo      *mockproductrepo.MockRepository
+		mockOrderRepo *mockorderrepo.MockRepository
+		service       portproductsvc.Service
+		ctx           context.Context
 	)
 
+	// withPolicy rebuilds the service with a product delete policy
+	withPolicy := func(policy domain.DeletePolicy) {
+		service = productsvc.New(mockRepo, mockOrderRepo, testutil.NewTxManager(GinkgoT()), policy)
+	}
+
 	BeforeEach(func() {
 		mockRepo = mockproductrepo.NewMockRepository(GinkgoT())
-		service = productsvc.New(mockRepo)
+		mockOrderRepo = mockorderrepo.NewMockRepository(GinkgoT())
+		withPolicy(domain.DeleteRestrict)
 		ctx = context.Background()
 	})
 
 	Describe("DeleteProduct", func() {
-		It("should delete product successfully when repository succeeds", func() {
+		It("should soft-delete the product and leave its orders alone", func() {
+			withPolicy(domain.DeleteCascade)
 			productID := "1"
 			productIDInt, _ := strconv.Atoi(productID)
 
 			mockRepo.EXPECT().
-				Delete(ctx, productIDInt).
+				Delete(ctx, productIDInt, "").
 				Return(nil).
 				Once()
 
-			err := service.DeleteProduct(ctx, productID)
+			err := service.DeleteProduct(ctx, productID, "")
 
 			Expect(err).ToNot(HaveOccurred())
 		})
@@ -47,21 +58,32 @@ var _ = Describe("ProductService DeleteProduct", func() {
 			productIDInt, _ := strconv.Atoi(productID)
 
 			mockRepo.EXPECT().
-				Delete(ctx, productIDInt).
+				Delete(ctx, productIDInt, "").
 				Return(expectedError).
 				Once()
 
-			err := service.DeleteProduct(ctx, productID)
+			err := service.DeleteProduct(ctx, productID, "")
 
 			Expect(err).To(MatchError(expectedError))
 		})
 
+		It("should return error when the product has changed since the expected version", func() {
+			mockRepo.EXPECT().
+				Delete(ctx, 1, "abc123").
+				Return(domain.ErrPreconditionFailed).
+				Once()
+
+			err := service.DeleteProduct(ctx, "1", "abc123")
+
+			Expect(err).To(MatchError(domain.ErrPreconditionFailed))
+		})
+
 		It("should return error when product ID is invalid", func() {
 			productID := "invalid"
 
-			err := service.DeleteProduct(ctx, productID)
+			err := service.DeleteProduct(ctx, productID, "")
 
-			Expect(err).To(HaveOccurred())
+			Expect(err).To(MatchError(domain.ErrInvalidID))
 		})
 	})
 })
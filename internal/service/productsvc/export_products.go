@@ -0,0 +1,20 @@
+package productsvc
+
+import (
+	"context"
+	"fmt"
+
+	"gin-swagger-api/internal/domain"
+)
+
+func (s *Service) ExportProducts(ctx context.Context, list domain.ListQuery, fn func([]domain.Product) error) error {
+	list, err := domain.ProductFields.Resolve(list)
+	if err != nil {
+		return err
+	}
+	if len(list.Sort) > 0 {
+		return fmt.Errorf("%w: exports are ordered by id and cannot be sorted", domain.ErrInvalidArgument)
+	}
+
+	return s.productRepo.Stream(ctx, list, domain.ExportBatchSize, fn)
+}
@@ -0,0 +1,80 @@
+package productsvc_test
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"gin-swagger-api/internal/domain"
+	portproductsvc "gin-swagger-api/internal/port/service/productsvc"
+	"gin-swagger-api/internal/service/productsvc"
+	"gin-swagger-api/internal/testutil"
+	mockorderrepo "gin-swagger-api/mock/repository/orderrepo"
+	mockproductrepo "gin-swagger-api/mock/repository/productrepo"
+)
+
+var _ = Describe("ProductService ExportProducts", func() {
+	var (
+		mockRepo *mockproductrepo.MockRepository
+		service  portproductsvc.Service
+		ctx      context.Context
+	)
+
+	BeforeEach(func() {
+		mockRepo = mockproductrepo.NewMockRepository(GinkgoT())
+		service = productsvc.New(mockRepo, mockorderrepo.NewMockRepository(GinkgoT()), testutil.NewTxManager(GinkgoT()), domain.DeleteRestrict)
+		ctx = context.Background()
+	})
+
+	Describe("ExportProducts", func() {
+		It("should stream the products in batches with resolved filters", func() {
+			first := []domain.Product{{ID: "1", Name: "Laptop", Price: testutil.THB("999.99"), Stock: 10}}
+			second := []domain.Product{{ID: "2", Name: "Mouse", Price: testutil.THB("25.00"), Stock: 100}}
+			mockRepo.EXPECT().
+				Stream(ctx, domain.ListQuery{Filters: []domain.Filter{{Field: "stock", Op: domain.OpLt, Values: []any{5}}}}, domain.ExportBatchSize, mock.Anything).
+				RunAndReturn(func(_ context.Context, _ domain.ListQuery, _ int, fn func([]domain.Product) error) error {
+					if err := fn(first); err != nil {
+						return err
+					}
+					return fn(second)
+				}).
+				Once()
+
+			var batches [][]domain.Product
+			err := service.ExportProducts(ctx, domain.ListQuery{Filters: []domain.Filter{{Field: "stock", Op: domain.OpLt, Values: []any{"5"}}}}, func(batch []domain.Product) error {
+				batches = append(batches, batch)
+				return nil
+			})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(batches).To(Equal([][]domain.Product{first, second}))
+		})
+
+		It("should reject a sort", func() {
+			err := service.ExportProducts(ctx, domain.ListQuery{Sort: []domain.Sort{{Field: "price"}}}, func([]domain.Product) error { return nil })
+
+			Expect(err).To(MatchError(domain.ErrInvalidArgument))
+		})
+
+		It("should reject an unknown filter field", func() {
+			err := service.ExportProducts(ctx, domain.ListQuery{Filters: []domain.Filter{{Field: "secret", Op: domain.OpEq, Values: []any{"x"}}}}, func([]domain.Product) error { return nil })
+
+			Expect(err).To(MatchError(domain.ErrInvalidArgument))
+		})
+
+		It("should return error when repository fails", func() {
+			expectedError := errors.New("database error")
+			mockRepo.EXPECT().
+				Stream(ctx, domain.ListQuery{}, domain.ExportBatchSize, mock.Anything).
+				Return(expectedError).
+				Once()
+
+			err := service.ExportProducts(ctx, domain.ListQuery{}, func([]domain.Product) error { return nil })
+
+			Expect(err).To(MatchError(expectedError))
+		})
+	})
+})
@@ -2,6 +2,7 @@ package productsvc
 
 import (
 	"context"
+	"fmt"
 	"strconv"
 
 	"gin-swagger-api/internal/domain"
@@ -11,7 +12,7 @@ func (s *Service) GetProduct(ctx context.Context, id string) (*domain.Product, e
 	// Convert string ID to int
 	intID, err := strconv.Atoi(id)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("%w: %q", domain.ErrInvalidID, id)
 	}
 
 	return s.productRepo.GetByID(ctx, intID)
@@ -0,0 +1,23 @@
+package productsvc
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"gin-swagger-api/internal/domain"
+)
+
+func (s *Service) GetProductOrders(ctx context.Context, id string, page domain.PageRequest) (*domain.Page[domain.Order], error) {
+	intID, err := strconv.Atoi(id)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %q", domain.ErrInvalidID, id)
+	}
+
+	// An unknown product is not found rather than a product without orders
+	if _, err := s.productRepo.GetByID(ctx, intID); err != nil {
+		return nil, err
+	}
+
+	return s.orderRepo.GetByProduct(ctx, intID, page.Normalize())
+}
@@ -0,0 +1,91 @@
+package productsvc_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"gin-swagger-api/internal/domain"
+	portproductsvc "gin-swagger-api/internal/port/service/productsvc"
+	"gin-swagger-api/internal/service/productsvc"
+	"gin-swagger-api/internal/testutil"
+	mockorderrepo "gin-swagger-api/mock/repository/orderrepo"
+	mockproductrepo "gin-swagger-api/mock/repository/productrepo"
+)
+
+var _ = Describe("ProductService GetProductOrders", func() {
+	var (
+		mockRepo      *mockproductrepo.MockRepository
+		mockOrderRepo *mockorderrepo.MockRepository
+		service       portproductsvc.Service
+		ctx           context.Context
+	)
+
+	BeforeEach(func() {
+		mockRepo = mockproductrepo.NewMockRepository(GinkgoT())
+		mockOrderRepo = mockorderrepo.NewMockRepository(GinkgoT())
+		service = productsvc.New(mockRepo, mockOrderRepo, testutil.NewTxManager(GinkgoT()), domain.DeleteRestrict)
+		ctx = context.Background()
+	})
+
+	Describe("GetProductOrders", func() {
+		It("should return a page of the product's orders", func() {
+			orders := []domain.Order{
+				{ID: "1", UserID: 1, Items: []domain.OrderItem{domain.NewOrderItem(1, 2, testutil.THB("50.00"))}, TotalPrice: testutil.THB("100.00"), Status: domain.OrderStatusPending},
+			}
+			mockRepo.EXPECT().GetByID(ctx, 1).Return(&domain.Product{ID: "1", Name: "Laptop", Price: testutil.THB("999.99"), Stock: 10}, nil).Once()
+			mockOrderRepo.EXPECT().
+				GetByProduct(ctx, 1, domain.PageRequest{Limit: 10, Offset: 20}).
+				Return(&domain.Page[domain.Order]{Items: orders, Total: 21, Limit: 10, Offset: 20}, nil).
+				Once()
+
+			page, err := service.GetProductOrders(ctx, "1", domain.PageRequest{Limit: 10, Offset: 20})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(page.Items).To(Equal(orders))
+			Expect(page.Total).To(Equal(21))
+		})
+
+		It("should apply the default page size", func() {
+			mockRepo.EXPECT().GetByID(ctx, 1).Return(&domain.Product{ID: "1", Name: "Laptop", Price: testutil.THB("999.99"), Stock: 10}, nil).Once()
+			mockOrderRepo.EXPECT().
+				GetByProduct(ctx, 1, domain.PageRequest{Limit: domain.DefaultPageSize}).
+				Return(&domain.Page[domain.Order]{Items: []domain.Order{}, Limit: domain.DefaultPageSize}, nil).
+				Once()
+
+			_, err := service.GetProductOrders(ctx, "1", domain.PageRequest{})
+
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should return not found when the product does not exist", func() {
+			mockRepo.EXPECT().GetByID(ctx, 99).Return(nil, fmt.Errorf("product %w", domain.ErrNotFound)).Once()
+
+			page, err := service.GetProductOrders(ctx, "99", domain.PageRequest{})
+
+			Expect(err).To(MatchError(domain.ErrNotFound))
+			Expect(page).To(BeNil())
+		})
+
+		It("should return error when the order repository fails", func() {
+			expectedError := errors.New("database error")
+			mockRepo.EXPECT().GetByID(ctx, 1).Return(&domain.Product{ID: "1", Name: "Laptop", Price: testutil.THB("999.99"), Stock: 10}, nil).Once()
+			mockOrderRepo.EXPECT().GetByProduct(ctx, 1, domain.PageRequest{Limit: domain.DefaultPageSize}).Return(nil, expectedError).Once()
+
+			page, err := service.GetProductOrders(ctx, "1", domain.PageRequest{})
+
+			Expect(err).To(MatchError(expectedError))
+			Expect(page).To(BeNil())
+		})
+
+		It("should return error when the product ID is invalid", func() {
+			page, err := service.GetProductOrders(ctx, "invalid", domain.PageRequest{})
+
+			Expect(err).To(MatchError(domain.ErrInvalidID))
+			Expect(page).To(BeNil())
+		})
+	})
+})
@@ -11,6 +11,8 @@ import (
 	"gin-swagger-api/internal/domain"
 	portproductsvc "gin-swagger-api/internal/port/service/productsvc"
 	"gin-swagger-api/internal/service/productsvc"
+	"gin-swagger-api/internal/testutil"
+	mockorderrepo "gin-swagger-api/mock/repository/orderrepo"
 	mockproductrepo "gin-swagger-api/mock/repository/productrepo"
 )
 
@@ -23,7 +25,7 @@ var _ = Describe("ProductService GetProduct", func() {
 
 	BeforeEach(func() {
 		mockRepo = mockproductrepo.NewMockRepository(GinkgoT())
-		service = productsvc.New(mockRepo)
+		service = productsvc.New(mockRepo, mockorderrepo.NewMockRepository(GinkgoT()), testutil.NewTxManager(GinkgoT()), domain.DeleteRestrict)
 		ctx = context.Background()
 	})
 
@@ -33,7 +35,7 @@ var _ = Describe("ProductService GetProduct", func() {
 				ID:          "1",
 				Name:        "Laptop",
 				Description: "High-performance laptop",
-				Price:       999.99,
+				Price:       testutil.THB("999.99"),
 				Stock:       10,
 			}
 
@@ -52,7 +54,7 @@ var _ = Describe("ProductService GetProduct", func() {
 			Expect(product.ID).To(Equal("1"))
 			Expect(product.Name).To(Equal("Laptop"))
 			Expect(product.Description).To(Equal("High-performance laptop"))
-			Expect(product.Price).To(Equal(999.99))
+			Expect(product.Price).To(Equal(testutil.THB("999.99")))
 			Expect(product.Stock).To(Equal(10))
 		})
 
@@ -77,7 +79,7 @@ var _ = Describe("ProductService GetProduct", func() {
 
 			product, err := service.GetProduct(ctx, productID)
 
-			Expect(err).To(HaveOccurred())
+			Expect(err).To(MatchError(domain.ErrInvalidID))
 			Expect(product).To(BeNil())
 		})
 	})
@@ -6,6 +6,11 @@ import (
 	"gin-swagger-api/internal/domain"
 )
 
-func (s *Service) GetProducts(ctx context.Context) ([]domain.Product, error) {
-	return s.productRepo.GetAll(ctx)
+func (s *Service) GetProducts(ctx context.Context, list domain.ListQuery, page domain.PageRequest) (*domain.Page[domain.Product], error) {
+	list, err := domain.ProductFields.Resolve(list)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.productRepo.GetAll(ctx, list, page.Normalize())
 }
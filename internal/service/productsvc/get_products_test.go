@@ -10,6 +10,8 @@ import (
 	"gin-swagger-api/internal/domain"
 	portproductsvc "gin-swagger-api/internal/port/service/productsvc"
 	"gin-swagger-api/internal/service/productsvc"
+	"gin-swagger-api/internal/testutil"
+	mockorderrepo "gin-swagger-api/mock/repository/orderrepo"
 	mockproductrepo "gin-swagger-api/mock/repository/productrepo"
 )
 
@@ -22,7 +24,7 @@ var _ = Describe("ProductService GetProducts", func() {
 
 	BeforeEach(func() {
 		mockRepo = mockproductrepo.NewMockRepository(GinkgoT())
-		service = productsvc.New(mockRepo)
+		service = productsvc.New(mockRepo, mockorderrepo.NewMockRepository(GinkgoT()), testutil.NewTxManager(GinkgoT()), domain.DeleteRestrict)
 		ctx = context.Background()
 	})
 
@@ -33,61 +35,100 @@ var _ = Describe("ProductService GetProducts", func() {
 					ID:          "1",
 					Name:        "Laptop",
 					Description: "High-performance laptop",
-					Price:       999.99,
+					Price:       testutil.THB("999.99"),
 					Stock:       10,
 				},
 				{
 					ID:          "2",
 					Name:        "Mouse",
 					Description: "Wireless mouse",
-					Price:       29.99,
+					Price:       testutil.THB("29.99"),
 					Stock:       50,
 				},
 			}
 
 			mockRepo.EXPECT().
-				GetAll(ctx).
-				Return(expectedProducts, nil).
+				GetAll(ctx, domain.ListQuery{}, domain.PageRequest{Limit: 10}).
+				Return(&domain.Page[domain.Product]{Items: expectedProducts, Total: 2, Limit: 10}, nil).
 				Once()
 
-			products, err := service.GetProducts(ctx)
+			page, err := service.GetProducts(ctx, domain.ListQuery{}, domain.PageRequest{Limit: 10})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(page).ToNot(BeNil())
+			Expect(page.Total).To(Equal(2))
+			Expect(page.Items).To(HaveLen(2))
+			Expect(page.Items[0].ID).To(Equal("1"))
+			Expect(page.Items[0].Name).To(Equal("Laptop"))
+			Expect(page.Items[1].ID).To(Equal("2"))
+			Expect(page.Items[1].Name).To(Equal("Mouse"))
+		})
+
+		It("should apply the default page size when no limit is given", func() {
+			mockRepo.EXPECT().
+				GetAll(ctx, domain.ListQuery{}, domain.PageRequest{Limit: domain.DefaultPageSize}).
+				Return(&domain.Page[domain.Product]{Limit: domain.DefaultPageSize}, nil).
+				Once()
+
+			_, err := service.GetProducts(ctx, domain.ListQuery{}, domain.PageRequest{})
+
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should cap the page size at the maximum", func() {
+			mockRepo.EXPECT().
+				GetAll(ctx, domain.ListQuery{}, domain.PageRequest{Limit: domain.MaxPageSize, Offset: 40}).
+				Return(&domain.Page[domain.Product]{Limit: domain.MaxPageSize, Offset: 40}, nil).
+				Once()
+
+			_, err := service.GetProducts(ctx, domain.ListQuery{}, domain.PageRequest{Limit: 1000, Offset: 40})
 
 			Expect(err).ToNot(HaveOccurred())
-			Expect(products).ToNot(BeNil())
-			Expect(products).To(HaveLen(2))
-			Expect(products[0].ID).To(Equal("1"))
-			Expect(products[0].Name).To(Equal("Laptop"))
-			Expect(products[1].ID).To(Equal("2"))
-			Expect(products[1].Name).To(Equal("Mouse"))
 		})
 
 		It("should return empty list when no products exist", func() {
-			expectedProducts := []domain.Product{}
+			mockRepo.EXPECT().
+				GetAll(ctx, domain.ListQuery{}, domain.PageRequest{Limit: domain.DefaultPageSize}).
+				Return(&domain.Page[domain.Product]{Items: []domain.Product{}, Limit: domain.DefaultPageSize}, nil).
+				Once()
+
+			page, err := service.GetProducts(ctx, domain.ListQuery{}, domain.PageRequest{})
 
+			Expect(err).ToNot(HaveOccurred())
+			Expect(page).ToNot(BeNil())
+			Expect(page.Items).To(BeEmpty())
+		})
+
+		It("should resolve filters before querying the repository", func() {
 			mockRepo.EXPECT().
-				GetAll(ctx).
-				Return(expectedProducts, nil).
+				GetAll(ctx, domain.ListQuery{Filters: []domain.Filter{{Field: "price", Op: domain.OpGt, Values: []any{int64(999)}}}}, domain.PageRequest{Limit: domain.DefaultPageSize}).
+				Return(&domain.Page[domain.Product]{Limit: domain.DefaultPageSize}, nil).
 				Once()
 
-			products, err := service.GetProducts(ctx)
+			_, err := service.GetProducts(ctx, domain.ListQuery{Filters: []domain.Filter{{Field: "price", Op: domain.OpGt, Values: []any{"9.99"}}}}, domain.PageRequest{})
 
 			Expect(err).ToNot(HaveOccurred())
-			Expect(products).ToNot(BeNil())
-			Expect(products).To(BeEmpty())
+		})
+
+		It("should reject unknown fields without querying the repository", func() {
+			page, err := service.GetProducts(ctx, domain.ListQuery{Sort: []domain.Sort{{Field: "secret"}}}, domain.PageRequest{})
+
+			Expect(err).To(MatchError(domain.ErrInvalidArgument))
+			Expect(page).To(BeNil())
 		})
 
 		It("should return error when repository fails", func() {
 			expectedError := errors.New("database error")
 
 			mockRepo.EXPECT().
-				GetAll(ctx).
+				GetAll(ctx, domain.ListQuery{}, domain.PageRequest{Limit: domain.DefaultPageSize}).
 				Return(nil, expectedError).
 				Once()
 
-			products, err := service.GetProducts(ctx)
+			page, err := service.GetProducts(ctx, domain.ListQuery{}, domain.PageRequest{})
 
 			Expect(err).To(MatchError(expectedError))
-			Expect(products).To(BeNil())
+			Expect(page).To(BeNil())
 		})
 	})
 })
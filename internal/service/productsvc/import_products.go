@@ -0,0 +1,94 @@
+package productsvc
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"gin-swagger-api/internal/domain"
+)
+
+// errDryRun rolls back the transaction of a dry run import
+var errDryRun = errors.New("dry run")
+
+// ImportProducts creates the rows without an ID and patches the others
+// with the fields they have, so a row leaves the fields it lacks, such as
+// the stock, as they are.
+// New products are inserted ImportBatchSize at a time. A row whose product
+// does not exist fails on its own; every other error aborts the import.
+// A dry run makes the same writes and rolls them back, so its report is
+// what a real import would return, less the IDs of new products.
+func (s *Service) ImportProducts(ctx context.Context, rows []domain.ProductImportRow, dryRun bool) ([]domain.ImportResult, error) {
+	results := make([]domain.ImportResult, len(rows))
+	var creates, updates []int
+	for i, row := range rows {
+		results[i] = domain.ImportResult{Row: row.Row, Status: domain.ImportCreated}
+		if row.ID == "" {
+			creates = append(creates, i)
+			continue
+		}
+		if _, err := strconv.Atoi(row.ID); err != nil {
+			results[i] = failed(row.Row, "must be a product id")
+			continue
+		}
+		results[i].Status = domain.ImportUpdated
+		updates = append(updates, i)
+	}
+
+	err := s.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		for start := 0; start < len(creates); start += domain.ImportBatchSize {
+			batch := creates[start:min(start+domain.ImportBatchSize, len(creates))]
+			products := make([]domain.Product, len(batch))
+			for i, idx := range batch {
+				products[i] = rows[idx].Product
+			}
+			created, err := s.productRepo.CreateBulk(ctx, products)
+			if err != nil {
+				return err
+			}
+			for i, idx := range batch {
+				results[idx].ID = created[i].ID
+			}
+		}
+
+		for _, idx := range updates {
+			row := rows[idx]
+			id, _ := strconv.Atoi(row.ID)
+			product, err := s.productRepo.Patch(ctx, id, "", row.Patch)
+			if errors.Is(err, domain.ErrNotFound) {
+				results[idx] = failed(row.Row, "product not found")
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			results[idx].ID = product.ID
+		}
+
+		if dryRun {
+			return errDryRun
+		}
+		return nil
+	})
+	if errors.Is(err, errDryRun) {
+		for i := range results {
+			if results[i].Status == domain.ImportCreated {
+				results[i].ID = ""
+			}
+		}
+		return results, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// failed reports a row whose id cannot be imported
+func failed(row int, message string) domain.ImportResult {
+	return domain.ImportResult{
+		Row:    row,
+		Status: domain.ImportFailed,
+		Errors: []domain.FieldError{{Field: "id", Message: message}},
+	}
+}
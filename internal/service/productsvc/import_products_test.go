@@ -0,0 +1,171 @@
+package productsvc_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"gin-swagger-api/internal/domain"
+	portproductsvc "gin-swagger-api/internal/port/service/productsvc"
+	"gin-swagger-api/internal/service/productsvc"
+	"gin-swagger-api/internal/testutil"
+	mockorderrepo "gin-swagger-api/mock/repository/orderrepo"
+	mockproductrepo "gin-swagger-api/mock/repository/productrepo"
+	mocktxmanager "gin-swagger-api/mock/repository/txmanager"
+)
+
+var _ = Describe("ProductService ImportProducts", func() {
+	var (
+		mockRepo *mockproductrepo.MockRepository
+		service  portproductsvc.Service
+		ctx      context.Context
+		rows     []domain.ProductImportRow
+	)
+
+	BeforeEach(func() {
+		mockRepo = mockproductrepo.NewMockRepository(GinkgoT())
+		service = productsvc.New(mockRepo, mockorderrepo.NewMockRepository(GinkgoT()), testutil.NewTxManager(GinkgoT()), domain.DeleteRestrict)
+		ctx = context.Background()
+		name, price := "Keyboard", testutil.THB("50")
+		rows = []domain.ProductImportRow{
+			{Row: 2, Product: domain.Product{Name: "Mouse", Price: testutil.THB("20"), Stock: 5}},
+			{Row: 3, Product: domain.Product{ID: "7"}, Patch: domain.ProductPatch{Name: &name, Price: &price}},
+			{Row: 4, Product: domain.Product{Name: "Monitor", Price: testutil.THB("200"), Stock: 2}},
+		}
+	})
+
+	Describe("ImportProducts", func() {
+		Context("with new and existing products", func() {
+			It("should create and update them and report each row", func() {
+				mockRepo.EXPECT().
+					CreateBulk(ctx, []domain.Product{rows[0].Product, rows[2].Product}).
+					Return([]domain.Product{{ID: "10", Name: "Mouse"}, {ID: "11", Name: "Monitor"}}, nil).
+					Once()
+				mockRepo.EXPECT().
+					Patch(ctx, 7, "", rows[1].Patch).
+					Return(&domain.Product{ID: "7", Name: "Keyboard"}, nil).
+					Once()
+
+				results, err := service.ImportProducts(ctx, rows, false)
+
+				Expect(err).ToNot(HaveOccurred())
+				Expect(results).To(Equal([]domain.ImportResult{
+					{Row: 2, Status: domain.ImportCreated, ID: "10"},
+					{Row: 3, Status: domain.ImportUpdated, ID: "7"},
+					{Row: 4, Status: domain.ImportCreated, ID: "11"},
+				}))
+			})
+		})
+
+		Context("with more new products than a batch", func() {
+			It("should create them in batches", func() {
+				rows = make([]domain.ProductImportRow, domain.ImportBatchSize+1)
+				for i := range rows {
+					rows[i] = domain.ProductImportRow{Row: i + 2, Product: domain.Product{Name: fmt.Sprintf("Product %d", i), Price: testutil.THB("1")}}
+				}
+				var sizes []int
+				mockRepo.EXPECT().
+					CreateBulk(ctx, mock.Anything).
+					RunAndReturn(func(_ context.Context, products []domain.Product) ([]domain.Product, error) {
+						sizes = append(sizes, len(products))
+						return products, nil
+					}).
+					Times(2)
+
+				results, err := service.ImportProducts(ctx, rows, false)
+
+				Expect(err).ToNot(HaveOccurred())
+				Expect(results).To(HaveLen(domain.ImportBatchSize + 1))
+				Expect(sizes).To(Equal([]int{domain.ImportBatchSize, 1}))
+			})
+		})
+
+		Context("when a product to update does not exist", func() {
+			It("should fail only that row", func() {
+				mockRepo.EXPECT().
+					CreateBulk(ctx, mock.Anything).
+					Return([]domain.Product{{ID: "10"}, {ID: "11"}}, nil).
+					Once()
+				mockRepo.EXPECT().
+					Patch(ctx, 7, "", rows[1].Patch).
+					Return(nil, fmt.Errorf("product %w", domain.ErrNotFound)).
+					Once()
+
+				results, err := service.ImportProducts(ctx, rows, false)
+
+				Expect(err).ToNot(HaveOccurred())
+				Expect(results[1]).To(Equal(domain.ImportResult{
+					Row:    3,
+					Status: domain.ImportFailed,
+					Errors: []domain.FieldError{{Field: "id", Message: "product not found"}},
+				}))
+				Expect(results[0].Failed()).To(BeFalse())
+			})
+		})
+
+		Context("when a row has a malformed id", func() {
+			It("should fail the row without writing it", func() {
+				rows = []domain.ProductImportRow{{Row: 2, Product: domain.Product{ID: "abc", Name: "Mouse", Price: testutil.THB("20")}}}
+
+				results, err := service.ImportProducts(ctx, rows, false)
+
+				Expect(err).ToNot(HaveOccurred())
+				Expect(results).To(HaveLen(1))
+				Expect(results[0].Failed()).To(BeTrue())
+				Expect(results[0].Errors).To(Equal([]domain.FieldError{{Field: "id", Message: "must be a product id"}}))
+			})
+		})
+
+		Context("in dry run mode", func() {
+			It("should roll the writes back and report without new ids", func() {
+				txManager := mocktxmanager.NewMockManager(GinkgoT())
+				service = productsvc.New(mockRepo, mockorderrepo.NewMockRepository(GinkgoT()), txManager, domain.DeleteRestrict)
+				var txErr error
+				txManager.EXPECT().
+					WithinTx(ctx, mock.Anything).
+					RunAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+						txErr = fn(ctx)
+						return txErr
+					}).
+					Once()
+				mockRepo.EXPECT().
+					CreateBulk(ctx, mock.Anything).
+					Return([]domain.Product{{ID: "10"}, {ID: "11"}}, nil).
+					Once()
+				mockRepo.EXPECT().
+					Patch(ctx, 7, "", rows[1].Patch).
+					Return(&domain.Product{ID: "7"}, nil).
+					Once()
+
+				results, err := service.ImportProducts(ctx, rows, true)
+
+				Expect(err).ToNot(HaveOccurred())
+				Expect(txErr).To(HaveOccurred(), "the transaction should be rolled back")
+				Expect(results).To(Equal([]domain.ImportResult{
+					{Row: 2, Status: domain.ImportCreated},
+					{Row: 3, Status: domain.ImportUpdated, ID: "7"},
+					{Row: 4, Status: domain.ImportCreated},
+				}))
+			})
+		})
+
+		Context("when the repository fails", func() {
+			It("should return the error", func() {
+				expectedError := errors.New("database error")
+				mockRepo.EXPECT().
+					CreateBulk(ctx, mock.Anything).
+					Return(nil, expectedError).
+					Once()
+
+				results, err := service.ImportProducts(ctx, rows, false)
+
+				Expect(err).To(MatchError(expectedError))
+				Expect(results).To(BeNil())
+			})
+		})
+	})
+})
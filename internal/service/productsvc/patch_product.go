@@ -0,0 +1,18 @@
+package productsvc
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"gin-swagger-api/internal/domain"
+)
+
+func (s *Service) PatchProduct(ctx context.Context, id, version string, patch domain.ProductPatch) (*domain.Product, error) {
+	intID, err := strconv.Atoi(id)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %q", domain.ErrInvalidID, id)
+	}
+
+	return s.productRepo.Patch(ctx, intID, version, patch)
+}
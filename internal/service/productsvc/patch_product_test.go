@@ -0,0 +1,80 @@
+package productsvc_test
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"gin-swagger-api/internal/domain"
+	portproductsvc "gin-swagger-api/internal/port/service/productsvc"
+	"gin-swagger-api/internal/service/productsvc"
+	"gin-swagger-api/internal/testutil"
+	mockorderrepo "gin-swagger-api/mock/repository/orderrepo"
+	mockproductrepo "gin-swagger-api/mock/repository/productrepo"
+)
+
+var _ = Describe("ProductService PatchProduct", func() {
+	var (
+		mockRepo *mockproductrepo.MockRepository
+		service  portproductsvc.Service
+		ctx      context.Context
+	)
+
+	BeforeEach(func() {
+		mockRepo = mockproductrepo.NewMockRepository(GinkgoT())
+		service = productsvc.New(mockRepo, mockorderrepo.NewMockRepository(GinkgoT()), testutil.NewTxManager(GinkgoT()), domain.DeleteRestrict)
+		ctx = context.Background()
+	})
+
+	Describe("PatchProduct", func() {
+		It("should pass only the supplied fields to the repository", func() {
+			price := testutil.THB("10.0")
+			patch := domain.ProductPatch{Price: &price}
+			expectedProduct := &domain.Product{ID: "1", Name: "Laptop", Description: "Gaming laptop", Price: testutil.THB("10.0"), Stock: 5}
+
+			mockRepo.EXPECT().
+				Patch(ctx, 1, "", patch).
+				Return(expectedProduct, nil).
+				Once()
+
+			product, err := service.PatchProduct(ctx, "1", "", patch)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(product).To(Equal(expectedProduct))
+		})
+
+		It("should return error when repository fails", func() {
+			expectedError := errors.New("database error")
+
+			mockRepo.EXPECT().
+				Patch(ctx, 1, "", domain.ProductPatch{}).
+				Return(nil, expectedError).
+				Once()
+
+			product, err := service.PatchProduct(ctx, "1", "", domain.ProductPatch{})
+
+			Expect(err).To(MatchError(expectedError))
+			Expect(product).To(BeNil())
+		})
+
+		It("should return error when the product has changed since the expected version", func() {
+			mockRepo.EXPECT().
+				Patch(ctx, 1, "abc123", domain.ProductPatch{}).
+				Return(nil, domain.ErrPreconditionFailed).
+				Once()
+
+			_, err := service.PatchProduct(ctx, "1", "abc123", domain.ProductPatch{})
+
+			Expect(err).To(MatchError(domain.ErrPreconditionFailed))
+		})
+
+		It("should return error when product ID is invalid", func() {
+			product, err := service.PatchProduct(ctx, "invalid", "", domain.ProductPatch{})
+
+			Expect(err).To(MatchError(domain.ErrInvalidID))
+			Expect(product).To(BeNil())
+		})
+	})
+})
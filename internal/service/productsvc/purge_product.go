@@ -0,0 +1,59 @@
+package productsvc
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"gin-swagger-api/internal/domain"
+	"gin-swagger-api/internal/service/deletepolicy"
+)
+
+// PurgeProduct removes a soft-deleted product for good in a transaction,
+// after applying the product delete policy to the orders with an item for
+// it. A failed purge leaves the orders untouched.
+func (s *Service) PurgeProduct(ctx context.Context, id string) error {
+	intID, err := strconv.Atoi(id)
+	if err != nil {
+		return fmt.Errorf("%w: %q", domain.ErrInvalidID, id)
+	}
+
+	return s.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		product, err := s.productRepo.GetByID(domain.WithDeleted(ctx), intID)
+		if err != nil {
+			return err
+		}
+		if product.DeletedAt == nil {
+			return fmt.Errorf("product %d is %w: delete it before purging it", intID, domain.ErrNotDeleted)
+		}
+
+		if err := s.applyDeletePolicy(ctx, intID); err != nil {
+			return err
+		}
+		return s.productRepo.Purge(ctx, intID)
+	})
+}
+
+// applyDeletePolicy restricts, cascades to or detaches the orders of a
+// product about to be purged. Cascaded orders return their stock of the
+// other products only.
+func (s *Service) applyDeletePolicy(ctx context.Context, productID int) error {
+	return deletepolicy.Apply(ctx, s.deletePolicy, deletepolicy.Orders{
+		Entity: "product",
+		Count: func(ctx context.Context) (int, error) {
+			return s.orderRepo.CountByProduct(ctx, productID)
+		},
+		Delete: func(ctx context.Context) ([]domain.Order, error) {
+			return s.orderRepo.DeleteByProduct(ctx, productID)
+		},
+		Detach: func(ctx context.Context) error {
+			return s.orderRepo.DetachProduct(ctx, productID)
+		},
+		Release: func(ctx context.Context, id, quantity int) error {
+			if id == productID {
+				return nil
+			}
+			return s.productRepo.ReleaseStock(ctx, id, quantity)
+		},
+	})
+}
@@ -0,0 +1,164 @@
+package productsvc_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"gin-swagger-api/internal/domain"
+	portproductsvc "gin-swagger-api/internal/port/service/productsvc"
+	"gin-swagger-api/internal/service/productsvc"
+	"gin-swagger-api/internal/testutil"
+	mockorderrepo "gin-swagger-api/mock/repository/orderrepo"
+	mockproductrepo "gin-swagger-api/mock/repository/productrepo"
+)
+
+var _ = Describe("ProductService PurgeProduct", func() {
+	var (
+		mockRepo      *mockproductrepo.MockRepository
+		mockOrderRepo *mockorderrepo.MockRepository
+		service       portproductsvc.Service
+		ctx           context.Context
+		deleted       *domain.Product
+	)
+
+	// withPolicy rebuilds the service with a product delete policy
+	withPolicy := func(policy domain.DeletePolicy) {
+		service = productsvc.New(mockRepo, mockOrderRepo, testutil.NewTxManager(GinkgoT()), policy)
+	}
+
+	// expectDeleted makes the product to purge a soft-deleted one
+	expectDeleted := func() {
+		mockRepo.EXPECT().
+			GetByID(mock.MatchedBy(domain.IncludesDeleted), 1).
+			Return(deleted, nil).
+			Once()
+	}
+
+	BeforeEach(func() {
+		mockRepo = mockproductrepo.NewMockRepository(GinkgoT())
+		mockOrderRepo = mockorderrepo.NewMockRepository(GinkgoT())
+		withPolicy(domain.DeleteRestrict)
+		ctx = context.Background()
+		deletedAt := time.Date(2026, 1, 15, 10, 0, 0, 0, time.UTC)
+		deleted = &domain.Product{ID: "1", Name: "Laptop", Price: testutil.THB("999.99"), Stock: 10, DeletedAt: &deletedAt}
+	})
+
+	Describe("PurgeProduct", func() {
+		It("should purge a soft-deleted product in no orders", func() {
+			expectDeleted()
+			mockOrderRepo.EXPECT().
+				CountByProduct(ctx, 1).
+				Return(0, nil).
+				Once()
+			mockRepo.EXPECT().
+				Purge(ctx, 1).
+				Return(nil).
+				Once()
+
+			err := service.PurgeProduct(ctx, "1")
+
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should refuse to purge a product that is not soft-deleted", func() {
+			mockRepo.EXPECT().
+				GetByID(mock.MatchedBy(domain.IncludesDeleted), 1).
+				Return(&domain.Product{ID: "1", Name: "Laptop", Price: testutil.THB("999.99"), Stock: 10}, nil).
+				Once()
+
+			err := service.PurgeProduct(ctx, "1")
+
+			Expect(err).To(MatchError(domain.ErrNotDeleted))
+		})
+
+		It("should return error when product ID is invalid", func() {
+			err := service.PurgeProduct(ctx, "invalid")
+
+			Expect(err).To(MatchError(domain.ErrInvalidID))
+		})
+
+		Context("with the restrict policy", func() {
+			It("should refuse to purge a product in orders and count them", func() {
+				expectDeleted()
+				mockOrderRepo.EXPECT().
+					CountByProduct(ctx, 1).
+					Return(2, nil).
+					Once()
+
+				err := service.PurgeProduct(ctx, "1")
+
+				var inUse *domain.InUseError
+				Expect(errors.As(err, &inUse)).To(BeTrue())
+				Expect(*inUse).To(Equal(domain.InUseError{Entity: "product", Orders: 2}))
+			})
+		})
+
+		Context("with the cascade policy", func() {
+			BeforeEach(func() {
+				withPolicy(domain.DeleteCascade)
+			})
+
+			It("should delete the orders and return the stock they hold of other products", func() {
+				expectDeleted()
+				mockOrderRepo.EXPECT().
+					DeleteByProduct(ctx, 1).
+					Return([]domain.Order{
+						{ID: "1", Items: []domain.OrderItem{domain.NewOrderItem(1, 2, testutil.THB("10")), domain.NewOrderItem(4, 3, testutil.THB("5"))}, Status: domain.OrderStatusPending},
+						{ID: "2", Items: []domain.OrderItem{domain.NewOrderItem(1, 1, testutil.THB("10")), domain.NewOrderItem(6, 5, testutil.THB("5"))}, Status: domain.OrderStatusDelivered},
+					}, nil).
+					Once()
+				mockRepo.EXPECT().ReleaseStock(ctx, 4, 3).Return(nil).Once()
+				mockRepo.EXPECT().
+					Purge(ctx, 1).
+					Return(nil).
+					Once()
+
+				err := service.PurgeProduct(ctx, "1")
+
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("should fail when stock cannot be returned", func() {
+				expectDeleted()
+				mockOrderRepo.EXPECT().
+					DeleteByProduct(ctx, 1).
+					Return([]domain.Order{
+						{ID: "1", Items: []domain.OrderItem{domain.NewOrderItem(1, 2, testutil.THB("10")), domain.NewOrderItem(4, 3, testutil.THB("5"))}, Status: domain.OrderStatusPending},
+					}, nil).
+					Once()
+				mockRepo.EXPECT().ReleaseStock(ctx, 4, 3).Return(errors.New("database error")).Once()
+
+				err := service.PurgeProduct(ctx, "1")
+
+				Expect(err).To(MatchError("database error"))
+			})
+		})
+
+		Context("with the nullify policy", func() {
+			BeforeEach(func() {
+				withPolicy(domain.DeleteNullify)
+			})
+
+			It("should keep the order items of the product without their product", func() {
+				expectDeleted()
+				mockOrderRepo.EXPECT().
+					DetachProduct(ctx, 1).
+					Return(nil).
+					Once()
+				mockRepo.EXPECT().
+					Purge(ctx, 1).
+					Return(nil).
+					Once()
+
+				err := service.PurgeProduct(ctx, "1")
+
+				Expect(err).ToNot(HaveOccurred())
+			})
+		})
+	})
+})
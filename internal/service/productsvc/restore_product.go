@@ -0,0 +1,19 @@
+package productsvc
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"gin-swagger-api/internal/domain"
+)
+
+// RestoreProduct brings back a soft-deleted product with the stock it had
+func (s *Service) RestoreProduct(ctx context.Context, id string) (*domain.Product, error) {
+	intID, err := strconv.Atoi(id)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %q", domain.ErrInvalidID, id)
+	}
+
+	return s.productRepo.Restore(ctx, intID)
+}
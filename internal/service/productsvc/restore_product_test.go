@@ -0,0 +1,62 @@
+package productsvc_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"gin-swagger-api/internal/domain"
+	portproductsvc "gin-swagger-api/internal/port/service/productsvc"
+	"gin-swagger-api/internal/service/productsvc"
+	"gin-swagger-api/internal/testutil"
+	mockorderrepo "gin-swagger-api/mock/repository/orderrepo"
+	mockproductrepo "gin-swagger-api/mock/repository/productrepo"
+)
+
+var _ = Describe("ProductService RestoreProduct", func() {
+	var (
+		mockRepo *mockproductrepo.MockRepository
+		service  portproductsvc.Service
+		ctx      context.Context
+	)
+
+	BeforeEach(func() {
+		mockRepo = mockproductrepo.NewMockRepository(GinkgoT())
+		service = productsvc.New(mockRepo, mockorderrepo.NewMockRepository(GinkgoT()), testutil.NewTxManager(GinkgoT()), domain.DeleteRestrict)
+		ctx = context.Background()
+	})
+
+	Describe("RestoreProduct", func() {
+		It("should return the restored product", func() {
+			restored := &domain.Product{ID: "1", Name: "Laptop", Price: testutil.THB("999.99"), Stock: 10}
+			mockRepo.EXPECT().
+				Restore(ctx, 1).
+				Return(restored, nil).
+				Once()
+
+			product, err := service.RestoreProduct(ctx, "1")
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(product).To(Equal(restored))
+		})
+
+		It("should return error when product not found", func() {
+			mockRepo.EXPECT().
+				Restore(ctx, 999).
+				Return(nil, domain.ErrNotFound).
+				Once()
+
+			product, err := service.RestoreProduct(ctx, "999")
+
+			Expect(err).To(MatchError(domain.ErrNotFound))
+			Expect(product).To(BeNil())
+		})
+
+		It("should return error when product ID is invalid", func() {
+			_, err := service.RestoreProduct(ctx, "invalid")
+
+			Expect(err).To(MatchError(domain.ErrInvalidID))
+		})
+	})
+})
@@ -1,18 +1,29 @@
 package productsvc
 
 import (
+	"gin-swagger-api/internal/domain"
 	port "gin-swagger-api/internal/port/service/productsvc"
+	orderrepo "gin-swagger-api/internal/port/repository/orderrepo"
 	productrepo "gin-swagger-api/internal/port/repository/productrepo"
+	"gin-swagger-api/internal/port/repository/txmanager"
 )
 
 // Service implements port.Service interface
 type Service struct {
-	productRepo productrepo.Repository
+	productRepo  productrepo.Repository
+	orderRepo    orderrepo.Repository
+	txManager    txmanager.Manager
+	deletePolicy domain.DeletePolicy
 }
 
-// New creates a new product service with product repository
-func New(productRepo productrepo.Repository) port.Service {
+// New creates a new product service with product and order repositories,
+// the transaction manager imports and deletes run in, and the policy
+// purging a product applies to its orders
+func New(productRepo productrepo.Repository, orderRepo orderrepo.Repository, txManager txmanager.Manager, deletePolicy domain.DeletePolicy) port.Service {
 	return &Service{
-		productRepo: productRepo,
+		productRepo:  productRepo,
+		orderRepo:    orderRepo,
+		txManager:    txManager,
+		deletePolicy: deletePolicy,
 	}
 }
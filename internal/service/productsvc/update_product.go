@@ -2,17 +2,18 @@ package productsvc
 
 import (
 	"context"
+	"fmt"
 	"strconv"
 
 	"gin-swagger-api/internal/domain"
 )
 
-func (s *Service) UpdateProduct(ctx context.Context, id, name, description string, price float64, stock int) (*domain.Product, error) {
+func (s *Service) UpdateProduct(ctx context.Context, id, version, name, description string, price domain.Money, stock int) (*domain.Product, error) {
 	// Convert string ID to int
 	intID, err := strconv.Atoi(id)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("%w: %q", domain.ErrInvalidID, id)
 	}
 
-	return s.productRepo.Update(ctx, intID, name, description, price, stock)
+	return s.productRepo.Update(ctx, intID, version, name, description, price, stock)
 }
@@ -11,6 +11,8 @@ import (
 	"gin-swagger-api/internal/domain"
 	portproductsvc "gin-swagger-api/internal/port/service/productsvc"
 	"gin-swagger-api/internal/service/productsvc"
+	"gin-swagger-api/internal/testutil"
+	mockorderrepo "gin-swagger-api/mock/repository/orderrepo"
 	mockproductrepo "gin-swagger-api/mock/repository/productrepo"
 )
 
@@ -23,7 +25,7 @@ var _ = Describe("ProductService UpdateProduct", func() {
 
 	BeforeEach(func() {
 		mockRepo = mockproductrepo.NewMockRepository(GinkgoT())
-		service = productsvc.New(mockRepo)
+		service = productsvc.New(mockRepo, mockorderrepo.NewMockRepository(GinkgoT()), testutil.NewTxManager(GinkgoT()), domain.DeleteRestrict)
 		ctx = context.Background()
 	})
 
@@ -33,7 +35,7 @@ var _ = Describe("ProductService UpdateProduct", func() {
 				ID:          "1",
 				Name:        "Gaming Laptop",
 				Description: "Updated high-performance gaming laptop",
-				Price:       1299.99,
+				Price:       testutil.THB("1299.99"),
 				Stock:       5,
 			}
 
@@ -41,18 +43,18 @@ var _ = Describe("ProductService UpdateProduct", func() {
 			productIDInt, _ := strconv.Atoi(productID)
 
 			mockRepo.EXPECT().
-				Update(ctx, productIDInt, "Gaming Laptop", "Updated high-performance gaming laptop", 1299.99, 5).
+				Update(ctx, productIDInt, "", "Gaming Laptop", "Updated high-performance gaming laptop", testutil.THB("1299.99"), 5).
 				Return(expectedProduct, nil).
 				Once()
 
-			product, err := service.UpdateProduct(ctx, productID, "Gaming Laptop", "Updated high-performance gaming laptop", 1299.99, 5)
+			product, err := service.UpdateProduct(ctx, productID, "", "Gaming Laptop", "Updated high-performance gaming laptop", testutil.THB("1299.99"), 5)
 
 			Expect(err).ToNot(HaveOccurred())
 			Expect(*product).To(Equal(*expectedProduct))
 			Expect(product.ID).To(Equal("1"))
 			Expect(product.Name).To(Equal("Gaming Laptop"))
 			Expect(product.Description).To(Equal("Updated high-performance gaming laptop"))
-			Expect(product.Price).To(Equal(1299.99))
+			Expect(product.Price).To(Equal(testutil.THB("1299.99")))
 			Expect(product.Stock).To(Equal(5))
 		})
 
@@ -62,22 +64,33 @@ var _ = Describe("ProductService UpdateProduct", func() {
 			productIDInt, _ := strconv.Atoi(productID)
 
 			mockRepo.EXPECT().
-				Update(ctx, productIDInt, "Gaming Laptop", "Updated description", 1299.99, 5).
+				Update(ctx, productIDInt, "", "Gaming Laptop", "Updated description", testutil.THB("1299.99"), 5).
 				Return(nil, expectedError).
 				Once()
 
-			product, err := service.UpdateProduct(ctx, productID, "Gaming Laptop", "Updated description", 1299.99, 5)
+			product, err := service.UpdateProduct(ctx, productID, "", "Gaming Laptop", "Updated description", testutil.THB("1299.99"), 5)
 
 			Expect(err).To(MatchError(expectedError))
 			Expect(product).To(BeNil())
 		})
 
+		It("should return error when the product has changed since the expected version", func() {
+			mockRepo.EXPECT().
+				Update(ctx, 1, "abc123", "Gaming Laptop", "Updated description", testutil.THB("1299.99"), 5).
+				Return(nil, domain.ErrPreconditionFailed).
+				Once()
+
+			_, err := service.UpdateProduct(ctx, "1", "abc123", "Gaming Laptop", "Updated description", testutil.THB("1299.99"), 5)
+
+			Expect(err).To(MatchError(domain.ErrPreconditionFailed))
+		})
+
 		It("should return error when product ID is invalid", func() {
 			productID := "invalid"
 
-			product, err := service.UpdateProduct(ctx, productID, "Gaming Laptop", "Updated description", 1299.99, 5)
+			product, err := service.UpdateProduct(ctx, productID, "", "Gaming Laptop", "Updated description", testutil.THB("1299.99"), 5)
 
-			Expect(err).To(HaveOccurred())
+			Expect(err).To(MatchError(domain.ErrInvalidID))
 			Expect(product).To(BeNil())
 		})
 	})
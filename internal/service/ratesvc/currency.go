@@ -0,0 +1,27 @@
+package ratesvc
+
+import (
+	"fmt"
+	"strings"
+
+	"gin-swagger-api/internal/domain"
+)
+
+// currencyCode upper-cases an ISO 4217 code and checks that it is
+// supported
+func currencyCode(currency string) (string, error) {
+	code := strings.ToUpper(currency)
+	if !domain.SupportedCurrency(code) {
+		return "", fmt.Errorf("%w: unsupported currency %q", domain.ErrInvalidArgument, currency)
+	}
+	return code, nil
+}
+
+// changeable rejects changes to the rate of the base currency, which is
+// always one
+func changeable(code string) error {
+	if code == domain.BaseCurrency {
+		return fmt.Errorf("%w: the rate of %s is always 1", domain.ErrInvalidArgument, domain.BaseCurrency)
+	}
+	return nil
+}
@@ -0,0 +1,17 @@
+package ratesvc
+
+import "context"
+
+// DeleteRate removes the rate of a currency, so new orders can no longer
+// be placed in it. Orders already placed keep their rate.
+func (s *Service) DeleteRate(ctx context.Context, currency string) error {
+	code, err := currencyCode(currency)
+	if err != nil {
+		return err
+	}
+	if err := changeable(code); err != nil {
+		return err
+	}
+
+	return s.rateRepo.Delete(ctx, code)
+}
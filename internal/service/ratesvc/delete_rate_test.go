@@ -0,0 +1,46 @@
+package ratesvc_test
+
+import (
+	"context"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"gin-swagger-api/internal/domain"
+	portratesvc "gin-swagger-api/internal/port/service/ratesvc"
+	"gin-swagger-api/internal/service/ratesvc"
+	mockraterepo "gin-swagger-api/mock/repository/raterepo"
+)
+
+var _ = Describe("RateService DeleteRate", func() {
+	var (
+		mockRepo *mockraterepo.MockRepository
+		service  portratesvc.Service
+		ctx      context.Context
+	)
+
+	BeforeEach(func() {
+		mockRepo = mockraterepo.NewMockRepository(GinkgoT())
+		service = ratesvc.New(mockRepo)
+		ctx = context.Background()
+	})
+
+	Describe("DeleteRate", func() {
+		It("should delete the rate of a currency", func() {
+			mockRepo.EXPECT().Delete(ctx, "USD").Return(nil).Once()
+
+			Expect(service.DeleteRate(ctx, "usd")).To(Succeed())
+		})
+
+		It("should return ErrNotFound for a currency without a rate", func() {
+			mockRepo.EXPECT().Delete(ctx, "EUR").Return(fmt.Errorf("exchange rate %w", domain.ErrNotFound)).Once()
+
+			Expect(service.DeleteRate(ctx, "EUR")).To(MatchError(domain.ErrNotFound))
+		})
+
+		It("should refuse to delete the base currency", func() {
+			Expect(service.DeleteRate(ctx, "THB")).To(MatchError(domain.ErrInvalidArgument))
+		})
+	})
+})
@@ -0,0 +1,22 @@
+package ratesvc
+
+import (
+	"context"
+
+	"gin-swagger-api/internal/domain"
+)
+
+// GetRate returns the rate of a currency. The base currency has rate one;
+// a currency without a rate fails with domain.ErrNotFound.
+func (s *Service) GetRate(ctx context.Context, currency string) (*domain.ExchangeRate, error) {
+	code, err := currencyCode(currency)
+	if err != nil {
+		return nil, err
+	}
+	if code == domain.BaseCurrency {
+		rate := domain.BaseRate()
+		return &rate, nil
+	}
+
+	return s.rateRepo.Get(ctx, code)
+}
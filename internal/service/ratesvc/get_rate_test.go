@@ -0,0 +1,63 @@
+package ratesvc_test
+
+import (
+	"context"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"gin-swagger-api/internal/domain"
+	portratesvc "gin-swagger-api/internal/port/service/ratesvc"
+	"gin-swagger-api/internal/service/ratesvc"
+	mockraterepo "gin-swagger-api/mock/repository/raterepo"
+)
+
+var _ = Describe("RateService GetRate", func() {
+	var (
+		mockRepo *mockraterepo.MockRepository
+		service  portratesvc.Service
+		ctx      context.Context
+	)
+
+	BeforeEach(func() {
+		mockRepo = mockraterepo.NewMockRepository(GinkgoT())
+		service = ratesvc.New(mockRepo)
+		ctx = context.Background()
+	})
+
+	Describe("GetRate", func() {
+		It("should look up the rate of a currency in any case", func() {
+			rate := &domain.ExchangeRate{Currency: "USD", Rate: "0.0275"}
+			mockRepo.EXPECT().Get(ctx, "USD").Return(rate, nil).Once()
+
+			result, err := service.GetRate(ctx, "usd")
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(Equal(rate))
+		})
+
+		It("should return rate one for the base currency", func() {
+			result, err := service.GetRate(ctx, "THB")
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(Equal(&domain.ExchangeRate{Currency: "THB", Rate: domain.RateOne}))
+		})
+
+		It("should return ErrNotFound for a currency without a rate", func() {
+			mockRepo.EXPECT().Get(ctx, "EUR").Return(nil, fmt.Errorf("exchange rate %w", domain.ErrNotFound)).Once()
+
+			result, err := service.GetRate(ctx, "EUR")
+
+			Expect(err).To(MatchError(domain.ErrNotFound))
+			Expect(result).To(BeNil())
+		})
+
+		It("should reject unsupported currencies", func() {
+			result, err := service.GetRate(ctx, "XYZ")
+
+			Expect(err).To(MatchError(domain.ErrInvalidArgument))
+			Expect(result).To(BeNil())
+		})
+	})
+})
@@ -0,0 +1,13 @@
+package ratesvc
+
+import (
+	"context"
+
+	"gin-swagger-api/internal/domain"
+)
+
+// GetRates returns the rates of the currencies other than the base
+// currency, ordered by currency
+func (s *Service) GetRates(ctx context.Context) ([]domain.ExchangeRate, error) {
+	return s.rateRepo.GetAll(ctx)
+}
@@ -0,0 +1,52 @@
+package ratesvc_test
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"gin-swagger-api/internal/domain"
+	portratesvc "gin-swagger-api/internal/port/service/ratesvc"
+	"gin-swagger-api/internal/service/ratesvc"
+	mockraterepo "gin-swagger-api/mock/repository/raterepo"
+)
+
+var _ = Describe("RateService GetRates", func() {
+	var (
+		mockRepo *mockraterepo.MockRepository
+		service  portratesvc.Service
+		ctx      context.Context
+	)
+
+	BeforeEach(func() {
+		mockRepo = mockraterepo.NewMockRepository(GinkgoT())
+		service = ratesvc.New(mockRepo)
+		ctx = context.Background()
+	})
+
+	Describe("GetRates", func() {
+		It("should return the rate table", func() {
+			rates := []domain.ExchangeRate{
+				{Currency: "EUR", Rate: "0.0255"},
+				{Currency: "USD", Rate: "0.0275"},
+			}
+			mockRepo.EXPECT().GetAll(ctx).Return(rates, nil).Once()
+
+			result, err := service.GetRates(ctx)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(Equal(rates))
+		})
+
+		It("should return repository errors", func() {
+			mockRepo.EXPECT().GetAll(ctx).Return(nil, errors.New("read failed")).Once()
+
+			result, err := service.GetRates(ctx)
+
+			Expect(err).To(MatchError("read failed"))
+			Expect(result).To(BeNil())
+		})
+	})
+})
@@ -0,0 +1,13 @@
+package ratesvc_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestRateSvc(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "RateSvc Suite")
+}
@@ -0,0 +1,18 @@
+package ratesvc
+
+import (
+	raterepo "gin-swagger-api/internal/port/repository/raterepo"
+	port "gin-swagger-api/internal/port/service/ratesvc"
+)
+
+// Service implements port.Service interface
+type Service struct {
+	rateRepo raterepo.Repository
+}
+
+// New creates a new exchange rate service with rate repository
+func New(rateRepo raterepo.Repository) port.Service {
+	return &Service{
+		rateRepo: rateRepo,
+	}
+}
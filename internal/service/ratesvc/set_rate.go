@@ -0,0 +1,32 @@
+package ratesvc
+
+import (
+	"context"
+	"time"
+
+	"gin-swagger-api/internal/domain"
+)
+
+// SetRate adds or replaces the rate of a currency, a positive decimal
+// number of units of it per unit of the base currency. Orders already
+// placed keep the rate they were placed at.
+func (s *Service) SetRate(ctx context.Context, currency, rate string) (*domain.ExchangeRate, error) {
+	code, err := currencyCode(currency)
+	if err != nil {
+		return nil, err
+	}
+	if err := changeable(code); err != nil {
+		return nil, err
+	}
+
+	parsed, err := domain.ParseRate(rate)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.rateRepo.Set(ctx, domain.ExchangeRate{
+		Currency:  code,
+		Rate:      parsed,
+		UpdatedAt: time.Now().UTC(),
+	})
+}
@@ -0,0 +1,73 @@
+package ratesvc_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"gin-swagger-api/internal/domain"
+	portratesvc "gin-swagger-api/internal/port/service/ratesvc"
+	"gin-swagger-api/internal/service/ratesvc"
+	mockraterepo "gin-swagger-api/mock/repository/raterepo"
+)
+
+var _ = Describe("RateService SetRate", func() {
+	var (
+		mockRepo *mockraterepo.MockRepository
+		service  portratesvc.Service
+		ctx      context.Context
+	)
+
+	BeforeEach(func() {
+		mockRepo = mockraterepo.NewMockRepository(GinkgoT())
+		service = ratesvc.New(mockRepo)
+		ctx = context.Background()
+	})
+
+	Describe("SetRate", func() {
+		It("should store the canonical rate with the time it was set", func() {
+			var stored domain.ExchangeRate
+			mockRepo.EXPECT().
+				Set(ctx, mock.Anything).
+				RunAndReturn(func(_ context.Context, rate domain.ExchangeRate) (*domain.ExchangeRate, error) {
+					stored = rate
+					return &rate, nil
+				}).
+				Once()
+
+			result, err := service.SetRate(ctx, "eur", "0.02550")
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(stored.Currency).To(Equal("EUR"))
+			Expect(stored.Rate).To(Equal(domain.Rate("0.0255")))
+			Expect(stored.UpdatedAt).To(BeTemporally("~", time.Now(), time.Second))
+			Expect(*result).To(Equal(stored))
+		})
+
+		DescribeTable("should reject invalid changes without storing them",
+			func(currency, rate string) {
+				result, err := service.SetRate(ctx, currency, rate)
+
+				Expect(err).To(MatchError(domain.ErrInvalidArgument))
+				Expect(result).To(BeNil())
+			},
+			Entry("unsupported currency", "XYZ", "1"),
+			Entry("base currency", "THB", "1"),
+			Entry("zero rate", "USD", "0"),
+			Entry("malformed rate", "USD", "abc"),
+		)
+
+		It("should return repository errors", func() {
+			mockRepo.EXPECT().Set(ctx, mock.Anything).Return(nil, errors.New("write failed")).Once()
+
+			result, err := service.SetRate(ctx, "USD", "0.0275")
+
+			Expect(err).To(MatchError("write failed"))
+			Expect(result).To(BeNil())
+		})
+	})
+})
@@ -0,0 +1,49 @@
+package reportsvc
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"gin-swagger-api/internal/domain"
+)
+
+func (s *Service) GetSalesReport(ctx context.Context, query domain.SalesQuery) (*domain.SalesReport, error) {
+	if query.To.IsZero() {
+		query.To = time.Now()
+	}
+	if query.From.IsZero() {
+		query.From = query.To.Add(-DefaultSalesRange)
+	}
+
+	if !query.From.Before(query.To) {
+		return nil, fmt.Errorf("%w: to must be after from", domain.ErrInvalidArgument)
+	}
+	if query.GroupBy != "" && !slices.Contains(domain.SalesDimensions, query.GroupBy) {
+		return nil, fmt.Errorf("%w: unknown group_by %q, use one of %s", domain.ErrInvalidArgument, query.GroupBy, strings.Join(domain.SalesDimensions, ", "))
+	}
+
+	report := &domain.SalesReport{From: query.From, To: query.To, GroupBy: query.GroupBy, Rows: []domain.SalesRow{}}
+
+	// Orders spanning several groups count once in each, so the total is
+	// aggregated on its own rather than summed from the rows
+	total, err := s.reportRepo.Sales(ctx, domain.SalesQuery{From: query.From, To: query.To})
+	if err != nil {
+		return nil, err
+	}
+	if len(total) > 0 {
+		report.Total = total[0]
+	}
+
+	if query.GroupBy != "" {
+		rows, err := s.reportRepo.Sales(ctx, query)
+		if err != nil {
+			return nil, err
+		}
+		report.Rows = rows
+	}
+
+	return report, nil
+}
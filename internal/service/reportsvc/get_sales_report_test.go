@@ -0,0 +1,122 @@
+package reportsvc_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"gin-swagger-api/internal/domain"
+	portreportsvc "gin-swagger-api/internal/port/service/reportsvc"
+	"gin-swagger-api/internal/service/reportsvc"
+	"gin-swagger-api/internal/testutil"
+	mockreportrepo "gin-swagger-api/mock/repository/reportrepo"
+)
+
+var _ = Describe("ReportService GetSalesReport", func() {
+	var (
+		mockRepo *mockreportrepo.MockRepository
+		service  portreportsvc.Service
+		ctx      context.Context
+		from     time.Time
+		to       time.Time
+	)
+
+	BeforeEach(func() {
+		mockRepo = mockreportrepo.NewMockRepository(GinkgoT())
+		service = reportsvc.New(mockRepo)
+		ctx = context.Background()
+		from = time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC)
+		to = time.Date(2026, time.February, 1, 0, 0, 0, 0, time.UTC)
+	})
+
+	Describe("GetSalesReport", func() {
+		It("should return the grouped rows and the total over all orders", func() {
+			rows := []domain.SalesRow{
+				{Key: "1", Revenue: testutil.THB("2900.00"), Orders: 2, Units: 3},
+				{Key: "2", Revenue: testutil.THB("75.00"), Orders: 2, Units: 3},
+			}
+			total := domain.SalesRow{Revenue: testutil.THB("2975.00"), Orders: 3, Units: 6}
+			mockRepo.EXPECT().
+				Sales(ctx, domain.SalesQuery{From: from, To: to}).
+				Return([]domain.SalesRow{total}, nil).
+				Once()
+			mockRepo.EXPECT().
+				Sales(ctx, domain.SalesQuery{From: from, To: to, GroupBy: domain.SalesByProduct}).
+				Return(rows, nil).
+				Once()
+
+			report, err := service.GetSalesReport(ctx, domain.SalesQuery{From: from, To: to, GroupBy: domain.SalesByProduct})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(*report).To(Equal(domain.SalesReport{
+				From:    from,
+				To:      to,
+				GroupBy: domain.SalesByProduct,
+				Rows:    rows,
+				Total:   total,
+			}))
+		})
+
+		It("should only aggregate the total when no dimension is given", func() {
+			total := domain.SalesRow{Revenue: testutil.THB("2975.00"), Orders: 3, Units: 6}
+			mockRepo.EXPECT().
+				Sales(ctx, domain.SalesQuery{From: from, To: to}).
+				Return([]domain.SalesRow{total}, nil).
+				Once()
+
+			report, err := service.GetSalesReport(ctx, domain.SalesQuery{From: from, To: to})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(report.Rows).To(BeEmpty())
+			Expect(report.Total).To(Equal(total))
+		})
+
+		It("should cover the last 30 days when no range is given", func() {
+			mockRepo.EXPECT().
+				Sales(ctx, mock.MatchedBy(func(query domain.SalesQuery) bool {
+					return query.To.Sub(query.From) == reportsvc.DefaultSalesRange &&
+						time.Since(query.To) < time.Minute
+				})).
+				Return([]domain.SalesRow{{}}, nil).
+				Once()
+
+			report, err := service.GetSalesReport(ctx, domain.SalesQuery{})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(report.To.Sub(report.From)).To(Equal(reportsvc.DefaultSalesRange))
+		})
+
+		It("should reject a range that ends before it starts", func() {
+			report, err := service.GetSalesReport(ctx, domain.SalesQuery{From: to, To: from})
+
+			Expect(err).To(MatchError(domain.ErrInvalidArgument))
+			Expect(err.Error()).To(ContainSubstring("to must be after from"))
+			Expect(report).To(BeNil())
+		})
+
+		It("should reject an unknown dimension", func() {
+			report, err := service.GetSalesReport(ctx, domain.SalesQuery{From: from, To: to, GroupBy: "country"})
+
+			Expect(err).To(MatchError(domain.ErrInvalidArgument))
+			Expect(err.Error()).To(ContainSubstring(`"country"`))
+			Expect(report).To(BeNil())
+		})
+
+		It("should return error when repository fails", func() {
+			expectedError := errors.New("database error")
+			mockRepo.EXPECT().
+				Sales(ctx, domain.SalesQuery{From: from, To: to}).
+				Return(nil, expectedError).
+				Once()
+
+			report, err := service.GetSalesReport(ctx, domain.SalesQuery{From: from, To: to, GroupBy: domain.SalesByDay})
+
+			Expect(err).To(MatchError(expectedError))
+			Expect(report).To(BeNil())
+		})
+	})
+})
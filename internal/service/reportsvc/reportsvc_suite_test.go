@@ -0,0 +1,13 @@
+package reportsvc_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestReportSvc(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "ReportSvc Suite")
+}
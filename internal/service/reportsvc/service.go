@@ -0,0 +1,24 @@
+package reportsvc
+
+import (
+	"time"
+
+	reportrepo "gin-swagger-api/internal/port/repository/reportrepo"
+	port "gin-swagger-api/internal/port/service/reportsvc"
+)
+
+// DefaultSalesRange is the range a sales report covers when the query
+// gives no start
+const DefaultSalesRange = 30 * 24 * time.Hour
+
+// Service implements port.Service interface
+type Service struct {
+	reportRepo reportrepo.Repository
+}
+
+// New creates a new report service with report repository
+func New(reportRepo reportrepo.Repository) port.Service {
+	return &Service{
+		reportRepo: reportRepo,
+	}
+}
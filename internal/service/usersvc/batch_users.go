@@ -0,0 +1,23 @@
+package usersvc
+
+import (
+	"context"
+
+	"gin-swagger-api/internal/domain"
+	"gin-swagger-api/internal/service/batch"
+)
+
+func (s *Service) BatchUsers(ctx context.Context, b domain.Batch[domain.User]) (*domain.BatchResults[domain.User], error) {
+	return batch.Run(ctx, s.txManager, b, batch.Writes[domain.User]{
+		CreateBulk: s.userRepo.CreateBulk,
+		Create: func(ctx context.Context, user domain.User) (*domain.User, error) {
+			return s.CreateUser(ctx, user.Name, user.Email)
+		},
+		Update: func(ctx context.Context, version string, user domain.User) (*domain.User, error) {
+			return s.UpdateUser(ctx, user.ID, version, user.Name, user.Email)
+		},
+		Delete: func(ctx context.Context, ref domain.BatchRef) error {
+			return s.DeleteUser(ctx, ref.ID, ref.Version)
+		},
+	})
+}
@@ -0,0 +1,93 @@
+package usersvc_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"gin-swagger-api/internal/domain"
+	portusersvc "gin-swagger-api/internal/port/service/usersvc"
+	"gin-swagger-api/internal/service/usersvc"
+	"gin-swagger-api/internal/testutil"
+	mockorderrepo "gin-swagger-api/mock/repository/orderrepo"
+	mockproductrepo "gin-swagger-api/mock/repository/productrepo"
+	mockuserrepo "gin-swagger-api/mock/repository/userrepo"
+)
+
+var _ = Describe("UserService BatchUsers", func() {
+	var (
+		mockRepo *mockuserrepo.MockRepository
+		service  portusersvc.Service
+		ctx      context.Context
+		batch    domain.Batch[domain.User]
+	)
+
+	BeforeEach(func() {
+		mockRepo = mockuserrepo.NewMockRepository(GinkgoT())
+		service = usersvc.New(mockRepo, mockorderrepo.NewMockRepository(GinkgoT()), mockproductrepo.NewMockRepository(GinkgoT()), testutil.NewTxManager(GinkgoT()), domain.DeleteRestrict)
+		ctx = context.Background()
+		batch = domain.Batch[domain.User]{
+			Mode:   domain.BatchAllOrNothing,
+			Create: []domain.User{{Name: "John Doe", Email: "john@example.com"}, {Name: "Jane Doe", Email: "jane@example.com"}},
+			Update: []domain.BatchUpdate[domain.User]{{Version: "abc", Value: domain.User{ID: "3", Name: "Bob", Email: "bob@example.com"}}},
+			Delete: []domain.BatchRef{{ID: "4"}},
+		}
+	})
+
+	Describe("BatchUsers", func() {
+		Context("when every item succeeds", func() {
+			It("should create in bulk, then update and delete", func() {
+				created := []domain.User{{ID: "1", Name: "John Doe", Email: "john@example.com"}, {ID: "2", Name: "Jane Doe", Email: "jane@example.com"}}
+				updated := &domain.User{ID: "3", Name: "Bob", Email: "bob@example.com"}
+				mockRepo.EXPECT().CreateBulk(ctx, batch.Create).Return(created, nil).Once()
+				mockRepo.EXPECT().Update(ctx, 3, "abc", "Bob", "bob@example.com").Return(updated, nil).Once()
+				mockRepo.EXPECT().Delete(ctx, 4, "").Return(nil).Once()
+
+				results, err := service.BatchUsers(ctx, batch)
+
+				Expect(err).ToNot(HaveOccurred())
+				Expect(results).To(Equal(&domain.BatchResults[domain.User]{
+					Create: []domain.BatchResult[domain.User]{{Value: &created[0]}, {Value: &created[1]}},
+					Update: []domain.BatchResult[domain.User]{{Value: updated}},
+					Delete: []domain.BatchResult[domain.User]{{}},
+				}))
+			})
+		})
+
+		Context("when an email is taken", func() {
+			It("should report the failing user and abort the others", func() {
+				conflict := fmt.Errorf("user %w", domain.ErrConflict)
+				mockRepo.EXPECT().CreateBulk(ctx, batch.Create).Return(nil, conflict).Once()
+				mockRepo.EXPECT().Create(ctx, "John Doe", "john@example.com").Return(&domain.User{ID: "1"}, nil).Once()
+				mockRepo.EXPECT().Create(ctx, "Jane Doe", "jane@example.com").Return(nil, conflict).Once()
+
+				results, err := service.BatchUsers(ctx, batch)
+
+				Expect(err).ToNot(HaveOccurred())
+				Expect(results.Create[0].Err).To(MatchError(domain.ErrBatchAborted))
+				Expect(results.Create[1].Err).To(MatchError(domain.ErrConflict))
+				Expect(results.Update[0].Err).To(MatchError(domain.ErrBatchAborted))
+				Expect(results.Delete[0].Err).To(MatchError(domain.ErrBatchAborted))
+			})
+		})
+
+		Context("in best-effort mode", func() {
+			It("should apply the items that succeed", func() {
+				batch.Mode = domain.BatchBestEffort
+				batch.Update[0].Value.ID = "abc"
+				mockRepo.EXPECT().CreateBulk(ctx, batch.Create).Return([]domain.User{{ID: "1"}, {ID: "2"}}, nil).Once()
+				mockRepo.EXPECT().Delete(ctx, 4, "").Return(errors.New("database error")).Once()
+
+				results, err := service.BatchUsers(ctx, batch)
+
+				Expect(err).ToNot(HaveOccurred())
+				Expect(results.Create[1].Value.ID).To(Equal("2"))
+				Expect(results.Update[0].Err).To(MatchError(domain.ErrInvalidID))
+				Expect(results.Delete[0].Err).To(MatchError("database error"))
+			})
+		})
+	})
+})
@@ -2,15 +2,20 @@ package usersvc
 
 import (
 	"context"
+	"fmt"
 	"strconv"
+
+	"gin-swagger-api/internal/domain"
 )
 
-func (s *Service) DeleteUser(ctx context.Context, id string) error {
+// DeleteUser soft-deletes a user. The orders the user placed are left as
+// they are until the user is purged.
+func (s *Service) DeleteUser(ctx context.Context, id, version string) error {
 	// Convert string ID to int
 	intID, err := strconv.Atoi(id)
 	if err != nil {
-		return err
+		return fmt.Errorf("%w: %q", domain.ErrInvalidID, id)
 	}
 
-	return s.userRepo.Delete(ctx, intID)
+	return s.userRepo.Delete(ctx, intID, version)
 }
@@ -8,35 +8,49 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	"gin-swagger-api/internal/domain"
 	portusersvc "gin-swagger-api/internal/port/service/usersvc"
 	"gin-swagger-api/internal/service/usersvc"
+	"gin-swagger-api/internal/testutil"
+	mockorderrepo "gin-swagger-api/mock/repository/orderrepo"
+	mockproductrepo "gin-swagger-api/mock/repository/productrepo"
 	mockuserrepo "gin-swagger-api/mock/repository/userrepo"
 )
 
 var _ = Describe("UserService DeleteUser", func() {
 	var (
-		mockRepo *mockuserrepo.MockRepository
-		service  portusersvc.Service
-		ctx      context.Context
+		mockRepo        *mockuserrepo.MockRepository
+		mockOrderRepo   *mockorderrepo.MockRepository
+		mockProductRepo *mockproductrepo.MockRepository
+		service         portusersvc.Service
+		ctx             context.Context
 	)
 
+	// withPolicy rebuilds the service with a user delete policy
+	withPolicy := func(policy domain.DeletePolicy) {
+		service = usersvc.New(mockRepo, mockOrderRepo, mockProductRepo, testutil.NewTxManager(GinkgoT()), policy)
+	}
+
 	BeforeEach(func() {
 		mockRepo = mockuserrepo.NewMockRepository(GinkgoT())
-		service = usersvc.New(mockRepo)
+		mockOrderRepo = mockorderrepo.NewMockRepository(GinkgoT())
+		mockProductRepo = mockproductrepo.NewMockRepository(GinkgoT())
+		withPolicy(domain.DeleteRestrict)
 		ctx = context.Background()
 	})
 
 	Describe("DeleteUser", func() {
-		It("should delete user successfully when repository succeeds", func() {
+		It("should soft-delete the user and leave its orders alone", func() {
+			withPolicy(domain.DeleteCascade)
 			userID := "1"
 			userIDInt, _ := strconv.Atoi(userID)
 
 			mockRepo.EXPECT().
-				Delete(ctx, userIDInt).
+				Delete(ctx, userIDInt, "").
 				Return(nil).
 				Once()
 
-			err := service.DeleteUser(ctx, userID)
+			err := service.DeleteUser(ctx, userID, "")
 
 			Expect(err).ToNot(HaveOccurred())
 		})
@@ -47,21 +61,32 @@ var _ = Describe("UserService DeleteUser", func() {
 			userIDInt, _ := strconv.Atoi(userID)
 
 			mockRepo.EXPECT().
-				Delete(ctx, userIDInt).
+				Delete(ctx, userIDInt, "").
 				Return(expectedError).
 				Once()
 
-			err := service.DeleteUser(ctx, userID)
+			err := service.DeleteUser(ctx, userID, "")
 
 			Expect(err).To(MatchError(expectedError))
 		})
 
+		It("should return error when the user has changed since the expected version", func() {
+			mockRepo.EXPECT().
+				Delete(ctx, 1, "abc123").
+				Return(domain.ErrPreconditionFailed).
+				Once()
+
+			err := service.DeleteUser(ctx, "1", "abc123")
+
+			Expect(err).To(MatchError(domain.ErrPreconditionFailed))
+		})
+
 		It("should return error when user ID is invalid", func() {
 			userID := "invalid"
 
-			err := service.DeleteUser(ctx, userID)
+			err := service.DeleteUser(ctx, userID, "")
 
-			Expect(err).To(HaveOccurred())
+			Expect(err).To(MatchError(domain.ErrInvalidID))
 		})
 	})
 })
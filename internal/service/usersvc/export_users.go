@@ -0,0 +1,20 @@
+package usersvc
+
+import (
+	"context"
+	"fmt"
+
+	"gin-swagger-api/internal/domain"
+)
+
+func (s *Service) ExportUsers(ctx context.Context, list domain.ListQuery, fn func([]domain.User) error) error {
+	list, err := domain.UserFields.Resolve(list)
+	if err != nil {
+		return err
+	}
+	if len(list.Sort) > 0 {
+		return fmt.Errorf("%w: exports are ordered by id and cannot be sorted", domain.ErrInvalidArgument)
+	}
+
+	return s.userRepo.Stream(ctx, list, domain.ExportBatchSize, fn)
+}
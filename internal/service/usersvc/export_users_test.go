@@ -0,0 +1,81 @@
+package usersvc_test
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"gin-swagger-api/internal/domain"
+	portusersvc "gin-swagger-api/internal/port/service/usersvc"
+	"gin-swagger-api/internal/service/usersvc"
+	"gin-swagger-api/internal/testutil"
+	mockorderrepo "gin-swagger-api/mock/repository/orderrepo"
+	mockproductrepo "gin-swagger-api/mock/repository/productrepo"
+	mockuserrepo "gin-swagger-api/mock/repository/userrepo"
+)
+
+var _ = Describe("UserService ExportUsers", func() {
+	var (
+		mockRepo *mockuserrepo.MockRepository
+		service  portusersvc.Service
+		ctx      context.Context
+	)
+
+	BeforeEach(func() {
+		mockRepo = mockuserrepo.NewMockRepository(GinkgoT())
+		service = usersvc.New(mockRepo, mockorderrepo.NewMockRepository(GinkgoT()), mockproductrepo.NewMockRepository(GinkgoT()), testutil.NewTxManager(GinkgoT()), domain.DeleteRestrict)
+		ctx = context.Background()
+	})
+
+	Describe("ExportUsers", func() {
+		It("should stream the users in batches with resolved filters", func() {
+			first := []domain.User{{ID: "1", Name: "John Doe", Email: "john@example.com"}}
+			second := []domain.User{{ID: "2", Name: "Jane Smith", Email: "jane@example.com"}}
+			mockRepo.EXPECT().
+				Stream(ctx, domain.ListQuery{Filters: []domain.Filter{{Field: "id", Op: domain.OpGt, Values: []any{10}}}}, domain.ExportBatchSize, mock.Anything).
+				RunAndReturn(func(_ context.Context, _ domain.ListQuery, _ int, fn func([]domain.User) error) error {
+					if err := fn(first); err != nil {
+						return err
+					}
+					return fn(second)
+				}).
+				Once()
+
+			var batches [][]domain.User
+			err := service.ExportUsers(ctx, domain.ListQuery{Filters: []domain.Filter{{Field: "id", Op: domain.OpGt, Values: []any{"10"}}}}, func(batch []domain.User) error {
+				batches = append(batches, batch)
+				return nil
+			})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(batches).To(Equal([][]domain.User{first, second}))
+		})
+
+		It("should reject a sort", func() {
+			err := service.ExportUsers(ctx, domain.ListQuery{Sort: []domain.Sort{{Field: "name"}}}, func([]domain.User) error { return nil })
+
+			Expect(err).To(MatchError(domain.ErrInvalidArgument))
+		})
+
+		It("should reject an unknown filter field", func() {
+			err := service.ExportUsers(ctx, domain.ListQuery{Filters: []domain.Filter{{Field: "secret", Op: domain.OpEq, Values: []any{"x"}}}}, func([]domain.User) error { return nil })
+
+			Expect(err).To(MatchError(domain.ErrInvalidArgument))
+		})
+
+		It("should return error when repository fails", func() {
+			expectedError := errors.New("database error")
+			mockRepo.EXPECT().
+				Stream(ctx, domain.ListQuery{}, domain.ExportBatchSize, mock.Anything).
+				Return(expectedError).
+				Once()
+
+			err := service.ExportUsers(ctx, domain.ListQuery{}, func([]domain.User) error { return nil })
+
+			Expect(err).To(MatchError(expectedError))
+		})
+	})
+})
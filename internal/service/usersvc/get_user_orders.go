@@ -0,0 +1,23 @@
+package usersvc
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"gin-swagger-api/internal/domain"
+)
+
+func (s *Service) GetUserOrders(ctx context.Context, id string, page domain.PageRequest) (*domain.Page[domain.Order], error) {
+	intID, err := strconv.Atoi(id)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %q", domain.ErrInvalidID, id)
+	}
+
+	// An unknown user is not found rather than a user without orders
+	if _, err := s.userRepo.GetByID(ctx, intID); err != nil {
+		return nil, err
+	}
+
+	return s.orderRepo.GetByUser(ctx, intID, page.Normalize())
+}
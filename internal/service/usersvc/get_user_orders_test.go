@@ -0,0 +1,92 @@
+package usersvc_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"gin-swagger-api/internal/domain"
+	portusersvc "gin-swagger-api/internal/port/service/usersvc"
+	"gin-swagger-api/internal/service/usersvc"
+	"gin-swagger-api/internal/testutil"
+	mockorderrepo "gin-swagger-api/mock/repository/orderrepo"
+	mockproductrepo "gin-swagger-api/mock/repository/productrepo"
+	mockuserrepo "gin-swagger-api/mock/repository/userrepo"
+)
+
+var _ = Describe("UserService GetUserOrders", func() {
+	var (
+		mockRepo      *mockuserrepo.MockRepository
+		mockOrderRepo *mockorderrepo.MockRepository
+		service       portusersvc.Service
+		ctx           context.Context
+	)
+
+	BeforeEach(func() {
+		mockRepo = mockuserrepo.NewMockRepository(GinkgoT())
+		mockOrderRepo = mockorderrepo.NewMockRepository(GinkgoT())
+		service = usersvc.New(mockRepo, mockOrderRepo, mockproductrepo.NewMockRepository(GinkgoT()), testutil.NewTxManager(GinkgoT()), domain.DeleteRestrict)
+		ctx = context.Background()
+	})
+
+	Describe("GetUserOrders", func() {
+		It("should return a page of the user's orders", func() {
+			orders := []domain.Order{
+				{ID: "1", UserID: 1, Items: []domain.OrderItem{domain.NewOrderItem(1, 2, testutil.THB("50.00"))}, TotalPrice: testutil.THB("100.00"), Status: domain.OrderStatusPending},
+			}
+			mockRepo.EXPECT().GetByID(ctx, 1).Return(&domain.User{ID: "1", Name: "John Doe", Email: "john@example.com"}, nil).Once()
+			mockOrderRepo.EXPECT().
+				GetByUser(ctx, 1, domain.PageRequest{Limit: 10, Offset: 20}).
+				Return(&domain.Page[domain.Order]{Items: orders, Total: 21, Limit: 10, Offset: 20}, nil).
+				Once()
+
+			page, err := service.GetUserOrders(ctx, "1", domain.PageRequest{Limit: 10, Offset: 20})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(page.Items).To(Equal(orders))
+			Expect(page.Total).To(Equal(21))
+		})
+
+		It("should apply the default page size", func() {
+			mockRepo.EXPECT().GetByID(ctx, 1).Return(&domain.User{ID: "1", Name: "John Doe", Email: "john@example.com"}, nil).Once()
+			mockOrderRepo.EXPECT().
+				GetByUser(ctx, 1, domain.PageRequest{Limit: domain.DefaultPageSize}).
+				Return(&domain.Page[domain.Order]{Items: []domain.Order{}, Limit: domain.DefaultPageSize}, nil).
+				Once()
+
+			_, err := service.GetUserOrders(ctx, "1", domain.PageRequest{})
+
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should return not found when the user does not exist", func() {
+			mockRepo.EXPECT().GetByID(ctx, 99).Return(nil, fmt.Errorf("user %w", domain.ErrNotFound)).Once()
+
+			page, err := service.GetUserOrders(ctx, "99", domain.PageRequest{})
+
+			Expect(err).To(MatchError(domain.ErrNotFound))
+			Expect(page).To(BeNil())
+		})
+
+		It("should return error when the order repository fails", func() {
+			expectedError := errors.New("database error")
+			mockRepo.EXPECT().GetByID(ctx, 1).Return(&domain.User{ID: "1", Name: "John Doe", Email: "john@example.com"}, nil).Once()
+			mockOrderRepo.EXPECT().GetByUser(ctx, 1, domain.PageRequest{Limit: domain.DefaultPageSize}).Return(nil, expectedError).Once()
+
+			page, err := service.GetUserOrders(ctx, "1", domain.PageRequest{})
+
+			Expect(err).To(MatchError(expectedError))
+			Expect(page).To(BeNil())
+		})
+
+		It("should return error when the user ID is invalid", func() {
+			page, err := service.GetUserOrders(ctx, "invalid", domain.PageRequest{})
+
+			Expect(err).To(MatchError(domain.ErrInvalidID))
+			Expect(page).To(BeNil())
+		})
+	})
+})
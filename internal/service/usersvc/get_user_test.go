@@ -11,6 +11,9 @@ import (
 	"gin-swagger-api/internal/domain"
 	portusersvc "gin-swagger-api/internal/port/service/usersvc"
 	"gin-swagger-api/internal/service/usersvc"
+	"gin-swagger-api/internal/testutil"
+	mockorderrepo "gin-swagger-api/mock/repository/orderrepo"
+	mockproductrepo "gin-swagger-api/mock/repository/productrepo"
 	mockuserrepo "gin-swagger-api/mock/repository/userrepo"
 )
 
@@ -23,7 +26,7 @@ var _ = Describe("UserService GetUser", func() {
 
 	BeforeEach(func() {
 		mockRepo = mockuserrepo.NewMockRepository(GinkgoT())
-		service = usersvc.New(mockRepo)
+		service = usersvc.New(mockRepo, mockorderrepo.NewMockRepository(GinkgoT()), mockproductrepo.NewMockRepository(GinkgoT()), testutil.NewTxManager(GinkgoT()), domain.DeleteRestrict)
 		ctx = context.Background()
 	})
 
@@ -73,7 +76,7 @@ var _ = Describe("UserService GetUser", func() {
 
 			user, err := service.GetUser(ctx, userID)
 
-			Expect(err).To(HaveOccurred())
+			Expect(err).To(MatchError(domain.ErrInvalidID))
 			Expect(user).To(BeNil())
 		})
 	})
@@ -6,6 +6,11 @@ import (
 	"gin-swagger-api/internal/domain"
 )
 
-func (s *Service) GetUsers(ctx context.Context) ([]domain.User, error) {
-	return s.userRepo.GetAll(ctx)
+func (s *Service) GetUsers(ctx context.Context, list domain.ListQuery, page domain.PageRequest) (*domain.Page[domain.User], error) {
+	list, err := domain.UserFields.Resolve(list)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.userRepo.GetAll(ctx, list, page.Normalize())
 }
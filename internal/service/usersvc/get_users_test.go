@@ -10,6 +10,9 @@ import (
 	"gin-swagger-api/internal/domain"
 	portusersvc "gin-swagger-api/internal/port/service/usersvc"
 	"gin-swagger-api/internal/service/usersvc"
+	"gin-swagger-api/internal/testutil"
+	mockorderrepo "gin-swagger-api/mock/repository/orderrepo"
+	mockproductrepo "gin-swagger-api/mock/repository/productrepo"
 	mockuserrepo "gin-swagger-api/mock/repository/userrepo"
 )
 
@@ -22,7 +25,7 @@ var _ = Describe("UserService GetUsers", func() {
 
 	BeforeEach(func() {
 		mockRepo = mockuserrepo.NewMockRepository(GinkgoT())
-		service = usersvc.New(mockRepo)
+		service = usersvc.New(mockRepo, mockorderrepo.NewMockRepository(GinkgoT()), mockproductrepo.NewMockRepository(GinkgoT()), testutil.NewTxManager(GinkgoT()), domain.DeleteRestrict)
 		ctx = context.Background()
 	})
 
@@ -42,48 +45,87 @@ var _ = Describe("UserService GetUsers", func() {
 			}
 
 			mockRepo.EXPECT().
-				GetAll(ctx).
-				Return(expectedUsers, nil).
+				GetAll(ctx, domain.ListQuery{}, domain.PageRequest{Limit: 10}).
+				Return(&domain.Page[domain.User]{Items: expectedUsers, Total: 2, Limit: 10}, nil).
 				Once()
 
-			users, err := service.GetUsers(ctx)
+			page, err := service.GetUsers(ctx, domain.ListQuery{}, domain.PageRequest{Limit: 10})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(page).ToNot(BeNil())
+			Expect(page.Total).To(Equal(2))
+			Expect(page.Items).To(HaveLen(2))
+			Expect(page.Items[0].ID).To(Equal("1"))
+			Expect(page.Items[0].Name).To(Equal("John Doe"))
+			Expect(page.Items[1].ID).To(Equal("2"))
+			Expect(page.Items[1].Name).To(Equal("Jane Smith"))
+		})
+
+		It("should apply the default page size when no limit is given", func() {
+			mockRepo.EXPECT().
+				GetAll(ctx, domain.ListQuery{}, domain.PageRequest{Limit: domain.DefaultPageSize}).
+				Return(&domain.Page[domain.User]{Limit: domain.DefaultPageSize}, nil).
+				Once()
+
+			_, err := service.GetUsers(ctx, domain.ListQuery{}, domain.PageRequest{})
+
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should cap the page size at the maximum", func() {
+			mockRepo.EXPECT().
+				GetAll(ctx, domain.ListQuery{}, domain.PageRequest{Limit: domain.MaxPageSize, Cursor: "YToxMA"}).
+				Return(&domain.Page[domain.User]{Limit: domain.MaxPageSize}, nil).
+				Once()
+
+			_, err := service.GetUsers(ctx, domain.ListQuery{}, domain.PageRequest{Limit: 500, Cursor: "YToxMA"})
 
 			Expect(err).ToNot(HaveOccurred())
-			Expect(users).ToNot(BeNil())
-			Expect(users).To(HaveLen(2))
-			Expect(users[0].ID).To(Equal("1"))
-			Expect(users[0].Name).To(Equal("John Doe"))
-			Expect(users[1].ID).To(Equal("2"))
-			Expect(users[1].Name).To(Equal("Jane Smith"))
 		})
 
 		It("should return empty list when no users exist", func() {
-			expectedUsers := []domain.User{}
+			mockRepo.EXPECT().
+				GetAll(ctx, domain.ListQuery{}, domain.PageRequest{Limit: domain.DefaultPageSize}).
+				Return(&domain.Page[domain.User]{Items: []domain.User{}, Limit: domain.DefaultPageSize}, nil).
+				Once()
+
+			page, err := service.GetUsers(ctx, domain.ListQuery{}, domain.PageRequest{})
 
+			Expect(err).ToNot(HaveOccurred())
+			Expect(page).ToNot(BeNil())
+			Expect(page.Items).To(BeEmpty())
+		})
+
+		It("should resolve filters before querying the repository", func() {
 			mockRepo.EXPECT().
-				GetAll(ctx).
-				Return(expectedUsers, nil).
+				GetAll(ctx, domain.ListQuery{Filters: []domain.Filter{{Field: "id", Op: domain.OpIn, Values: []any{1, 2}}}}, domain.PageRequest{Limit: domain.DefaultPageSize}).
+				Return(&domain.Page[domain.User]{Limit: domain.DefaultPageSize}, nil).
 				Once()
 
-			users, err := service.GetUsers(ctx)
+			_, err := service.GetUsers(ctx, domain.ListQuery{Filters: []domain.Filter{{Field: "id", Op: domain.OpIn, Values: []any{"1", "2"}}}}, domain.PageRequest{})
 
 			Expect(err).ToNot(HaveOccurred())
-			Expect(users).ToNot(BeNil())
-			Expect(users).To(BeEmpty())
+		})
+
+		It("should reject unknown fields without querying the repository", func() {
+			page, err := service.GetUsers(ctx, domain.ListQuery{Sort: []domain.Sort{{Field: "password"}}}, domain.PageRequest{})
+
+			Expect(err).To(MatchError(domain.ErrInvalidArgument))
+			Expect(page).To(BeNil())
 		})
 
 		It("should return error when repository fails", func() {
 			expectedError := errors.New("database error")
 
 			mockRepo.EXPECT().
-				GetAll(ctx).
+				GetAll(ctx, domain.ListQuery{}, domain.PageRequest{Limit: domain.DefaultPageSize}).
 				Return(nil, expectedError).
 				Once()
 
-			users, err := service.GetUsers(ctx)
+			page, err := service.GetUsers(ctx, domain.ListQuery{}, domain.PageRequest{})
 
 			Expect(err).To(MatchError(expectedError))
-			Expect(users).To(BeNil())
+			Expect(page).To(BeNil())
 		})
 	})
 })
@@ -0,0 +1,53 @@
+package usersvc
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"gin-swagger-api/internal/domain"
+	"gin-swagger-api/internal/service/deletepolicy"
+)
+
+// PurgeUser removes a soft-deleted user for good in a transaction, after
+// applying the user delete policy to the orders the user placed. A failed
+// purge leaves the orders untouched.
+func (s *Service) PurgeUser(ctx context.Context, id string) error {
+	intID, err := strconv.Atoi(id)
+	if err != nil {
+		return fmt.Errorf("%w: %q", domain.ErrInvalidID, id)
+	}
+
+	return s.txManager.WithinTx(ctx, func(ctx context.Context) error {
+		user, err := s.userRepo.GetByID(domain.WithDeleted(ctx), intID)
+		if err != nil {
+			return err
+		}
+		if user.DeletedAt == nil {
+			return fmt.Errorf("user %d is %w: delete it before purging it", intID, domain.ErrNotDeleted)
+		}
+
+		if err := s.applyDeletePolicy(ctx, intID); err != nil {
+			return err
+		}
+		return s.userRepo.Purge(ctx, intID)
+	})
+}
+
+// applyDeletePolicy restricts, cascades to or detaches the orders of a
+// user about to be purged
+func (s *Service) applyDeletePolicy(ctx context.Context, userID int) error {
+	return deletepolicy.Apply(ctx, s.deletePolicy, deletepolicy.Orders{
+		Entity: "user",
+		Count: func(ctx context.Context) (int, error) {
+			return s.orderRepo.CountByUser(ctx, userID)
+		},
+		Delete: func(ctx context.Context) ([]domain.Order, error) {
+			return s.orderRepo.DeleteByUser(ctx, userID)
+		},
+		Detach: func(ctx context.Context) error {
+			return s.orderRepo.DetachUser(ctx, userID)
+		},
+		Release: s.productRepo.ReleaseStock,
+	})
+}
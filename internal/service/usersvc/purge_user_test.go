@@ -0,0 +1,181 @@
+package usersvc_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"gin-swagger-api/internal/domain"
+	portusersvc "gin-swagger-api/internal/port/service/usersvc"
+	"gin-swagger-api/internal/service/usersvc"
+	"gin-swagger-api/internal/testutil"
+	mockorderrepo "gin-swagger-api/mock/repository/orderrepo"
+	mockproductrepo "gin-swagger-api/mock/repository/productrepo"
+	mockuserrepo "gin-swagger-api/mock/repository/userrepo"
+)
+
+var _ = Describe("UserService PurgeUser", func() {
+	var (
+		mockRepo        *mockuserrepo.MockRepository
+		mockOrderRepo   *mockorderrepo.MockRepository
+		mockProductRepo *mockproductrepo.MockRepository
+		service         portusersvc.Service
+		ctx             context.Context
+		deleted         *domain.User
+	)
+
+	// withPolicy rebuilds the service with a user delete policy
+	withPolicy := func(policy domain.DeletePolicy) {
+		service = usersvc.New(mockRepo, mockOrderRepo, mockProductRepo, testutil.NewTxManager(GinkgoT()), policy)
+	}
+
+	// expectDeleted makes the user to purge a soft-deleted one
+	expectDeleted := func() {
+		mockRepo.EXPECT().
+			GetByID(mock.MatchedBy(domain.IncludesDeleted), 1).
+			Return(deleted, nil).
+			Once()
+	}
+
+	BeforeEach(func() {
+		mockRepo = mockuserrepo.NewMockRepository(GinkgoT())
+		mockOrderRepo = mockorderrepo.NewMockRepository(GinkgoT())
+		mockProductRepo = mockproductrepo.NewMockRepository(GinkgoT())
+		withPolicy(domain.DeleteRestrict)
+		ctx = context.Background()
+		deletedAt := time.Date(2026, 1, 15, 10, 0, 0, 0, time.UTC)
+		deleted = &domain.User{ID: "1", Name: "John Doe", Email: "john@example.com", DeletedAt: &deletedAt}
+	})
+
+	Describe("PurgeUser", func() {
+		It("should purge a soft-deleted user without orders", func() {
+			expectDeleted()
+			mockOrderRepo.EXPECT().
+				CountByUser(ctx, 1).
+				Return(0, nil).
+				Once()
+			mockRepo.EXPECT().
+				Purge(ctx, 1).
+				Return(nil).
+				Once()
+
+			err := service.PurgeUser(ctx, "1")
+
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should refuse to purge a user that is not soft-deleted", func() {
+			mockRepo.EXPECT().
+				GetByID(mock.MatchedBy(domain.IncludesDeleted), 1).
+				Return(&domain.User{ID: "1", Name: "John Doe", Email: "john@example.com"}, nil).
+				Once()
+
+			err := service.PurgeUser(ctx, "1")
+
+			Expect(err).To(MatchError(domain.ErrNotDeleted))
+		})
+
+		It("should return error when user not found", func() {
+			mockRepo.EXPECT().
+				GetByID(mock.MatchedBy(domain.IncludesDeleted), 999).
+				Return(nil, domain.ErrNotFound).
+				Once()
+
+			err := service.PurgeUser(ctx, "999")
+
+			Expect(err).To(MatchError(domain.ErrNotFound))
+		})
+
+		It("should return error when user ID is invalid", func() {
+			err := service.PurgeUser(ctx, "invalid")
+
+			Expect(err).To(MatchError(domain.ErrInvalidID))
+		})
+
+		Context("with the restrict policy", func() {
+			It("should refuse to purge a user with orders and count them", func() {
+				expectDeleted()
+				mockOrderRepo.EXPECT().
+					CountByUser(ctx, 1).
+					Return(3, nil).
+					Once()
+
+				err := service.PurgeUser(ctx, "1")
+
+				Expect(err).To(MatchError(domain.ErrInUse))
+				var inUse *domain.InUseError
+				Expect(errors.As(err, &inUse)).To(BeTrue())
+				Expect(inUse.Orders).To(Equal(3))
+			})
+		})
+
+		Context("with the cascade policy", func() {
+			BeforeEach(func() {
+				withPolicy(domain.DeleteCascade)
+			})
+
+			It("should delete the orders of the user and return the stock they hold", func() {
+				orderDeletedAt := time.Date(2026, 1, 10, 10, 0, 0, 0, time.UTC)
+				expectDeleted()
+				mockOrderRepo.EXPECT().
+					DeleteByUser(ctx, 1).
+					Return([]domain.Order{
+						{ID: "1", Items: []domain.OrderItem{domain.NewOrderItem(5, 2, testutil.THB("10"))}, Status: domain.OrderStatusPending},
+						{ID: "2", Items: []domain.OrderItem{domain.NewOrderItem(5, 1, testutil.THB("10")), domain.NewOrderItem(3, 4, testutil.THB("5"))}, Status: domain.OrderStatusPaid},
+						{ID: "3", Items: []domain.OrderItem{domain.NewOrderItem(7, 1, testutil.THB("10"))}, Status: domain.OrderStatusCancelled},
+						{ID: "4", Items: []domain.OrderItem{domain.NewOrderItem(8, 1, testutil.THB("10"))}, Status: domain.OrderStatusPending, DeletedAt: &orderDeletedAt},
+						{ID: "5", Items: []domain.OrderItem{domain.NewOrderItem(9, 2, testutil.THB("10"))}, Status: domain.OrderStatusDelivered},
+					}, nil).
+					Once()
+				mockProductRepo.EXPECT().ReleaseStock(ctx, 3, 4).Return(nil).Once()
+				mockProductRepo.EXPECT().ReleaseStock(ctx, 5, 3).Return(nil).Once()
+				mockRepo.EXPECT().
+					Purge(ctx, 1).
+					Return(nil).
+					Once()
+
+				err := service.PurgeUser(ctx, "1")
+
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("should not purge the user when the orders cannot be deleted", func() {
+				expectDeleted()
+				mockOrderRepo.EXPECT().
+					DeleteByUser(ctx, 1).
+					Return(nil, errors.New("database error")).
+					Once()
+
+				err := service.PurgeUser(ctx, "1")
+
+				Expect(err).To(MatchError("database error"))
+			})
+		})
+
+		Context("with the nullify policy", func() {
+			BeforeEach(func() {
+				withPolicy(domain.DeleteNullify)
+			})
+
+			It("should keep the orders of the user without their user", func() {
+				expectDeleted()
+				mockOrderRepo.EXPECT().
+					DetachUser(ctx, 1).
+					Return(nil).
+					Once()
+				mockRepo.EXPECT().
+					Purge(ctx, 1).
+					Return(nil).
+					Once()
+
+				err := service.PurgeUser(ctx, "1")
+
+				Expect(err).ToNot(HaveOccurred())
+			})
+		})
+	})
+})
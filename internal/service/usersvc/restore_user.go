@@ -0,0 +1,19 @@
+package usersvc
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"gin-swagger-api/internal/domain"
+)
+
+// RestoreUser brings back a soft-deleted user
+func (s *Service) RestoreUser(ctx context.Context, id string) (*domain.User, error) {
+	intID, err := strconv.Atoi(id)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %q", domain.ErrInvalidID, id)
+	}
+
+	return s.userRepo.Restore(ctx, intID)
+}
@@ -0,0 +1,63 @@
+package usersvc_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"gin-swagger-api/internal/domain"
+	portusersvc "gin-swagger-api/internal/port/service/usersvc"
+	"gin-swagger-api/internal/service/usersvc"
+	"gin-swagger-api/internal/testutil"
+	mockorderrepo "gin-swagger-api/mock/repository/orderrepo"
+	mockproductrepo "gin-swagger-api/mock/repository/productrepo"
+	mockuserrepo "gin-swagger-api/mock/repository/userrepo"
+)
+
+var _ = Describe("UserService RestoreUser", func() {
+	var (
+		mockRepo *mockuserrepo.MockRepository
+		service  portusersvc.Service
+		ctx      context.Context
+	)
+
+	BeforeEach(func() {
+		mockRepo = mockuserrepo.NewMockRepository(GinkgoT())
+		service = usersvc.New(mockRepo, mockorderrepo.NewMockRepository(GinkgoT()), mockproductrepo.NewMockRepository(GinkgoT()), testutil.NewTxManager(GinkgoT()), domain.DeleteRestrict)
+		ctx = context.Background()
+	})
+
+	Describe("RestoreUser", func() {
+		It("should return the restored user", func() {
+			restored := &domain.User{ID: "1", Name: "John Doe", Email: "john@example.com"}
+			mockRepo.EXPECT().
+				Restore(ctx, 1).
+				Return(restored, nil).
+				Once()
+
+			user, err := service.RestoreUser(ctx, "1")
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(user).To(Equal(restored))
+		})
+
+		It("should return error when user not found", func() {
+			mockRepo.EXPECT().
+				Restore(ctx, 999).
+				Return(nil, domain.ErrNotFound).
+				Once()
+
+			user, err := service.RestoreUser(ctx, "999")
+
+			Expect(err).To(MatchError(domain.ErrNotFound))
+			Expect(user).To(BeNil())
+		})
+
+		It("should return error when user ID is invalid", func() {
+			_, err := service.RestoreUser(ctx, "invalid")
+
+			Expect(err).To(MatchError(domain.ErrInvalidID))
+		})
+	})
+})
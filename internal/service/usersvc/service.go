@@ -1,18 +1,32 @@
 package usersvc
 
 import (
+	"gin-swagger-api/internal/domain"
 	port "gin-swagger-api/internal/port/service/usersvc"
+	orderrepo "gin-swagger-api/internal/port/repository/orderrepo"
+	productrepo "gin-swagger-api/internal/port/repository/productrepo"
 	userrepo "gin-swagger-api/internal/port/repository/userrepo"
+	"gin-swagger-api/internal/port/repository/txmanager"
 )
 
 // Service implements port.Service interface
 type Service struct {
-	userRepo userrepo.Repository
+	userRepo     userrepo.Repository
+	orderRepo    orderrepo.Repository
+	productRepo  productrepo.Repository
+	txManager    txmanager.Manager
+	deletePolicy domain.DeletePolicy
 }
 
-// New creates a new user service with user repository
-func New(userRepo userrepo.Repository) port.Service {
+// New creates a new user service with user, order and product
+// repositories, the transaction manager batches and deletes run in, and
+// the policy purging a user applies to its orders
+func New(userRepo userrepo.Repository, orderRepo orderrepo.Repository, productRepo productrepo.Repository, txManager txmanager.Manager, deletePolicy domain.DeletePolicy) port.Service {
 	return &Service{
-		userRepo: userRepo,
+		userRepo:     userRepo,
+		orderRepo:    orderRepo,
+		productRepo:  productRepo,
+		txManager:    txManager,
+		deletePolicy: deletePolicy,
 	}
 }
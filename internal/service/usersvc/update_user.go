@@ -2,17 +2,18 @@ package usersvc
 
 import (
 	"context"
+	"fmt"
 	"strconv"
 
 	"gin-swagger-api/internal/domain"
 )
 
-func (s *Service) UpdateUser(ctx context.Context, id, name, email string) (*domain.User, error) {
+func (s *Service) UpdateUser(ctx context.Context, id, version, name, email string) (*domain.User, error) {
 	// Convert string ID to int
 	intID, err := strconv.Atoi(id)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("%w: %q", domain.ErrInvalidID, id)
 	}
 
-	return s.userRepo.Update(ctx, intID, name, email)
+	return s.userRepo.Update(ctx, intID, version, name, email)
 }
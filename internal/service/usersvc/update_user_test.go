@@ -11,6 +11,9 @@ import (
 	"gin-swagger-api/internal/domain"
 	portusersvc "gin-swagger-api/internal/port/service/usersvc"
 	"gin-swagger-api/internal/service/usersvc"
+	"gin-swagger-api/internal/testutil"
+	mockorderrepo "gin-swagger-api/mock/repository/orderrepo"
+	mockproductrepo "gin-swagger-api/mock/repository/productrepo"
 	mockuserrepo "gin-swagger-api/mock/repository/userrepo"
 )
 
@@ -23,7 +26,7 @@ var _ = Describe("UserService UpdateUser", func() {
 
 	BeforeEach(func() {
 		mockRepo = mockuserrepo.NewMockRepository(GinkgoT())
-		service = usersvc.New(mockRepo)
+		service = usersvc.New(mockRepo, mockorderrepo.NewMockRepository(GinkgoT()), mockproductrepo.NewMockRepository(GinkgoT()), testutil.NewTxManager(GinkgoT()), domain.DeleteRestrict)
 		ctx = context.Background()
 	})
 
@@ -39,11 +42,11 @@ var _ = Describe("UserService UpdateUser", func() {
 			userIDInt, _ := strconv.Atoi(userID)
 
 			mockRepo.EXPECT().
-				Update(ctx, userIDInt, "Jane Updated", "jane.updated@example.com").
+				Update(ctx, userIDInt, "", "Jane Updated", "jane.updated@example.com").
 				Return(expectedUser, nil).
 				Once()
 
-			user, err := service.UpdateUser(ctx, userID, "Jane Updated", "jane.updated@example.com")
+			user, err := service.UpdateUser(ctx, userID, "", "Jane Updated", "jane.updated@example.com")
 
 			Expect(err).ToNot(HaveOccurred())
 			Expect(*user).To(Equal(*expectedUser))
@@ -58,22 +61,33 @@ var _ = Describe("UserService UpdateUser", func() {
 			userIDInt, _ := strconv.Atoi(userID)
 
 			mockRepo.EXPECT().
-				Update(ctx, userIDInt, "Jane Updated", "jane.updated@example.com").
+				Update(ctx, userIDInt, "", "Jane Updated", "jane.updated@example.com").
 				Return(nil, expectedError).
 				Once()
 
-			user, err := service.UpdateUser(ctx, userID, "Jane Updated", "jane.updated@example.com")
+			user, err := service.UpdateUser(ctx, userID, "", "Jane Updated", "jane.updated@example.com")
 
 			Expect(err).To(MatchError(expectedError))
 			Expect(user).To(BeNil())
 		})
 
+		It("should return error when the user has changed since the expected version", func() {
+			mockRepo.EXPECT().
+				Update(ctx, 1, "abc123", "Jane Updated", "jane.updated@example.com").
+				Return(nil, domain.ErrPreconditionFailed).
+				Once()
+
+			_, err := service.UpdateUser(ctx, "1", "abc123", "Jane Updated", "jane.updated@example.com")
+
+			Expect(err).To(MatchError(domain.ErrPreconditionFailed))
+		})
+
 		It("should return error when user ID is invalid", func() {
 			userID := "invalid"
 
-			user, err := service.UpdateUser(ctx, userID, "New Name", "new@example.com")
+			user, err := service.UpdateUser(ctx, userID, "", "New Name", "new@example.com")
 
-			Expect(err).To(HaveOccurred())
+			Expect(err).To(MatchError(domain.ErrInvalidID))
 			Expect(user).To(BeNil())
 		})
 	})
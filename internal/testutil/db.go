@@ -2,9 +2,11 @@ package testutil
 
 import (
 	"context"
+	"path/filepath"
 
 	_ "github.com/mattn/go-sqlite3"
 	"github.com/snilli/ormprovider"
+	"github.com/snilli/ormprovider/ent"
 	"github.com/snilli/ormprovider/ent/enttest"
 )
 
@@ -16,10 +18,12 @@ type TestingT interface {
 	Helper()
 }
 
-// NewTestDBClient creates a new ORM client for testing using SQLite in-memory database
-func NewTestDBClient(t TestingT) *ormprovider.Client {
+// NewTestDBClient creates a new ORM client for testing using SQLite in-memory
+// database. Clients opened together share the same database; options such
+// as ent.Debug apply to the returned client only.
+func NewTestDBClient(t TestingT, clientOpts ...ent.Option) *ormprovider.Client {
 	opts := []enttest.Option{
-		enttest.WithOptions(),
+		enttest.WithOptions(clientOpts...),
 	}
 
 	// Create a wrapper that implements testing.TB
@@ -35,6 +39,27 @@ func NewTestDBClient(t TestingT) *ormprovider.Client {
 	return &ormprovider.Client{Client: client}
 }
 
+// NewTestFileDBClient creates a new ORM client for testing using an SQLite
+// database file in dir. Unlike the in-memory database, its transactions
+// wait for each other instead of failing on a locked table, so tests can
+// run concurrent writes.
+func NewTestFileDBClient(t TestingT, dir string, clientOpts ...ent.Option) *ormprovider.Client {
+	opts := []enttest.Option{
+		enttest.WithOptions(clientOpts...),
+	}
+
+	wrapper := &testWrapper{t: t}
+
+	dsn := "file:" + filepath.Join(dir, "ent.db") + "?_fk=1&_journal_mode=WAL&_busy_timeout=10000&_txlock=immediate"
+	client := enttest.Open(wrapper, "sqlite3", dsn, opts...)
+
+	if err := client.Schema.Create(context.Background()); err != nil {
+		t.Fatalf("failed to create schema: %v", err)
+	}
+
+	return &ormprovider.Client{Client: client}
+}
+
 // testWrapper wraps TestingT to implement testing.TB
 type testWrapper struct {
 	t TestingT
@@ -0,0 +1,9 @@
+package testutil
+
+import "gin-swagger-api/internal/domain"
+
+// THB returns an amount of the base currency. It panics on a malformed
+// amount.
+func THB(amount string) domain.Money {
+	return domain.MustParseMoney(amount, domain.BaseCurrency)
+}
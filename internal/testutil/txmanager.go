@@ -0,0 +1,25 @@
+package testutil
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+
+	mocktxmanager "gin-swagger-api/mock/repository/txmanager"
+)
+
+// NewTxManager returns a transaction manager mock that runs the functions
+// it is given directly, with the context they were passed
+func NewTxManager(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mocktxmanager.MockManager {
+	txManager := mocktxmanager.NewMockManager(t)
+	txManager.EXPECT().
+		WithinTx(mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+			return fn(ctx)
+		}).
+		Maybe()
+	return txManager
+}
@@ -38,51 +38,641 @@ func (_m *MockRepository) EXPECT() *MockRepository_Expecter {
 	return &MockRepository_Expecter{mock: &_m.Mock}
 }
 
+// CountByProduct provides a mock function for the type MockRepository
+func (_mock *MockRepository) CountByProduct(ctx context.Context, productID int) (int, error) {
+	ret := _mock.Called(ctx, productID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountByProduct")
+	}
+
+	var r0 int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) (int, error)); ok {
+		return returnFunc(ctx, productID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) int); ok {
+		r0 = returnFunc(ctx, productID)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = returnFunc(ctx, productID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_CountByProduct_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountByProduct'
+type MockRepository_CountByProduct_Call struct {
+	*mock.Call
+}
+
+// CountByProduct is a helper method to define mock.On call
+//   - ctx context.Context
+//   - productID int
+func (_e *MockRepository_Expecter) CountByProduct(ctx interface{}, productID interface{}) *MockRepository_CountByProduct_Call {
+	return &MockRepository_CountByProduct_Call{Call: _e.mock.On("CountByProduct", ctx, productID)}
+}
+
+func (_c *MockRepository_CountByProduct_Call) Run(run func(ctx context.Context, productID int)) *MockRepository_CountByProduct_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_CountByProduct_Call) Return(n int, err error) *MockRepository_CountByProduct_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockRepository_CountByProduct_Call) RunAndReturn(run func(ctx context.Context, productID int) (int, error)) *MockRepository_CountByProduct_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountByUser provides a mock function for the type MockRepository
+func (_mock *MockRepository) CountByUser(ctx context.Context, userID int) (int, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountByUser")
+	}
+
+	var r0 int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) (int, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) int); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_CountByUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountByUser'
+type MockRepository_CountByUser_Call struct {
+	*mock.Call
+}
+
+// CountByUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int
+func (_e *MockRepository_Expecter) CountByUser(ctx interface{}, userID interface{}) *MockRepository_CountByUser_Call {
+	return &MockRepository_CountByUser_Call{Call: _e.mock.On("CountByUser", ctx, userID)}
+}
+
+func (_c *MockRepository_CountByUser_Call) Run(run func(ctx context.Context, userID int)) *MockRepository_CountByUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_CountByUser_Call) Return(n int, err error) *MockRepository_CountByUser_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockRepository_CountByUser_Call) RunAndReturn(run func(ctx context.Context, userID int) (int, error)) *MockRepository_CountByUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Create provides a mock function for the type MockRepository
-func (_mock *MockRepository) Create(ctx context.Context, userID int, productID int, quantity int, totalPrice float64, status string) (*domain.Order, error) {
-	ret := _mock.Called(ctx, userID, productID, quantity, totalPrice, status)
+func (_mock *MockRepository) Create(ctx context.Context, order domain.Order) (*domain.Order, error) {
+	ret := _mock.Called(ctx, order)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 *domain.Order
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Order) (*domain.Order, error)); ok {
+		return returnFunc(ctx, order)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Order) *domain.Order); ok {
+		r0 = returnFunc(ctx, order)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Order)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Order) error); ok {
+		r1 = returnFunc(ctx, order)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - order domain.Order
+func (_e *MockRepository_Expecter) Create(ctx interface{}, order interface{}) *MockRepository_Create_Call {
+	return &MockRepository_Create_Call{Call: _e.mock.On("Create", ctx, order)}
+}
+
+func (_c *MockRepository_Create_Call) Run(run func(ctx context.Context, order domain.Order)) *MockRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Order
+		if args[1] != nil {
+			arg1 = args[1].(domain.Order)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_Create_Call) Return(order1 *domain.Order, err error) *MockRepository_Create_Call {
+	_c.Call.Return(order1, err)
+	return _c
+}
+
+func (_c *MockRepository_Create_Call) RunAndReturn(run func(ctx context.Context, order domain.Order) (*domain.Order, error)) *MockRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function for the type MockRepository
+func (_mock *MockRepository) Delete(ctx context.Context, id int, version string) error {
+	ret := _mock.Called(ctx, id, version)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, string) error); ok {
+		r0 = returnFunc(ctx, id, version)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockRepository_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+//   - version string
+func (_e *MockRepository_Expecter) Delete(ctx interface{}, id interface{}, version interface{}) *MockRepository_Delete_Call {
+	return &MockRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, id, version)}
+}
+
+func (_c *MockRepository_Delete_Call) Run(run func(ctx context.Context, id int, version string)) *MockRepository_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_Delete_Call) Return(err error) *MockRepository_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRepository_Delete_Call) RunAndReturn(run func(ctx context.Context, id int, version string) error) *MockRepository_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteByProduct provides a mock function for the type MockRepository
+func (_mock *MockRepository) DeleteByProduct(ctx context.Context, productID int) ([]domain.Order, error) {
+	ret := _mock.Called(ctx, productID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteByProduct")
+	}
+
+	var r0 []domain.Order
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) ([]domain.Order, error)); ok {
+		return returnFunc(ctx, productID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) []domain.Order); ok {
+		r0 = returnFunc(ctx, productID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Order)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = returnFunc(ctx, productID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_DeleteByProduct_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteByProduct'
+type MockRepository_DeleteByProduct_Call struct {
+	*mock.Call
+}
+
+// DeleteByProduct is a helper method to define mock.On call
+//   - ctx context.Context
+//   - productID int
+func (_e *MockRepository_Expecter) DeleteByProduct(ctx interface{}, productID interface{}) *MockRepository_DeleteByProduct_Call {
+	return &MockRepository_DeleteByProduct_Call{Call: _e.mock.On("DeleteByProduct", ctx, productID)}
+}
+
+func (_c *MockRepository_DeleteByProduct_Call) Run(run func(ctx context.Context, productID int)) *MockRepository_DeleteByProduct_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_DeleteByProduct_Call) Return(orders []domain.Order, err error) *MockRepository_DeleteByProduct_Call {
+	_c.Call.Return(orders, err)
+	return _c
+}
+
+func (_c *MockRepository_DeleteByProduct_Call) RunAndReturn(run func(ctx context.Context, productID int) ([]domain.Order, error)) *MockRepository_DeleteByProduct_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteByUser provides a mock function for the type MockRepository
+func (_mock *MockRepository) DeleteByUser(ctx context.Context, userID int) ([]domain.Order, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteByUser")
+	}
+
+	var r0 []domain.Order
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) ([]domain.Order, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) []domain.Order); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Order)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_DeleteByUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteByUser'
+type MockRepository_DeleteByUser_Call struct {
+	*mock.Call
+}
+
+// DeleteByUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int
+func (_e *MockRepository_Expecter) DeleteByUser(ctx interface{}, userID interface{}) *MockRepository_DeleteByUser_Call {
+	return &MockRepository_DeleteByUser_Call{Call: _e.mock.On("DeleteByUser", ctx, userID)}
+}
+
+func (_c *MockRepository_DeleteByUser_Call) Run(run func(ctx context.Context, userID int)) *MockRepository_DeleteByUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_DeleteByUser_Call) Return(orders []domain.Order, err error) *MockRepository_DeleteByUser_Call {
+	_c.Call.Return(orders, err)
+	return _c
+}
+
+func (_c *MockRepository_DeleteByUser_Call) RunAndReturn(run func(ctx context.Context, userID int) ([]domain.Order, error)) *MockRepository_DeleteByUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DetachProduct provides a mock function for the type MockRepository
+func (_mock *MockRepository) DetachProduct(ctx context.Context, productID int) error {
+	ret := _mock.Called(ctx, productID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DetachProduct")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = returnFunc(ctx, productID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRepository_DetachProduct_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DetachProduct'
+type MockRepository_DetachProduct_Call struct {
+	*mock.Call
+}
+
+// DetachProduct is a helper method to define mock.On call
+//   - ctx context.Context
+//   - productID int
+func (_e *MockRepository_Expecter) DetachProduct(ctx interface{}, productID interface{}) *MockRepository_DetachProduct_Call {
+	return &MockRepository_DetachProduct_Call{Call: _e.mock.On("DetachProduct", ctx, productID)}
+}
+
+func (_c *MockRepository_DetachProduct_Call) Run(run func(ctx context.Context, productID int)) *MockRepository_DetachProduct_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_DetachProduct_Call) Return(err error) *MockRepository_DetachProduct_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRepository_DetachProduct_Call) RunAndReturn(run func(ctx context.Context, productID int) error) *MockRepository_DetachProduct_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DetachUser provides a mock function for the type MockRepository
+func (_mock *MockRepository) DetachUser(ctx context.Context, userID int) error {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DetachUser")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRepository_DetachUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DetachUser'
+type MockRepository_DetachUser_Call struct {
+	*mock.Call
+}
+
+// DetachUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int
+func (_e *MockRepository_Expecter) DetachUser(ctx interface{}, userID interface{}) *MockRepository_DetachUser_Call {
+	return &MockRepository_DetachUser_Call{Call: _e.mock.On("DetachUser", ctx, userID)}
+}
+
+func (_c *MockRepository_DetachUser_Call) Run(run func(ctx context.Context, userID int)) *MockRepository_DetachUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_DetachUser_Call) Return(err error) *MockRepository_DetachUser_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRepository_DetachUser_Call) RunAndReturn(run func(ctx context.Context, userID int) error) *MockRepository_DetachUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAll provides a mock function for the type MockRepository
+func (_mock *MockRepository) GetAll(ctx context.Context, list domain.ListQuery, page domain.PageRequest, expand domain.OrderExpand) (*domain.Page[domain.Order], error) {
+	ret := _mock.Called(ctx, list, page, expand)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAll")
+	}
+
+	var r0 *domain.Page[domain.Order]
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.ListQuery, domain.PageRequest, domain.OrderExpand) (*domain.Page[domain.Order], error)); ok {
+		return returnFunc(ctx, list, page, expand)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.ListQuery, domain.PageRequest, domain.OrderExpand) *domain.Page[domain.Order]); ok {
+		r0 = returnFunc(ctx, list, page, expand)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Page[domain.Order])
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.ListQuery, domain.PageRequest, domain.OrderExpand) error); ok {
+		r1 = returnFunc(ctx, list, page, expand)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_GetAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAll'
+type MockRepository_GetAll_Call struct {
+	*mock.Call
+}
+
+// GetAll is a helper method to define mock.On call
+//   - ctx context.Context
+//   - list domain.ListQuery
+//   - page domain.PageRequest
+//   - expand domain.OrderExpand
+func (_e *MockRepository_Expecter) GetAll(ctx interface{}, list interface{}, page interface{}, expand interface{}) *MockRepository_GetAll_Call {
+	return &MockRepository_GetAll_Call{Call: _e.mock.On("GetAll", ctx, list, page, expand)}
+}
+
+func (_c *MockRepository_GetAll_Call) Run(run func(ctx context.Context, list domain.ListQuery, page domain.PageRequest, expand domain.OrderExpand)) *MockRepository_GetAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.ListQuery
+		if args[1] != nil {
+			arg1 = args[1].(domain.ListQuery)
+		}
+		var arg2 domain.PageRequest
+		if args[2] != nil {
+			arg2 = args[2].(domain.PageRequest)
+		}
+		var arg3 domain.OrderExpand
+		if args[3] != nil {
+			arg3 = args[3].(domain.OrderExpand)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_GetAll_Call) Return(page1 *domain.Page[domain.Order], err error) *MockRepository_GetAll_Call {
+	_c.Call.Return(page1, err)
+	return _c
+}
+
+func (_c *MockRepository_GetAll_Call) RunAndReturn(run func(ctx context.Context, list domain.ListQuery, page domain.PageRequest, expand domain.OrderExpand) (*domain.Page[domain.Order], error)) *MockRepository_GetAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function for the type MockRepository
+func (_mock *MockRepository) GetByID(ctx context.Context, id int, expand domain.OrderExpand) (*domain.Order, error) {
+	ret := _mock.Called(ctx, id, expand)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Create")
+		panic("no return value specified for GetByID")
 	}
 
 	var r0 *domain.Order
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int, int, int, float64, string) (*domain.Order, error)); ok {
-		return returnFunc(ctx, userID, productID, quantity, totalPrice, status)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, domain.OrderExpand) (*domain.Order, error)); ok {
+		return returnFunc(ctx, id, expand)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int, int, int, float64, string) *domain.Order); ok {
-		r0 = returnFunc(ctx, userID, productID, quantity, totalPrice, status)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, domain.OrderExpand) *domain.Order); ok {
+		r0 = returnFunc(ctx, id, expand)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*domain.Order)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, int, int, int, float64, string) error); ok {
-		r1 = returnFunc(ctx, userID, productID, quantity, totalPrice, status)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int, domain.OrderExpand) error); ok {
+		r1 = returnFunc(ctx, id, expand)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
-type MockRepository_Create_Call struct {
+// MockRepository_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type MockRepository_GetByID_Call struct {
 	*mock.Call
 }
 
-// Create is a helper method to define mock.On call
+// GetByID is a helper method to define mock.On call
 //   - ctx context.Context
-//   - userID int
-//   - productID int
-//   - quantity int
-//   - totalPrice float64
-//   - status string
-func (_e *MockRepository_Expecter) Create(ctx interface{}, userID interface{}, productID interface{}, quantity interface{}, totalPrice interface{}, status interface{}) *MockRepository_Create_Call {
-	return &MockRepository_Create_Call{Call: _e.mock.On("Create", ctx, userID, productID, quantity, totalPrice, status)}
+//   - id int
+//   - expand domain.OrderExpand
+func (_e *MockRepository_Expecter) GetByID(ctx interface{}, id interface{}, expand interface{}) *MockRepository_GetByID_Call {
+	return &MockRepository_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id, expand)}
 }
 
-func (_c *MockRepository_Create_Call) Run(run func(ctx context.Context, userID int, productID int, quantity int, totalPrice float64, status string)) *MockRepository_Create_Call {
+func (_c *MockRepository_GetByID_Call) Run(run func(ctx context.Context, id int, expand domain.OrderExpand)) *MockRepository_GetByID_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -92,74 +682,145 @@ func (_c *MockRepository_Create_Call) Run(run func(ctx context.Context, userID i
 		if args[1] != nil {
 			arg1 = args[1].(int)
 		}
-		var arg2 int
+		var arg2 domain.OrderExpand
 		if args[2] != nil {
-			arg2 = args[2].(int)
+			arg2 = args[2].(domain.OrderExpand)
 		}
-		var arg3 int
-		if args[3] != nil {
-			arg3 = args[3].(int)
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_GetByID_Call) Return(order *domain.Order, err error) *MockRepository_GetByID_Call {
+	_c.Call.Return(order, err)
+	return _c
+}
+
+func (_c *MockRepository_GetByID_Call) RunAndReturn(run func(ctx context.Context, id int, expand domain.OrderExpand) (*domain.Order, error)) *MockRepository_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByProduct provides a mock function for the type MockRepository
+func (_mock *MockRepository) GetByProduct(ctx context.Context, productID int, page domain.PageRequest) (*domain.Page[domain.Order], error) {
+	ret := _mock.Called(ctx, productID, page)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByProduct")
+	}
+
+	var r0 *domain.Page[domain.Order]
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, domain.PageRequest) (*domain.Page[domain.Order], error)); ok {
+		return returnFunc(ctx, productID, page)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, domain.PageRequest) *domain.Page[domain.Order]); ok {
+		r0 = returnFunc(ctx, productID, page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Page[domain.Order])
 		}
-		var arg4 float64
-		if args[4] != nil {
-			arg4 = args[4].(float64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int, domain.PageRequest) error); ok {
+		r1 = returnFunc(ctx, productID, page)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_GetByProduct_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByProduct'
+type MockRepository_GetByProduct_Call struct {
+	*mock.Call
+}
+
+// GetByProduct is a helper method to define mock.On call
+//   - ctx context.Context
+//   - productID int
+//   - page domain.PageRequest
+func (_e *MockRepository_Expecter) GetByProduct(ctx interface{}, productID interface{}, page interface{}) *MockRepository_GetByProduct_Call {
+	return &MockRepository_GetByProduct_Call{Call: _e.mock.On("GetByProduct", ctx, productID, page)}
+}
+
+func (_c *MockRepository_GetByProduct_Call) Run(run func(ctx context.Context, productID int, page domain.PageRequest)) *MockRepository_GetByProduct_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
 		}
-		var arg5 string
-		if args[5] != nil {
-			arg5 = args[5].(string)
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		var arg2 domain.PageRequest
+		if args[2] != nil {
+			arg2 = args[2].(domain.PageRequest)
 		}
 		run(
 			arg0,
 			arg1,
 			arg2,
-			arg3,
-			arg4,
-			arg5,
 		)
 	})
 	return _c
 }
 
-func (_c *MockRepository_Create_Call) Return(order *domain.Order, err error) *MockRepository_Create_Call {
-	_c.Call.Return(order, err)
+func (_c *MockRepository_GetByProduct_Call) Return(page1 *domain.Page[domain.Order], err error) *MockRepository_GetByProduct_Call {
+	_c.Call.Return(page1, err)
 	return _c
 }
 
-func (_c *MockRepository_Create_Call) RunAndReturn(run func(ctx context.Context, userID int, productID int, quantity int, totalPrice float64, status string) (*domain.Order, error)) *MockRepository_Create_Call {
+func (_c *MockRepository_GetByProduct_Call) RunAndReturn(run func(ctx context.Context, productID int, page domain.PageRequest) (*domain.Page[domain.Order], error)) *MockRepository_GetByProduct_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Delete provides a mock function for the type MockRepository
-func (_mock *MockRepository) Delete(ctx context.Context, id int) error {
-	ret := _mock.Called(ctx, id)
+// GetByUser provides a mock function for the type MockRepository
+func (_mock *MockRepository) GetByUser(ctx context.Context, userID int, page domain.PageRequest) (*domain.Page[domain.Order], error) {
+	ret := _mock.Called(ctx, userID, page)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Delete")
+		panic("no return value specified for GetByUser")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int) error); ok {
-		r0 = returnFunc(ctx, id)
+	var r0 *domain.Page[domain.Order]
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, domain.PageRequest) (*domain.Page[domain.Order], error)); ok {
+		return returnFunc(ctx, userID, page)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, domain.PageRequest) *domain.Page[domain.Order]); ok {
+		r0 = returnFunc(ctx, userID, page)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Page[domain.Order])
+		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int, domain.PageRequest) error); ok {
+		r1 = returnFunc(ctx, userID, page)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockRepository_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
-type MockRepository_Delete_Call struct {
+// MockRepository_GetByUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByUser'
+type MockRepository_GetByUser_Call struct {
 	*mock.Call
 }
 
-// Delete is a helper method to define mock.On call
+// GetByUser is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id int
-func (_e *MockRepository_Expecter) Delete(ctx interface{}, id interface{}) *MockRepository_Delete_Call {
-	return &MockRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+//   - userID int
+//   - page domain.PageRequest
+func (_e *MockRepository_Expecter) GetByUser(ctx interface{}, userID interface{}, page interface{}) *MockRepository_GetByUser_Call {
+	return &MockRepository_GetByUser_Call{Call: _e.mock.On("GetByUser", ctx, userID, page)}
 }
 
-func (_c *MockRepository_Delete_Call) Run(run func(ctx context.Context, id int)) *MockRepository_Delete_Call {
+func (_c *MockRepository_GetByUser_Call) Run(run func(ctx context.Context, userID int, page domain.PageRequest)) *MockRepository_GetByUser_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -169,92 +830,172 @@ func (_c *MockRepository_Delete_Call) Run(run func(ctx context.Context, id int))
 		if args[1] != nil {
 			arg1 = args[1].(int)
 		}
+		var arg2 domain.PageRequest
+		if args[2] != nil {
+			arg2 = args[2].(domain.PageRequest)
+		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockRepository_Delete_Call) Return(err error) *MockRepository_Delete_Call {
-	_c.Call.Return(err)
+func (_c *MockRepository_GetByUser_Call) Return(page1 *domain.Page[domain.Order], err error) *MockRepository_GetByUser_Call {
+	_c.Call.Return(page1, err)
 	return _c
 }
 
-func (_c *MockRepository_Delete_Call) RunAndReturn(run func(ctx context.Context, id int) error) *MockRepository_Delete_Call {
+func (_c *MockRepository_GetByUser_Call) RunAndReturn(run func(ctx context.Context, userID int, page domain.PageRequest) (*domain.Page[domain.Order], error)) *MockRepository_GetByUser_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetAll provides a mock function for the type MockRepository
-func (_mock *MockRepository) GetAll(ctx context.Context) ([]domain.Order, error) {
-	ret := _mock.Called(ctx)
+// Patch provides a mock function for the type MockRepository
+func (_mock *MockRepository) Patch(ctx context.Context, id int, version string, patch domain.OrderPatch) (*domain.Order, error) {
+	ret := _mock.Called(ctx, id, version, patch)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetAll")
+		panic("no return value specified for Patch")
 	}
 
-	var r0 []domain.Order
+	var r0 *domain.Order
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]domain.Order, error)); ok {
-		return returnFunc(ctx)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, string, domain.OrderPatch) (*domain.Order, error)); ok {
+		return returnFunc(ctx, id, version, patch)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context) []domain.Order); ok {
-		r0 = returnFunc(ctx)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, string, domain.OrderPatch) *domain.Order); ok {
+		r0 = returnFunc(ctx, id, version, patch)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]domain.Order)
+			r0 = ret.Get(0).(*domain.Order)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = returnFunc(ctx)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int, string, domain.OrderPatch) error); ok {
+		r1 = returnFunc(ctx, id, version, patch)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockRepository_GetAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAll'
-type MockRepository_GetAll_Call struct {
+// MockRepository_Patch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Patch'
+type MockRepository_Patch_Call struct {
 	*mock.Call
 }
 
-// GetAll is a helper method to define mock.On call
+// Patch is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *MockRepository_Expecter) GetAll(ctx interface{}) *MockRepository_GetAll_Call {
-	return &MockRepository_GetAll_Call{Call: _e.mock.On("GetAll", ctx)}
+//   - id int
+//   - version string
+//   - patch domain.OrderPatch
+func (_e *MockRepository_Expecter) Patch(ctx interface{}, id interface{}, version interface{}, patch interface{}) *MockRepository_Patch_Call {
+	return &MockRepository_Patch_Call{Call: _e.mock.On("Patch", ctx, id, version, patch)}
 }
 
-func (_c *MockRepository_GetAll_Call) Run(run func(ctx context.Context)) *MockRepository_GetAll_Call {
+func (_c *MockRepository_Patch_Call) Run(run func(ctx context.Context, id int, version string, patch domain.OrderPatch)) *MockRepository_Patch_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 domain.OrderPatch
+		if args[3] != nil {
+			arg3 = args[3].(domain.OrderPatch)
+		}
 		run(
 			arg0,
+			arg1,
+			arg2,
+			arg3,
 		)
 	})
 	return _c
 }
 
-func (_c *MockRepository_GetAll_Call) Return(orders []domain.Order, err error) *MockRepository_GetAll_Call {
-	_c.Call.Return(orders, err)
+func (_c *MockRepository_Patch_Call) Return(order *domain.Order, err error) *MockRepository_Patch_Call {
+	_c.Call.Return(order, err)
 	return _c
 }
 
-func (_c *MockRepository_GetAll_Call) RunAndReturn(run func(ctx context.Context) ([]domain.Order, error)) *MockRepository_GetAll_Call {
+func (_c *MockRepository_Patch_Call) RunAndReturn(run func(ctx context.Context, id int, version string, patch domain.OrderPatch) (*domain.Order, error)) *MockRepository_Patch_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetByID provides a mock function for the type MockRepository
-func (_mock *MockRepository) GetByID(ctx context.Context, id int) (*domain.Order, error) {
+// Purge provides a mock function for the type MockRepository
+func (_mock *MockRepository) Purge(ctx context.Context, id int) error {
 	ret := _mock.Called(ctx, id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetByID")
+		panic("no return value specified for Purge")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRepository_Purge_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Purge'
+type MockRepository_Purge_Call struct {
+	*mock.Call
+}
+
+// Purge is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+func (_e *MockRepository_Expecter) Purge(ctx interface{}, id interface{}) *MockRepository_Purge_Call {
+	return &MockRepository_Purge_Call{Call: _e.mock.On("Purge", ctx, id)}
+}
+
+func (_c *MockRepository_Purge_Call) Run(run func(ctx context.Context, id int)) *MockRepository_Purge_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_Purge_Call) Return(err error) *MockRepository_Purge_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRepository_Purge_Call) RunAndReturn(run func(ctx context.Context, id int) error) *MockRepository_Purge_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Restore provides a mock function for the type MockRepository
+func (_mock *MockRepository) Restore(ctx context.Context, id int) (*domain.Order, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Restore")
 	}
 
 	var r0 *domain.Order
@@ -277,19 +1018,19 @@ func (_mock *MockRepository) GetByID(ctx context.Context, id int) (*domain.Order
 	return r0, r1
 }
 
-// MockRepository_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
-type MockRepository_GetByID_Call struct {
+// MockRepository_Restore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Restore'
+type MockRepository_Restore_Call struct {
 	*mock.Call
 }
 
-// GetByID is a helper method to define mock.On call
+// Restore is a helper method to define mock.On call
 //   - ctx context.Context
 //   - id int
-func (_e *MockRepository_Expecter) GetByID(ctx interface{}, id interface{}) *MockRepository_GetByID_Call {
-	return &MockRepository_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id)}
+func (_e *MockRepository_Expecter) Restore(ctx interface{}, id interface{}) *MockRepository_Restore_Call {
+	return &MockRepository_Restore_Call{Call: _e.mock.On("Restore", ctx, id)}
 }
 
-func (_c *MockRepository_GetByID_Call) Run(run func(ctx context.Context, id int)) *MockRepository_GetByID_Call {
+func (_c *MockRepository_Restore_Call) Run(run func(ctx context.Context, id int)) *MockRepository_Restore_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -307,19 +1048,88 @@ func (_c *MockRepository_GetByID_Call) Run(run func(ctx context.Context, id int)
 	return _c
 }
 
-func (_c *MockRepository_GetByID_Call) Return(order *domain.Order, err error) *MockRepository_GetByID_Call {
+func (_c *MockRepository_Restore_Call) Return(order *domain.Order, err error) *MockRepository_Restore_Call {
 	_c.Call.Return(order, err)
 	return _c
 }
 
-func (_c *MockRepository_GetByID_Call) RunAndReturn(run func(ctx context.Context, id int) (*domain.Order, error)) *MockRepository_GetByID_Call {
+func (_c *MockRepository_Restore_Call) RunAndReturn(run func(ctx context.Context, id int) (*domain.Order, error)) *MockRepository_Restore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Stream provides a mock function for the type MockRepository
+func (_mock *MockRepository) Stream(ctx context.Context, list domain.ListQuery, batchSize int, fn func([]domain.Order) error) error {
+	ret := _mock.Called(ctx, list, batchSize, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Stream")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.ListQuery, int, func([]domain.Order) error) error); ok {
+		r0 = returnFunc(ctx, list, batchSize, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRepository_Stream_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Stream'
+type MockRepository_Stream_Call struct {
+	*mock.Call
+}
+
+// Stream is a helper method to define mock.On call
+//   - ctx context.Context
+//   - list domain.ListQuery
+//   - batchSize int
+//   - fn func([]domain.Order) error
+func (_e *MockRepository_Expecter) Stream(ctx interface{}, list interface{}, batchSize interface{}, fn interface{}) *MockRepository_Stream_Call {
+	return &MockRepository_Stream_Call{Call: _e.mock.On("Stream", ctx, list, batchSize, fn)}
+}
+
+func (_c *MockRepository_Stream_Call) Run(run func(ctx context.Context, list domain.ListQuery, batchSize int, fn func([]domain.Order) error)) *MockRepository_Stream_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.ListQuery
+		if args[1] != nil {
+			arg1 = args[1].(domain.ListQuery)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		var arg3 func([]domain.Order) error
+		if args[3] != nil {
+			arg3 = args[3].(func([]domain.Order) error)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_Stream_Call) Return(err error) *MockRepository_Stream_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRepository_Stream_Call) RunAndReturn(run func(ctx context.Context, list domain.ListQuery, batchSize int, fn func([]domain.Order) error) error) *MockRepository_Stream_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // Update provides a mock function for the type MockRepository
-func (_mock *MockRepository) Update(ctx context.Context, id int, quantity int, totalPrice float64, status string) (*domain.Order, error) {
-	ret := _mock.Called(ctx, id, quantity, totalPrice, status)
+func (_mock *MockRepository) Update(ctx context.Context, id int, version string, items []domain.OrderItem, totalPrice domain.Money, status string) (*domain.Order, error) {
+	ret := _mock.Called(ctx, id, version, items, totalPrice, status)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Update")
@@ -327,18 +1137,18 @@ func (_mock *MockRepository) Update(ctx context.Context, id int, quantity int, t
 
 	var r0 *domain.Order
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int, int, float64, string) (*domain.Order, error)); ok {
-		return returnFunc(ctx, id, quantity, totalPrice, status)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, string, []domain.OrderItem, domain.Money, string) (*domain.Order, error)); ok {
+		return returnFunc(ctx, id, version, items, totalPrice, status)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int, int, float64, string) *domain.Order); ok {
-		r0 = returnFunc(ctx, id, quantity, totalPrice, status)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, string, []domain.OrderItem, domain.Money, string) *domain.Order); ok {
+		r0 = returnFunc(ctx, id, version, items, totalPrice, status)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*domain.Order)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, int, int, float64, string) error); ok {
-		r1 = returnFunc(ctx, id, quantity, totalPrice, status)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int, string, []domain.OrderItem, domain.Money, string) error); ok {
+		r1 = returnFunc(ctx, id, version, items, totalPrice, status)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -353,14 +1163,15 @@ type MockRepository_Update_Call struct {
 // Update is a helper method to define mock.On call
 //   - ctx context.Context
 //   - id int
-//   - quantity int
-//   - totalPrice float64
+//   - version string
+//   - items []domain.OrderItem
+//   - totalPrice domain.Money
 //   - status string
-func (_e *MockRepository_Expecter) Update(ctx interface{}, id interface{}, quantity interface{}, totalPrice interface{}, status interface{}) *MockRepository_Update_Call {
-	return &MockRepository_Update_Call{Call: _e.mock.On("Update", ctx, id, quantity, totalPrice, status)}
+func (_e *MockRepository_Expecter) Update(ctx interface{}, id interface{}, version interface{}, items interface{}, totalPrice interface{}, status interface{}) *MockRepository_Update_Call {
+	return &MockRepository_Update_Call{Call: _e.mock.On("Update", ctx, id, version, items, totalPrice, status)}
 }
 
-func (_c *MockRepository_Update_Call) Run(run func(ctx context.Context, id int, quantity int, totalPrice float64, status string)) *MockRepository_Update_Call {
+func (_c *MockRepository_Update_Call) Run(run func(ctx context.Context, id int, version string, items []domain.OrderItem, totalPrice domain.Money, status string)) *MockRepository_Update_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -370,17 +1181,21 @@ func (_c *MockRepository_Update_Call) Run(run func(ctx context.Context, id int,
 		if args[1] != nil {
 			arg1 = args[1].(int)
 		}
-		var arg2 int
+		var arg2 string
 		if args[2] != nil {
-			arg2 = args[2].(int)
+			arg2 = args[2].(string)
 		}
-		var arg3 float64
+		var arg3 []domain.OrderItem
 		if args[3] != nil {
-			arg3 = args[3].(float64)
+			arg3 = args[3].([]domain.OrderItem)
 		}
-		var arg4 string
+		var arg4 domain.Money
 		if args[4] != nil {
-			arg4 = args[4].(string)
+			arg4 = args[4].(domain.Money)
+		}
+		var arg5 string
+		if args[5] != nil {
+			arg5 = args[5].(string)
 		}
 		run(
 			arg0,
@@ -388,6 +1203,7 @@ func (_c *MockRepository_Update_Call) Run(run func(ctx context.Context, id int,
 			arg2,
 			arg3,
 			arg4,
+			arg5,
 		)
 	})
 	return _c
@@ -398,7 +1214,7 @@ func (_c *MockRepository_Update_Call) Return(order *domain.Order, err error) *Mo
 	return _c
 }
 
-func (_c *MockRepository_Update_Call) RunAndReturn(run func(ctx context.Context, id int, quantity int, totalPrice float64, status string) (*domain.Order, error)) *MockRepository_Update_Call {
+func (_c *MockRepository_Update_Call) RunAndReturn(run func(ctx context.Context, id int, version string, items []domain.OrderItem, totalPrice domain.Money, status string) (*domain.Order, error)) *MockRepository_Update_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -39,7 +39,7 @@ func (_m *MockRepository) EXPECT() *MockRepository_Expecter {
 }
 
 // Create provides a mock function for the type MockRepository
-func (_mock *MockRepository) Create(ctx context.Context, name string, description string, price float64, stock int) (*domain.Product, error) {
+func (_mock *MockRepository) Create(ctx context.Context, name string, description string, price domain.Money, stock int) (*domain.Product, error) {
 	ret := _mock.Called(ctx, name, description, price, stock)
 
 	if len(ret) == 0 {
@@ -48,17 +48,17 @@ func (_mock *MockRepository) Create(ctx context.Context, name string, descriptio
 
 	var r0 *domain.Product
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, float64, int) (*domain.Product, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, domain.Money, int) (*domain.Product, error)); ok {
 		return returnFunc(ctx, name, description, price, stock)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, float64, int) *domain.Product); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, domain.Money, int) *domain.Product); ok {
 		r0 = returnFunc(ctx, name, description, price, stock)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*domain.Product)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, float64, int) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, domain.Money, int) error); ok {
 		r1 = returnFunc(ctx, name, description, price, stock)
 	} else {
 		r1 = ret.Error(1)
@@ -75,13 +75,13 @@ type MockRepository_Create_Call struct {
 //   - ctx context.Context
 //   - name string
 //   - description string
-//   - price float64
+//   - price domain.Money
 //   - stock int
 func (_e *MockRepository_Expecter) Create(ctx interface{}, name interface{}, description interface{}, price interface{}, stock interface{}) *MockRepository_Create_Call {
 	return &MockRepository_Create_Call{Call: _e.mock.On("Create", ctx, name, description, price, stock)}
 }
 
-func (_c *MockRepository_Create_Call) Run(run func(ctx context.Context, name string, description string, price float64, stock int)) *MockRepository_Create_Call {
+func (_c *MockRepository_Create_Call) Run(run func(ctx context.Context, name string, description string, price domain.Money, stock int)) *MockRepository_Create_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -95,9 +95,9 @@ func (_c *MockRepository_Create_Call) Run(run func(ctx context.Context, name str
 		if args[2] != nil {
 			arg2 = args[2].(string)
 		}
-		var arg3 float64
+		var arg3 domain.Money
 		if args[3] != nil {
-			arg3 = args[3].(float64)
+			arg3 = args[3].(domain.Money)
 		}
 		var arg4 int
 		if args[4] != nil {
@@ -119,22 +119,90 @@ func (_c *MockRepository_Create_Call) Return(product *domain.Product, err error)
 	return _c
 }
 
-func (_c *MockRepository_Create_Call) RunAndReturn(run func(ctx context.Context, name string, description string, price float64, stock int) (*domain.Product, error)) *MockRepository_Create_Call {
+func (_c *MockRepository_Create_Call) RunAndReturn(run func(ctx context.Context, name string, description string, price domain.Money, stock int) (*domain.Product, error)) *MockRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateBulk provides a mock function for the type MockRepository
+func (_mock *MockRepository) CreateBulk(ctx context.Context, products []domain.Product) ([]domain.Product, error) {
+	ret := _mock.Called(ctx, products)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateBulk")
+	}
+
+	var r0 []domain.Product
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []domain.Product) ([]domain.Product, error)); ok {
+		return returnFunc(ctx, products)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []domain.Product) []domain.Product); ok {
+		r0 = returnFunc(ctx, products)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Product)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []domain.Product) error); ok {
+		r1 = returnFunc(ctx, products)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_CreateBulk_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateBulk'
+type MockRepository_CreateBulk_Call struct {
+	*mock.Call
+}
+
+// CreateBulk is a helper method to define mock.On call
+//   - ctx context.Context
+//   - products []domain.Product
+func (_e *MockRepository_Expecter) CreateBulk(ctx interface{}, products interface{}) *MockRepository_CreateBulk_Call {
+	return &MockRepository_CreateBulk_Call{Call: _e.mock.On("CreateBulk", ctx, products)}
+}
+
+func (_c *MockRepository_CreateBulk_Call) Run(run func(ctx context.Context, products []domain.Product)) *MockRepository_CreateBulk_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []domain.Product
+		if args[1] != nil {
+			arg1 = args[1].([]domain.Product)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_CreateBulk_Call) Return(products1 []domain.Product, err error) *MockRepository_CreateBulk_Call {
+	_c.Call.Return(products1, err)
+	return _c
+}
+
+func (_c *MockRepository_CreateBulk_Call) RunAndReturn(run func(ctx context.Context, products []domain.Product) ([]domain.Product, error)) *MockRepository_CreateBulk_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // Delete provides a mock function for the type MockRepository
-func (_mock *MockRepository) Delete(ctx context.Context, id int) error {
-	ret := _mock.Called(ctx, id)
+func (_mock *MockRepository) Delete(ctx context.Context, id int, version string) error {
+	ret := _mock.Called(ctx, id, version)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Delete")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int) error); ok {
-		r0 = returnFunc(ctx, id)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, string) error); ok {
+		r0 = returnFunc(ctx, id, version)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -149,11 +217,12 @@ type MockRepository_Delete_Call struct {
 // Delete is a helper method to define mock.On call
 //   - ctx context.Context
 //   - id int
-func (_e *MockRepository_Expecter) Delete(ctx interface{}, id interface{}) *MockRepository_Delete_Call {
-	return &MockRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+//   - version string
+func (_e *MockRepository_Expecter) Delete(ctx interface{}, id interface{}, version interface{}) *MockRepository_Delete_Call {
+	return &MockRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, id, version)}
 }
 
-func (_c *MockRepository_Delete_Call) Run(run func(ctx context.Context, id int)) *MockRepository_Delete_Call {
+func (_c *MockRepository_Delete_Call) Run(run func(ctx context.Context, id int, version string)) *MockRepository_Delete_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -163,9 +232,14 @@ func (_c *MockRepository_Delete_Call) Run(run func(ctx context.Context, id int))
 		if args[1] != nil {
 			arg1 = args[1].(int)
 		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
@@ -176,33 +250,33 @@ func (_c *MockRepository_Delete_Call) Return(err error) *MockRepository_Delete_C
 	return _c
 }
 
-func (_c *MockRepository_Delete_Call) RunAndReturn(run func(ctx context.Context, id int) error) *MockRepository_Delete_Call {
+func (_c *MockRepository_Delete_Call) RunAndReturn(run func(ctx context.Context, id int, version string) error) *MockRepository_Delete_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // GetAll provides a mock function for the type MockRepository
-func (_mock *MockRepository) GetAll(ctx context.Context) ([]domain.Product, error) {
-	ret := _mock.Called(ctx)
+func (_mock *MockRepository) GetAll(ctx context.Context, list domain.ListQuery, page domain.PageRequest) (*domain.Page[domain.Product], error) {
+	ret := _mock.Called(ctx, list, page)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetAll")
 	}
 
-	var r0 []domain.Product
+	var r0 *domain.Page[domain.Product]
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]domain.Product, error)); ok {
-		return returnFunc(ctx)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.ListQuery, domain.PageRequest) (*domain.Page[domain.Product], error)); ok {
+		return returnFunc(ctx, list, page)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context) []domain.Product); ok {
-		r0 = returnFunc(ctx)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.ListQuery, domain.PageRequest) *domain.Page[domain.Product]); ok {
+		r0 = returnFunc(ctx, list, page)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]domain.Product)
+			r0 = ret.Get(0).(*domain.Page[domain.Product])
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = returnFunc(ctx)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.ListQuery, domain.PageRequest) error); ok {
+		r1 = returnFunc(ctx, list, page)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -216,29 +290,41 @@ type MockRepository_GetAll_Call struct {
 
 // GetAll is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *MockRepository_Expecter) GetAll(ctx interface{}) *MockRepository_GetAll_Call {
-	return &MockRepository_GetAll_Call{Call: _e.mock.On("GetAll", ctx)}
+//   - list domain.ListQuery
+//   - page domain.PageRequest
+func (_e *MockRepository_Expecter) GetAll(ctx interface{}, list interface{}, page interface{}) *MockRepository_GetAll_Call {
+	return &MockRepository_GetAll_Call{Call: _e.mock.On("GetAll", ctx, list, page)}
 }
 
-func (_c *MockRepository_GetAll_Call) Run(run func(ctx context.Context)) *MockRepository_GetAll_Call {
+func (_c *MockRepository_GetAll_Call) Run(run func(ctx context.Context, list domain.ListQuery, page domain.PageRequest)) *MockRepository_GetAll_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
+		var arg1 domain.ListQuery
+		if args[1] != nil {
+			arg1 = args[1].(domain.ListQuery)
+		}
+		var arg2 domain.PageRequest
+		if args[2] != nil {
+			arg2 = args[2].(domain.PageRequest)
+		}
 		run(
 			arg0,
+			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockRepository_GetAll_Call) Return(products []domain.Product, err error) *MockRepository_GetAll_Call {
-	_c.Call.Return(products, err)
+func (_c *MockRepository_GetAll_Call) Return(page1 *domain.Page[domain.Product], err error) *MockRepository_GetAll_Call {
+	_c.Call.Return(page1, err)
 	return _c
 }
 
-func (_c *MockRepository_GetAll_Call) RunAndReturn(run func(ctx context.Context) ([]domain.Product, error)) *MockRepository_GetAll_Call {
+func (_c *MockRepository_GetAll_Call) RunAndReturn(run func(ctx context.Context, list domain.ListQuery, page domain.PageRequest) (*domain.Page[domain.Product], error)) *MockRepository_GetAll_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -311,9 +397,409 @@ func (_c *MockRepository_GetByID_Call) RunAndReturn(run func(ctx context.Context
 	return _c
 }
 
+// Patch provides a mock function for the type MockRepository
+func (_mock *MockRepository) Patch(ctx context.Context, id int, version string, patch domain.ProductPatch) (*domain.Product, error) {
+	ret := _mock.Called(ctx, id, version, patch)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Patch")
+	}
+
+	var r0 *domain.Product
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, string, domain.ProductPatch) (*domain.Product, error)); ok {
+		return returnFunc(ctx, id, version, patch)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, string, domain.ProductPatch) *domain.Product); ok {
+		r0 = returnFunc(ctx, id, version, patch)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Product)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int, string, domain.ProductPatch) error); ok {
+		r1 = returnFunc(ctx, id, version, patch)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_Patch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Patch'
+type MockRepository_Patch_Call struct {
+	*mock.Call
+}
+
+// Patch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+//   - version string
+//   - patch domain.ProductPatch
+func (_e *MockRepository_Expecter) Patch(ctx interface{}, id interface{}, version interface{}, patch interface{}) *MockRepository_Patch_Call {
+	return &MockRepository_Patch_Call{Call: _e.mock.On("Patch", ctx, id, version, patch)}
+}
+
+func (_c *MockRepository_Patch_Call) Run(run func(ctx context.Context, id int, version string, patch domain.ProductPatch)) *MockRepository_Patch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 domain.ProductPatch
+		if args[3] != nil {
+			arg3 = args[3].(domain.ProductPatch)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_Patch_Call) Return(product *domain.Product, err error) *MockRepository_Patch_Call {
+	_c.Call.Return(product, err)
+	return _c
+}
+
+func (_c *MockRepository_Patch_Call) RunAndReturn(run func(ctx context.Context, id int, version string, patch domain.ProductPatch) (*domain.Product, error)) *MockRepository_Patch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Purge provides a mock function for the type MockRepository
+func (_mock *MockRepository) Purge(ctx context.Context, id int) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Purge")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRepository_Purge_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Purge'
+type MockRepository_Purge_Call struct {
+	*mock.Call
+}
+
+// Purge is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+func (_e *MockRepository_Expecter) Purge(ctx interface{}, id interface{}) *MockRepository_Purge_Call {
+	return &MockRepository_Purge_Call{Call: _e.mock.On("Purge", ctx, id)}
+}
+
+func (_c *MockRepository_Purge_Call) Run(run func(ctx context.Context, id int)) *MockRepository_Purge_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_Purge_Call) Return(err error) *MockRepository_Purge_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRepository_Purge_Call) RunAndReturn(run func(ctx context.Context, id int) error) *MockRepository_Purge_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReleaseStock provides a mock function for the type MockRepository
+func (_mock *MockRepository) ReleaseStock(ctx context.Context, id int, quantity int) error {
+	ret := _mock.Called(ctx, id, quantity)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReleaseStock")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, int) error); ok {
+		r0 = returnFunc(ctx, id, quantity)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRepository_ReleaseStock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReleaseStock'
+type MockRepository_ReleaseStock_Call struct {
+	*mock.Call
+}
+
+// ReleaseStock is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+//   - quantity int
+func (_e *MockRepository_Expecter) ReleaseStock(ctx interface{}, id interface{}, quantity interface{}) *MockRepository_ReleaseStock_Call {
+	return &MockRepository_ReleaseStock_Call{Call: _e.mock.On("ReleaseStock", ctx, id, quantity)}
+}
+
+func (_c *MockRepository_ReleaseStock_Call) Run(run func(ctx context.Context, id int, quantity int)) *MockRepository_ReleaseStock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_ReleaseStock_Call) Return(err error) *MockRepository_ReleaseStock_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRepository_ReleaseStock_Call) RunAndReturn(run func(ctx context.Context, id int, quantity int) error) *MockRepository_ReleaseStock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReserveStock provides a mock function for the type MockRepository
+func (_mock *MockRepository) ReserveStock(ctx context.Context, id int, quantity int) error {
+	ret := _mock.Called(ctx, id, quantity)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReserveStock")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, int) error); ok {
+		r0 = returnFunc(ctx, id, quantity)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRepository_ReserveStock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReserveStock'
+type MockRepository_ReserveStock_Call struct {
+	*mock.Call
+}
+
+// ReserveStock is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+//   - quantity int
+func (_e *MockRepository_Expecter) ReserveStock(ctx interface{}, id interface{}, quantity interface{}) *MockRepository_ReserveStock_Call {
+	return &MockRepository_ReserveStock_Call{Call: _e.mock.On("ReserveStock", ctx, id, quantity)}
+}
+
+func (_c *MockRepository_ReserveStock_Call) Run(run func(ctx context.Context, id int, quantity int)) *MockRepository_ReserveStock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_ReserveStock_Call) Return(err error) *MockRepository_ReserveStock_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRepository_ReserveStock_Call) RunAndReturn(run func(ctx context.Context, id int, quantity int) error) *MockRepository_ReserveStock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Restore provides a mock function for the type MockRepository
+func (_mock *MockRepository) Restore(ctx context.Context, id int) (*domain.Product, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Restore")
+	}
+
+	var r0 *domain.Product
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) (*domain.Product, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) *domain.Product); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Product)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_Restore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Restore'
+type MockRepository_Restore_Call struct {
+	*mock.Call
+}
+
+// Restore is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+func (_e *MockRepository_Expecter) Restore(ctx interface{}, id interface{}) *MockRepository_Restore_Call {
+	return &MockRepository_Restore_Call{Call: _e.mock.On("Restore", ctx, id)}
+}
+
+func (_c *MockRepository_Restore_Call) Run(run func(ctx context.Context, id int)) *MockRepository_Restore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_Restore_Call) Return(product *domain.Product, err error) *MockRepository_Restore_Call {
+	_c.Call.Return(product, err)
+	return _c
+}
+
+func (_c *MockRepository_Restore_Call) RunAndReturn(run func(ctx context.Context, id int) (*domain.Product, error)) *MockRepository_Restore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Stream provides a mock function for the type MockRepository
+func (_mock *MockRepository) Stream(ctx context.Context, list domain.ListQuery, batchSize int, fn func([]domain.Product) error) error {
+	ret := _mock.Called(ctx, list, batchSize, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Stream")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.ListQuery, int, func([]domain.Product) error) error); ok {
+		r0 = returnFunc(ctx, list, batchSize, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRepository_Stream_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Stream'
+type MockRepository_Stream_Call struct {
+	*mock.Call
+}
+
+// Stream is a helper method to define mock.On call
+//   - ctx context.Context
+//   - list domain.ListQuery
+//   - batchSize int
+//   - fn func([]domain.Product) error
+func (_e *MockRepository_Expecter) Stream(ctx interface{}, list interface{}, batchSize interface{}, fn interface{}) *MockRepository_Stream_Call {
+	return &MockRepository_Stream_Call{Call: _e.mock.On("Stream", ctx, list, batchSize, fn)}
+}
+
+func (_c *MockRepository_Stream_Call) Run(run func(ctx context.Context, list domain.ListQuery, batchSize int, fn func([]domain.Product) error)) *MockRepository_Stream_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.ListQuery
+		if args[1] != nil {
+			arg1 = args[1].(domain.ListQuery)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		var arg3 func([]domain.Product) error
+		if args[3] != nil {
+			arg3 = args[3].(func([]domain.Product) error)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_Stream_Call) Return(err error) *MockRepository_Stream_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRepository_Stream_Call) RunAndReturn(run func(ctx context.Context, list domain.ListQuery, batchSize int, fn func([]domain.Product) error) error) *MockRepository_Stream_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Update provides a mock function for the type MockRepository
-func (_mock *MockRepository) Update(ctx context.Context, id int, name string, description string, price float64, stock int) (*domain.Product, error) {
-	ret := _mock.Called(ctx, id, name, description, price, stock)
+func (_mock *MockRepository) Update(ctx context.Context, id int, version string, name string, description string, price domain.Money, stock int) (*domain.Product, error) {
+	ret := _mock.Called(ctx, id, version, name, description, price, stock)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Update")
@@ -321,18 +807,18 @@ func (_mock *MockRepository) Update(ctx context.Context, id int, name string, de
 
 	var r0 *domain.Product
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int, string, string, float64, int) (*domain.Product, error)); ok {
-		return returnFunc(ctx, id, name, description, price, stock)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, string, string, string, domain.Money, int) (*domain.Product, error)); ok {
+		return returnFunc(ctx, id, version, name, description, price, stock)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int, string, string, float64, int) *domain.Product); ok {
-		r0 = returnFunc(ctx, id, name, description, price, stock)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, string, string, string, domain.Money, int) *domain.Product); ok {
+		r0 = returnFunc(ctx, id, version, name, description, price, stock)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*domain.Product)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, int, string, string, float64, int) error); ok {
-		r1 = returnFunc(ctx, id, name, description, price, stock)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int, string, string, string, domain.Money, int) error); ok {
+		r1 = returnFunc(ctx, id, version, name, description, price, stock)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -347,15 +833,16 @@ type MockRepository_Update_Call struct {
 // Update is a helper method to define mock.On call
 //   - ctx context.Context
 //   - id int
+//   - version string
 //   - name string
 //   - description string
-//   - price float64
+//   - price domain.Money
 //   - stock int
-func (_e *MockRepository_Expecter) Update(ctx interface{}, id interface{}, name interface{}, description interface{}, price interface{}, stock interface{}) *MockRepository_Update_Call {
-	return &MockRepository_Update_Call{Call: _e.mock.On("Update", ctx, id, name, description, price, stock)}
+func (_e *MockRepository_Expecter) Update(ctx interface{}, id interface{}, version interface{}, name interface{}, description interface{}, price interface{}, stock interface{}) *MockRepository_Update_Call {
+	return &MockRepository_Update_Call{Call: _e.mock.On("Update", ctx, id, version, name, description, price, stock)}
 }
 
-func (_c *MockRepository_Update_Call) Run(run func(ctx context.Context, id int, name string, description string, price float64, stock int)) *MockRepository_Update_Call {
+func (_c *MockRepository_Update_Call) Run(run func(ctx context.Context, id int, version string, name string, description string, price domain.Money, stock int)) *MockRepository_Update_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -373,13 +860,17 @@ func (_c *MockRepository_Update_Call) Run(run func(ctx context.Context, id int,
 		if args[3] != nil {
 			arg3 = args[3].(string)
 		}
-		var arg4 float64
+		var arg4 string
 		if args[4] != nil {
-			arg4 = args[4].(float64)
+			arg4 = args[4].(string)
 		}
-		var arg5 int
+		var arg5 domain.Money
 		if args[5] != nil {
-			arg5 = args[5].(int)
+			arg5 = args[5].(domain.Money)
+		}
+		var arg6 int
+		if args[6] != nil {
+			arg6 = args[6].(int)
 		}
 		run(
 			arg0,
@@ -388,6 +879,7 @@ func (_c *MockRepository_Update_Call) Run(run func(ctx context.Context, id int,
 			arg3,
 			arg4,
 			arg5,
+			arg6,
 		)
 	})
 	return _c
@@ -398,7 +890,7 @@ func (_c *MockRepository_Update_Call) Return(product *domain.Product, err error)
 	return _c
 }
 
-func (_c *MockRepository_Update_Call) RunAndReturn(run func(ctx context.Context, id int, name string, description string, price float64, stock int) (*domain.Product, error)) *MockRepository_Update_Call {
+func (_c *MockRepository_Update_Call) RunAndReturn(run func(ctx context.Context, id int, version string, name string, description string, price domain.Money, stock int) (*domain.Product, error)) *MockRepository_Update_Call {
 	_c.Call.Return(run)
 	return _c
 }
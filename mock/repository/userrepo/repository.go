@@ -112,17 +112,85 @@ func (_c *MockRepository_Create_Call) RunAndReturn(run func(ctx context.Context,
 	return _c
 }
 
+// CreateBulk provides a mock function for the type MockRepository
+func (_mock *MockRepository) CreateBulk(ctx context.Context, users []domain.User) ([]domain.User, error) {
+	ret := _mock.Called(ctx, users)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateBulk")
+	}
+
+	var r0 []domain.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []domain.User) ([]domain.User, error)); ok {
+		return returnFunc(ctx, users)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []domain.User) []domain.User); ok {
+		r0 = returnFunc(ctx, users)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.User)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []domain.User) error); ok {
+		r1 = returnFunc(ctx, users)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_CreateBulk_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateBulk'
+type MockRepository_CreateBulk_Call struct {
+	*mock.Call
+}
+
+// CreateBulk is a helper method to define mock.On call
+//   - ctx context.Context
+//   - users []domain.User
+func (_e *MockRepository_Expecter) CreateBulk(ctx interface{}, users interface{}) *MockRepository_CreateBulk_Call {
+	return &MockRepository_CreateBulk_Call{Call: _e.mock.On("CreateBulk", ctx, users)}
+}
+
+func (_c *MockRepository_CreateBulk_Call) Run(run func(ctx context.Context, users []domain.User)) *MockRepository_CreateBulk_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []domain.User
+		if args[1] != nil {
+			arg1 = args[1].([]domain.User)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_CreateBulk_Call) Return(users1 []domain.User, err error) *MockRepository_CreateBulk_Call {
+	_c.Call.Return(users1, err)
+	return _c
+}
+
+func (_c *MockRepository_CreateBulk_Call) RunAndReturn(run func(ctx context.Context, users []domain.User) ([]domain.User, error)) *MockRepository_CreateBulk_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Delete provides a mock function for the type MockRepository
-func (_mock *MockRepository) Delete(ctx context.Context, id int) error {
-	ret := _mock.Called(ctx, id)
+func (_mock *MockRepository) Delete(ctx context.Context, id int, version string) error {
+	ret := _mock.Called(ctx, id, version)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Delete")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int) error); ok {
-		r0 = returnFunc(ctx, id)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, string) error); ok {
+		r0 = returnFunc(ctx, id, version)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -137,11 +205,12 @@ type MockRepository_Delete_Call struct {
 // Delete is a helper method to define mock.On call
 //   - ctx context.Context
 //   - id int
-func (_e *MockRepository_Expecter) Delete(ctx interface{}, id interface{}) *MockRepository_Delete_Call {
-	return &MockRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+//   - version string
+func (_e *MockRepository_Expecter) Delete(ctx interface{}, id interface{}, version interface{}) *MockRepository_Delete_Call {
+	return &MockRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, id, version)}
 }
 
-func (_c *MockRepository_Delete_Call) Run(run func(ctx context.Context, id int)) *MockRepository_Delete_Call {
+func (_c *MockRepository_Delete_Call) Run(run func(ctx context.Context, id int, version string)) *MockRepository_Delete_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -151,9 +220,14 @@ func (_c *MockRepository_Delete_Call) Run(run func(ctx context.Context, id int))
 		if args[1] != nil {
 			arg1 = args[1].(int)
 		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
@@ -164,33 +238,33 @@ func (_c *MockRepository_Delete_Call) Return(err error) *MockRepository_Delete_C
 	return _c
 }
 
-func (_c *MockRepository_Delete_Call) RunAndReturn(run func(ctx context.Context, id int) error) *MockRepository_Delete_Call {
+func (_c *MockRepository_Delete_Call) RunAndReturn(run func(ctx context.Context, id int, version string) error) *MockRepository_Delete_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // GetAll provides a mock function for the type MockRepository
-func (_mock *MockRepository) GetAll(ctx context.Context) ([]domain.User, error) {
-	ret := _mock.Called(ctx)
+func (_mock *MockRepository) GetAll(ctx context.Context, list domain.ListQuery, page domain.PageRequest) (*domain.Page[domain.User], error) {
+	ret := _mock.Called(ctx, list, page)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetAll")
 	}
 
-	var r0 []domain.User
+	var r0 *domain.Page[domain.User]
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]domain.User, error)); ok {
-		return returnFunc(ctx)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.ListQuery, domain.PageRequest) (*domain.Page[domain.User], error)); ok {
+		return returnFunc(ctx, list, page)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context) []domain.User); ok {
-		r0 = returnFunc(ctx)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.ListQuery, domain.PageRequest) *domain.Page[domain.User]); ok {
+		r0 = returnFunc(ctx, list, page)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]domain.User)
+			r0 = ret.Get(0).(*domain.Page[domain.User])
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = returnFunc(ctx)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.ListQuery, domain.PageRequest) error); ok {
+		r1 = returnFunc(ctx, list, page)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -204,29 +278,41 @@ type MockRepository_GetAll_Call struct {
 
 // GetAll is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *MockRepository_Expecter) GetAll(ctx interface{}) *MockRepository_GetAll_Call {
-	return &MockRepository_GetAll_Call{Call: _e.mock.On("GetAll", ctx)}
+//   - list domain.ListQuery
+//   - page domain.PageRequest
+func (_e *MockRepository_Expecter) GetAll(ctx interface{}, list interface{}, page interface{}) *MockRepository_GetAll_Call {
+	return &MockRepository_GetAll_Call{Call: _e.mock.On("GetAll", ctx, list, page)}
 }
 
-func (_c *MockRepository_GetAll_Call) Run(run func(ctx context.Context)) *MockRepository_GetAll_Call {
+func (_c *MockRepository_GetAll_Call) Run(run func(ctx context.Context, list domain.ListQuery, page domain.PageRequest)) *MockRepository_GetAll_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
+		var arg1 domain.ListQuery
+		if args[1] != nil {
+			arg1 = args[1].(domain.ListQuery)
+		}
+		var arg2 domain.PageRequest
+		if args[2] != nil {
+			arg2 = args[2].(domain.PageRequest)
+		}
 		run(
 			arg0,
+			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockRepository_GetAll_Call) Return(users []domain.User, err error) *MockRepository_GetAll_Call {
-	_c.Call.Return(users, err)
+func (_c *MockRepository_GetAll_Call) Return(page1 *domain.Page[domain.User], err error) *MockRepository_GetAll_Call {
+	_c.Call.Return(page1, err)
 	return _c
 }
 
-func (_c *MockRepository_GetAll_Call) RunAndReturn(run func(ctx context.Context) ([]domain.User, error)) *MockRepository_GetAll_Call {
+func (_c *MockRepository_GetAll_Call) RunAndReturn(run func(ctx context.Context, list domain.ListQuery, page domain.PageRequest) (*domain.Page[domain.User], error)) *MockRepository_GetAll_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -299,9 +385,203 @@ func (_c *MockRepository_GetByID_Call) RunAndReturn(run func(ctx context.Context
 	return _c
 }
 
+// Purge provides a mock function for the type MockRepository
+func (_mock *MockRepository) Purge(ctx context.Context, id int) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Purge")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRepository_Purge_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Purge'
+type MockRepository_Purge_Call struct {
+	*mock.Call
+}
+
+// Purge is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+func (_e *MockRepository_Expecter) Purge(ctx interface{}, id interface{}) *MockRepository_Purge_Call {
+	return &MockRepository_Purge_Call{Call: _e.mock.On("Purge", ctx, id)}
+}
+
+func (_c *MockRepository_Purge_Call) Run(run func(ctx context.Context, id int)) *MockRepository_Purge_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_Purge_Call) Return(err error) *MockRepository_Purge_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRepository_Purge_Call) RunAndReturn(run func(ctx context.Context, id int) error) *MockRepository_Purge_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Restore provides a mock function for the type MockRepository
+func (_mock *MockRepository) Restore(ctx context.Context, id int) (*domain.User, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Restore")
+	}
+
+	var r0 *domain.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) (*domain.User, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) *domain.User); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.User)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_Restore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Restore'
+type MockRepository_Restore_Call struct {
+	*mock.Call
+}
+
+// Restore is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+func (_e *MockRepository_Expecter) Restore(ctx interface{}, id interface{}) *MockRepository_Restore_Call {
+	return &MockRepository_Restore_Call{Call: _e.mock.On("Restore", ctx, id)}
+}
+
+func (_c *MockRepository_Restore_Call) Run(run func(ctx context.Context, id int)) *MockRepository_Restore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_Restore_Call) Return(user *domain.User, err error) *MockRepository_Restore_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *MockRepository_Restore_Call) RunAndReturn(run func(ctx context.Context, id int) (*domain.User, error)) *MockRepository_Restore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Stream provides a mock function for the type MockRepository
+func (_mock *MockRepository) Stream(ctx context.Context, list domain.ListQuery, batchSize int, fn func([]domain.User) error) error {
+	ret := _mock.Called(ctx, list, batchSize, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Stream")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.ListQuery, int, func([]domain.User) error) error); ok {
+		r0 = returnFunc(ctx, list, batchSize, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRepository_Stream_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Stream'
+type MockRepository_Stream_Call struct {
+	*mock.Call
+}
+
+// Stream is a helper method to define mock.On call
+//   - ctx context.Context
+//   - list domain.ListQuery
+//   - batchSize int
+//   - fn func([]domain.User) error
+func (_e *MockRepository_Expecter) Stream(ctx interface{}, list interface{}, batchSize interface{}, fn interface{}) *MockRepository_Stream_Call {
+	return &MockRepository_Stream_Call{Call: _e.mock.On("Stream", ctx, list, batchSize, fn)}
+}
+
+func (_c *MockRepository_Stream_Call) Run(run func(ctx context.Context, list domain.ListQuery, batchSize int, fn func([]domain.User) error)) *MockRepository_Stream_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.ListQuery
+		if args[1] != nil {
+			arg1 = args[1].(domain.ListQuery)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		var arg3 func([]domain.User) error
+		if args[3] != nil {
+			arg3 = args[3].(func([]domain.User) error)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_Stream_Call) Return(err error) *MockRepository_Stream_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRepository_Stream_Call) RunAndReturn(run func(ctx context.Context, list domain.ListQuery, batchSize int, fn func([]domain.User) error) error) *MockRepository_Stream_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Update provides a mock function for the type MockRepository
-func (_mock *MockRepository) Update(ctx context.Context, id int, name string, email string) (*domain.User, error) {
-	ret := _mock.Called(ctx, id, name, email)
+func (_mock *MockRepository) Update(ctx context.Context, id int, version string, name string, email string) (*domain.User, error) {
+	ret := _mock.Called(ctx, id, version, name, email)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Update")
@@ -309,18 +589,18 @@ func (_mock *MockRepository) Update(ctx context.Context, id int, name string, em
 
 	var r0 *domain.User
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int, string, string) (*domain.User, error)); ok {
-		return returnFunc(ctx, id, name, email)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, string, string, string) (*domain.User, error)); ok {
+		return returnFunc(ctx, id, version, name, email)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int, string, string) *domain.User); ok {
-		r0 = returnFunc(ctx, id, name, email)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, string, string, string) *domain.User); ok {
+		r0 = returnFunc(ctx, id, version, name, email)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*domain.User)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, int, string, string) error); ok {
-		r1 = returnFunc(ctx, id, name, email)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int, string, string, string) error); ok {
+		r1 = returnFunc(ctx, id, version, name, email)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -335,13 +615,14 @@ type MockRepository_Update_Call struct {
 // Update is a helper method to define mock.On call
 //   - ctx context.Context
 //   - id int
+//   - version string
 //   - name string
 //   - email string
-func (_e *MockRepository_Expecter) Update(ctx interface{}, id interface{}, name interface{}, email interface{}) *MockRepository_Update_Call {
-	return &MockRepository_Update_Call{Call: _e.mock.On("Update", ctx, id, name, email)}
+func (_e *MockRepository_Expecter) Update(ctx interface{}, id interface{}, version interface{}, name interface{}, email interface{}) *MockRepository_Update_Call {
+	return &MockRepository_Update_Call{Call: _e.mock.On("Update", ctx, id, version, name, email)}
 }
 
-func (_c *MockRepository_Update_Call) Run(run func(ctx context.Context, id int, name string, email string)) *MockRepository_Update_Call {
+func (_c *MockRepository_Update_Call) Run(run func(ctx context.Context, id int, version string, name string, email string)) *MockRepository_Update_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -359,11 +640,16 @@ func (_c *MockRepository_Update_Call) Run(run func(ctx context.Context, id int,
 		if args[3] != nil {
 			arg3 = args[3].(string)
 		}
+		var arg4 string
+		if args[4] != nil {
+			arg4 = args[4].(string)
+		}
 		run(
 			arg0,
 			arg1,
 			arg2,
 			arg3,
+			arg4,
 		)
 	})
 	return _c
@@ -374,7 +660,7 @@ func (_c *MockRepository_Update_Call) Return(user *domain.User, err error) *Mock
 	return _c
 }
 
-func (_c *MockRepository_Update_Call) RunAndReturn(run func(ctx context.Context, id int, name string, email string) (*domain.User, error)) *MockRepository_Update_Call {
+func (_c *MockRepository_Update_Call) RunAndReturn(run func(ctx context.Context, id int, version string, name string, email string) (*domain.User, error)) *MockRepository_Update_Call {
 	_c.Call.Return(run)
 	return _c
 }
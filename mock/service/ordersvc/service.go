@@ -7,6 +7,7 @@ package mockordersvc
 import (
 	"context"
 	"gin-swagger-api/internal/domain"
+	"gin-swagger-api/internal/port/service/ordersvc"
 
 	mock "github.com/stretchr/testify/mock"
 )
@@ -38,9 +39,77 @@ func (_m *MockService) EXPECT() *MockService_Expecter {
 	return &MockService_Expecter{mock: &_m.Mock}
 }
 
+// BatchOrders provides a mock function for the type MockService
+func (_mock *MockService) BatchOrders(ctx context.Context, batch domain.Batch[domain.Order]) (*domain.BatchResults[domain.Order], error) {
+	ret := _mock.Called(ctx, batch)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BatchOrders")
+	}
+
+	var r0 *domain.BatchResults[domain.Order]
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Batch[domain.Order]) (*domain.BatchResults[domain.Order], error)); ok {
+		return returnFunc(ctx, batch)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Batch[domain.Order]) *domain.BatchResults[domain.Order]); ok {
+		r0 = returnFunc(ctx, batch)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.BatchResults[domain.Order])
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Batch[domain.Order]) error); ok {
+		r1 = returnFunc(ctx, batch)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockService_BatchOrders_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BatchOrders'
+type MockService_BatchOrders_Call struct {
+	*mock.Call
+}
+
+// BatchOrders is a helper method to define mock.On call
+//   - ctx context.Context
+//   - batch domain.Batch[domain.Order]
+func (_e *MockService_Expecter) BatchOrders(ctx interface{}, batch interface{}) *MockService_BatchOrders_Call {
+	return &MockService_BatchOrders_Call{Call: _e.mock.On("BatchOrders", ctx, batch)}
+}
+
+func (_c *MockService_BatchOrders_Call) Run(run func(ctx context.Context, batch domain.Batch[domain.Order])) *MockService_BatchOrders_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Batch[domain.Order]
+		if args[1] != nil {
+			arg1 = args[1].(domain.Batch[domain.Order])
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockService_BatchOrders_Call) Return(batchResults *domain.BatchResults[domain.Order], err error) *MockService_BatchOrders_Call {
+	_c.Call.Return(batchResults, err)
+	return _c
+}
+
+func (_c *MockService_BatchOrders_Call) RunAndReturn(run func(ctx context.Context, batch domain.Batch[domain.Order]) (*domain.BatchResults[domain.Order], error)) *MockService_BatchOrders_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateOrder provides a mock function for the type MockService
-func (_mock *MockService) CreateOrder(ctx context.Context, userID int, productID int, quantity int, totalPrice float64, status string) (*domain.Order, error) {
-	ret := _mock.Called(ctx, userID, productID, quantity, totalPrice, status)
+func (_mock *MockService) CreateOrder(ctx context.Context, input ordersvc.CreateOrderInput) (*domain.Order, error) {
+	ret := _mock.Called(ctx, input)
 
 	if len(ret) == 0 {
 		panic("no return value specified for CreateOrder")
@@ -48,18 +117,18 @@ func (_mock *MockService) CreateOrder(ctx context.Context, userID int, productID
 
 	var r0 *domain.Order
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int, int, int, float64, string) (*domain.Order, error)); ok {
-		return returnFunc(ctx, userID, productID, quantity, totalPrice, status)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, ordersvc.CreateOrderInput) (*domain.Order, error)); ok {
+		return returnFunc(ctx, input)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int, int, int, float64, string) *domain.Order); ok {
-		r0 = returnFunc(ctx, userID, productID, quantity, totalPrice, status)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, ordersvc.CreateOrderInput) *domain.Order); ok {
+		r0 = returnFunc(ctx, input)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*domain.Order)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, int, int, int, float64, string) error); ok {
-		r1 = returnFunc(ctx, userID, productID, quantity, totalPrice, status)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, ordersvc.CreateOrderInput) error); ok {
+		r1 = returnFunc(ctx, input)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -73,48 +142,24 @@ type MockService_CreateOrder_Call struct {
 
 // CreateOrder is a helper method to define mock.On call
 //   - ctx context.Context
-//   - userID int
-//   - productID int
-//   - quantity int
-//   - totalPrice float64
-//   - status string
-func (_e *MockService_Expecter) CreateOrder(ctx interface{}, userID interface{}, productID interface{}, quantity interface{}, totalPrice interface{}, status interface{}) *MockService_CreateOrder_Call {
-	return &MockService_CreateOrder_Call{Call: _e.mock.On("CreateOrder", ctx, userID, productID, quantity, totalPrice, status)}
+//   - input ordersvc.CreateOrderInput
+func (_e *MockService_Expecter) CreateOrder(ctx interface{}, input interface{}) *MockService_CreateOrder_Call {
+	return &MockService_CreateOrder_Call{Call: _e.mock.On("CreateOrder", ctx, input)}
 }
 
-func (_c *MockService_CreateOrder_Call) Run(run func(ctx context.Context, userID int, productID int, quantity int, totalPrice float64, status string)) *MockService_CreateOrder_Call {
+func (_c *MockService_CreateOrder_Call) Run(run func(ctx context.Context, input ordersvc.CreateOrderInput)) *MockService_CreateOrder_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 int
+		var arg1 ordersvc.CreateOrderInput
 		if args[1] != nil {
-			arg1 = args[1].(int)
-		}
-		var arg2 int
-		if args[2] != nil {
-			arg2 = args[2].(int)
-		}
-		var arg3 int
-		if args[3] != nil {
-			arg3 = args[3].(int)
-		}
-		var arg4 float64
-		if args[4] != nil {
-			arg4 = args[4].(float64)
-		}
-		var arg5 string
-		if args[5] != nil {
-			arg5 = args[5].(string)
+			arg1 = args[1].(ordersvc.CreateOrderInput)
 		}
 		run(
 			arg0,
 			arg1,
-			arg2,
-			arg3,
-			arg4,
-			arg5,
 		)
 	})
 	return _c
@@ -125,22 +170,22 @@ func (_c *MockService_CreateOrder_Call) Return(order *domain.Order, err error) *
 	return _c
 }
 
-func (_c *MockService_CreateOrder_Call) RunAndReturn(run func(ctx context.Context, userID int, productID int, quantity int, totalPrice float64, status string) (*domain.Order, error)) *MockService_CreateOrder_Call {
+func (_c *MockService_CreateOrder_Call) RunAndReturn(run func(ctx context.Context, input ordersvc.CreateOrderInput) (*domain.Order, error)) *MockService_CreateOrder_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // DeleteOrder provides a mock function for the type MockService
-func (_mock *MockService) DeleteOrder(ctx context.Context, id string) error {
-	ret := _mock.Called(ctx, id)
+func (_mock *MockService) DeleteOrder(ctx context.Context, id string, version string) error {
+	ret := _mock.Called(ctx, id, version)
 
 	if len(ret) == 0 {
 		panic("no return value specified for DeleteOrder")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
-		r0 = returnFunc(ctx, id)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, id, version)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -155,11 +200,12 @@ type MockService_DeleteOrder_Call struct {
 // DeleteOrder is a helper method to define mock.On call
 //   - ctx context.Context
 //   - id string
-func (_e *MockService_Expecter) DeleteOrder(ctx interface{}, id interface{}) *MockService_DeleteOrder_Call {
-	return &MockService_DeleteOrder_Call{Call: _e.mock.On("DeleteOrder", ctx, id)}
+//   - version string
+func (_e *MockService_Expecter) DeleteOrder(ctx interface{}, id interface{}, version interface{}) *MockService_DeleteOrder_Call {
+	return &MockService_DeleteOrder_Call{Call: _e.mock.On("DeleteOrder", ctx, id, version)}
 }
 
-func (_c *MockService_DeleteOrder_Call) Run(run func(ctx context.Context, id string)) *MockService_DeleteOrder_Call {
+func (_c *MockService_DeleteOrder_Call) Run(run func(ctx context.Context, id string, version string)) *MockService_DeleteOrder_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -169,9 +215,14 @@ func (_c *MockService_DeleteOrder_Call) Run(run func(ctx context.Context, id str
 		if args[1] != nil {
 			arg1 = args[1].(string)
 		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
@@ -182,14 +233,77 @@ func (_c *MockService_DeleteOrder_Call) Return(err error) *MockService_DeleteOrd
 	return _c
 }
 
-func (_c *MockService_DeleteOrder_Call) RunAndReturn(run func(ctx context.Context, id string) error) *MockService_DeleteOrder_Call {
+func (_c *MockService_DeleteOrder_Call) RunAndReturn(run func(ctx context.Context, id string, version string) error) *MockService_DeleteOrder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExportOrders provides a mock function for the type MockService
+func (_mock *MockService) ExportOrders(ctx context.Context, list domain.ListQuery, fn func([]domain.Order) error) error {
+	ret := _mock.Called(ctx, list, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportOrders")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.ListQuery, func([]domain.Order) error) error); ok {
+		r0 = returnFunc(ctx, list, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockService_ExportOrders_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportOrders'
+type MockService_ExportOrders_Call struct {
+	*mock.Call
+}
+
+// ExportOrders is a helper method to define mock.On call
+//   - ctx context.Context
+//   - list domain.ListQuery
+//   - fn func([]domain.Order) error
+func (_e *MockService_Expecter) ExportOrders(ctx interface{}, list interface{}, fn interface{}) *MockService_ExportOrders_Call {
+	return &MockService_ExportOrders_Call{Call: _e.mock.On("ExportOrders", ctx, list, fn)}
+}
+
+func (_c *MockService_ExportOrders_Call) Run(run func(ctx context.Context, list domain.ListQuery, fn func([]domain.Order) error)) *MockService_ExportOrders_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.ListQuery
+		if args[1] != nil {
+			arg1 = args[1].(domain.ListQuery)
+		}
+		var arg2 func([]domain.Order) error
+		if args[2] != nil {
+			arg2 = args[2].(func([]domain.Order) error)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockService_ExportOrders_Call) Return(err error) *MockService_ExportOrders_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockService_ExportOrders_Call) RunAndReturn(run func(ctx context.Context, list domain.ListQuery, fn func([]domain.Order) error) error) *MockService_ExportOrders_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // GetOrder provides a mock function for the type MockService
-func (_mock *MockService) GetOrder(ctx context.Context, id string) (*domain.Order, error) {
-	ret := _mock.Called(ctx, id)
+func (_mock *MockService) GetOrder(ctx context.Context, id string, expand domain.OrderExpand) (*domain.Order, error) {
+	ret := _mock.Called(ctx, id, expand)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetOrder")
@@ -197,18 +311,18 @@ func (_mock *MockService) GetOrder(ctx context.Context, id string) (*domain.Orde
 
 	var r0 *domain.Order
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.Order, error)); ok {
-		return returnFunc(ctx, id)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, domain.OrderExpand) (*domain.Order, error)); ok {
+		return returnFunc(ctx, id, expand)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.Order); ok {
-		r0 = returnFunc(ctx, id)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, domain.OrderExpand) *domain.Order); ok {
+		r0 = returnFunc(ctx, id, expand)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*domain.Order)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = returnFunc(ctx, id)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, domain.OrderExpand) error); ok {
+		r1 = returnFunc(ctx, id, expand)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -223,11 +337,12 @@ type MockService_GetOrder_Call struct {
 // GetOrder is a helper method to define mock.On call
 //   - ctx context.Context
 //   - id string
-func (_e *MockService_Expecter) GetOrder(ctx interface{}, id interface{}) *MockService_GetOrder_Call {
-	return &MockService_GetOrder_Call{Call: _e.mock.On("GetOrder", ctx, id)}
+//   - expand domain.OrderExpand
+func (_e *MockService_Expecter) GetOrder(ctx interface{}, id interface{}, expand interface{}) *MockService_GetOrder_Call {
+	return &MockService_GetOrder_Call{Call: _e.mock.On("GetOrder", ctx, id, expand)}
 }
 
-func (_c *MockService_GetOrder_Call) Run(run func(ctx context.Context, id string)) *MockService_GetOrder_Call {
+func (_c *MockService_GetOrder_Call) Run(run func(ctx context.Context, id string, expand domain.OrderExpand)) *MockService_GetOrder_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -237,9 +352,14 @@ func (_c *MockService_GetOrder_Call) Run(run func(ctx context.Context, id string
 		if args[1] != nil {
 			arg1 = args[1].(string)
 		}
+		var arg2 domain.OrderExpand
+		if args[2] != nil {
+			arg2 = args[2].(domain.OrderExpand)
+		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
@@ -250,33 +370,101 @@ func (_c *MockService_GetOrder_Call) Return(order *domain.Order, err error) *Moc
 	return _c
 }
 
-func (_c *MockService_GetOrder_Call) RunAndReturn(run func(ctx context.Context, id string) (*domain.Order, error)) *MockService_GetOrder_Call {
+func (_c *MockService_GetOrder_Call) RunAndReturn(run func(ctx context.Context, id string, expand domain.OrderExpand) (*domain.Order, error)) *MockService_GetOrder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrderHistory provides a mock function for the type MockService
+func (_mock *MockService) GetOrderHistory(ctx context.Context, id string) ([]domain.OrderEvent, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrderHistory")
+	}
+
+	var r0 []domain.OrderEvent
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]domain.OrderEvent, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []domain.OrderEvent); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.OrderEvent)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockService_GetOrderHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrderHistory'
+type MockService_GetOrderHistory_Call struct {
+	*mock.Call
+}
+
+// GetOrderHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockService_Expecter) GetOrderHistory(ctx interface{}, id interface{}) *MockService_GetOrderHistory_Call {
+	return &MockService_GetOrderHistory_Call{Call: _e.mock.On("GetOrderHistory", ctx, id)}
+}
+
+func (_c *MockService_GetOrderHistory_Call) Run(run func(ctx context.Context, id string)) *MockService_GetOrderHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockService_GetOrderHistory_Call) Return(orderEvents []domain.OrderEvent, err error) *MockService_GetOrderHistory_Call {
+	_c.Call.Return(orderEvents, err)
+	return _c
+}
+
+func (_c *MockService_GetOrderHistory_Call) RunAndReturn(run func(ctx context.Context, id string) ([]domain.OrderEvent, error)) *MockService_GetOrderHistory_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // GetOrders provides a mock function for the type MockService
-func (_mock *MockService) GetOrders(ctx context.Context) ([]domain.Order, error) {
-	ret := _mock.Called(ctx)
+func (_mock *MockService) GetOrders(ctx context.Context, list domain.ListQuery, page domain.PageRequest, expand domain.OrderExpand) (*domain.Page[domain.Order], error) {
+	ret := _mock.Called(ctx, list, page, expand)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetOrders")
 	}
 
-	var r0 []domain.Order
+	var r0 *domain.Page[domain.Order]
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]domain.Order, error)); ok {
-		return returnFunc(ctx)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.ListQuery, domain.PageRequest, domain.OrderExpand) (*domain.Page[domain.Order], error)); ok {
+		return returnFunc(ctx, list, page, expand)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context) []domain.Order); ok {
-		r0 = returnFunc(ctx)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.ListQuery, domain.PageRequest, domain.OrderExpand) *domain.Page[domain.Order]); ok {
+		r0 = returnFunc(ctx, list, page, expand)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]domain.Order)
+			r0 = ret.Get(0).(*domain.Page[domain.Order])
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = returnFunc(ctx)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.ListQuery, domain.PageRequest, domain.OrderExpand) error); ok {
+		r1 = returnFunc(ctx, list, page, expand)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -290,36 +478,345 @@ type MockService_GetOrders_Call struct {
 
 // GetOrders is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *MockService_Expecter) GetOrders(ctx interface{}) *MockService_GetOrders_Call {
-	return &MockService_GetOrders_Call{Call: _e.mock.On("GetOrders", ctx)}
+//   - list domain.ListQuery
+//   - page domain.PageRequest
+//   - expand domain.OrderExpand
+func (_e *MockService_Expecter) GetOrders(ctx interface{}, list interface{}, page interface{}, expand interface{}) *MockService_GetOrders_Call {
+	return &MockService_GetOrders_Call{Call: _e.mock.On("GetOrders", ctx, list, page, expand)}
+}
+
+func (_c *MockService_GetOrders_Call) Run(run func(ctx context.Context, list domain.ListQuery, page domain.PageRequest, expand domain.OrderExpand)) *MockService_GetOrders_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.ListQuery
+		if args[1] != nil {
+			arg1 = args[1].(domain.ListQuery)
+		}
+		var arg2 domain.PageRequest
+		if args[2] != nil {
+			arg2 = args[2].(domain.PageRequest)
+		}
+		var arg3 domain.OrderExpand
+		if args[3] != nil {
+			arg3 = args[3].(domain.OrderExpand)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockService_GetOrders_Call) Return(page1 *domain.Page[domain.Order], err error) *MockService_GetOrders_Call {
+	_c.Call.Return(page1, err)
+	return _c
+}
+
+func (_c *MockService_GetOrders_Call) RunAndReturn(run func(ctx context.Context, list domain.ListQuery, page domain.PageRequest, expand domain.OrderExpand) (*domain.Page[domain.Order], error)) *MockService_GetOrders_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PatchOrder provides a mock function for the type MockService
+func (_mock *MockService) PatchOrder(ctx context.Context, id string, version string, patch domain.OrderPatch) (*domain.Order, error) {
+	ret := _mock.Called(ctx, id, version, patch)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PatchOrder")
+	}
+
+	var r0 *domain.Order
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, domain.OrderPatch) (*domain.Order, error)); ok {
+		return returnFunc(ctx, id, version, patch)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, domain.OrderPatch) *domain.Order); ok {
+		r0 = returnFunc(ctx, id, version, patch)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Order)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, domain.OrderPatch) error); ok {
+		r1 = returnFunc(ctx, id, version, patch)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockService_PatchOrder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PatchOrder'
+type MockService_PatchOrder_Call struct {
+	*mock.Call
+}
+
+// PatchOrder is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - version string
+//   - patch domain.OrderPatch
+func (_e *MockService_Expecter) PatchOrder(ctx interface{}, id interface{}, version interface{}, patch interface{}) *MockService_PatchOrder_Call {
+	return &MockService_PatchOrder_Call{Call: _e.mock.On("PatchOrder", ctx, id, version, patch)}
+}
+
+func (_c *MockService_PatchOrder_Call) Run(run func(ctx context.Context, id string, version string, patch domain.OrderPatch)) *MockService_PatchOrder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 domain.OrderPatch
+		if args[3] != nil {
+			arg3 = args[3].(domain.OrderPatch)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockService_PatchOrder_Call) Return(order *domain.Order, err error) *MockService_PatchOrder_Call {
+	_c.Call.Return(order, err)
+	return _c
+}
+
+func (_c *MockService_PatchOrder_Call) RunAndReturn(run func(ctx context.Context, id string, version string, patch domain.OrderPatch) (*domain.Order, error)) *MockService_PatchOrder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PurgeOrder provides a mock function for the type MockService
+func (_mock *MockService) PurgeOrder(ctx context.Context, id string) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PurgeOrder")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockService_PurgeOrder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PurgeOrder'
+type MockService_PurgeOrder_Call struct {
+	*mock.Call
+}
+
+// PurgeOrder is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockService_Expecter) PurgeOrder(ctx interface{}, id interface{}) *MockService_PurgeOrder_Call {
+	return &MockService_PurgeOrder_Call{Call: _e.mock.On("PurgeOrder", ctx, id)}
+}
+
+func (_c *MockService_PurgeOrder_Call) Run(run func(ctx context.Context, id string)) *MockService_PurgeOrder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockService_PurgeOrder_Call) Return(err error) *MockService_PurgeOrder_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockService_PurgeOrder_Call) RunAndReturn(run func(ctx context.Context, id string) error) *MockService_PurgeOrder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RestoreOrder provides a mock function for the type MockService
+func (_mock *MockService) RestoreOrder(ctx context.Context, id string) (*domain.Order, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreOrder")
+	}
+
+	var r0 *domain.Order
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.Order, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.Order); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Order)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockService_RestoreOrder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreOrder'
+type MockService_RestoreOrder_Call struct {
+	*mock.Call
+}
+
+// RestoreOrder is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockService_Expecter) RestoreOrder(ctx interface{}, id interface{}) *MockService_RestoreOrder_Call {
+	return &MockService_RestoreOrder_Call{Call: _e.mock.On("RestoreOrder", ctx, id)}
 }
 
-func (_c *MockService_GetOrders_Call) Run(run func(ctx context.Context)) *MockService_GetOrders_Call {
+func (_c *MockService_RestoreOrder_Call) Run(run func(ctx context.Context, id string)) *MockService_RestoreOrder_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *MockService_GetOrders_Call) Return(orders []domain.Order, err error) *MockService_GetOrders_Call {
-	_c.Call.Return(orders, err)
+func (_c *MockService_RestoreOrder_Call) Return(order *domain.Order, err error) *MockService_RestoreOrder_Call {
+	_c.Call.Return(order, err)
 	return _c
 }
 
-func (_c *MockService_GetOrders_Call) RunAndReturn(run func(ctx context.Context) ([]domain.Order, error)) *MockService_GetOrders_Call {
+func (_c *MockService_RestoreOrder_Call) RunAndReturn(run func(ctx context.Context, id string) (*domain.Order, error)) *MockService_RestoreOrder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TransitionOrder provides a mock function for the type MockService
+func (_mock *MockService) TransitionOrder(ctx context.Context, id string, version string, status string, reason string) (*domain.Order, error) {
+	ret := _mock.Called(ctx, id, version, status, reason)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TransitionOrder")
+	}
+
+	var r0 *domain.Order
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string, string) (*domain.Order, error)); ok {
+		return returnFunc(ctx, id, version, status, reason)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string, string) *domain.Order); ok {
+		r0 = returnFunc(ctx, id, version, status, reason)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Order)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, string, string) error); ok {
+		r1 = returnFunc(ctx, id, version, status, reason)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockService_TransitionOrder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TransitionOrder'
+type MockService_TransitionOrder_Call struct {
+	*mock.Call
+}
+
+// TransitionOrder is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - version string
+//   - status string
+//   - reason string
+func (_e *MockService_Expecter) TransitionOrder(ctx interface{}, id interface{}, version interface{}, status interface{}, reason interface{}) *MockService_TransitionOrder_Call {
+	return &MockService_TransitionOrder_Call{Call: _e.mock.On("TransitionOrder", ctx, id, version, status, reason)}
+}
+
+func (_c *MockService_TransitionOrder_Call) Run(run func(ctx context.Context, id string, version string, status string, reason string)) *MockService_TransitionOrder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		var arg4 string
+		if args[4] != nil {
+			arg4 = args[4].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *MockService_TransitionOrder_Call) Return(order *domain.Order, err error) *MockService_TransitionOrder_Call {
+	_c.Call.Return(order, err)
+	return _c
+}
+
+func (_c *MockService_TransitionOrder_Call) RunAndReturn(run func(ctx context.Context, id string, version string, status string, reason string) (*domain.Order, error)) *MockService_TransitionOrder_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // UpdateOrder provides a mock function for the type MockService
-func (_mock *MockService) UpdateOrder(ctx context.Context, id string, userID int, productID int, quantity int, totalPrice float64, status string) (*domain.Order, error) {
-	ret := _mock.Called(ctx, id, userID, productID, quantity, totalPrice, status)
+func (_mock *MockService) UpdateOrder(ctx context.Context, id string, version string, items []domain.OrderItem, status string) (*domain.Order, error) {
+	ret := _mock.Called(ctx, id, version, items, status)
 
 	if len(ret) == 0 {
 		panic("no return value specified for UpdateOrder")
@@ -327,18 +824,18 @@ func (_mock *MockService) UpdateOrder(ctx context.Context, id string, userID int
 
 	var r0 *domain.Order
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int, int, int, float64, string) (*domain.Order, error)); ok {
-		return returnFunc(ctx, id, userID, productID, quantity, totalPrice, status)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, []domain.OrderItem, string) (*domain.Order, error)); ok {
+		return returnFunc(ctx, id, version, items, status)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int, int, int, float64, string) *domain.Order); ok {
-		r0 = returnFunc(ctx, id, userID, productID, quantity, totalPrice, status)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, []domain.OrderItem, string) *domain.Order); ok {
+		r0 = returnFunc(ctx, id, version, items, status)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*domain.Order)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string, int, int, int, float64, string) error); ok {
-		r1 = returnFunc(ctx, id, userID, productID, quantity, totalPrice, status)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, []domain.OrderItem, string) error); ok {
+		r1 = returnFunc(ctx, id, version, items, status)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -353,16 +850,14 @@ type MockService_UpdateOrder_Call struct {
 // UpdateOrder is a helper method to define mock.On call
 //   - ctx context.Context
 //   - id string
-//   - userID int
-//   - productID int
-//   - quantity int
-//   - totalPrice float64
+//   - version string
+//   - items []domain.OrderItem
 //   - status string
-func (_e *MockService_Expecter) UpdateOrder(ctx interface{}, id interface{}, userID interface{}, productID interface{}, quantity interface{}, totalPrice interface{}, status interface{}) *MockService_UpdateOrder_Call {
-	return &MockService_UpdateOrder_Call{Call: _e.mock.On("UpdateOrder", ctx, id, userID, productID, quantity, totalPrice, status)}
+func (_e *MockService_Expecter) UpdateOrder(ctx interface{}, id interface{}, version interface{}, items interface{}, status interface{}) *MockService_UpdateOrder_Call {
+	return &MockService_UpdateOrder_Call{Call: _e.mock.On("UpdateOrder", ctx, id, version, items, status)}
 }
 
-func (_c *MockService_UpdateOrder_Call) Run(run func(ctx context.Context, id string, userID int, productID int, quantity int, totalPrice float64, status string)) *MockService_UpdateOrder_Call {
+func (_c *MockService_UpdateOrder_Call) Run(run func(ctx context.Context, id string, version string, items []domain.OrderItem, status string)) *MockService_UpdateOrder_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -372,25 +867,17 @@ func (_c *MockService_UpdateOrder_Call) Run(run func(ctx context.Context, id str
 		if args[1] != nil {
 			arg1 = args[1].(string)
 		}
-		var arg2 int
+		var arg2 string
 		if args[2] != nil {
-			arg2 = args[2].(int)
+			arg2 = args[2].(string)
 		}
-		var arg3 int
+		var arg3 []domain.OrderItem
 		if args[3] != nil {
-			arg3 = args[3].(int)
+			arg3 = args[3].([]domain.OrderItem)
 		}
-		var arg4 int
+		var arg4 string
 		if args[4] != nil {
-			arg4 = args[4].(int)
-		}
-		var arg5 float64
-		if args[5] != nil {
-			arg5 = args[5].(float64)
-		}
-		var arg6 string
-		if args[6] != nil {
-			arg6 = args[6].(string)
+			arg4 = args[4].(string)
 		}
 		run(
 			arg0,
@@ -398,8 +885,6 @@ func (_c *MockService_UpdateOrder_Call) Run(run func(ctx context.Context, id str
 			arg2,
 			arg3,
 			arg4,
-			arg5,
-			arg6,
 		)
 	})
 	return _c
@@ -410,7 +895,7 @@ func (_c *MockService_UpdateOrder_Call) Return(order *domain.Order, err error) *
 	return _c
 }
 
-func (_c *MockService_UpdateOrder_Call) RunAndReturn(run func(ctx context.Context, id string, userID int, productID int, quantity int, totalPrice float64, status string) (*domain.Order, error)) *MockService_UpdateOrder_Call {
+func (_c *MockService_UpdateOrder_Call) RunAndReturn(run func(ctx context.Context, id string, version string, items []domain.OrderItem, status string) (*domain.Order, error)) *MockService_UpdateOrder_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -38,8 +38,76 @@ func (_m *MockService) EXPECT() *MockService_Expecter {
 	return &MockService_Expecter{mock: &_m.Mock}
 }
 
+// BatchProducts provides a mock function for the type MockService
+func (_mock *MockService) BatchProducts(ctx context.Context, batch domain.Batch[domain.Product]) (*domain.BatchResults[domain.Product], error) {
+	ret := _mock.Called(ctx, batch)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BatchProducts")
+	}
+
+	var r0 *domain.BatchResults[domain.Product]
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Batch[domain.Product]) (*domain.BatchResults[domain.Product], error)); ok {
+		return returnFunc(ctx, batch)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Batch[domain.Product]) *domain.BatchResults[domain.Product]); ok {
+		r0 = returnFunc(ctx, batch)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.BatchResults[domain.Product])
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Batch[domain.Product]) error); ok {
+		r1 = returnFunc(ctx, batch)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockService_BatchProducts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BatchProducts'
+type MockService_BatchProducts_Call struct {
+	*mock.Call
+}
+
+// BatchProducts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - batch domain.Batch[domain.Product]
+func (_e *MockService_Expecter) BatchProducts(ctx interface{}, batch interface{}) *MockService_BatchProducts_Call {
+	return &MockService_BatchProducts_Call{Call: _e.mock.On("BatchProducts", ctx, batch)}
+}
+
+func (_c *MockService_BatchProducts_Call) Run(run func(ctx context.Context, batch domain.Batch[domain.Product])) *MockService_BatchProducts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Batch[domain.Product]
+		if args[1] != nil {
+			arg1 = args[1].(domain.Batch[domain.Product])
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockService_BatchProducts_Call) Return(batchResults *domain.BatchResults[domain.Product], err error) *MockService_BatchProducts_Call {
+	_c.Call.Return(batchResults, err)
+	return _c
+}
+
+func (_c *MockService_BatchProducts_Call) RunAndReturn(run func(ctx context.Context, batch domain.Batch[domain.Product]) (*domain.BatchResults[domain.Product], error)) *MockService_BatchProducts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateProduct provides a mock function for the type MockService
-func (_mock *MockService) CreateProduct(ctx context.Context, name string, description string, price float64, stock int) (*domain.Product, error) {
+func (_mock *MockService) CreateProduct(ctx context.Context, name string, description string, price domain.Money, stock int) (*domain.Product, error) {
 	ret := _mock.Called(ctx, name, description, price, stock)
 
 	if len(ret) == 0 {
@@ -48,17 +116,17 @@ func (_mock *MockService) CreateProduct(ctx context.Context, name string, descri
 
 	var r0 *domain.Product
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, float64, int) (*domain.Product, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, domain.Money, int) (*domain.Product, error)); ok {
 		return returnFunc(ctx, name, description, price, stock)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, float64, int) *domain.Product); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, domain.Money, int) *domain.Product); ok {
 		r0 = returnFunc(ctx, name, description, price, stock)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*domain.Product)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, float64, int) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, domain.Money, int) error); ok {
 		r1 = returnFunc(ctx, name, description, price, stock)
 	} else {
 		r1 = ret.Error(1)
@@ -75,13 +143,13 @@ type MockService_CreateProduct_Call struct {
 //   - ctx context.Context
 //   - name string
 //   - description string
-//   - price float64
+//   - price domain.Money
 //   - stock int
 func (_e *MockService_Expecter) CreateProduct(ctx interface{}, name interface{}, description interface{}, price interface{}, stock interface{}) *MockService_CreateProduct_Call {
 	return &MockService_CreateProduct_Call{Call: _e.mock.On("CreateProduct", ctx, name, description, price, stock)}
 }
 
-func (_c *MockService_CreateProduct_Call) Run(run func(ctx context.Context, name string, description string, price float64, stock int)) *MockService_CreateProduct_Call {
+func (_c *MockService_CreateProduct_Call) Run(run func(ctx context.Context, name string, description string, price domain.Money, stock int)) *MockService_CreateProduct_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -95,9 +163,9 @@ func (_c *MockService_CreateProduct_Call) Run(run func(ctx context.Context, name
 		if args[2] != nil {
 			arg2 = args[2].(string)
 		}
-		var arg3 float64
+		var arg3 domain.Money
 		if args[3] != nil {
-			arg3 = args[3].(float64)
+			arg3 = args[3].(domain.Money)
 		}
 		var arg4 int
 		if args[4] != nil {
@@ -119,22 +187,22 @@ func (_c *MockService_CreateProduct_Call) Return(product *domain.Product, err er
 	return _c
 }
 
-func (_c *MockService_CreateProduct_Call) RunAndReturn(run func(ctx context.Context, name string, description string, price float64, stock int) (*domain.Product, error)) *MockService_CreateProduct_Call {
+func (_c *MockService_CreateProduct_Call) RunAndReturn(run func(ctx context.Context, name string, description string, price domain.Money, stock int) (*domain.Product, error)) *MockService_CreateProduct_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // DeleteProduct provides a mock function for the type MockService
-func (_mock *MockService) DeleteProduct(ctx context.Context, id string) error {
-	ret := _mock.Called(ctx, id)
+func (_mock *MockService) DeleteProduct(ctx context.Context, id string, version string) error {
+	ret := _mock.Called(ctx, id, version)
 
 	if len(ret) == 0 {
 		panic("no return value specified for DeleteProduct")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
-		r0 = returnFunc(ctx, id)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, id, version)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -149,11 +217,12 @@ type MockService_DeleteProduct_Call struct {
 // DeleteProduct is a helper method to define mock.On call
 //   - ctx context.Context
 //   - id string
-func (_e *MockService_Expecter) DeleteProduct(ctx interface{}, id interface{}) *MockService_DeleteProduct_Call {
-	return &MockService_DeleteProduct_Call{Call: _e.mock.On("DeleteProduct", ctx, id)}
+//   - version string
+func (_e *MockService_Expecter) DeleteProduct(ctx interface{}, id interface{}, version interface{}) *MockService_DeleteProduct_Call {
+	return &MockService_DeleteProduct_Call{Call: _e.mock.On("DeleteProduct", ctx, id, version)}
 }
 
-func (_c *MockService_DeleteProduct_Call) Run(run func(ctx context.Context, id string)) *MockService_DeleteProduct_Call {
+func (_c *MockService_DeleteProduct_Call) Run(run func(ctx context.Context, id string, version string)) *MockService_DeleteProduct_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -163,9 +232,14 @@ func (_c *MockService_DeleteProduct_Call) Run(run func(ctx context.Context, id s
 		if args[1] != nil {
 			arg1 = args[1].(string)
 		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
@@ -176,7 +250,70 @@ func (_c *MockService_DeleteProduct_Call) Return(err error) *MockService_DeleteP
 	return _c
 }
 
-func (_c *MockService_DeleteProduct_Call) RunAndReturn(run func(ctx context.Context, id string) error) *MockService_DeleteProduct_Call {
+func (_c *MockService_DeleteProduct_Call) RunAndReturn(run func(ctx context.Context, id string, version string) error) *MockService_DeleteProduct_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExportProducts provides a mock function for the type MockService
+func (_mock *MockService) ExportProducts(ctx context.Context, list domain.ListQuery, fn func([]domain.Product) error) error {
+	ret := _mock.Called(ctx, list, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportProducts")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.ListQuery, func([]domain.Product) error) error); ok {
+		r0 = returnFunc(ctx, list, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockService_ExportProducts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportProducts'
+type MockService_ExportProducts_Call struct {
+	*mock.Call
+}
+
+// ExportProducts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - list domain.ListQuery
+//   - fn func([]domain.Product) error
+func (_e *MockService_Expecter) ExportProducts(ctx interface{}, list interface{}, fn interface{}) *MockService_ExportProducts_Call {
+	return &MockService_ExportProducts_Call{Call: _e.mock.On("ExportProducts", ctx, list, fn)}
+}
+
+func (_c *MockService_ExportProducts_Call) Run(run func(ctx context.Context, list domain.ListQuery, fn func([]domain.Product) error)) *MockService_ExportProducts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.ListQuery
+		if args[1] != nil {
+			arg1 = args[1].(domain.ListQuery)
+		}
+		var arg2 func([]domain.Product) error
+		if args[2] != nil {
+			arg2 = args[2].(func([]domain.Product) error)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockService_ExportProducts_Call) Return(err error) *MockService_ExportProducts_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockService_ExportProducts_Call) RunAndReturn(run func(ctx context.Context, list domain.ListQuery, fn func([]domain.Product) error) error) *MockService_ExportProducts_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -249,28 +386,102 @@ func (_c *MockService_GetProduct_Call) RunAndReturn(run func(ctx context.Context
 	return _c
 }
 
+// GetProductOrders provides a mock function for the type MockService
+func (_mock *MockService) GetProductOrders(ctx context.Context, id string, page domain.PageRequest) (*domain.Page[domain.Order], error) {
+	ret := _mock.Called(ctx, id, page)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProductOrders")
+	}
+
+	var r0 *domain.Page[domain.Order]
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, domain.PageRequest) (*domain.Page[domain.Order], error)); ok {
+		return returnFunc(ctx, id, page)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, domain.PageRequest) *domain.Page[domain.Order]); ok {
+		r0 = returnFunc(ctx, id, page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Page[domain.Order])
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, domain.PageRequest) error); ok {
+		r1 = returnFunc(ctx, id, page)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockService_GetProductOrders_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProductOrders'
+type MockService_GetProductOrders_Call struct {
+	*mock.Call
+}
+
+// GetProductOrders is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - page domain.PageRequest
+func (_e *MockService_Expecter) GetProductOrders(ctx interface{}, id interface{}, page interface{}) *MockService_GetProductOrders_Call {
+	return &MockService_GetProductOrders_Call{Call: _e.mock.On("GetProductOrders", ctx, id, page)}
+}
+
+func (_c *MockService_GetProductOrders_Call) Run(run func(ctx context.Context, id string, page domain.PageRequest)) *MockService_GetProductOrders_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 domain.PageRequest
+		if args[2] != nil {
+			arg2 = args[2].(domain.PageRequest)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockService_GetProductOrders_Call) Return(page1 *domain.Page[domain.Order], err error) *MockService_GetProductOrders_Call {
+	_c.Call.Return(page1, err)
+	return _c
+}
+
+func (_c *MockService_GetProductOrders_Call) RunAndReturn(run func(ctx context.Context, id string, page domain.PageRequest) (*domain.Page[domain.Order], error)) *MockService_GetProductOrders_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetProducts provides a mock function for the type MockService
-func (_mock *MockService) GetProducts(ctx context.Context) ([]domain.Product, error) {
-	ret := _mock.Called(ctx)
+func (_mock *MockService) GetProducts(ctx context.Context, list domain.ListQuery, page domain.PageRequest) (*domain.Page[domain.Product], error) {
+	ret := _mock.Called(ctx, list, page)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetProducts")
 	}
 
-	var r0 []domain.Product
+	var r0 *domain.Page[domain.Product]
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]domain.Product, error)); ok {
-		return returnFunc(ctx)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.ListQuery, domain.PageRequest) (*domain.Page[domain.Product], error)); ok {
+		return returnFunc(ctx, list, page)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context) []domain.Product); ok {
-		r0 = returnFunc(ctx)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.ListQuery, domain.PageRequest) *domain.Page[domain.Product]); ok {
+		r0 = returnFunc(ctx, list, page)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]domain.Product)
+			r0 = ret.Get(0).(*domain.Page[domain.Product])
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = returnFunc(ctx)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.ListQuery, domain.PageRequest) error); ok {
+		r1 = returnFunc(ctx, list, page)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -284,36 +495,327 @@ type MockService_GetProducts_Call struct {
 
 // GetProducts is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *MockService_Expecter) GetProducts(ctx interface{}) *MockService_GetProducts_Call {
-	return &MockService_GetProducts_Call{Call: _e.mock.On("GetProducts", ctx)}
+//   - list domain.ListQuery
+//   - page domain.PageRequest
+func (_e *MockService_Expecter) GetProducts(ctx interface{}, list interface{}, page interface{}) *MockService_GetProducts_Call {
+	return &MockService_GetProducts_Call{Call: _e.mock.On("GetProducts", ctx, list, page)}
+}
+
+func (_c *MockService_GetProducts_Call) Run(run func(ctx context.Context, list domain.ListQuery, page domain.PageRequest)) *MockService_GetProducts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.ListQuery
+		if args[1] != nil {
+			arg1 = args[1].(domain.ListQuery)
+		}
+		var arg2 domain.PageRequest
+		if args[2] != nil {
+			arg2 = args[2].(domain.PageRequest)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockService_GetProducts_Call) Return(page1 *domain.Page[domain.Product], err error) *MockService_GetProducts_Call {
+	_c.Call.Return(page1, err)
+	return _c
+}
+
+func (_c *MockService_GetProducts_Call) RunAndReturn(run func(ctx context.Context, list domain.ListQuery, page domain.PageRequest) (*domain.Page[domain.Product], error)) *MockService_GetProducts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ImportProducts provides a mock function for the type MockService
+func (_mock *MockService) ImportProducts(ctx context.Context, rows []domain.ProductImportRow, dryRun bool) ([]domain.ImportResult, error) {
+	ret := _mock.Called(ctx, rows, dryRun)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ImportProducts")
+	}
+
+	var r0 []domain.ImportResult
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []domain.ProductImportRow, bool) ([]domain.ImportResult, error)); ok {
+		return returnFunc(ctx, rows, dryRun)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []domain.ProductImportRow, bool) []domain.ImportResult); ok {
+		r0 = returnFunc(ctx, rows, dryRun)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.ImportResult)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []domain.ProductImportRow, bool) error); ok {
+		r1 = returnFunc(ctx, rows, dryRun)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockService_ImportProducts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ImportProducts'
+type MockService_ImportProducts_Call struct {
+	*mock.Call
+}
+
+// ImportProducts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rows []domain.ProductImportRow
+//   - dryRun bool
+func (_e *MockService_Expecter) ImportProducts(ctx interface{}, rows interface{}, dryRun interface{}) *MockService_ImportProducts_Call {
+	return &MockService_ImportProducts_Call{Call: _e.mock.On("ImportProducts", ctx, rows, dryRun)}
+}
+
+func (_c *MockService_ImportProducts_Call) Run(run func(ctx context.Context, rows []domain.ProductImportRow, dryRun bool)) *MockService_ImportProducts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []domain.ProductImportRow
+		if args[1] != nil {
+			arg1 = args[1].([]domain.ProductImportRow)
+		}
+		var arg2 bool
+		if args[2] != nil {
+			arg2 = args[2].(bool)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockService_ImportProducts_Call) Return(importResults []domain.ImportResult, err error) *MockService_ImportProducts_Call {
+	_c.Call.Return(importResults, err)
+	return _c
+}
+
+func (_c *MockService_ImportProducts_Call) RunAndReturn(run func(ctx context.Context, rows []domain.ProductImportRow, dryRun bool) ([]domain.ImportResult, error)) *MockService_ImportProducts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PatchProduct provides a mock function for the type MockService
+func (_mock *MockService) PatchProduct(ctx context.Context, id string, version string, patch domain.ProductPatch) (*domain.Product, error) {
+	ret := _mock.Called(ctx, id, version, patch)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PatchProduct")
+	}
+
+	var r0 *domain.Product
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, domain.ProductPatch) (*domain.Product, error)); ok {
+		return returnFunc(ctx, id, version, patch)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, domain.ProductPatch) *domain.Product); ok {
+		r0 = returnFunc(ctx, id, version, patch)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Product)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, domain.ProductPatch) error); ok {
+		r1 = returnFunc(ctx, id, version, patch)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockService_PatchProduct_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PatchProduct'
+type MockService_PatchProduct_Call struct {
+	*mock.Call
+}
+
+// PatchProduct is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - version string
+//   - patch domain.ProductPatch
+func (_e *MockService_Expecter) PatchProduct(ctx interface{}, id interface{}, version interface{}, patch interface{}) *MockService_PatchProduct_Call {
+	return &MockService_PatchProduct_Call{Call: _e.mock.On("PatchProduct", ctx, id, version, patch)}
+}
+
+func (_c *MockService_PatchProduct_Call) Run(run func(ctx context.Context, id string, version string, patch domain.ProductPatch)) *MockService_PatchProduct_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 domain.ProductPatch
+		if args[3] != nil {
+			arg3 = args[3].(domain.ProductPatch)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockService_PatchProduct_Call) Return(product *domain.Product, err error) *MockService_PatchProduct_Call {
+	_c.Call.Return(product, err)
+	return _c
+}
+
+func (_c *MockService_PatchProduct_Call) RunAndReturn(run func(ctx context.Context, id string, version string, patch domain.ProductPatch) (*domain.Product, error)) *MockService_PatchProduct_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PurgeProduct provides a mock function for the type MockService
+func (_mock *MockService) PurgeProduct(ctx context.Context, id string) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PurgeProduct")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockService_PurgeProduct_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PurgeProduct'
+type MockService_PurgeProduct_Call struct {
+	*mock.Call
+}
+
+// PurgeProduct is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockService_Expecter) PurgeProduct(ctx interface{}, id interface{}) *MockService_PurgeProduct_Call {
+	return &MockService_PurgeProduct_Call{Call: _e.mock.On("PurgeProduct", ctx, id)}
+}
+
+func (_c *MockService_PurgeProduct_Call) Run(run func(ctx context.Context, id string)) *MockService_PurgeProduct_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockService_PurgeProduct_Call) Return(err error) *MockService_PurgeProduct_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockService_PurgeProduct_Call) RunAndReturn(run func(ctx context.Context, id string) error) *MockService_PurgeProduct_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RestoreProduct provides a mock function for the type MockService
+func (_mock *MockService) RestoreProduct(ctx context.Context, id string) (*domain.Product, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreProduct")
+	}
+
+	var r0 *domain.Product
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.Product, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.Product); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Product)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockService_RestoreProduct_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreProduct'
+type MockService_RestoreProduct_Call struct {
+	*mock.Call
+}
+
+// RestoreProduct is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockService_Expecter) RestoreProduct(ctx interface{}, id interface{}) *MockService_RestoreProduct_Call {
+	return &MockService_RestoreProduct_Call{Call: _e.mock.On("RestoreProduct", ctx, id)}
 }
 
-func (_c *MockService_GetProducts_Call) Run(run func(ctx context.Context)) *MockService_GetProducts_Call {
+func (_c *MockService_RestoreProduct_Call) Run(run func(ctx context.Context, id string)) *MockService_RestoreProduct_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *MockService_GetProducts_Call) Return(products []domain.Product, err error) *MockService_GetProducts_Call {
-	_c.Call.Return(products, err)
+func (_c *MockService_RestoreProduct_Call) Return(product *domain.Product, err error) *MockService_RestoreProduct_Call {
+	_c.Call.Return(product, err)
 	return _c
 }
 
-func (_c *MockService_GetProducts_Call) RunAndReturn(run func(ctx context.Context) ([]domain.Product, error)) *MockService_GetProducts_Call {
+func (_c *MockService_RestoreProduct_Call) RunAndReturn(run func(ctx context.Context, id string) (*domain.Product, error)) *MockService_RestoreProduct_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // UpdateProduct provides a mock function for the type MockService
-func (_mock *MockService) UpdateProduct(ctx context.Context, id string, name string, description string, price float64, stock int) (*domain.Product, error) {
-	ret := _mock.Called(ctx, id, name, description, price, stock)
+func (_mock *MockService) UpdateProduct(ctx context.Context, id string, version string, name string, description string, price domain.Money, stock int) (*domain.Product, error) {
+	ret := _mock.Called(ctx, id, version, name, description, price, stock)
 
 	if len(ret) == 0 {
 		panic("no return value specified for UpdateProduct")
@@ -321,18 +823,18 @@ func (_mock *MockService) UpdateProduct(ctx context.Context, id string, name str
 
 	var r0 *domain.Product
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string, float64, int) (*domain.Product, error)); ok {
-		return returnFunc(ctx, id, name, description, price, stock)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string, string, domain.Money, int) (*domain.Product, error)); ok {
+		return returnFunc(ctx, id, version, name, description, price, stock)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string, float64, int) *domain.Product); ok {
-		r0 = returnFunc(ctx, id, name, description, price, stock)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string, string, domain.Money, int) *domain.Product); ok {
+		r0 = returnFunc(ctx, id, version, name, description, price, stock)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*domain.Product)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, string, float64, int) error); ok {
-		r1 = returnFunc(ctx, id, name, description, price, stock)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, string, string, domain.Money, int) error); ok {
+		r1 = returnFunc(ctx, id, version, name, description, price, stock)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -347,15 +849,16 @@ type MockService_UpdateProduct_Call struct {
 // UpdateProduct is a helper method to define mock.On call
 //   - ctx context.Context
 //   - id string
+//   - version string
 //   - name string
 //   - description string
-//   - price float64
+//   - price domain.Money
 //   - stock int
-func (_e *MockService_Expecter) UpdateProduct(ctx interface{}, id interface{}, name interface{}, description interface{}, price interface{}, stock interface{}) *MockService_UpdateProduct_Call {
-	return &MockService_UpdateProduct_Call{Call: _e.mock.On("UpdateProduct", ctx, id, name, description, price, stock)}
+func (_e *MockService_Expecter) UpdateProduct(ctx interface{}, id interface{}, version interface{}, name interface{}, description interface{}, price interface{}, stock interface{}) *MockService_UpdateProduct_Call {
+	return &MockService_UpdateProduct_Call{Call: _e.mock.On("UpdateProduct", ctx, id, version, name, description, price, stock)}
 }
 
-func (_c *MockService_UpdateProduct_Call) Run(run func(ctx context.Context, id string, name string, description string, price float64, stock int)) *MockService_UpdateProduct_Call {
+func (_c *MockService_UpdateProduct_Call) Run(run func(ctx context.Context, id string, version string, name string, description string, price domain.Money, stock int)) *MockService_UpdateProduct_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -373,13 +876,17 @@ func (_c *MockService_UpdateProduct_Call) Run(run func(ctx context.Context, id s
 		if args[3] != nil {
 			arg3 = args[3].(string)
 		}
-		var arg4 float64
+		var arg4 string
 		if args[4] != nil {
-			arg4 = args[4].(float64)
+			arg4 = args[4].(string)
 		}
-		var arg5 int
+		var arg5 domain.Money
 		if args[5] != nil {
-			arg5 = args[5].(int)
+			arg5 = args[5].(domain.Money)
+		}
+		var arg6 int
+		if args[6] != nil {
+			arg6 = args[6].(int)
 		}
 		run(
 			arg0,
@@ -388,6 +895,7 @@ func (_c *MockService_UpdateProduct_Call) Run(run func(ctx context.Context, id s
 			arg3,
 			arg4,
 			arg5,
+			arg6,
 		)
 	})
 	return _c
@@ -398,7 +906,7 @@ func (_c *MockService_UpdateProduct_Call) Return(product *domain.Product, err er
 	return _c
 }
 
-func (_c *MockService_UpdateProduct_Call) RunAndReturn(run func(ctx context.Context, id string, name string, description string, price float64, stock int) (*domain.Product, error)) *MockService_UpdateProduct_Call {
+func (_c *MockService_UpdateProduct_Call) RunAndReturn(run func(ctx context.Context, id string, version string, name string, description string, price domain.Money, stock int) (*domain.Product, error)) *MockService_UpdateProduct_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -38,6 +38,74 @@ func (_m *MockService) EXPECT() *MockService_Expecter {
 	return &MockService_Expecter{mock: &_m.Mock}
 }
 
+// BatchUsers provides a mock function for the type MockService
+func (_mock *MockService) BatchUsers(ctx context.Context, batch domain.Batch[domain.User]) (*domain.BatchResults[domain.User], error) {
+	ret := _mock.Called(ctx, batch)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BatchUsers")
+	}
+
+	var r0 *domain.BatchResults[domain.User]
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Batch[domain.User]) (*domain.BatchResults[domain.User], error)); ok {
+		return returnFunc(ctx, batch)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Batch[domain.User]) *domain.BatchResults[domain.User]); ok {
+		r0 = returnFunc(ctx, batch)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.BatchResults[domain.User])
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Batch[domain.User]) error); ok {
+		r1 = returnFunc(ctx, batch)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockService_BatchUsers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BatchUsers'
+type MockService_BatchUsers_Call struct {
+	*mock.Call
+}
+
+// BatchUsers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - batch domain.Batch[domain.User]
+func (_e *MockService_Expecter) BatchUsers(ctx interface{}, batch interface{}) *MockService_BatchUsers_Call {
+	return &MockService_BatchUsers_Call{Call: _e.mock.On("BatchUsers", ctx, batch)}
+}
+
+func (_c *MockService_BatchUsers_Call) Run(run func(ctx context.Context, batch domain.Batch[domain.User])) *MockService_BatchUsers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Batch[domain.User]
+		if args[1] != nil {
+			arg1 = args[1].(domain.Batch[domain.User])
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockService_BatchUsers_Call) Return(batchResults *domain.BatchResults[domain.User], err error) *MockService_BatchUsers_Call {
+	_c.Call.Return(batchResults, err)
+	return _c
+}
+
+func (_c *MockService_BatchUsers_Call) RunAndReturn(run func(ctx context.Context, batch domain.Batch[domain.User]) (*domain.BatchResults[domain.User], error)) *MockService_BatchUsers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateUser provides a mock function for the type MockService
 func (_mock *MockService) CreateUser(ctx context.Context, name string, email string) (*domain.User, error) {
 	ret := _mock.Called(ctx, name, email)
@@ -113,16 +181,16 @@ func (_c *MockService_CreateUser_Call) RunAndReturn(run func(ctx context.Context
 }
 
 // DeleteUser provides a mock function for the type MockService
-func (_mock *MockService) DeleteUser(ctx context.Context, id string) error {
-	ret := _mock.Called(ctx, id)
+func (_mock *MockService) DeleteUser(ctx context.Context, id string, version string) error {
+	ret := _mock.Called(ctx, id, version)
 
 	if len(ret) == 0 {
 		panic("no return value specified for DeleteUser")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
-		r0 = returnFunc(ctx, id)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, id, version)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -137,11 +205,12 @@ type MockService_DeleteUser_Call struct {
 // DeleteUser is a helper method to define mock.On call
 //   - ctx context.Context
 //   - id string
-func (_e *MockService_Expecter) DeleteUser(ctx interface{}, id interface{}) *MockService_DeleteUser_Call {
-	return &MockService_DeleteUser_Call{Call: _e.mock.On("DeleteUser", ctx, id)}
+//   - version string
+func (_e *MockService_Expecter) DeleteUser(ctx interface{}, id interface{}, version interface{}) *MockService_DeleteUser_Call {
+	return &MockService_DeleteUser_Call{Call: _e.mock.On("DeleteUser", ctx, id, version)}
 }
 
-func (_c *MockService_DeleteUser_Call) Run(run func(ctx context.Context, id string)) *MockService_DeleteUser_Call {
+func (_c *MockService_DeleteUser_Call) Run(run func(ctx context.Context, id string, version string)) *MockService_DeleteUser_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -151,9 +220,14 @@ func (_c *MockService_DeleteUser_Call) Run(run func(ctx context.Context, id stri
 		if args[1] != nil {
 			arg1 = args[1].(string)
 		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
@@ -164,7 +238,70 @@ func (_c *MockService_DeleteUser_Call) Return(err error) *MockService_DeleteUser
 	return _c
 }
 
-func (_c *MockService_DeleteUser_Call) RunAndReturn(run func(ctx context.Context, id string) error) *MockService_DeleteUser_Call {
+func (_c *MockService_DeleteUser_Call) RunAndReturn(run func(ctx context.Context, id string, version string) error) *MockService_DeleteUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExportUsers provides a mock function for the type MockService
+func (_mock *MockService) ExportUsers(ctx context.Context, list domain.ListQuery, fn func([]domain.User) error) error {
+	ret := _mock.Called(ctx, list, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportUsers")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.ListQuery, func([]domain.User) error) error); ok {
+		r0 = returnFunc(ctx, list, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockService_ExportUsers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportUsers'
+type MockService_ExportUsers_Call struct {
+	*mock.Call
+}
+
+// ExportUsers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - list domain.ListQuery
+//   - fn func([]domain.User) error
+func (_e *MockService_Expecter) ExportUsers(ctx interface{}, list interface{}, fn interface{}) *MockService_ExportUsers_Call {
+	return &MockService_ExportUsers_Call{Call: _e.mock.On("ExportUsers", ctx, list, fn)}
+}
+
+func (_c *MockService_ExportUsers_Call) Run(run func(ctx context.Context, list domain.ListQuery, fn func([]domain.User) error)) *MockService_ExportUsers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.ListQuery
+		if args[1] != nil {
+			arg1 = args[1].(domain.ListQuery)
+		}
+		var arg2 func([]domain.User) error
+		if args[2] != nil {
+			arg2 = args[2].(func([]domain.User) error)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockService_ExportUsers_Call) Return(err error) *MockService_ExportUsers_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockService_ExportUsers_Call) RunAndReturn(run func(ctx context.Context, list domain.ListQuery, fn func([]domain.User) error) error) *MockService_ExportUsers_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -237,28 +374,102 @@ func (_c *MockService_GetUser_Call) RunAndReturn(run func(ctx context.Context, i
 	return _c
 }
 
+// GetUserOrders provides a mock function for the type MockService
+func (_mock *MockService) GetUserOrders(ctx context.Context, id string, page domain.PageRequest) (*domain.Page[domain.Order], error) {
+	ret := _mock.Called(ctx, id, page)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserOrders")
+	}
+
+	var r0 *domain.Page[domain.Order]
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, domain.PageRequest) (*domain.Page[domain.Order], error)); ok {
+		return returnFunc(ctx, id, page)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, domain.PageRequest) *domain.Page[domain.Order]); ok {
+		r0 = returnFunc(ctx, id, page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Page[domain.Order])
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, domain.PageRequest) error); ok {
+		r1 = returnFunc(ctx, id, page)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockService_GetUserOrders_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserOrders'
+type MockService_GetUserOrders_Call struct {
+	*mock.Call
+}
+
+// GetUserOrders is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - page domain.PageRequest
+func (_e *MockService_Expecter) GetUserOrders(ctx interface{}, id interface{}, page interface{}) *MockService_GetUserOrders_Call {
+	return &MockService_GetUserOrders_Call{Call: _e.mock.On("GetUserOrders", ctx, id, page)}
+}
+
+func (_c *MockService_GetUserOrders_Call) Run(run func(ctx context.Context, id string, page domain.PageRequest)) *MockService_GetUserOrders_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 domain.PageRequest
+		if args[2] != nil {
+			arg2 = args[2].(domain.PageRequest)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockService_GetUserOrders_Call) Return(page1 *domain.Page[domain.Order], err error) *MockService_GetUserOrders_Call {
+	_c.Call.Return(page1, err)
+	return _c
+}
+
+func (_c *MockService_GetUserOrders_Call) RunAndReturn(run func(ctx context.Context, id string, page domain.PageRequest) (*domain.Page[domain.Order], error)) *MockService_GetUserOrders_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetUsers provides a mock function for the type MockService
-func (_mock *MockService) GetUsers(ctx context.Context) ([]domain.User, error) {
-	ret := _mock.Called(ctx)
+func (_mock *MockService) GetUsers(ctx context.Context, list domain.ListQuery, page domain.PageRequest) (*domain.Page[domain.User], error) {
+	ret := _mock.Called(ctx, list, page)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetUsers")
 	}
 
-	var r0 []domain.User
+	var r0 *domain.Page[domain.User]
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]domain.User, error)); ok {
-		return returnFunc(ctx)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.ListQuery, domain.PageRequest) (*domain.Page[domain.User], error)); ok {
+		return returnFunc(ctx, list, page)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context) []domain.User); ok {
-		r0 = returnFunc(ctx)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.ListQuery, domain.PageRequest) *domain.Page[domain.User]); ok {
+		r0 = returnFunc(ctx, list, page)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]domain.User)
+			r0 = ret.Get(0).(*domain.Page[domain.User])
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = returnFunc(ctx)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.ListQuery, domain.PageRequest) error); ok {
+		r1 = returnFunc(ctx, list, page)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -272,36 +483,173 @@ type MockService_GetUsers_Call struct {
 
 // GetUsers is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *MockService_Expecter) GetUsers(ctx interface{}) *MockService_GetUsers_Call {
-	return &MockService_GetUsers_Call{Call: _e.mock.On("GetUsers", ctx)}
+//   - list domain.ListQuery
+//   - page domain.PageRequest
+func (_e *MockService_Expecter) GetUsers(ctx interface{}, list interface{}, page interface{}) *MockService_GetUsers_Call {
+	return &MockService_GetUsers_Call{Call: _e.mock.On("GetUsers", ctx, list, page)}
 }
 
-func (_c *MockService_GetUsers_Call) Run(run func(ctx context.Context)) *MockService_GetUsers_Call {
+func (_c *MockService_GetUsers_Call) Run(run func(ctx context.Context, list domain.ListQuery, page domain.PageRequest)) *MockService_GetUsers_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
+		var arg1 domain.ListQuery
+		if args[1] != nil {
+			arg1 = args[1].(domain.ListQuery)
+		}
+		var arg2 domain.PageRequest
+		if args[2] != nil {
+			arg2 = args[2].(domain.PageRequest)
+		}
 		run(
 			arg0,
+			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockService_GetUsers_Call) Return(users []domain.User, err error) *MockService_GetUsers_Call {
-	_c.Call.Return(users, err)
+func (_c *MockService_GetUsers_Call) Return(page1 *domain.Page[domain.User], err error) *MockService_GetUsers_Call {
+	_c.Call.Return(page1, err)
 	return _c
 }
 
-func (_c *MockService_GetUsers_Call) RunAndReturn(run func(ctx context.Context) ([]domain.User, error)) *MockService_GetUsers_Call {
+func (_c *MockService_GetUsers_Call) RunAndReturn(run func(ctx context.Context, list domain.ListQuery, page domain.PageRequest) (*domain.Page[domain.User], error)) *MockService_GetUsers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PurgeUser provides a mock function for the type MockService
+func (_mock *MockService) PurgeUser(ctx context.Context, id string) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PurgeUser")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockService_PurgeUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PurgeUser'
+type MockService_PurgeUser_Call struct {
+	*mock.Call
+}
+
+// PurgeUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockService_Expecter) PurgeUser(ctx interface{}, id interface{}) *MockService_PurgeUser_Call {
+	return &MockService_PurgeUser_Call{Call: _e.mock.On("PurgeUser", ctx, id)}
+}
+
+func (_c *MockService_PurgeUser_Call) Run(run func(ctx context.Context, id string)) *MockService_PurgeUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockService_PurgeUser_Call) Return(err error) *MockService_PurgeUser_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockService_PurgeUser_Call) RunAndReturn(run func(ctx context.Context, id string) error) *MockService_PurgeUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RestoreUser provides a mock function for the type MockService
+func (_mock *MockService) RestoreUser(ctx context.Context, id string) (*domain.User, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreUser")
+	}
+
+	var r0 *domain.User
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.User, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.User); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.User)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockService_RestoreUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreUser'
+type MockService_RestoreUser_Call struct {
+	*mock.Call
+}
+
+// RestoreUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockService_Expecter) RestoreUser(ctx interface{}, id interface{}) *MockService_RestoreUser_Call {
+	return &MockService_RestoreUser_Call{Call: _e.mock.On("RestoreUser", ctx, id)}
+}
+
+func (_c *MockService_RestoreUser_Call) Run(run func(ctx context.Context, id string)) *MockService_RestoreUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockService_RestoreUser_Call) Return(user *domain.User, err error) *MockService_RestoreUser_Call {
+	_c.Call.Return(user, err)
+	return _c
+}
+
+func (_c *MockService_RestoreUser_Call) RunAndReturn(run func(ctx context.Context, id string) (*domain.User, error)) *MockService_RestoreUser_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // UpdateUser provides a mock function for the type MockService
-func (_mock *MockService) UpdateUser(ctx context.Context, id string, name string, email string) (*domain.User, error) {
-	ret := _mock.Called(ctx, id, name, email)
+func (_mock *MockService) UpdateUser(ctx context.Context, id string, version string, name string, email string) (*domain.User, error) {
+	ret := _mock.Called(ctx, id, version, name, email)
 
 	if len(ret) == 0 {
 		panic("no return value specified for UpdateUser")
@@ -309,18 +657,18 @@ func (_mock *MockService) UpdateUser(ctx context.Context, id string, name string
 
 	var r0 *domain.User
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) (*domain.User, error)); ok {
-		return returnFunc(ctx, id, name, email)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string, string) (*domain.User, error)); ok {
+		return returnFunc(ctx, id, version, name, email)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) *domain.User); ok {
-		r0 = returnFunc(ctx, id, name, email)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string, string) *domain.User); ok {
+		r0 = returnFunc(ctx, id, version, name, email)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*domain.User)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
-		r1 = returnFunc(ctx, id, name, email)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, string, string) error); ok {
+		r1 = returnFunc(ctx, id, version, name, email)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -335,13 +683,14 @@ type MockService_UpdateUser_Call struct {
 // UpdateUser is a helper method to define mock.On call
 //   - ctx context.Context
 //   - id string
+//   - version string
 //   - name string
 //   - email string
-func (_e *MockService_Expecter) UpdateUser(ctx interface{}, id interface{}, name interface{}, email interface{}) *MockService_UpdateUser_Call {
-	return &MockService_UpdateUser_Call{Call: _e.mock.On("UpdateUser", ctx, id, name, email)}
+func (_e *MockService_Expecter) UpdateUser(ctx interface{}, id interface{}, version interface{}, name interface{}, email interface{}) *MockService_UpdateUser_Call {
+	return &MockService_UpdateUser_Call{Call: _e.mock.On("UpdateUser", ctx, id, version, name, email)}
 }
 
-func (_c *MockService_UpdateUser_Call) Run(run func(ctx context.Context, id string, name string, email string)) *MockService_UpdateUser_Call {
+func (_c *MockService_UpdateUser_Call) Run(run func(ctx context.Context, id string, version string, name string, email string)) *MockService_UpdateUser_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -359,11 +708,16 @@ func (_c *MockService_UpdateUser_Call) Run(run func(ctx context.Context, id stri
 		if args[3] != nil {
 			arg3 = args[3].(string)
 		}
+		var arg4 string
+		if args[4] != nil {
+			arg4 = args[4].(string)
+		}
 		run(
 			arg0,
 			arg1,
 			arg2,
 			arg3,
+			arg4,
 		)
 	})
 	return _c
@@ -374,7 +728,7 @@ func (_c *MockService_UpdateUser_Call) Return(user *domain.User, err error) *Moc
 	return _c
 }
 
-func (_c *MockService_UpdateUser_Call) RunAndReturn(run func(ctx context.Context, id string, name string, email string) (*domain.User, error)) *MockService_UpdateUser_Call {
+func (_c *MockService_UpdateUser_Call) RunAndReturn(run func(ctx context.Context, id string, version string, name string, email string) (*domain.User, error)) *MockService_UpdateUser_Call {
 	_c.Call.Return(run)
 	return _c
 }
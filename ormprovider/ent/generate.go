@@ -0,0 +1,4 @@
+package ent
+
+//go:generate go run -mod=mod entc.go
+//go:generate sh -c "cd graphql && go run -mod=mod github.com/99designs/gqlgen generate"
@@ -0,0 +1,37 @@
+package graphql
+
+import (
+	"fmt"
+)
+
+// baseCurrency is the currency product prices are kept in
+const baseCurrency = "THB"
+
+// currencyDigits holds the number of decimal places of each currency
+// amounts are stored in
+var currencyDigits = map[string]int{
+	"THB": 2,
+	"USD": 2,
+	"EUR": 2,
+}
+
+// newMoney formats an amount in minor units of currency as a decimal
+// string, so that no precision is lost on the way to the client
+func newMoney(minor int64, currency string) *Money {
+	digits := currencyDigits[currency]
+	sign := ""
+	if minor < 0 {
+		sign = "-"
+		minor = -minor
+	}
+
+	amount := fmt.Sprint(minor)
+	if digits > 0 {
+		scale := int64(1)
+		for range digits {
+			scale *= 10
+		}
+		amount = fmt.Sprintf("%d.%0*d", minor/scale, digits, minor%scale)
+	}
+	return &Money{Amount: sign + amount, Currency: currency}
+}
@@ -0,0 +1,44 @@
+package graphql
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.81
+
+import (
+	"context"
+
+	"github.com/snilli/ormprovider/ent"
+)
+
+// TotalPrice is the resolver for the totalPrice field.
+func (r *orderResolver) TotalPrice(ctx context.Context, obj *ent.Order) (*Money, error) {
+	return newMoney(obj.TotalPrice, obj.Currency), nil
+}
+
+// Discount is the resolver for the discount field.
+func (r *orderResolver) Discount(ctx context.Context, obj *ent.Order) (*Money, error) {
+	return newMoney(obj.Discount, obj.Currency), nil
+}
+
+// UnitPrice is the resolver for the unitPrice field.
+func (r *orderItemResolver) UnitPrice(ctx context.Context, obj *ent.OrderItem) (*Money, error) {
+	o, err := obj.Order(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return newMoney(obj.UnitPrice, o.Currency), nil
+}
+
+// LineTotal is the resolver for the lineTotal field.
+func (r *orderItemResolver) LineTotal(ctx context.Context, obj *ent.OrderItem) (*Money, error) {
+	o, err := obj.Order(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return newMoney(obj.LineTotal, o.Currency), nil
+}
+
+// Price is the resolver for the price field.
+func (r *productResolver) Price(ctx context.Context, obj *ent.Product) (*Money, error) {
+	return newMoney(obj.Price, baseCurrency), nil
+}
@@ -0,0 +1,23 @@
+package graphql
+
+import "testing"
+
+func TestNewMoney(t *testing.T) {
+	tests := []struct {
+		minor    int64
+		currency string
+		want     string
+	}{
+		{minor: 149997, currency: "THB", want: "1499.97"},
+		{minor: 5, currency: "USD", want: "0.05"},
+		{minor: -1050, currency: "EUR", want: "-10.50"},
+		{minor: 0, currency: "THB", want: "0.00"},
+	}
+
+	for _, tt := range tests {
+		got := newMoney(tt.minor, tt.currency)
+		if got.Amount != tt.want || got.Currency != tt.currency {
+			t.Errorf("newMoney(%d, %s) = %s %s, want %s %s", tt.minor, tt.currency, got.Amount, got.Currency, tt.want, tt.currency)
+		}
+	}
+}
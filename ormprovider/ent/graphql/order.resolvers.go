@@ -0,0 +1,19 @@
+package graphql
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.81
+
+import (
+	"context"
+
+	"github.com/snilli/ormprovider/ent"
+	"github.com/snilli/ormprovider/ent/orderevent"
+)
+
+// History is the resolver for the history field.
+func (r *orderResolver) History(ctx context.Context, obj *ent.Order) ([]*ent.OrderEvent, error) {
+	return obj.QueryEvents().
+		Order(ent.Asc(orderevent.FieldCreatedAt), ent.Asc(orderevent.FieldID)).
+		All(ctx)
+}
@@ -0,0 +1,112 @@
+package graphql
+
+import (
+	"context"
+	"testing"
+
+	"github.com/99designs/gqlgen/client"
+	"github.com/99designs/gqlgen/graphql/handler"
+	_ "github.com/mattn/go-sqlite3"
+
+	"github.com/snilli/ormprovider/ent"
+	"github.com/snilli/ormprovider/ent/enttest"
+)
+
+// newTestClient opens an ent client on a private in-memory database and
+// a GraphQL client executing queries against it
+func newTestClient(t *testing.T) (*ent.Client, *client.Client) {
+	db := enttest.Open(t, "sqlite3", "file:"+t.Name()+"?mode=memory&_fk=1")
+	t.Cleanup(func() { _ = db.Close() })
+
+	srv := handler.NewDefaultServer(NewExecutableSchema(Config{Resolvers: NewResolver(db)}))
+	return db, client.New(srv)
+}
+
+func TestOrderHistory(t *testing.T) {
+	ctx := context.Background()
+	db, c := newTestClient(t)
+
+	o := db.Order.Create().SetTotalPrice(10000).SetStatus("paid").SaveX(ctx)
+	db.OrderEvent.Create().SetOrderID(o.ID).SetToStatus("pending").ExecX(ctx)
+	db.OrderEvent.Create().SetOrderID(o.ID).SetFromStatus("pending").SetToStatus("paid").SetActor("admin").SetReason("bank transfer").ExecX(ctx)
+
+	var resp struct {
+		Orders struct {
+			Edges []struct {
+				Node struct {
+					History []struct {
+						FromStatus string
+						ToStatus   string
+						Actor      string
+						Reason     string
+					}
+				}
+			}
+		}
+	}
+	c.MustPost(`{ orders { edges { node { history { fromStatus toStatus actor reason } } } } }`, &resp)
+
+	if len(resp.Orders.Edges) != 1 {
+		t.Fatalf("got %d orders, want 1", len(resp.Orders.Edges))
+	}
+	history := resp.Orders.Edges[0].Node.History
+	if len(history) != 2 {
+		t.Fatalf("got %d events, want 2", len(history))
+	}
+	if history[0].ToStatus != "pending" || history[1].FromStatus != "pending" || history[1].ToStatus != "paid" {
+		t.Errorf("history is not in order: %+v", history)
+	}
+	if history[1].Actor != "admin" || history[1].Reason != "bank transfer" {
+		t.Errorf("got actor %q and reason %q, want admin and bank transfer", history[1].Actor, history[1].Reason)
+	}
+}
+
+func TestOrderItems(t *testing.T) {
+	ctx := context.Background()
+	db, c := newTestClient(t)
+
+	p := db.Product.Create().SetName("Keyboard").SetPrice(149900).SetStock(5).SaveX(ctx)
+	o := db.Order.Create().SetTotalPrice(8250).SetCurrency("USD").SetExchangeRate("0.0275").SetStatus("pending").SaveX(ctx)
+	db.OrderItem.Create().SetOrderID(o.ID).SetProductID(p.ID).SetQuantity(2).SetUnitPrice(4125).SetLineTotal(8250).ExecX(ctx)
+
+	var resp struct {
+		Orders struct {
+			Edges []struct {
+				Node struct {
+					TotalPrice struct{ Amount, Currency string }
+					Items      []struct {
+						Quantity  int
+						UnitPrice struct{ Amount, Currency string }
+						LineTotal struct{ Amount, Currency string }
+						Product   struct {
+							Name  string
+							Price struct{ Amount, Currency string }
+						}
+					}
+				}
+			}
+		}
+	}
+	c.MustPost(`{ orders { edges { node {
+		totalPrice { amount currency }
+		items { quantity unitPrice { amount currency } lineTotal { amount currency } product { name price { amount currency } } }
+	} } } }`, &resp)
+
+	if len(resp.Orders.Edges) != 1 {
+		t.Fatalf("got %d orders, want 1", len(resp.Orders.Edges))
+	}
+	node := resp.Orders.Edges[0].Node
+	if node.TotalPrice.Amount != "82.50" || node.TotalPrice.Currency != "USD" {
+		t.Errorf("got total %+v, want 82.50 USD", node.TotalPrice)
+	}
+	if len(node.Items) != 1 {
+		t.Fatalf("got %d items, want 1", len(node.Items))
+	}
+	item := node.Items[0]
+	if item.Quantity != 2 || item.UnitPrice.Amount != "41.25" || item.LineTotal.Amount != "82.50" || item.LineTotal.Currency != "USD" {
+		t.Errorf("got item %+v, want 2 at 41.25 USD for 82.50 USD", item)
+	}
+	if item.Product.Name != "Keyboard" || item.Product.Price.Amount != "1499.00" || item.Product.Price.Currency != "THB" {
+		t.Errorf("got product %+v, want Keyboard at 1499.00 THB", item.Product)
+	}
+}
@@ -0,0 +1,15 @@
+package graphql
+
+import (
+	"github.com/snilli/ormprovider/ent"
+)
+
+// Resolver is the root GraphQL resolver, backed by the ent client
+type Resolver struct {
+	client *ent.Client
+}
+
+// NewResolver creates the root GraphQL resolver
+func NewResolver(client *ent.Client) *Resolver {
+	return &Resolver{client: client}
+}
@@ -0,0 +1,56 @@
+package schema
+
+import (
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// Coupon holds the schema definition for the Coupon entity. Amounts are
+// in minor units of the base currency.
+type Coupon struct {
+	ent.Schema
+}
+
+// Fields of the Coupon.
+func (Coupon) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("code").
+			Unique(),
+		field.String("type"),
+		field.Int("percent").
+			Default(0),
+		field.Int64("amount").
+			Default(0),
+		field.Int64("min_order_value").
+			Default(0),
+		field.Int("usage_limit").
+			Default(0),
+		field.Int("per_user_limit").
+			Default(0),
+		field.Int("redemptions").
+			Default(0),
+		field.Time("starts_at").
+			Optional().
+			Nillable(),
+		field.Time("ends_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Edges of the Coupon.
+func (Coupon) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("uses", CouponRedemption.Type),
+	}
+}
+
+// Annotations of the Coupon.
+func (Coupon) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.Skip(),
+	}
+}
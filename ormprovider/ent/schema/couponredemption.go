@@ -0,0 +1,54 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// CouponRedemption holds the schema definition for the CouponRedemption
+// entity: the use of a coupon on an order. Discount is in minor units of
+// the order currency.
+type CouponRedemption struct {
+	ent.Schema
+}
+
+// Fields of the CouponRedemption.
+func (CouponRedemption) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("coupon_id"),
+		field.Int("user_id"),
+		field.Int("order_id"),
+		field.Int64("discount"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the CouponRedemption.
+func (CouponRedemption) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("coupon", Coupon.Type).
+			Ref("uses").
+			Field("coupon_id").
+			Unique().
+			Required(),
+		edge.From("order", Order.Type).
+			Ref("redemptions").
+			Field("order_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Annotations of the CouponRedemption.
+func (CouponRedemption) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.Skip(),
+	}
+}
@@ -0,0 +1,34 @@
+package schema
+
+import (
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+)
+
+// ExchangeRate holds the schema definition for the ExchangeRate entity:
+// the rate at which prices in the base currency are converted to a
+// currency. The rate is a decimal string so that it is stored exactly.
+type ExchangeRate struct {
+	ent.Schema
+}
+
+// Fields of the ExchangeRate.
+func (ExchangeRate) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("currency").
+			NotEmpty().
+			Unique(),
+		field.String("rate").
+			NotEmpty(),
+		field.Time("updated_at"),
+	}
+}
+
+// Annotations of the ExchangeRate.
+func (ExchangeRate) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.Skip(),
+	}
+}
@@ -0,0 +1,38 @@
+package schema
+
+import (
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+)
+
+// IdempotencyKey holds the schema definition for the IdempotencyKey
+// entity: a key sent with a write request and, once the request
+// completes, its response.
+type IdempotencyKey struct {
+	ent.Schema
+}
+
+// Fields of the IdempotencyKey.
+func (IdempotencyKey) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("key").
+			Unique(),
+		field.String("fingerprint"),
+		field.Int("status").
+			Default(0),
+		field.JSON("header", map[string]string{}).
+			Optional(),
+		field.Bytes("body").
+			Optional(),
+		field.Time("expires_at"),
+	}
+}
+
+// Annotations of the IdempotencyKey.
+func (IdempotencyKey) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.Skip(),
+	}
+}
@@ -0,0 +1,77 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// Order holds the schema definition for the Order entity.
+type Order struct {
+	ent.Schema
+}
+
+// Fields of the Order. Amounts are in minor units of the order currency;
+// exchange_rate is the decimal rate from the base currency used at
+// checkout. base_total is total_price converted back to the base currency
+// at that rate, so reports can sum orders placed in different currencies.
+// version counts the writes to the order and its items, so that a write
+// can be made conditional on the order not having changed since it was
+// read.
+func (Order) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("user_id").
+			Optional(),
+		field.Int64("total_price").
+			Annotations(entgql.Skip()),
+		field.Int64("base_total").
+			Default(0).
+			Annotations(entgql.Skip()),
+		field.String("currency").
+			Default("THB"),
+		field.String("exchange_rate").
+			Default("1"),
+		field.String("coupon_code").
+			Default(""),
+		field.Int64("discount").
+			Default(0).
+			Annotations(entgql.Skip()),
+		field.String("status"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("deleted_at").
+			Optional().
+			Nillable(),
+		field.Int("version").
+			Default(0).
+			Annotations(entgql.Skip()),
+	}
+}
+
+// Edges of the Order.
+func (Order) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("orders").
+			Field("user_id").
+			Unique(),
+		edge.To("items", OrderItem.Type),
+		edge.To("events", OrderEvent.Type).
+			Annotations(entgql.Skip()),
+		edge.To("redemptions", CouponRedemption.Type).
+			Annotations(entgql.Skip()),
+	}
+}
+
+// Annotations of the Order.
+func (Order) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.QueryField(),
+		entgql.RelayConnection(),
+	}
+}
@@ -0,0 +1,43 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// OrderEvent holds the schema definition for the OrderEvent entity: a
+// change of the status of an order.
+type OrderEvent struct {
+	ent.Schema
+}
+
+// Fields of the OrderEvent.
+func (OrderEvent) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("order_id"),
+		field.String("from_status").
+			Default(""),
+		field.String("to_status"),
+		field.String("actor").
+			Default(""),
+		field.String("reason").
+			Default(""),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the OrderEvent.
+func (OrderEvent) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("order", Order.Type).
+			Ref("events").
+			Field("order_id").
+			Unique().
+			Required(),
+	}
+}
@@ -0,0 +1,47 @@
+package schema
+
+import (
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// OrderItem holds the schema definition for the OrderItem entity.
+type OrderItem struct {
+	ent.Schema
+}
+
+// Fields of the OrderItem. Prices are in minor units of the order
+// currency; base_total is the item's share of the base_total of its order.
+func (OrderItem) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("order_id"),
+		field.Int("product_id").
+			Optional(),
+		field.Int("quantity").
+			Positive(),
+		field.Int64("unit_price").
+			Annotations(entgql.Skip()),
+		field.Int64("line_total").
+			Annotations(entgql.Skip()),
+		field.Int64("base_total").
+			Default(0).
+			Annotations(entgql.Skip()),
+	}
+}
+
+// Edges of the OrderItem.
+func (OrderItem) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("order", Order.Type).
+			Ref("items").
+			Field("order_id").
+			Unique().
+			Required(),
+		edge.From("product", Product.Type).
+			Ref("order_items").
+			Field("product_id").
+			Unique(),
+	}
+}
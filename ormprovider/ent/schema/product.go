@@ -0,0 +1,47 @@
+package schema
+
+import (
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// Product holds the schema definition for the Product entity.
+type Product struct {
+	ent.Schema
+}
+
+// Fields of the Product. Price is in minor units of the base currency.
+func (Product) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			NotEmpty(),
+		field.String("description").
+			Default(""),
+		field.Int64("price").
+			Annotations(entgql.Skip()),
+		field.Int("stock").
+			Default(0),
+		field.Time("deleted_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Edges of the Product.
+func (Product) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("order_items", OrderItem.Type).
+			Annotations(entgql.Skip()),
+	}
+}
+
+// Annotations of the Product.
+func (Product) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.QueryField(),
+		entgql.RelayConnection(),
+	}
+}
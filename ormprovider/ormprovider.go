@@ -0,0 +1,49 @@
+// Package ormprovider provides the ent client of the application database
+// and runs its schema migration on connect.
+package ormprovider
+
+import (
+	"context"
+	"fmt"
+
+	_ "github.com/lib/pq"
+
+	"github.com/snilli/ormprovider/ent"
+)
+
+// Client wraps the generated ent client
+type Client struct {
+	*ent.Client
+}
+
+// Config holds the PostgreSQL connection settings
+type Config struct {
+	Host     string
+	Port     string
+	User     string
+	Password string
+	DBName   string
+	SSLMode  string
+}
+
+// DSN returns the PostgreSQL connection string of the config
+func (c Config) DSN() string {
+	return fmt.Sprintf("host=%s port=%s user=%s password=%s dbname=%s sslmode=%s",
+		c.Host, c.Port, c.User, c.Password, c.DBName, c.SSLMode)
+}
+
+// NewClient connects to the database and migrates its schema to the one
+// the client was generated from
+func NewClient(ctx context.Context, cfg Config, opts ...ent.Option) (*Client, error) {
+	client, err := ent.Open("postgres", cfg.DSN(), opts...)
+	if err != nil {
+		return nil, fmt.Errorf("opening database: %w", err)
+	}
+
+	if err := client.Schema.Create(ctx); err != nil {
+		_ = client.Close()
+		return nil, fmt.Errorf("migrating schema: %w", err)
+	}
+
+	return &Client{Client: client}, nil
+}